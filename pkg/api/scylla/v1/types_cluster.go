@@ -102,6 +102,11 @@ type ScyllaClusterSpec struct {
 	// +optional
 	Backups []BackupTaskSpec `json:"backups,omitempty"`
 
+	// restores specifies restore tasks in Scylla Manager.
+	// When Scylla Manager is not installed, these will be ignored.
+	// +optional
+	Restores []RestoreTaskSpec `json:"restores,omitempty"`
+
 	// forceRedeploymentReason can be used to force a rolling update of all racks by providing a unique string.
 	// +optional
 	ForceRedeploymentReason string `json:"forceRedeploymentReason,omitempty"`
@@ -436,6 +441,50 @@ type BackupTaskSpec struct {
 	UploadParallel []string `json:"uploadParallel,omitempty"`
 }
 
+type RestoreTaskSpec struct {
+	TaskSpec `json:",inline"`
+
+	// location is a list of backup locations in the format [<dc>:]<provider>:<name> ex. s3:my-bucket,
+	// from which the snapshot is restored.
+	Location []string `json:"location"`
+
+	// snapshotTag is the tag of the snapshot to restore, e.g. sm_20240101000000UTC.
+	SnapshotTag string `json:"snapshotTag"`
+
+	// keyspace is a list of keyspace/tables glob patterns,
+	// e.g. 'keyspace,!keyspace.table_prefix_*' used to include or exclude keyspaces from restore.
+	// +optional
+	Keyspace []string `json:"keyspace,omitempty"`
+
+	// restoreSchema specifies that the task restores the schema, which is the first phase of a restore.
+	// Exactly one of restoreSchema and restoreTables has to be set.
+	// +optional
+	RestoreSchema bool `json:"restoreSchema,omitempty"`
+
+	// restoreTables specifies that the task restores the data of tables, which is the second phase of a restore.
+	// It requires the schema to be restored already.
+	// Exactly one of restoreSchema and restoreTables has to be set.
+	// +optional
+	RestoreTables bool `json:"restoreTables,omitempty"`
+
+	// batchSize is the number of SSTables per shard that are restored by a node in a single batch.
+	// +kubebuilder:default:=2
+	// +optional
+	BatchSize int64 `json:"batchSize,omitempty"`
+
+	// parallel is the maximum number of Scylla restore jobs that can run at the same time (on different SSTables).
+	// Each node can take part in at most one restore job at any given moment.
+	// +kubebuilder:default:=1
+	// +optional
+	Parallel int64 `json:"parallel,omitempty"`
+
+	// rateLimit is a list of megabytes (MiB) per second rate limits expressed in the format [<dc>:]<limit>.
+	// The <dc>: part is optional and only needed when different datacenters need different download limits.
+	// Set to 0 for no limit.
+	// +optional
+	RateLimit []string `json:"rateLimit,omitempty"`
+}
+
 type Network struct {
 	// hostNetworking determines if scylla uses the host's network namespace. Setting this option
 	// avoids going through Kubernetes SDN and exposes scylla on node's IP.
@@ -706,6 +755,54 @@ type BackupTaskStatus struct {
 	UploadParallel []string `json:"uploadParallel,omitempty" mapstructure:"upload_parallel,omitempty"`
 }
 
+type RestoreTaskStatus struct {
+	TaskStatus `json:",inline"`
+
+	// location reflects a list of backup locations in the format [<dc>:]<provider>:<name> ex. s3:my-bucket.
+	Location []string `json:"location,omitempty" mapstructure:"location,omitempty"`
+
+	// snapshotTag reflects the tag of the restored snapshot.
+	// +optional
+	SnapshotTag *string `json:"snapshotTag,omitempty" mapstructure:"snapshot_tag,omitempty"`
+
+	// keyspace reflects a list of keyspace/tables glob patterns,
+	// e.g. 'keyspace,!keyspace.table_prefix_*' used to include or exclude keyspaces from restore.
+	// +optional
+	Keyspace []string `json:"keyspace,omitempty" mapstructure:"keyspace,omitempty"`
+
+	// restoreSchema reflects whether the task restores the schema.
+	// +optional
+	RestoreSchema *bool `json:"restoreSchema,omitempty" mapstructure:"restore_schema,omitempty"`
+
+	// restoreTables reflects whether the task restores the data of tables.
+	// +optional
+	RestoreTables *bool `json:"restoreTables,omitempty" mapstructure:"restore_tables,omitempty"`
+
+	// batchSize reflects the number of SSTables per shard that are restored by a node in a single batch.
+	// +optional
+	BatchSize *int64 `json:"batchSize,omitempty" mapstructure:"batch_size,omitempty"`
+
+	// parallel reflects the maximum number of Scylla restore jobs that can run at the same time.
+	// +optional
+	Parallel *int64 `json:"parallel,omitempty" mapstructure:"parallel,omitempty"`
+
+	// rateLimit reflects a list of megabytes (MiB) per second rate limits expressed in the format [<dc>:]<limit>.
+	// +optional
+	RateLimit []string `json:"rateLimit,omitempty" mapstructure:"rate_limit,omitempty"`
+
+	// state reflects the state of the last run of the task in Scylla Manager, e.g. NEW, RUNNING, DONE or ERROR.
+	// +optional
+	State *string `json:"state,omitempty" mapstructure:"-"`
+
+	// successCount reflects the number of successful runs of the task.
+	// +optional
+	SuccessCount *int64 `json:"successCount,omitempty" mapstructure:"-"`
+
+	// errorCount reflects the number of failed runs of the task.
+	// +optional
+	ErrorCount *int64 `json:"errorCount,omitempty" mapstructure:"-"`
+}
+
 // ScyllaClusterStatus defines the observed state of ScyllaCluster.
 type ScyllaClusterStatus struct {
 	// observedGeneration is the most recent generation observed for this ScyllaCluster. It corresponds to the
@@ -741,6 +838,9 @@ type ScyllaClusterStatus struct {
 	// backups reflects status of backup tasks.
 	Backups []BackupTaskStatus `json:"backups,omitempty"`
 
+	// restores reflects status of restore tasks.
+	Restores []RestoreTaskStatus `json:"restores,omitempty"`
+
 	// upgrade reflects state of ongoing upgrade procedure.
 	Upgrade *UpgradeStatus `json:"upgrade,omitempty"`
 
@@ -661,6 +661,110 @@ func (in *RepairTaskStatus) DeepCopy() *RepairTaskStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreTaskSpec) DeepCopyInto(out *RestoreTaskSpec) {
+	*out = *in
+	in.TaskSpec.DeepCopyInto(&out.TaskSpec)
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Keyspace != nil {
+		in, out := &in.Keyspace, &out.Keyspace
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreTaskSpec.
+func (in *RestoreTaskSpec) DeepCopy() *RestoreTaskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreTaskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreTaskStatus) DeepCopyInto(out *RestoreTaskStatus) {
+	*out = *in
+	in.TaskStatus.DeepCopyInto(&out.TaskStatus)
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SnapshotTag != nil {
+		in, out := &in.SnapshotTag, &out.SnapshotTag
+		*out = new(string)
+		**out = **in
+	}
+	if in.Keyspace != nil {
+		in, out := &in.Keyspace, &out.Keyspace
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RestoreSchema != nil {
+		in, out := &in.RestoreSchema, &out.RestoreSchema
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestoreTables != nil {
+		in, out := &in.RestoreTables, &out.RestoreTables
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Parallel != nil {
+		in, out := &in.Parallel, &out.Parallel
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.SuccessCount != nil {
+		in, out := &in.SuccessCount, &out.SuccessCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ErrorCount != nil {
+		in, out := &in.ErrorCount, &out.ErrorCount
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreTaskStatus.
+func (in *RestoreTaskStatus) DeepCopy() *RestoreTaskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreTaskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulerTaskSpec) DeepCopyInto(out *SchedulerTaskSpec) {
 	*out = *in
@@ -843,6 +947,13 @@ func (in *ScyllaClusterSpec) DeepCopyInto(out *ScyllaClusterSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Restores != nil {
+		in, out := &in.Restores, &out.Restores
+		*out = make([]RestoreTaskSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ImagePullSecrets != nil {
 		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
 		*out = make([]corev1.LocalObjectReference, len(*in))
@@ -945,6 +1056,13 @@ func (in *ScyllaClusterStatus) DeepCopyInto(out *ScyllaClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Restores != nil {
+		in, out := &in.Restores, &out.Restores
+		*out = make([]RestoreTaskStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Upgrade != nil {
 		in, out := &in.Upgrade, &out.Upgrade
 		*out = new(UpgradeStatus)
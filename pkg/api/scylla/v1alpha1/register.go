@@ -38,6 +38,20 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ScyllaDBMonitoringList{},
 		&ScyllaDBDatacenter{},
 		&ScyllaDBDatacenterList{},
+		&ScyllaDBCluster{},
+		&ScyllaDBClusterList{},
+		&ScyllaDBKeyspace{},
+		&ScyllaDBKeyspaceList{},
+		&ScyllaDBRole{},
+		&ScyllaDBRoleList{},
+		&ScyllaDBDatacenterAutoscaler{},
+		&ScyllaDBDatacenterAutoscalerList{},
+		&ScyllaDBBackupInventory{},
+		&ScyllaDBBackupInventoryList{},
+		&ScyllaDBRepair{},
+		&ScyllaDBRepairList{},
+		&ScyllaDBBackup{},
+		&ScyllaDBBackupList{},
 	)
 	metav1.AddToGroupVersion(scheme, GroupVersion)
 	return nil
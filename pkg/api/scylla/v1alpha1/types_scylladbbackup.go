@@ -0,0 +1,100 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScyllaDBBackupSpec defines the desired state of ScyllaDBBackup.
+// Except for ttlSecondsAfterFinished, it can't be changed once the ScyllaDBBackup is created.
+type ScyllaDBBackupSpec struct {
+	// scyllaClusterName is the name of the ScyllaCluster to back up.
+	ScyllaClusterName string `json:"scyllaClusterName"`
+
+	// dc is a list of datacenter glob patterns, e.g. 'dc1,!otherdc*' used to specify the DCs
+	// to include or exclude from backup.
+	// +optional
+	DC []string `json:"dc,omitempty"`
+
+	// keyspace is a list of keyspace/tables glob patterns,
+	// e.g. 'keyspace,!keyspace.table_prefix_*' used to include or exclude keyspaces from backup.
+	// +optional
+	Keyspace []string `json:"keyspace,omitempty"`
+
+	// location is a list of backup locations in the format [<dc>:]<provider>:<name> ex. s3:my-bucket.
+	// The <dc>: part is optional and is only needed when different datacenters are being used to upload data
+	// to different locations.
+	Location []string `json:"location"`
+
+	// rateLimit is a list of megabytes (MiB) per second rate limits expressed in the format [<dc>:]<limit>.
+	// The <dc>: part is optional and only needed when different datacenters need different upload limits.
+	// Set to 0 for no limit (default 100).
+	// +optional
+	RateLimit []string `json:"rateLimit,omitempty"`
+
+	// retention is the number of backups of this task which are to be stored.
+	// +kubebuilder:default:=1
+	// +optional
+	Retention int64 `json:"retention,omitempty"`
+
+	// snapshotParallel is a list of snapshot parallelism limits in the format [<dc>:]<limit>.
+	// +optional
+	SnapshotParallel []string `json:"snapshotParallel,omitempty"`
+
+	// uploadParallel is a list of upload parallelism limits in the format [<dc>:]<limit>.
+	// +optional
+	UploadParallel []string `json:"uploadParallel,omitempty"`
+
+	// numRetries indicates how many times the task will be retried before failing.
+	// +kubebuilder:default:=3
+	// +optional
+	NumRetries *int64 `json:"numRetries,omitempty"`
+
+	// ttlSecondsAfterFinished limits the lifetime of a ScyllaDBBackup that has finished.
+	// When set, the ScyllaDBBackup is deleted the given number of seconds after it has succeeded or failed.
+	// Deleting a ScyllaDBBackup doesn't remove the snapshot it has taken.
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// ScyllaDBBackupStatus defines the observed state of ScyllaDBBackup.
+type ScyllaDBBackupStatus struct {
+	ScyllaDBManagerTaskStatus `json:",inline"`
+
+	// snapshotTag is the tag of the snapshot taken by the backup.
+	// +optional
+	SnapshotTag *string `json:"snapshotTag,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:printcolumn:name="CLUSTER",type=string,JSONPath=".spec.scyllaClusterName"
+// +kubebuilder:printcolumn:name="PHASE",type=string,JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="PROGRESS",type=integer,JSONPath=".status.progress"
+// +kubebuilder:printcolumn:name="SNAPSHOT",type=string,JSONPath=".status.snapshotTag"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ScyllaDBBackup runs a one-off Scylla Manager backup of a ScyllaCluster.
+type ScyllaDBBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec defines the desired state of this ScyllaDBBackup.
+	Spec ScyllaDBBackupSpec `json:"spec,omitempty"`
+
+	// status specifies the current status of this ScyllaDBBackup.
+	Status ScyllaDBBackupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ScyllaDBBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScyllaDBBackup `json:"items"`
+}
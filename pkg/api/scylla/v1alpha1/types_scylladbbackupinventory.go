@@ -0,0 +1,110 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScyllaDBBackupInventorySpec defines the desired state of ScyllaDBBackupInventory.
+type ScyllaDBBackupInventorySpec struct {
+	// scyllaClusterName is the name of the ScyllaCluster which backups are listed.
+	ScyllaClusterName string `json:"scyllaClusterName"`
+
+	// locations is a list of backup locations in the format [<dc>:]<provider>:<name> ex. s3:my-bucket,
+	// which snapshots are listed.
+	// +optional
+	Locations []string `json:"locations,omitempty"`
+}
+
+// BackupSnapshot describes a snapshot available in a backup location.
+type BackupSnapshot struct {
+	// snapshotTag is the tag of the snapshot, e.g. sm_20240101000000UTC.
+	SnapshotTag string `json:"snapshotTag"`
+
+	// creationTime is the time the snapshot was taken at.
+	// +optional
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// size is the size of the snapshot in bytes.
+	// +optional
+	Size int64 `json:"size,omitempty"`
+
+	// nodes is the number of nodes included in the snapshot.
+	// +optional
+	Nodes int64 `json:"nodes,omitempty"`
+
+	// taskID is the ID of the Scylla Manager backup task that took the snapshot.
+	// +optional
+	TaskID string `json:"taskID,omitempty"`
+
+	// taskName is the name of the backup task that took the snapshot.
+	// It's empty when the task no longer exists.
+	// +optional
+	TaskName string `json:"taskName,omitempty"`
+
+	// retention is the number of snapshots retained by the backup task that took the snapshot.
+	// +optional
+	Retention *int64 `json:"retention,omitempty"`
+}
+
+// BackupLocationInventory describes snapshots available in a backup location.
+type BackupLocationInventory struct {
+	// location is the backup location in the format [<dc>:]<provider>:<name>.
+	Location string `json:"location"`
+
+	// snapshots is a list of snapshots available in the location.
+	// +optional
+	Snapshots []BackupSnapshot `json:"snapshots,omitempty"`
+
+	// error holds the error of listing the location, if any.
+	// +optional
+	Error *string `json:"error,omitempty"`
+}
+
+// ScyllaDBBackupInventoryStatus defines the observed state of ScyllaDBBackupInventory.
+type ScyllaDBBackupInventoryStatus struct {
+	// observedGeneration is the most recent generation observed for this ScyllaDBBackupInventory. It corresponds to the
+	// ScyllaDBBackupInventory's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// lastSyncTime is the last time the locations were listed.
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// locations reflect snapshots available in backup locations.
+	// +optional
+	Locations []BackupLocationInventory `json:"locations,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:printcolumn:name="CLUSTER",type=string,JSONPath=".spec.scyllaClusterName"
+// +kubebuilder:printcolumn:name="LAST SYNC",type="date",JSONPath=".status.lastSyncTime"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ScyllaDBBackupInventory lists snapshots available in backup locations of a ScyllaCluster.
+// It is managed by Scylla Manager Controller for every ScyllaCluster with backup tasks.
+type ScyllaDBBackupInventory struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec defines the desired state of this ScyllaDBBackupInventory.
+	Spec ScyllaDBBackupInventorySpec `json:"spec,omitempty"`
+
+	// status specifies the current status of this ScyllaDBBackupInventory.
+	Status ScyllaDBBackupInventoryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ScyllaDBBackupInventoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScyllaDBBackupInventory `json:"items"`
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScyllaDBClusterSpec defines the desired state of ScyllaDBCluster.
+type ScyllaDBClusterSpec struct {
+	// metadata controls shared metadata for all ScyllaDBDatacenters of the cluster.
+	// +optional
+	Metadata *ObjectTemplateMetadata `json:"metadata,omitempty"`
+
+	// clusterName specifies the name of the ScyllaDB cluster.
+	// When empty, the name of the ScyllaDBCluster is used.
+	// This field is immutable.
+	// +optional
+	ClusterName *string `json:"clusterName,omitempty"`
+
+	// scyllaDB holds a specification of ScyllaDB shared by all datacenters.
+	// External seeds are added to the seeds of the other datacenters managed by the ScyllaDBCluster.
+	ScyllaDB ScyllaDB `json:"scyllaDB"`
+
+	// scyllaDBManagerAgent holds a specification of ScyllaDB Manager Agent shared by all datacenters.
+	// +optional
+	ScyllaDBManagerAgent *ScyllaDBManagerAgent `json:"scyllaDBManagerAgent,omitempty"`
+
+	// imagePullSecrets is an optional list of references to secrets used for pulling any of the images used by the datacenters.
+	// The Secrets have to exist in the namespaces of all datacenters.
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// exposeOptions specifies options for exposing ScyllaDB nodes of all datacenters.
+	// Nodes have to be reachable from the other datacenters.
+	// +optional
+	ExposeOptions *ExposeOptions `json:"exposeOptions,omitempty"`
+
+	// forceRedeploymentReason specifies an opaque value that triggers a rolling restart of all datacenters when changed.
+	// +optional
+	ForceRedeploymentReason *string `json:"forceRedeploymentReason,omitempty"`
+
+	// datacenterTemplate specifies the defaults shared by all datacenters.
+	// +optional
+	DatacenterTemplate *ScyllaDBClusterDatacenterTemplate `json:"datacenterTemplate,omitempty"`
+
+	// datacenters specify the datacenters of the cluster.
+	// New datacenters are added in order, one at a time, once all preceding datacenters are available.
+	// Removed datacenters are decommissioned according to datacenterDecommission before they are deleted.
+	// +listType=map
+	// +listMapKey=name
+	Datacenters []ScyllaDBClusterDatacenter `json:"datacenters"`
+
+	// datacenterDecommission specifies how removed datacenters are decommissioned.
+	// +optional
+	DatacenterDecommission *DatacenterDecommissionOptions `json:"datacenterDecommission,omitempty"`
+}
+
+type DatacenterReplicationPolicy string
+
+const (
+	// DatacenterReplicationPolicyVerify refuses to decommission a datacenter as long as any keyspace replicates to it.
+	DatacenterReplicationPolicyVerify DatacenterReplicationPolicy = "Verify"
+
+	// DatacenterReplicationPolicyRewrite removes the datacenter from the replication of keyspaces before it's decommissioned.
+	// Keyspaces that are replicated only to the datacenter are never rewritten, and the decommission is refused instead.
+	DatacenterReplicationPolicyRewrite DatacenterReplicationPolicy = "Rewrite"
+)
+
+// DatacenterDecommissionOptions specifies how removed datacenters are decommissioned.
+type DatacenterDecommissionOptions struct {
+	// replicationPolicy specifies how keyspaces replicated to a removed datacenter are handled.
+	// +kubebuilder:validation:Enum="Verify";"Rewrite"
+	// +kubebuilder:default:="Verify"
+	// +optional
+	ReplicationPolicy DatacenterReplicationPolicy `json:"replicationPolicy,omitempty"`
+}
+
+// ScyllaDBClusterDatacenterTemplate specifies the parts of a datacenter that can be shared or overridden.
+type ScyllaDBClusterDatacenterTemplate struct {
+	// metadata controls metadata of the datacenter. It's merged with the metadata of the template,
+	// with the values of the datacenter taking precedence.
+	// +optional
+	Metadata *ObjectTemplateMetadata `json:"metadata,omitempty"`
+
+	// dnsDomains is a list of DNS domains the datacenter is reachable by.
+	// +optional
+	DNSDomains []string `json:"dnsDomains,omitempty"`
+
+	// rackTemplate provides a template for every rack.
+	// +optional
+	RackTemplate *RackTemplate `json:"rackTemplate,omitempty"`
+
+	// racks specify the racks of the datacenter.
+	// +optional
+	Racks []RackSpec `json:"racks,omitempty"`
+}
+
+// RemoteKubernetesClusterReference references a remote Kubernetes cluster a datacenter is deployed to.
+type RemoteKubernetesClusterReference struct {
+	// kubeconfigSecretName is the name of a Secret in the namespace of the ScyllaDBCluster
+	// holding a kubeconfig of the remote Kubernetes cluster under the "kubeconfig" key.
+	// Only inline credentials are supported; exec plugins, auth providers and file references are rejected.
+	KubeconfigSecretName string `json:"kubeconfigSecretName"`
+
+	// namespace is the namespace in the remote Kubernetes cluster the datacenter is deployed to.
+	// When empty, the namespace of the ScyllaDBCluster is used.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ScyllaDBClusterDatacenter specifies a datacenter of the cluster.
+type ScyllaDBClusterDatacenter struct {
+	ScyllaDBClusterDatacenterTemplate `json:",inline"`
+
+	// name specifies the name of the datacenter.
+	// This field is immutable.
+	Name string `json:"name"`
+
+	// remoteKubernetesCluster references a remote Kubernetes cluster the datacenter is deployed to.
+	// When empty, the datacenter is deployed to the namespace of the ScyllaDBCluster.
+	// This field is immutable.
+	// +optional
+	RemoteKubernetesCluster *RemoteKubernetesClusterReference `json:"remoteKubernetesCluster,omitempty"`
+}
+
+type ScyllaDBClusterDatacenterPhase string
+
+const (
+	// ScyllaDBClusterDatacenterPhasePending means the datacenter waits for the preceding datacenters to become available.
+	ScyllaDBClusterDatacenterPhasePending ScyllaDBClusterDatacenterPhase = "Pending"
+
+	// ScyllaDBClusterDatacenterPhaseProvisioned means the ScyllaDBDatacenter of the datacenter was created.
+	ScyllaDBClusterDatacenterPhaseProvisioned ScyllaDBClusterDatacenterPhase = "Provisioned"
+
+	// ScyllaDBClusterDatacenterPhaseDecommissioning means the datacenter was removed from the spec,
+	// and its racks are being decommissioned before the ScyllaDBDatacenter is deleted.
+	ScyllaDBClusterDatacenterPhaseDecommissioning ScyllaDBClusterDatacenterPhase = "Decommissioning"
+)
+
+// ScyllaDBClusterDatacenterStatus reports the status of a datacenter.
+type ScyllaDBClusterDatacenterStatus struct {
+	// name is the name of the datacenter.
+	Name string `json:"name"`
+
+	// remoteKubernetesCluster references the remote Kubernetes cluster the datacenter is deployed to.
+	// +optional
+	RemoteKubernetesCluster *RemoteKubernetesClusterReference `json:"remoteKubernetesCluster,omitempty"`
+
+	// phase is the phase of the datacenter.
+	Phase ScyllaDBClusterDatacenterPhase `json:"phase"`
+
+	// message is a human readable description of the datacenter state.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// currentVersion is the version of ScyllaDB currently deployed in the datacenter.
+	// +optional
+	CurrentVersion string `json:"currentVersion,omitempty"`
+
+	// nodes is the total number of nodes in the datacenter.
+	// +optional
+	Nodes *int32 `json:"nodes,omitempty"`
+
+	// readyNodes is the number of ready nodes in the datacenter.
+	// +optional
+	ReadyNodes *int32 `json:"readyNodes,omitempty"`
+
+	// availableNodes is the number of available nodes in the datacenter.
+	// +optional
+	AvailableNodes *int32 `json:"availableNodes,omitempty"`
+
+	// conditions are the conditions of the ScyllaDBDatacenter.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// ScyllaDBClusterStatus defines the observed state of ScyllaDBCluster.
+type ScyllaDBClusterStatus struct {
+	// observedGeneration is the most recent generation observed for this ScyllaDBCluster. It corresponds to the
+	// ScyllaDBCluster's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// conditions hold conditions describing ScyllaDBCluster state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// nodes is the total number of nodes in all datacenters.
+	// +optional
+	Nodes *int32 `json:"nodes,omitempty"`
+
+	// readyNodes is the number of ready nodes in all datacenters.
+	// +optional
+	ReadyNodes *int32 `json:"readyNodes,omitempty"`
+
+	// availableNodes is the number of available nodes in all datacenters.
+	// +optional
+	AvailableNodes *int32 `json:"availableNodes,omitempty"`
+
+	// datacenters reflect the status of the datacenters.
+	// +optional
+	Datacenters []ScyllaDBClusterDatacenterStatus `json:"datacenters,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:printcolumn:name="AVAILABLE",type=string,JSONPath=".status.conditions[?(@.type=='Available')].status"
+// +kubebuilder:printcolumn:name="PROGRESSING",type=string,JSONPath=".status.conditions[?(@.type=='Progressing')].status"
+// +kubebuilder:printcolumn:name="DEGRADED",type=string,JSONPath=".status.conditions[?(@.type=='Degraded')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ScyllaDBCluster defines a ScyllaDB cluster spanning multiple datacenters.
+type ScyllaDBCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec defines the desired state of this ScyllaDBCluster.
+	Spec ScyllaDBClusterSpec `json:"spec,omitempty"`
+
+	// status specifies the current status of this ScyllaDBCluster.
+	Status ScyllaDBClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ScyllaDBClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScyllaDBCluster `json:"items"`
+}
@@ -61,10 +61,36 @@ type ScyllaDBDatacenterSpec struct {
 	// racks specify the racks in the datacenter.
 	Racks []RackSpec `json:"racks"`
 
+	// automaticRacks enables generating one rack for every zone, discovered from the topology.kubernetes.io/zone
+	// label of Nodes. Racks are managed by the operator in the racks field: racks are added for new zones, the nodes
+	// are split evenly between them, and racks of zones without any Nodes for longer than zoneRemovalGracePeriodSeconds
+	// are scaled down to zero and removed.
+	// Generated racks inherit the rack template and select their zone using topologyLabelSelector.
+	// Existing racks are adopted by the zone they select, every rack has to select a zone when this is set.
+	// +optional
+	AutomaticRacks *AutomaticRacks `json:"automaticRacks,omitempty"`
+
 	// disableAutomaticOrphanedNodeReplacement controls if automatic orphan node replacement should be disabled.
 	// +optional
 	DisableAutomaticOrphanedNodeReplacement *bool `json:"disableAutomaticOrphanedNodeReplacement,omitempty"`
 
+	// orphanedNodeDetection controls which conditions mark a ScyllaDB node as orphaned, in addition to the Kubernetes
+	// node its volume is bound to not existing anymore.
+	// Orphaned nodes are only replaced when automatic orphaned node replacement is enabled.
+	// +optional
+	OrphanedNodeDetection *OrphanedNodeDetection `json:"orphanedNodeDetection,omitempty"`
+
+	// unavailableNodeRemovalDelay specifies how long a node that is being decommissioned, but isn't running,
+	// has to be reported as down by the other nodes before it's removed from the cluster without its participation.
+	// +kubebuilder:default:="10m"
+	// +optional
+	UnavailableNodeRemovalDelay *metav1.Duration `json:"unavailableNodeRemovalDelay,omitempty"`
+
+	// orphanedNodeReplacement controls how orphaned ScyllaDB nodes are replaced.
+	// If not provided, orphaned nodes are replaced as soon as they are detected.
+	// +optional
+	OrphanedNodeReplacement *OrphanedNodeReplacement `json:"orphanedNodeReplacement,omitempty"`
+
 	// minTerminationGracePeriodSeconds specifies minimum duration in seconds to wait before every drained node is
 	// terminated. This gives time to potential load balancer in front of a node to notice that node is not ready anymore
 	// and stop forwarding new requests.
@@ -88,6 +114,202 @@ type ScyllaDBDatacenterSpec struct {
 	// about readiness gates.
 	// +optional
 	ReadinessGates []corev1.PodReadinessGate `json:"readinessGates,omitempty"`
+
+	// upgradeRollback controls automated rollback of failed ScyllaDB version upgrades.
+	// If not provided, upgrades are only rolled back when requested with the rollback annotation.
+	// +optional
+	UpgradeRollback *UpgradeRollbackOptions `json:"upgradeRollback,omitempty"`
+
+	// rolloutStrategy controls how changes to the racks are rolled out to the nodes.
+	// Version upgrades that require running upgrade hooks aren't affected.
+	// If not provided, changes are rolled out to all nodes, one rack at a time.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
+
+	// maintenanceWindows restrict when disruptive operations, like rolling restarts, scaling, version upgrades
+	// and cleanups, can start. Version upgrades, decommissions and cleanups that have already started aren't
+	// interrupted when a window closes, rollouts of other changes stop before updating the next node.
+	// If empty, disruptive operations can start at any time.
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+}
+
+// AutomaticRacks specifies how racks are generated from zones.
+type AutomaticRacks struct {
+	// nodes specifies the total number of ScyllaDB nodes in the datacenter, which are split evenly between the racks.
+	// Racks in zones that are first in alphabetical order get the remaining nodes.
+	Nodes int32 `json:"nodes"`
+
+	// zones restricts the zones that racks are generated for.
+	// If empty, racks are generated for all zones of Nodes matching the rack template placement.
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// zoneRemovalGracePeriodSeconds specifies how long a zone has to be without any matching Nodes before
+	// the nodes of its rack are moved to the other zones. It protects against Nodes disappearing temporarily,
+	// e.g. while they are being replaced.
+	// +kubebuilder:default:=600
+	// +optional
+	ZoneRemovalGracePeriodSeconds *int64 `json:"zoneRemovalGracePeriodSeconds,omitempty"`
+}
+
+// AutomaticRacksStatus reflects the zones that automatic racks are generated for.
+type AutomaticRacksStatus struct {
+	// zones reflect the tracked zones.
+	// +optional
+	Zones []AutomaticRacksZoneStatus `json:"zones,omitempty"`
+}
+
+// AutomaticRacksZoneStatus reflects a zone that racks are generated for.
+type AutomaticRacksZoneStatus struct {
+	// name is the name of the zone.
+	Name string `json:"name"`
+
+	// missingSince is the time since when the zone has no matching Nodes.
+	// The zone is kept until it's missing for longer than zoneRemovalGracePeriodSeconds.
+	// +optional
+	MissingSince *metav1.Time `json:"missingSince,omitempty"`
+}
+
+type OrphanedVolumeDetector string
+
+const (
+	// PersistentVolumePhaseOrphanedVolumeDetector marks a volume as orphaned when its PersistentVolume doesn't exist
+	// or has failed, or when its PersistentVolumeClaim has lost it.
+	PersistentVolumePhaseOrphanedVolumeDetector OrphanedVolumeDetector = "PersistentVolumePhase"
+
+	// VolumeHealthOrphanedVolumeDetector marks a volume as orphaned when its CSI driver recently reported
+	// an abnormal volume condition.
+	// It requires the CSI driver to support volume health monitoring.
+	VolumeHealthOrphanedVolumeDetector OrphanedVolumeDetector = "VolumeHealth"
+
+	// MountFailuresOrphanedVolumeDetector marks a bound volume as orphaned when its Node was replaced under the same name,
+	// i.e. a Node matching the volume was created after it, and a pending ScyllaDB Pod repeatedly fails to attach or mount it.
+	// Failures of other volumes of the Pod aren't counted.
+	MountFailuresOrphanedVolumeDetector OrphanedVolumeDetector = "MountFailures"
+)
+
+// OrphanedNodeDetection specifies how orphaned ScyllaDB nodes are detected.
+type OrphanedNodeDetection struct {
+	// detectors specify additional conditions marking a volume of a ScyllaDB node as orphaned.
+	// +optional
+	Detectors []OrphanedVolumeDetector `json:"detectors,omitempty"`
+
+	// mountFailureThreshold specifies the number of failed attempts to attach or mount a volume after which
+	// the volume is considered orphaned by the MountFailures detector.
+	// +kubebuilder:default:=10
+	// +optional
+	MountFailureThreshold *int32 `json:"mountFailureThreshold,omitempty"`
+}
+
+type OrphanedNodeReplacementMode string
+
+const (
+	// AutomaticOrphanedNodeReplacementMode replaces orphaned nodes as soon as they are detected.
+	AutomaticOrphanedNodeReplacementMode OrphanedNodeReplacementMode = "Automatic"
+
+	// RequireApprovalOrphanedNodeReplacementMode only reports orphaned nodes in the status and with events.
+	// A node is replaced once its member Service is annotated with the approval annotation.
+	RequireApprovalOrphanedNodeReplacementMode OrphanedNodeReplacementMode = "RequireApproval"
+)
+
+// OrphanedNodeReplacement specifies how orphaned ScyllaDB nodes are replaced.
+type OrphanedNodeReplacement struct {
+	// mode specifies whether orphaned nodes are replaced automatically or need to be approved.
+	// +kubebuilder:default:="Automatic"
+	// +optional
+	Mode OrphanedNodeReplacementMode `json:"mode,omitempty"`
+
+	// rateLimit limits how many orphaned nodes are replaced in a rack within a time period.
+	// If not provided, replacements aren't limited.
+	// +optional
+	RateLimit *OrphanedNodeReplacementRateLimit `json:"rateLimit,omitempty"`
+}
+
+// OrphanedNodeReplacementRateLimit limits the number of orphaned node replacements.
+type OrphanedNodeReplacementRateLimit struct {
+	// maxReplacementsPerRack specifies how many orphaned nodes can be replaced in a rack within the period.
+	MaxReplacementsPerRack int32 `json:"maxReplacementsPerRack"`
+
+	// period specifies the length of the sliding time window the replacements are counted in.
+	Period metav1.Duration `json:"period"`
+}
+
+// MaintenanceWindow specifies a recurring time window in which disruptive operations can start.
+type MaintenanceWindow struct {
+	// cron specifies when the maintenance window opens as a cron expression.
+	// It supports an extended syntax including @monthly, @weekly, @daily, @midnight and @hourly.
+	// @every X[h|m|s] isn't supported, because the window has to open at fixed times.
+	Cron string `json:"cron"`
+
+	// timezone specifies the timezone of cron field. Defaults to UTC.
+	// +optional
+	Timezone *string `json:"timezone,omitempty"`
+
+	// duration specifies how long the maintenance window stays open.
+	Duration metav1.Duration `json:"duration"`
+}
+
+type RolloutStrategyType string
+
+const (
+	// RollingUpdateRolloutStrategyType rolls out changes to all nodes, one rack at a time.
+	RollingUpdateRolloutStrategyType RolloutStrategyType = "RollingUpdate"
+
+	// CanaryRolloutStrategyType rolls out changes to a single canary node first. Once the canary node
+	// has soaked, the changes are rolled out to all nodes, one rack at a time.
+	CanaryRolloutStrategyType RolloutStrategyType = "Canary"
+)
+
+// RolloutStrategy specifies how changes are rolled out to the nodes.
+type RolloutStrategy struct {
+	// type specifies the type of the rollout strategy.
+	// +kubebuilder:validation:Enum="RollingUpdate";"Canary"
+	// +kubebuilder:default:="RollingUpdate"
+	// +optional
+	Type RolloutStrategyType `json:"type,omitempty"`
+
+	// canary configures the Canary rollout strategy.
+	// +optional
+	Canary *CanaryRolloutStrategy `json:"canary,omitempty"`
+}
+
+// CanaryRolloutStrategy configures how the canary node is verified.
+type CanaryRolloutStrategy struct {
+	// soakDuration specifies how long the canary node has to stay ready before the rollout continues.
+	// +kubebuilder:default:="10m"
+	// +optional
+	SoakDuration metav1.Duration `json:"soakDuration,omitempty"`
+
+	// prometheus specifies Prometheus queries that have to pass after the soak period for the rollout to continue.
+	// +optional
+	Prometheus *CanaryPrometheusOptions `json:"prometheus,omitempty"`
+}
+
+// CanaryPrometheusOptions specifies Prometheus queries verifying the canary node.
+type CanaryPrometheusOptions struct {
+	// url is the base URL of the Prometheus HTTP API, e.g. "http://prometheus.monitoring.svc:9090".
+	URL string `json:"url"`
+
+	// queries are instant PromQL queries. A query passes when it returns at least one sample
+	// and none of the returned samples is zero. Comparisons need the bool modifier, e.g. "== bool 0".
+	// +kubebuilder:validation:MinItems=1
+	Queries []string `json:"queries"`
+}
+
+// UpgradeRollbackOptions controls when failed ScyllaDB version upgrades are rolled back.
+type UpgradeRollbackOptions struct {
+	// failedNodesThreshold specifies how many upgraded nodes have to fail to become ready
+	// for the upgrade to be rolled back.
+	// +kubebuilder:default:=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	FailedNodesThreshold int32 `json:"failedNodesThreshold,omitempty"`
+
+	// nodeReadinessTimeout specifies how long an upgraded node can stay not ready before it's considered failed.
+	// +kubebuilder:default:="15m"
+	// +optional
+	NodeReadinessTimeout metav1.Duration `json:"nodeReadinessTimeout,omitempty"`
 }
 
 type ObjectTemplateMetadata struct {
@@ -199,6 +421,38 @@ type ScyllaDB struct {
 	// developerMode determines if the cluster runs in developer-mode.
 	// +optional
 	EnableDeveloperMode *bool `json:"enableDeveloperMode,omitempty"`
+
+	// internodeEncryptionOptions specifies options for encrypting traffic between ScyllaDB nodes.
+	// When set, the operator issues a dedicated certificate for every node, signed by a CA local to this datacenter,
+	// and rolls out the encryption in two phases, so nodes can keep talking to each other during the rollout.
+	// +optional
+	InternodeEncryptionOptions *InternodeEncryptionOptions `json:"internodeEncryptionOptions,omitempty"`
+}
+
+type InternodeEncryptionMode string
+
+const (
+	// InternodeEncryptionModeNone disables encryption of internode traffic.
+	InternodeEncryptionModeNone InternodeEncryptionMode = "None"
+
+	// InternodeEncryptionModeAll encrypts all internode traffic.
+	InternodeEncryptionModeAll InternodeEncryptionMode = "All"
+
+	// InternodeEncryptionModeDC encrypts only traffic between nodes in different datacenters.
+	InternodeEncryptionModeDC InternodeEncryptionMode = "DC"
+
+	// InternodeEncryptionModeRack encrypts only traffic between nodes in different racks.
+	InternodeEncryptionModeRack InternodeEncryptionMode = "Rack"
+)
+
+// InternodeEncryptionOptions hold options related to encryption of traffic between ScyllaDB nodes.
+type InternodeEncryptionOptions struct {
+	// mode specifies which internode connections are encrypted.
+	// This field controls the `server_encryption_options.internode_encryption` value in ScyllaDB config.
+	// +kubebuilder:validation:Enum="None";"All";"DC";"Rack"
+	// +kubebuilder:default:="All"
+	// +optional
+	Mode InternodeEncryptionMode `json:"mode,omitempty"`
 }
 
 // StorageOptions describes options of storage.
@@ -268,6 +522,84 @@ type ScyllaDBManagerAgent struct {
 	// image holds a reference to the ScyllaDB Manager Agent container image.
 	// +optional
 	Image *string `json:"image,omitempty"`
+
+	// config specifies common ScyllaDB Manager Agent settings. The operator renders them into the agent configuration
+	// and restarts the agents when it changes. Options from customConfigSecretRef take precedence.
+	// +optional
+	Config *ScyllaDBManagerAgentConfig `json:"config,omitempty"`
+}
+
+// ScyllaDBManagerAgentConfig describes common settings of ScyllaDB Manager Agent.
+type ScyllaDBManagerAgentConfig struct {
+	// s3 configures access to S3 compatible backup locations.
+	// +optional
+	S3 *ScyllaDBManagerAgentS3Options `json:"s3,omitempty"`
+
+	// gcs configures access to Google Cloud Storage backup locations.
+	// +optional
+	GCS *ScyllaDBManagerAgentGCSOptions `json:"gcs,omitempty"`
+
+	// azure configures access to Azure Blob Storage backup locations.
+	// +optional
+	Azure *ScyllaDBManagerAgentAzureOptions `json:"azure,omitempty"`
+
+	// rateLimits limit the resources used by the agent to transfer backups.
+	// +optional
+	RateLimits *ScyllaDBManagerAgentRateLimits `json:"rateLimits,omitempty"`
+}
+
+// ScyllaDBManagerAgentS3Options describe access to S3 compatible backup locations.
+// Credentials can be left out, when they are provided by the environment, e.g. an instance profile.
+type ScyllaDBManagerAgentS3Options struct {
+	// provider is the S3 provider, e.g. AWS or Minio.
+	// +optional
+	Provider string `json:"provider,omitempty"`
+
+	// region is the region of the buckets.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// endpoint is the URL of the S3 API, for S3 compatible providers other than AWS.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// accessKeyIDSecretKeyRef references the access key ID in a Secret.
+	// +optional
+	AccessKeyIDSecretKeyRef *corev1.SecretKeySelector `json:"accessKeyIDSecretKeyRef,omitempty"`
+
+	// secretAccessKeySecretKeyRef references the secret access key in a Secret.
+	// +optional
+	SecretAccessKeySecretKeyRef *corev1.SecretKeySelector `json:"secretAccessKeySecretKeyRef,omitempty"`
+}
+
+// ScyllaDBManagerAgentGCSOptions describe access to Google Cloud Storage backup locations.
+type ScyllaDBManagerAgentGCSOptions struct {
+	// serviceAccountSecretKeyRef references a JSON service account key in a Secret.
+	// When it's not set, the credentials are provided by the environment, e.g. workload identity.
+	// +optional
+	ServiceAccountSecretKeyRef *corev1.SecretKeySelector `json:"serviceAccountSecretKeyRef,omitempty"`
+}
+
+// ScyllaDBManagerAgentAzureOptions describe access to Azure Blob Storage backup locations.
+type ScyllaDBManagerAgentAzureOptions struct {
+	// account is the name of the storage account.
+	Account string `json:"account"`
+
+	// keySecretKeyRef references the access key of the storage account in a Secret.
+	// When it's not set, the credentials are provided by the environment, e.g. a managed identity.
+	// +optional
+	KeySecretKeyRef *corev1.SecretKeySelector `json:"keySecretKeyRef,omitempty"`
+}
+
+// ScyllaDBManagerAgentRateLimits limit the resources used by the agent to transfer backups.
+type ScyllaDBManagerAgentRateLimits struct {
+	// bandwidthMiBPerSecond limits the bandwidth used by each agent, in MiB/s.
+	// +optional
+	BandwidthMiBPerSecond *int32 `json:"bandwidthMiBPerSecond,omitempty"`
+
+	// transfers is the number of files each agent transfers in parallel.
+	// +optional
+	Transfers *int32 `json:"transfers,omitempty"`
 }
 
 type PodIPSourceType string
@@ -493,6 +825,263 @@ type ScyllaDBDatacenterStatus struct {
 
 	// racks reflect the status of datacenter racks.
 	Racks []RackStatus `json:"racks"`
+
+	// internodeEncryption reflects the state of internode encryption rollout.
+	// +optional
+	InternodeEncryption *InternodeEncryptionStatus `json:"internodeEncryption,omitempty"`
+
+	// detectedVersion holds the ScyllaDB version reported by the running nodes.
+	// Version dependent features are enabled based on it.
+	// +optional
+	DetectedVersion *DetectedScyllaDBVersion `json:"detectedVersion,omitempty"`
+
+	// keyspaces reflect how data of keyspaces is replicated.
+	// System keyspaces aren't included.
+	// +optional
+	Keyspaces []KeyspaceStatus `json:"keyspaces,omitempty"`
+
+	// keyspacesLastSyncTime is the last time keyspaces were read from the nodes.
+	// Keyspaces are resynced periodically, so the status may not reflect keyspaces changed in the meantime.
+	// +optional
+	KeyspacesLastSyncTime *metav1.Time `json:"keyspacesLastSyncTime,omitempty"`
+
+	// upgradeRollback reflects the last rollback of a failed ScyllaDB version upgrade.
+	// While spec.scyllaDB.image matches the image of the rolled back upgrade, nodes are kept on the image
+	// the upgrade started from. It's cleared once spec.scyllaDB.image changes.
+	// +optional
+	UpgradeRollback *UpgradeRollbackStatus `json:"upgradeRollback,omitempty"`
+
+	// automaticRacks reflects the zones that racks are generated for, when spec.automaticRacks is set.
+	// +optional
+	AutomaticRacks *AutomaticRacksStatus `json:"automaticRacks,omitempty"`
+
+	// rollout reflects the progress of rolling out the last change to the nodes.
+	// It's only reported when spec.rolloutStrategy is set.
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+
+	// orphanedNodeReplacements reflect detected orphaned nodes and their recent replacements.
+	// +optional
+	OrphanedNodeReplacements []OrphanedNodeReplacementStatus `json:"orphanedNodeReplacements,omitempty"`
+
+	// storageMigrations reflect the progress of moving nodes of racks to a new storage class.
+	// +optional
+	StorageMigrations []StorageMigrationStatus `json:"storageMigrations,omitempty"`
+}
+
+type StorageMigrationPhase string
+
+const (
+	// StorageMigrationPhaseProgressing means nodes are being replaced, one at a time, onto volumes of the new storage class.
+	StorageMigrationPhaseProgressing StorageMigrationPhase = "Progressing"
+
+	// StorageMigrationPhasePaused means the migration is paused with the pause annotation. No more nodes are replaced
+	// until the annotation is removed.
+	StorageMigrationPhasePaused StorageMigrationPhase = "Paused"
+
+	// StorageMigrationPhaseAborted means the migration was aborted with the abort annotation. No more nodes are replaced
+	// until the storage class of the rack changes.
+	StorageMigrationPhaseAborted StorageMigrationPhase = "Aborted"
+
+	// StorageMigrationPhaseComplete means all nodes of the rack use volumes of the new storage class.
+	StorageMigrationPhaseComplete StorageMigrationPhase = "Complete"
+)
+
+// StorageMigrationStatus describes the progress of moving nodes of a rack to a new storage class.
+type StorageMigrationStatus struct {
+	// rack is the name of the migrated rack.
+	Rack string `json:"rack"`
+
+	// storageClassName is the name of the storage class the nodes are moved to.
+	StorageClassName string `json:"storageClassName"`
+
+	// phase is the phase of the migration.
+	Phase StorageMigrationPhase `json:"phase"`
+
+	// message is a human readable description of the migration progress.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// nodes is the number of nodes of the rack.
+	Nodes int32 `json:"nodes"`
+
+	// migratedNodes is the number of nodes using volumes of the new storage class.
+	MigratedNodes int32 `json:"migratedNodes"`
+
+	// currentNode is the name of the node being replaced.
+	// +optional
+	CurrentNode string `json:"currentNode,omitempty"`
+
+	// startTime is the time the migration started.
+	StartTime metav1.Time `json:"startTime"`
+
+	// completionTime is the time the migration completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+type OrphanedNodeReplacementPhase string
+
+const (
+	// OrphanedNodeReplacementPhasePendingApproval means the replacement waits for the approval annotation.
+	OrphanedNodeReplacementPhasePendingApproval OrphanedNodeReplacementPhase = "PendingApproval"
+
+	// OrphanedNodeReplacementPhaseRateLimited means the replacement waits for the rate limit of the rack.
+	OrphanedNodeReplacementPhaseRateLimited OrphanedNodeReplacementPhase = "RateLimited"
+
+	// OrphanedNodeReplacementPhaseReplacing means the node was marked for replacement.
+	OrphanedNodeReplacementPhaseReplacing OrphanedNodeReplacementPhase = "Replacing"
+)
+
+// OrphanedNodeReplacementStatus describes an orphaned node and its replacement.
+type OrphanedNodeReplacementStatus struct {
+	// serviceName is the name of the member Service of the orphaned node.
+	ServiceName string `json:"serviceName"`
+
+	// rack is the name of the rack of the orphaned node.
+	Rack string `json:"rack"`
+
+	// reason explains why the node is orphaned.
+	Reason string `json:"reason"`
+
+	// phase is the phase of the replacement.
+	Phase OrphanedNodeReplacementPhase `json:"phase"`
+
+	// detectionTime is the time the node was detected as orphaned.
+	DetectionTime metav1.Time `json:"detectionTime"`
+
+	// replacementTime is the time the node was marked for replacement.
+	// +optional
+	ReplacementTime *metav1.Time `json:"replacementTime,omitempty"`
+}
+
+type RolloutPhase string
+
+const (
+	// RolloutPhaseCanary means the changes are being rolled out to the canary node, or the canary node is soaking.
+	RolloutPhaseCanary RolloutPhase = "Canary"
+
+	// RolloutPhaseProgressing means the changes are being rolled out to all nodes, one rack at a time.
+	RolloutPhaseProgressing RolloutPhase = "Progressing"
+
+	// RolloutPhasePaused means the rollout is paused with the pause annotation. No more nodes are updated until
+	// the annotation is removed.
+	RolloutPhasePaused RolloutPhase = "Paused"
+
+	// RolloutPhaseAborted means the rollout was aborted with the abort annotation. No more nodes are updated
+	// until the spec changes.
+	RolloutPhaseAborted RolloutPhase = "Aborted"
+
+	// RolloutPhaseComplete means the changes have been rolled out to all nodes.
+	RolloutPhaseComplete RolloutPhase = "Complete"
+)
+
+// RolloutStatus describes the progress of a rollout.
+type RolloutStatus struct {
+	// generation is the generation of the ScyllaDBDatacenter being rolled out.
+	Generation int64 `json:"generation"`
+
+	// phase is the phase of the rollout.
+	Phase RolloutPhase `json:"phase"`
+
+	// message is a human readable description of the rollout progress.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// canaryNode is the name of the canary node.
+	// +optional
+	CanaryNode string `json:"canaryNode,omitempty"`
+
+	// canarySoakStartTime is the time the canary node started soaking.
+	// +optional
+	CanarySoakStartTime *metav1.Time `json:"canarySoakStartTime,omitempty"`
+
+	// canaryPassed indicates whether the canary node has been verified.
+	// +optional
+	CanaryPassed bool `json:"canaryPassed,omitempty"`
+}
+
+type UpgradeRollbackPhase string
+
+const (
+	// UpgradeRollbackPhaseRunning means nodes are being reverted to the image the upgrade started from.
+	UpgradeRollbackPhaseRunning UpgradeRollbackPhase = "Running"
+
+	// UpgradeRollbackPhaseCompleted means all nodes run the image the upgrade started from.
+	UpgradeRollbackPhaseCompleted UpgradeRollbackPhase = "Completed"
+)
+
+// UpgradeRollbackStatus describes a rollback of a ScyllaDB version upgrade.
+type UpgradeRollbackStatus struct {
+	// phase is the phase of the rollback.
+	Phase UpgradeRollbackPhase `json:"phase"`
+
+	// reason is a machine readable reason of the rollback.
+	Reason string `json:"reason"`
+
+	// message is a human readable description of the rollback.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// fromImage is the image the upgrade started from and the nodes are reverted to.
+	FromImage string `json:"fromImage"`
+
+	// toImage is the image of the rolled back upgrade.
+	ToImage string `json:"toImage"`
+
+	// failedNodes lists the nodes that failed to become ready after being upgraded.
+	// +optional
+	FailedNodes []string `json:"failedNodes,omitempty"`
+
+	// restoredSystemKeyspacesNodes lists the nodes that had their system keyspaces restored
+	// from the snapshot taken before the upgrade.
+	// +optional
+	RestoredSystemKeyspacesNodes []string `json:"restoredSystemKeyspacesNodes,omitempty"`
+
+	// startTime is the time the rollback started.
+	StartTime metav1.Time `json:"startTime"`
+
+	// completionTime is the time the rollback completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+type KeyspaceReplicationType string
+
+const (
+	// KeyspaceReplicationTypeVnodes means the keyspace data is distributed using vnode token ownership.
+	KeyspaceReplicationTypeVnodes KeyspaceReplicationType = "Vnodes"
+
+	// KeyspaceReplicationTypeTablets means the keyspace data is distributed using tablets.
+	KeyspaceReplicationTypeTablets KeyspaceReplicationType = "Tablets"
+)
+
+// KeyspaceStatus describes the replication of a keyspace.
+type KeyspaceStatus struct {
+	// name is the name of the keyspace.
+	Name string `json:"name"`
+
+	// replication specifies how data of the keyspace is distributed across nodes.
+	Replication KeyspaceReplicationType `json:"replication"`
+}
+
+// DetectedScyllaDBVersion describes the ScyllaDB version detected from the running nodes.
+type DetectedScyllaDBVersion struct {
+	// version is the lowest ScyllaDB version reported by the nodes.
+	Version string `json:"version"`
+
+	// image is the ScyllaDB image all nodes were running when the version was detected.
+	Image string `json:"image"`
+}
+
+// InternodeEncryptionStatus describes the internode encryption configuration that ScyllaDB nodes are rendered with.
+type InternodeEncryptionStatus struct {
+	// listening indicates whether ScyllaDB nodes are configured with internode certificates
+	// and accept encrypted connections from their peers.
+	Listening bool `json:"listening"`
+
+	// mode specifies which connections ScyllaDB nodes encrypt when connecting to their peers.
+	Mode InternodeEncryptionMode `json:"mode"`
 }
 
 // +kubebuilder:object:root=true
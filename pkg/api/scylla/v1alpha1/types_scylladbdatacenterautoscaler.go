@@ -0,0 +1,187 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type AutoscalerMode string
+
+const (
+	// AutoscalerModeRecommend only reports the recommended number of nodes of every rack.
+	AutoscalerModeRecommend AutoscalerMode = "Recommend"
+
+	// AutoscalerModeApply scales the racks of the ScyllaDBDatacenter to the recommended number of nodes.
+	AutoscalerModeApply AutoscalerMode = "Apply"
+)
+
+type AutoscalerMetricsSourceType string
+
+const (
+	// AutoscalerMetricsSourceTypePrometheus reads disk utilization and load from Prometheus.
+	AutoscalerMetricsSourceTypePrometheus AutoscalerMetricsSourceType = "Prometheus"
+
+	// AutoscalerMetricsSourceTypeScyllaDBAPI reads disk utilization from the ScyllaDB REST API.
+	// The API doesn't expose load, so load thresholds aren't evaluated with this source.
+	AutoscalerMetricsSourceTypeScyllaDBAPI AutoscalerMetricsSourceType = "ScyllaDBAPI"
+)
+
+// AutoscalerPrometheusOptions specify how to query Prometheus.
+type AutoscalerPrometheusOptions struct {
+	// url is the address of the Prometheus API, e.g. "http://prometheus.monitoring.svc:9090".
+	URL string `json:"url"`
+
+	// diskUtilizationQuery overrides the query returning the disk utilization of ScyllaDB nodes, in percent.
+	// The query has to return an instant vector with a "pod" label. Occurrences of "$namespace"
+	// are replaced with the namespace of the ScyllaDBDatacenter.
+	// +optional
+	DiskUtilizationQuery string `json:"diskUtilizationQuery,omitempty"`
+
+	// loadQuery overrides the query returning the load of ScyllaDB nodes, in percent.
+	// The query has to return an instant vector with a "pod" label. Occurrences of "$namespace"
+	// are replaced with the namespace of the ScyllaDBDatacenter.
+	// +optional
+	LoadQuery string `json:"loadQuery,omitempty"`
+}
+
+// AutoscalerMetricsSource specifies where metrics are read from.
+type AutoscalerMetricsSource struct {
+	// type is the type of the metrics source.
+	// +kubebuilder:validation:Enum="Prometheus";"ScyllaDBAPI"
+	Type AutoscalerMetricsSourceType `json:"type"`
+
+	// prometheus specifies the Prometheus options. Required when type is Prometheus.
+	// +optional
+	Prometheus *AutoscalerPrometheusOptions `json:"prometheus,omitempty"`
+}
+
+// AutoscalerThresholds specify utilization thresholds, in percent.
+type AutoscalerThresholds struct {
+	// diskUtilizationPercent is the threshold of disk utilization of the most utilized node in a rack.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	DiskUtilizationPercent *int32 `json:"diskUtilizationPercent,omitempty"`
+
+	// loadPercent is the threshold of the average load of nodes in a rack.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	LoadPercent *int32 `json:"loadPercent,omitempty"`
+}
+
+// ScyllaDBDatacenterAutoscalerSpec defines the desired state of ScyllaDBDatacenterAutoscaler.
+type ScyllaDBDatacenterAutoscalerSpec struct {
+	// scyllaDBDatacenterRef references the ScyllaDBDatacenter to scale.
+	// This field is immutable.
+	ScyllaDBDatacenterRef ScyllaDBDatacenterReference `json:"scyllaDBDatacenterRef"`
+
+	// mode specifies whether recommendations are only reported or also applied.
+	// +kubebuilder:validation:Enum="Recommend";"Apply"
+	// +kubebuilder:default:="Recommend"
+	// +optional
+	Mode AutoscalerMode `json:"mode,omitempty"`
+
+	// minNodes is the minimum number of nodes of every rack.
+	// +kubebuilder:validation:Minimum=1
+	MinNodes int32 `json:"minNodes"`
+
+	// maxNodes is the maximum number of nodes of every rack.
+	// +kubebuilder:validation:Minimum=1
+	MaxNodes int32 `json:"maxNodes"`
+
+	// metricsSource specifies where metrics are read from.
+	MetricsSource AutoscalerMetricsSource `json:"metricsSource"`
+
+	// scaleOut specifies thresholds above which a rack is scaled out. Exceeding any of them is enough.
+	// +optional
+	ScaleOut AutoscalerThresholds `json:"scaleOut,omitempty"`
+
+	// scaleIn specifies thresholds below which a rack is scaled in. All of them have to be met.
+	// +optional
+	ScaleIn AutoscalerThresholds `json:"scaleIn,omitempty"`
+
+	// scaleOutCooldown is the minimal time between the last scaling of a rack and its scale-out.
+	// +kubebuilder:default:="10m"
+	// +optional
+	ScaleOutCooldown *metav1.Duration `json:"scaleOutCooldown,omitempty"`
+
+	// scaleInCooldown is the minimal time between the last scaling of a rack and its scale-in.
+	// +kubebuilder:default:="30m"
+	// +optional
+	ScaleInCooldown *metav1.Duration `json:"scaleInCooldown,omitempty"`
+}
+
+// AutoscalerRackStatus describes the observed state of a rack.
+type AutoscalerRackStatus struct {
+	// name is the name of the rack.
+	Name string `json:"name"`
+
+	// currentNodes is the number of nodes the rack is configured with.
+	CurrentNodes int32 `json:"currentNodes"`
+
+	// recommendedNodes is the recommended number of nodes of the rack.
+	RecommendedNodes int32 `json:"recommendedNodes"`
+
+	// diskUtilizationPercent is the disk utilization of the most utilized node in the rack.
+	// +optional
+	DiskUtilizationPercent *int32 `json:"diskUtilizationPercent,omitempty"`
+
+	// loadPercent is the average load of nodes in the rack.
+	// +optional
+	LoadPercent *int32 `json:"loadPercent,omitempty"`
+
+	// lastScaleTime is the time the rack was last scaled by the autoscaler.
+	// +optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+}
+
+// ScyllaDBDatacenterAutoscalerStatus defines the observed state of ScyllaDBDatacenterAutoscaler.
+type ScyllaDBDatacenterAutoscalerStatus struct {
+	// observedGeneration is the most recent generation observed for this ScyllaDBDatacenterAutoscaler. It corresponds to the
+	// ScyllaDBDatacenterAutoscaler's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// conditions hold conditions describing ScyllaDBDatacenterAutoscaler state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// racks hold the observed state of racks.
+	// +optional
+	Racks []AutoscalerRackStatus `json:"racks,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:printcolumn:name="DATACENTER",type=string,JSONPath=".spec.scyllaDBDatacenterRef.name"
+// +kubebuilder:printcolumn:name="MODE",type=string,JSONPath=".spec.mode"
+// +kubebuilder:printcolumn:name="AVAILABLE",type=string,JSONPath=".status.conditions[?(@.type=='Available')].status"
+// +kubebuilder:printcolumn:name="PROGRESSING",type=string,JSONPath=".status.conditions[?(@.type=='Progressing')].status"
+// +kubebuilder:printcolumn:name="DEGRADED",type=string,JSONPath=".status.conditions[?(@.type=='Degraded')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ScyllaDBDatacenterAutoscaler scales racks of a ScyllaDBDatacenter based on disk utilization and load.
+type ScyllaDBDatacenterAutoscaler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec defines the desired state of this ScyllaDBDatacenterAutoscaler.
+	Spec ScyllaDBDatacenterAutoscalerSpec `json:"spec,omitempty"`
+
+	// status specifies the current status of this ScyllaDBDatacenterAutoscaler.
+	Status ScyllaDBDatacenterAutoscalerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ScyllaDBDatacenterAutoscalerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScyllaDBDatacenterAutoscaler `json:"items"`
+}
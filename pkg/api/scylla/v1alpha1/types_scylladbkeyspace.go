@@ -0,0 +1,173 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScyllaDBDatacenterReference references a ScyllaDBDatacenter in the namespace of the referencing object.
+type ScyllaDBDatacenterReference struct {
+	// name is the name of the ScyllaDBDatacenter.
+	Name string `json:"name"`
+}
+
+// DatacenterReplicationFactor specifies the number of replicas in a datacenter.
+type DatacenterReplicationFactor struct {
+	// name is the name of the datacenter.
+	Name string `json:"name"`
+
+	// replicationFactor is the number of replicas of every piece of data in the datacenter.
+	// +kubebuilder:validation:Minimum=0
+	ReplicationFactor int32 `json:"replicationFactor"`
+}
+
+// KeyspaceReplication specifies how data of a keyspace is replicated.
+type KeyspaceReplication struct {
+	// datacenters specify the replication factor of every datacenter the keyspace is replicated to.
+	// Keyspaces are replicated using NetworkTopologyStrategy. Datacenters that aren't listed don't hold any replicas.
+	// +listType=map
+	// +listMapKey=name
+	Datacenters []DatacenterReplicationFactor `json:"datacenters"`
+}
+
+// KeyspaceTablets specifies the tablets options of a keyspace.
+type KeyspaceTablets struct {
+	// enabled specifies whether the keyspace uses tablets. When empty, the default of the ScyllaDB cluster is used.
+	// This field is immutable.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// initialTablets is the number of tablets a table of the keyspace starts with.
+	// Zero lets ScyllaDB choose the number of tablets.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	InitialTablets *int32 `json:"initialTablets,omitempty"`
+}
+
+type KeyspaceDeletionPolicy string
+
+const (
+	// KeyspaceDeletionPolicyRetain keeps the keyspace when the ScyllaDBKeyspace is deleted.
+	KeyspaceDeletionPolicyRetain KeyspaceDeletionPolicy = "Retain"
+
+	// KeyspaceDeletionPolicyDelete drops the keyspace, including all its data, when the ScyllaDBKeyspace is deleted.
+	KeyspaceDeletionPolicyDelete KeyspaceDeletionPolicy = "Delete"
+)
+
+// ScyllaDBKeyspaceSpec defines the desired state of ScyllaDBKeyspace.
+type ScyllaDBKeyspaceSpec struct {
+	// scyllaDBDatacenterRef references the ScyllaDBDatacenter the keyspace is managed through.
+	// The keyspace is created in the whole cluster the ScyllaDBDatacenter belongs to.
+	// This field is immutable.
+	ScyllaDBDatacenterRef ScyllaDBDatacenterReference `json:"scyllaDBDatacenterRef"`
+
+	// keyspaceName is the name of the keyspace. When empty, the name of the ScyllaDBKeyspace is used.
+	// This field is immutable.
+	// +optional
+	KeyspaceName string `json:"keyspaceName,omitempty"`
+
+	// replication specifies how data of the keyspace is replicated.
+	// Increasing the replication factor of a keyspace that doesn't use tablets triggers a repair of the keyspace.
+	Replication KeyspaceReplication `json:"replication"`
+
+	// tablets specifies the tablets options of the keyspace.
+	// +optional
+	Tablets *KeyspaceTablets `json:"tablets,omitempty"`
+
+	// durableWrites specifies whether writes to the keyspace go through the commit log.
+	// +kubebuilder:default:=true
+	// +optional
+	DurableWrites *bool `json:"durableWrites,omitempty"`
+
+	// deletionPolicy specifies what happens to the keyspace when the ScyllaDBKeyspace is deleted.
+	// +kubebuilder:validation:Enum="Retain";"Delete"
+	// +kubebuilder:default:="Retain"
+	// +optional
+	DeletionPolicy KeyspaceDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// KeyspaceRepairStatus reports a repair of a keyspace that follows an increase of its replication factor.
+type KeyspaceRepairStatus struct {
+	// pendingHosts are the hosts that are yet to be repaired.
+	// +optional
+	PendingHosts []string `json:"pendingHosts,omitempty"`
+
+	// host is the host that is being repaired.
+	// +optional
+	Host string `json:"host,omitempty"`
+
+	// id is the ID of the repair running on the host.
+	// +optional
+	ID *int32 `json:"id,omitempty"`
+}
+
+const (
+	// ScyllaDBKeyspaceDriftedCondition reports whether the keyspace in the cluster differs from the spec.
+	ScyllaDBKeyspaceDriftedCondition = "Drifted"
+)
+
+// ScyllaDBKeyspaceStatus defines the observed state of ScyllaDBKeyspace.
+type ScyllaDBKeyspaceStatus struct {
+	// observedGeneration is the most recent generation observed for this ScyllaDBKeyspace. It corresponds to the
+	// ScyllaDBKeyspace's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// conditions hold conditions describing ScyllaDBKeyspace state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// keyspaceName is the name of the managed keyspace.
+	// +optional
+	KeyspaceName string `json:"keyspaceName,omitempty"`
+
+	// replication is the replication of the keyspace observed in the cluster.
+	// +optional
+	Replication []DatacenterReplicationFactor `json:"replication,omitempty"`
+
+	// tabletsEnabled reports whether the keyspace observed in the cluster uses tablets.
+	// +optional
+	TabletsEnabled *bool `json:"tabletsEnabled,omitempty"`
+
+	// durableWrites reports whether the keyspace observed in the cluster uses durable writes.
+	// +optional
+	DurableWrites *bool `json:"durableWrites,omitempty"`
+
+	// repair reports the repair of the keyspace following an increase of its replication factor.
+	// +optional
+	Repair *KeyspaceRepairStatus `json:"repair,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:printcolumn:name="KEYSPACE",type=string,JSONPath=".status.keyspaceName"
+// +kubebuilder:printcolumn:name="AVAILABLE",type=string,JSONPath=".status.conditions[?(@.type=='Available')].status"
+// +kubebuilder:printcolumn:name="PROGRESSING",type=string,JSONPath=".status.conditions[?(@.type=='Progressing')].status"
+// +kubebuilder:printcolumn:name="DEGRADED",type=string,JSONPath=".status.conditions[?(@.type=='Degraded')].status"
+// +kubebuilder:printcolumn:name="DRIFTED",type=string,JSONPath=".status.conditions[?(@.type=='Drifted')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ScyllaDBKeyspace defines a keyspace of a ScyllaDB cluster.
+type ScyllaDBKeyspace struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec defines the desired state of this ScyllaDBKeyspace.
+	Spec ScyllaDBKeyspaceSpec `json:"spec,omitempty"`
+
+	// status specifies the current status of this ScyllaDBKeyspace.
+	Status ScyllaDBKeyspaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ScyllaDBKeyspaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScyllaDBKeyspace `json:"items"`
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScyllaDBManagerTaskPhase describes the lifecycle phase of a one-off Scylla Manager task.
+type ScyllaDBManagerTaskPhase string
+
+const (
+	// ScyllaDBManagerTaskPhasePending means the task wasn't started yet,
+	// e.g. because the cluster isn't registered with Scylla Manager.
+	ScyllaDBManagerTaskPhasePending ScyllaDBManagerTaskPhase = "Pending"
+
+	// ScyllaDBManagerTaskPhaseRunning means the task is running or waiting to be retried.
+	ScyllaDBManagerTaskPhaseRunning ScyllaDBManagerTaskPhase = "Running"
+
+	// ScyllaDBManagerTaskPhaseSucceeded means the task has finished successfully.
+	ScyllaDBManagerTaskPhaseSucceeded ScyllaDBManagerTaskPhase = "Succeeded"
+
+	// ScyllaDBManagerTaskPhaseFailed means the task has failed, or was stopped, and won't be retried.
+	ScyllaDBManagerTaskPhaseFailed ScyllaDBManagerTaskPhase = "Failed"
+)
+
+// ScyllaDBManagerTaskStatus describes the observed state of a one-off Scylla Manager task.
+type ScyllaDBManagerTaskStatus struct {
+	// observedGeneration is the most recent generation observed for this object. It corresponds to the
+	// object's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// conditions hold conditions describing the state of this object.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// phase is the lifecycle phase of the task.
+	// +optional
+	Phase ScyllaDBManagerTaskPhase `json:"phase,omitempty"`
+
+	// taskID is the ID of the task in Scylla Manager.
+	// +optional
+	TaskID *string `json:"taskID,omitempty"`
+
+	// progress is the percentage of the work done by the task.
+	// +optional
+	Progress *int64 `json:"progress,omitempty"`
+
+	// startTime is the time the task run was started at.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// completionTime is the time the task has succeeded or failed at.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// message is a human-readable message describing why the task has failed, if it did.
+	// +optional
+	Message *string `json:"message,omitempty"`
+}
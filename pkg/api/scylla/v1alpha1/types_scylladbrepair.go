@@ -0,0 +1,96 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScyllaDBRepairSpec defines the desired state of ScyllaDBRepair.
+// Except for ttlSecondsAfterFinished, it can't be changed once the ScyllaDBRepair is created.
+type ScyllaDBRepairSpec struct {
+	// scyllaClusterName is the name of the ScyllaCluster to repair.
+	ScyllaClusterName string `json:"scyllaClusterName"`
+
+	// dc is a list of datacenter glob patterns, e.g. 'dc1', '!otherdc*' used to specify the DCs
+	// to include or exclude from repair.
+	// +optional
+	DC []string `json:"dc,omitempty"`
+
+	// keyspace is a list of keyspace/tables glob patterns, e.g. 'keyspace,!keyspace.table_prefix_*'
+	// used to include or exclude keyspaces from repair.
+	// +optional
+	Keyspace []string `json:"keyspace,omitempty"`
+
+	// failFast indicates if a repair should be stopped on first error.
+	// +optional
+	FailFast bool `json:"failFast,omitempty"`
+
+	// intensity indicates how many token ranges (per shard) to repair in a single Scylla repair job.
+	// If you set it to 0 the number of token ranges is adjusted to the maximum supported by node.
+	// +kubebuilder:default:="1"
+	// +optional
+	Intensity string `json:"intensity,omitempty"`
+
+	// parallel is the maximum number of Scylla repair jobs that can run at the same time (on different token ranges and replicas).
+	// By default the maximum possible parallelism is used.
+	// +kubebuilder:default:=0
+	// +optional
+	Parallel int64 `json:"parallel,omitempty"`
+
+	// smallTableThreshold enable small table optimization for tables of size lower than given threshold.
+	// Supported units [B, MiB, GiB, TiB].
+	// +kubebuilder:default:="1GiB"
+	// +optional
+	SmallTableThreshold string `json:"smallTableThreshold,omitempty"`
+
+	// host specifies a host to repair. If empty, all hosts are repaired.
+	// +optional
+	Host *string `json:"host,omitempty"`
+
+	// numRetries indicates how many times the task will be retried before failing.
+	// +kubebuilder:default:=3
+	// +optional
+	NumRetries *int64 `json:"numRetries,omitempty"`
+
+	// ttlSecondsAfterFinished limits the lifetime of a ScyllaDBRepair that has finished.
+	// When set, the ScyllaDBRepair is deleted the given number of seconds after it has succeeded or failed.
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// ScyllaDBRepairStatus defines the observed state of ScyllaDBRepair.
+type ScyllaDBRepairStatus struct {
+	ScyllaDBManagerTaskStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:printcolumn:name="CLUSTER",type=string,JSONPath=".spec.scyllaClusterName"
+// +kubebuilder:printcolumn:name="PHASE",type=string,JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="PROGRESS",type=integer,JSONPath=".status.progress"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ScyllaDBRepair runs a one-off Scylla Manager repair of a ScyllaCluster.
+type ScyllaDBRepair struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec defines the desired state of this ScyllaDBRepair.
+	Spec ScyllaDBRepairSpec `json:"spec,omitempty"`
+
+	// status specifies the current status of this ScyllaDBRepair.
+	Status ScyllaDBRepairStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ScyllaDBRepairList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScyllaDBRepair `json:"items"`
+}
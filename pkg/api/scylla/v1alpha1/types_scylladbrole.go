@@ -0,0 +1,149 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type RolePermission string
+
+const (
+	RolePermissionCreate    RolePermission = "Create"
+	RolePermissionAlter     RolePermission = "Alter"
+	RolePermissionDrop      RolePermission = "Drop"
+	RolePermissionSelect    RolePermission = "Select"
+	RolePermissionModify    RolePermission = "Modify"
+	RolePermissionAuthorize RolePermission = "Authorize"
+)
+
+// RoleGrant specifies permissions of a role on a data resource.
+type RoleGrant struct {
+	// keyspace is the name of the keyspace the permissions are granted on.
+	// When empty, the permissions are granted on all keyspaces.
+	// +optional
+	Keyspace string `json:"keyspace,omitempty"`
+
+	// table is the name of the table of the keyspace the permissions are granted on.
+	// When empty, the permissions are granted on the whole keyspace.
+	// +optional
+	Table string `json:"table,omitempty"`
+
+	// permissions are the permissions granted on the resource.
+	// Create permission can't be granted on a table.
+	// +listType=set
+	Permissions []RolePermission `json:"permissions"`
+}
+
+// ScyllaDBRoleSpec defines the desired state of ScyllaDBRole.
+type ScyllaDBRoleSpec struct {
+	// scyllaDBDatacenterRef references the ScyllaDBDatacenter the role is managed through.
+	// The role is created in the whole cluster the ScyllaDBDatacenter belongs to.
+	// This field is immutable.
+	ScyllaDBDatacenterRef ScyllaDBDatacenterReference `json:"scyllaDBDatacenterRef"`
+
+	// roleName is the name of the role. When empty, the name of the ScyllaDBRole is used.
+	// This field is immutable.
+	// +optional
+	RoleName string `json:"roleName,omitempty"`
+
+	// login specifies whether the role can log in.
+	// Roles that can log in get a password, which is stored in a Secret named after the ScyllaDBRole.
+	// +kubebuilder:default:=true
+	// +optional
+	Login *bool `json:"login,omitempty"`
+
+	// superuser specifies whether the role is a superuser.
+	// +optional
+	Superuser bool `json:"superuser,omitempty"`
+
+	// grants specify the permissions of the role.
+	// Permissions of the role that aren't listed are revoked.
+	// +optional
+	Grants []RoleGrant `json:"grants,omitempty"`
+
+	// passwordRotationReason specifies the latest password rotation reason.
+	// Can be used to rotate the password of the role by providing a unique string.
+	// +optional
+	PasswordRotationReason string `json:"passwordRotationReason,omitempty"`
+
+	// adoptExistingRole specifies whether a role that already exists in the cluster is taken over.
+	// An adopted role is altered to match the spec, its password is replaced and it's dropped when the ScyllaDBRole is deleted.
+	// Roles that already exist aren't modified unless they are adopted.
+	// +optional
+	AdoptExistingRole bool `json:"adoptExistingRole,omitempty"`
+}
+
+// ScyllaDBRoleStatus defines the observed state of ScyllaDBRole.
+type ScyllaDBRoleStatus struct {
+	// observedGeneration is the most recent generation observed for this ScyllaDBRole. It corresponds to the
+	// ScyllaDBRole's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// conditions hold conditions describing ScyllaDBRole state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// roleName is the name of the managed role.
+	// +optional
+	RoleName string `json:"roleName,omitempty"`
+
+	// ownsRole reflects whether the role was created or adopted by this ScyllaDBRole.
+	// Only owned roles are managed and dropped on deletion.
+	// +optional
+	OwnsRole bool `json:"ownsRole,omitempty"`
+
+	// passwordSecretName is the name of the Secret holding the credentials of the role.
+	// +optional
+	PasswordSecretName string `json:"passwordSecretName,omitempty"`
+
+	// passwordSecretResourceVersion is the resource version of the password Secret that was last applied to the role.
+	// +optional
+	PasswordSecretResourceVersion string `json:"passwordSecretResourceVersion,omitempty"`
+
+	// passwordRotationReason is the password rotation reason that was last handled.
+	// +optional
+	PasswordRotationReason string `json:"passwordRotationReason,omitempty"`
+
+	// lastPasswordRotationTime is the time the password of the role was last rotated.
+	// +optional
+	LastPasswordRotationTime *metav1.Time `json:"lastPasswordRotationTime,omitempty"`
+
+	// grantedPermissions are the permissions of the role observed in the cluster, in the form of
+	// "<permission> ON <resource>".
+	// +optional
+	GrantedPermissions []string `json:"grantedPermissions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:printcolumn:name="ROLE",type=string,JSONPath=".status.roleName"
+// +kubebuilder:printcolumn:name="AVAILABLE",type=string,JSONPath=".status.conditions[?(@.type=='Available')].status"
+// +kubebuilder:printcolumn:name="PROGRESSING",type=string,JSONPath=".status.conditions[?(@.type=='Progressing')].status"
+// +kubebuilder:printcolumn:name="DEGRADED",type=string,JSONPath=".status.conditions[?(@.type=='Degraded')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ScyllaDBRole defines a CQL role of a ScyllaDB cluster.
+type ScyllaDBRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec defines the desired state of this ScyllaDBRole.
+	Spec ScyllaDBRoleSpec `json:"spec,omitempty"`
+
+	// status specifies the current status of this ScyllaDBRole.
+	Status ScyllaDBRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ScyllaDBRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScyllaDBRole `json:"items"`
+}
@@ -32,6 +32,221 @@ func (in *AlternatorOptions) DeepCopy() *AlternatorOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutomaticRacks) DeepCopyInto(out *AutomaticRacks) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ZoneRemovalGracePeriodSeconds != nil {
+		in, out := &in.ZoneRemovalGracePeriodSeconds, &out.ZoneRemovalGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutomaticRacks.
+func (in *AutomaticRacks) DeepCopy() *AutomaticRacks {
+	if in == nil {
+		return nil
+	}
+	out := new(AutomaticRacks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutomaticRacksStatus) DeepCopyInto(out *AutomaticRacksStatus) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]AutomaticRacksZoneStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutomaticRacksStatus.
+func (in *AutomaticRacksStatus) DeepCopy() *AutomaticRacksStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutomaticRacksStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutomaticRacksZoneStatus) DeepCopyInto(out *AutomaticRacksZoneStatus) {
+	*out = *in
+	if in.MissingSince != nil {
+		in, out := &in.MissingSince, &out.MissingSince
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutomaticRacksZoneStatus.
+func (in *AutomaticRacksZoneStatus) DeepCopy() *AutomaticRacksZoneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutomaticRacksZoneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerMetricsSource) DeepCopyInto(out *AutoscalerMetricsSource) {
+	*out = *in
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(AutoscalerPrometheusOptions)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerMetricsSource.
+func (in *AutoscalerMetricsSource) DeepCopy() *AutoscalerMetricsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerMetricsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerPrometheusOptions) DeepCopyInto(out *AutoscalerPrometheusOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerPrometheusOptions.
+func (in *AutoscalerPrometheusOptions) DeepCopy() *AutoscalerPrometheusOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerPrometheusOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerRackStatus) DeepCopyInto(out *AutoscalerRackStatus) {
+	*out = *in
+	if in.DiskUtilizationPercent != nil {
+		in, out := &in.DiskUtilizationPercent, &out.DiskUtilizationPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LoadPercent != nil {
+		in, out := &in.LoadPercent, &out.LoadPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerRackStatus.
+func (in *AutoscalerRackStatus) DeepCopy() *AutoscalerRackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerRackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerThresholds) DeepCopyInto(out *AutoscalerThresholds) {
+	*out = *in
+	if in.DiskUtilizationPercent != nil {
+		in, out := &in.DiskUtilizationPercent, &out.DiskUtilizationPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LoadPercent != nil {
+		in, out := &in.LoadPercent, &out.LoadPercent
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerThresholds.
+func (in *AutoscalerThresholds) DeepCopy() *AutoscalerThresholds {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerThresholds)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupLocationInventory) DeepCopyInto(out *BackupLocationInventory) {
+	*out = *in
+	if in.Snapshots != nil {
+		in, out := &in.Snapshots, &out.Snapshots
+		*out = make([]BackupSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupLocationInventory.
+func (in *BackupLocationInventory) DeepCopy() *BackupLocationInventory {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupLocationInventory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSnapshot) DeepCopyInto(out *BackupSnapshot) {
+	*out = *in
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSnapshot.
+func (in *BackupSnapshot) DeepCopy() *BackupSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BroadcastOptions) DeepCopyInto(out *BroadcastOptions) {
 	*out = *in
@@ -91,6 +306,49 @@ func (in *CQLExposeOptions) DeepCopy() *CQLExposeOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryPrometheusOptions) DeepCopyInto(out *CanaryPrometheusOptions) {
+	*out = *in
+	if in.Queries != nil {
+		in, out := &in.Queries, &out.Queries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryPrometheusOptions.
+func (in *CanaryPrometheusOptions) DeepCopy() *CanaryPrometheusOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryPrometheusOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryRolloutStrategy) DeepCopyInto(out *CanaryRolloutStrategy) {
+	*out = *in
+	out.SoakDuration = in.SoakDuration
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(CanaryPrometheusOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryRolloutStrategy.
+func (in *CanaryRolloutStrategy) DeepCopy() *CanaryRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Components) DeepCopyInto(out *Components) {
 	*out = *in
@@ -117,6 +375,54 @@ func (in *Components) DeepCopy() *Components {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatacenterDecommissionOptions) DeepCopyInto(out *DatacenterDecommissionOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatacenterDecommissionOptions.
+func (in *DatacenterDecommissionOptions) DeepCopy() *DatacenterDecommissionOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DatacenterDecommissionOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatacenterReplicationFactor) DeepCopyInto(out *DatacenterReplicationFactor) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatacenterReplicationFactor.
+func (in *DatacenterReplicationFactor) DeepCopy() *DatacenterReplicationFactor {
+	if in == nil {
+		return nil
+	}
+	out := new(DatacenterReplicationFactor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DetectedScyllaDBVersion) DeepCopyInto(out *DetectedScyllaDBVersion) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DetectedScyllaDBVersion.
+func (in *DetectedScyllaDBVersion) DeepCopy() *DetectedScyllaDBVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(DetectedScyllaDBVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeviceDiscovery) DeepCopyInto(out *DeviceDiscovery) {
 	*out = *in
@@ -300,76 +606,219 @@ func (in *IngressOptions) DeepCopy() *IngressOptions {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalDiskSetup) DeepCopyInto(out *LocalDiskSetup) {
+func (in *InternodeEncryptionOptions) DeepCopyInto(out *InternodeEncryptionOptions) {
 	*out = *in
-	if in.LoopDevices != nil {
-		in, out := &in.LoopDevices, &out.LoopDevices
-		*out = make([]LoopDeviceConfiguration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.RAIDs != nil {
-		in, out := &in.RAIDs, &out.RAIDs
-		*out = make([]RAIDConfiguration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Filesystems != nil {
-		in, out := &in.Filesystems, &out.Filesystems
-		*out = make([]FilesystemConfiguration, len(*in))
-		copy(*out, *in)
-	}
-	if in.Mounts != nil {
-		in, out := &in.Mounts, &out.Mounts
-		*out = make([]MountConfiguration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalDiskSetup.
-func (in *LocalDiskSetup) DeepCopy() *LocalDiskSetup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternodeEncryptionOptions.
+func (in *InternodeEncryptionOptions) DeepCopy() *InternodeEncryptionOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalDiskSetup)
+	out := new(InternodeEncryptionOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoopDeviceConfiguration) DeepCopyInto(out *LoopDeviceConfiguration) {
+func (in *InternodeEncryptionStatus) DeepCopyInto(out *InternodeEncryptionStatus) {
 	*out = *in
-	out.Size = in.Size.DeepCopy()
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoopDeviceConfiguration.
-func (in *LoopDeviceConfiguration) DeepCopy() *LoopDeviceConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternodeEncryptionStatus.
+func (in *InternodeEncryptionStatus) DeepCopy() *InternodeEncryptionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LoopDeviceConfiguration)
+	out := new(InternodeEncryptionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MountConfiguration) DeepCopyInto(out *MountConfiguration) {
+func (in *KeyspaceRepairStatus) DeepCopyInto(out *KeyspaceRepairStatus) {
 	*out = *in
-	if in.UnsupportedOptions != nil {
-		in, out := &in.UnsupportedOptions, &out.UnsupportedOptions
+	if in.PendingHosts != nil {
+		in, out := &in.PendingHosts, &out.PendingHosts
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MountConfiguration.
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyspaceRepairStatus.
+func (in *KeyspaceRepairStatus) DeepCopy() *KeyspaceRepairStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyspaceRepairStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyspaceReplication) DeepCopyInto(out *KeyspaceReplication) {
+	*out = *in
+	if in.Datacenters != nil {
+		in, out := &in.Datacenters, &out.Datacenters
+		*out = make([]DatacenterReplicationFactor, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyspaceReplication.
+func (in *KeyspaceReplication) DeepCopy() *KeyspaceReplication {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyspaceReplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyspaceStatus) DeepCopyInto(out *KeyspaceStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyspaceStatus.
+func (in *KeyspaceStatus) DeepCopy() *KeyspaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyspaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyspaceTablets) DeepCopyInto(out *KeyspaceTablets) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InitialTablets != nil {
+		in, out := &in.InitialTablets, &out.InitialTablets
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyspaceTablets.
+func (in *KeyspaceTablets) DeepCopy() *KeyspaceTablets {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyspaceTablets)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalDiskSetup) DeepCopyInto(out *LocalDiskSetup) {
+	*out = *in
+	if in.LoopDevices != nil {
+		in, out := &in.LoopDevices, &out.LoopDevices
+		*out = make([]LoopDeviceConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RAIDs != nil {
+		in, out := &in.RAIDs, &out.RAIDs
+		*out = make([]RAIDConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Filesystems != nil {
+		in, out := &in.Filesystems, &out.Filesystems
+		*out = make([]FilesystemConfiguration, len(*in))
+		copy(*out, *in)
+	}
+	if in.Mounts != nil {
+		in, out := &in.Mounts, &out.Mounts
+		*out = make([]MountConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalDiskSetup.
+func (in *LocalDiskSetup) DeepCopy() *LocalDiskSetup {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalDiskSetup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoopDeviceConfiguration) DeepCopyInto(out *LoopDeviceConfiguration) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoopDeviceConfiguration.
+func (in *LoopDeviceConfiguration) DeepCopy() *LoopDeviceConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LoopDeviceConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
+	}
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MountConfiguration) DeepCopyInto(out *MountConfiguration) {
+	*out = *in
+	if in.UnsupportedOptions != nil {
+		in, out := &in.UnsupportedOptions, &out.UnsupportedOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MountConfiguration.
 func (in *MountConfiguration) DeepCopy() *MountConfiguration {
 	if in == nil {
 		return nil
@@ -694,6 +1143,91 @@ func (in *OperatorManagedTLSCertificateOptions) DeepCopy() *OperatorManagedTLSCe
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedNodeDetection) DeepCopyInto(out *OrphanedNodeDetection) {
+	*out = *in
+	if in.Detectors != nil {
+		in, out := &in.Detectors, &out.Detectors
+		*out = make([]OrphanedVolumeDetector, len(*in))
+		copy(*out, *in)
+	}
+	if in.MountFailureThreshold != nil {
+		in, out := &in.MountFailureThreshold, &out.MountFailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedNodeDetection.
+func (in *OrphanedNodeDetection) DeepCopy() *OrphanedNodeDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedNodeDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedNodeReplacement) DeepCopyInto(out *OrphanedNodeReplacement) {
+	*out = *in
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(OrphanedNodeReplacementRateLimit)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedNodeReplacement.
+func (in *OrphanedNodeReplacement) DeepCopy() *OrphanedNodeReplacement {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedNodeReplacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedNodeReplacementRateLimit) DeepCopyInto(out *OrphanedNodeReplacementRateLimit) {
+	*out = *in
+	out.Period = in.Period
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedNodeReplacementRateLimit.
+func (in *OrphanedNodeReplacementRateLimit) DeepCopy() *OrphanedNodeReplacementRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedNodeReplacementRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedNodeReplacementStatus) DeepCopyInto(out *OrphanedNodeReplacementStatus) {
+	*out = *in
+	in.DetectionTime.DeepCopyInto(&out.DetectionTime)
+	if in.ReplacementTime != nil {
+		in, out := &in.ReplacementTime, &out.ReplacementTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedNodeReplacementStatus.
+func (in *OrphanedNodeReplacementStatus) DeepCopy() *OrphanedNodeReplacementStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedNodeReplacementStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Placement) DeepCopyInto(out *Placement) {
 	*out = *in
@@ -999,6 +1533,84 @@ func (in *RackTemplate) DeepCopy() *RackTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteKubernetesClusterReference) DeepCopyInto(out *RemoteKubernetesClusterReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteKubernetesClusterReference.
+func (in *RemoteKubernetesClusterReference) DeepCopy() *RemoteKubernetesClusterReference {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteKubernetesClusterReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleGrant) DeepCopyInto(out *RoleGrant) {
+	*out = *in
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]RolePermission, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleGrant.
+func (in *RoleGrant) DeepCopy() *RoleGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	if in.CanarySoakStartTime != nil {
+		in, out := &in.CanarySoakStartTime, &out.CanarySoakStartTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStrategy) DeepCopyInto(out *RolloutStrategy) {
+	*out = *in
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryRolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategy.
+func (in *RolloutStrategy) DeepCopy() *RolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScyllaDB) DeepCopyInto(out *ScyllaDB) {
 	*out = *in
@@ -1022,6 +1634,11 @@ func (in *ScyllaDB) DeepCopyInto(out *ScyllaDB) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.InternodeEncryptionOptions != nil {
+		in, out := &in.InternodeEncryptionOptions, &out.InternodeEncryptionOptions
+		*out = new(InternodeEncryptionOptions)
+		**out = **in
+	}
 	return
 }
 
@@ -1036,7 +1653,7 @@ func (in *ScyllaDB) DeepCopy() *ScyllaDB {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScyllaDBDatacenter) DeepCopyInto(out *ScyllaDBDatacenter) {
+func (in *ScyllaDBBackup) DeepCopyInto(out *ScyllaDBBackup) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1045,18 +1662,18 @@ func (in *ScyllaDBDatacenter) DeepCopyInto(out *ScyllaDBDatacenter) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBDatacenter.
-func (in *ScyllaDBDatacenter) DeepCopy() *ScyllaDBDatacenter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBBackup.
+func (in *ScyllaDBBackup) DeepCopy() *ScyllaDBBackup {
 	if in == nil {
 		return nil
 	}
-	out := new(ScyllaDBDatacenter)
+	out := new(ScyllaDBBackup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ScyllaDBDatacenter) DeepCopyObject() runtime.Object {
+func (in *ScyllaDBBackup) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1064,13 +1681,41 @@ func (in *ScyllaDBDatacenter) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScyllaDBDatacenterList) DeepCopyInto(out *ScyllaDBDatacenterList) {
+func (in *ScyllaDBBackupInventory) DeepCopyInto(out *ScyllaDBBackupInventory) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBBackupInventory.
+func (in *ScyllaDBBackupInventory) DeepCopy() *ScyllaDBBackupInventory {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBBackupInventory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScyllaDBBackupInventory) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBBackupInventoryList) DeepCopyInto(out *ScyllaDBBackupInventoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ScyllaDBDatacenter, len(*in))
+		*out = make([]ScyllaDBBackupInventory, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1078,18 +1723,18 @@ func (in *ScyllaDBDatacenterList) DeepCopyInto(out *ScyllaDBDatacenterList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBDatacenterList.
-func (in *ScyllaDBDatacenterList) DeepCopy() *ScyllaDBDatacenterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBBackupInventoryList.
+func (in *ScyllaDBBackupInventoryList) DeepCopy() *ScyllaDBBackupInventoryList {
 	if in == nil {
 		return nil
 	}
-	out := new(ScyllaDBDatacenterList)
+	out := new(ScyllaDBBackupInventoryList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ScyllaDBDatacenterList) DeepCopyObject() runtime.Object {
+func (in *ScyllaDBBackupInventoryList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1097,49 +1742,275 @@ func (in *ScyllaDBDatacenterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScyllaDBDatacenterSpec) DeepCopyInto(out *ScyllaDBDatacenterSpec) {
+func (in *ScyllaDBBackupInventorySpec) DeepCopyInto(out *ScyllaDBBackupInventorySpec) {
 	*out = *in
-	if in.Metadata != nil {
-		in, out := &in.Metadata, &out.Metadata
-		*out = new(ObjectTemplateMetadata)
-		(*in).DeepCopyInto(*out)
+	if in.Locations != nil {
+		in, out := &in.Locations, &out.Locations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.DatacenterName != nil {
-		in, out := &in.DatacenterName, &out.DatacenterName
-		*out = new(string)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBBackupInventorySpec.
+func (in *ScyllaDBBackupInventorySpec) DeepCopy() *ScyllaDBBackupInventorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBBackupInventorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBBackupInventoryStatus) DeepCopyInto(out *ScyllaDBBackupInventoryStatus) {
+	*out = *in
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
 		**out = **in
 	}
-	in.ScyllaDB.DeepCopyInto(&out.ScyllaDB)
-	if in.ScyllaDBManagerAgent != nil {
-		in, out := &in.ScyllaDBManagerAgent, &out.ScyllaDBManagerAgent
-		*out = new(ScyllaDBManagerAgent)
-		(*in).DeepCopyInto(*out)
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
 	}
-	if in.ImagePullSecrets != nil {
-		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
-		*out = make([]v1.LocalObjectReference, len(*in))
+	if in.Locations != nil {
+		in, out := &in.Locations, &out.Locations
+		*out = make([]BackupLocationInventory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBBackupInventoryStatus.
+func (in *ScyllaDBBackupInventoryStatus) DeepCopy() *ScyllaDBBackupInventoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBBackupInventoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBBackupList) DeepCopyInto(out *ScyllaDBBackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScyllaDBBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBBackupList.
+func (in *ScyllaDBBackupList) DeepCopy() *ScyllaDBBackupList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBBackupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScyllaDBBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBBackupSpec) DeepCopyInto(out *ScyllaDBBackupSpec) {
+	*out = *in
+	if in.DC != nil {
+		in, out := &in.DC, &out.DC
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.DNSPolicy != nil {
-		in, out := &in.DNSPolicy, &out.DNSPolicy
-		*out = new(v1.DNSPolicy)
-		**out = **in
+	if in.Keyspace != nil {
+		in, out := &in.Keyspace, &out.Keyspace
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.DNSDomains != nil {
-		in, out := &in.DNSDomains, &out.DNSDomains
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.ForceRedeploymentReason != nil {
-		in, out := &in.ForceRedeploymentReason, &out.ForceRedeploymentReason
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SnapshotParallel != nil {
+		in, out := &in.SnapshotParallel, &out.SnapshotParallel
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UploadParallel != nil {
+		in, out := &in.UploadParallel, &out.UploadParallel
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NumRetries != nil {
+		in, out := &in.NumRetries, &out.NumRetries
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBBackupSpec.
+func (in *ScyllaDBBackupSpec) DeepCopy() *ScyllaDBBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBBackupStatus) DeepCopyInto(out *ScyllaDBBackupStatus) {
+	*out = *in
+	in.ScyllaDBManagerTaskStatus.DeepCopyInto(&out.ScyllaDBManagerTaskStatus)
+	if in.SnapshotTag != nil {
+		in, out := &in.SnapshotTag, &out.SnapshotTag
 		*out = new(string)
 		**out = **in
 	}
-	if in.ExposeOptions != nil {
-		in, out := &in.ExposeOptions, &out.ExposeOptions
-		*out = new(ExposeOptions)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBBackupStatus.
+func (in *ScyllaDBBackupStatus) DeepCopy() *ScyllaDBBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBCluster) DeepCopyInto(out *ScyllaDBCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBCluster.
+func (in *ScyllaDBCluster) DeepCopy() *ScyllaDBCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScyllaDBCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterDatacenter) DeepCopyInto(out *ScyllaDBClusterDatacenter) {
+	*out = *in
+	in.ScyllaDBClusterDatacenterTemplate.DeepCopyInto(&out.ScyllaDBClusterDatacenterTemplate)
+	if in.RemoteKubernetesCluster != nil {
+		in, out := &in.RemoteKubernetesCluster, &out.RemoteKubernetesCluster
+		*out = new(RemoteKubernetesClusterReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterDatacenter.
+func (in *ScyllaDBClusterDatacenter) DeepCopy() *ScyllaDBClusterDatacenter {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterDatacenter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterDatacenterStatus) DeepCopyInto(out *ScyllaDBClusterDatacenterStatus) {
+	*out = *in
+	if in.RemoteKubernetesCluster != nil {
+		in, out := &in.RemoteKubernetesCluster, &out.RemoteKubernetesCluster
+		*out = new(RemoteKubernetesClusterReference)
+		**out = **in
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReadyNodes != nil {
+		in, out := &in.ReadyNodes, &out.ReadyNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AvailableNodes != nil {
+		in, out := &in.AvailableNodes, &out.AvailableNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterDatacenterStatus.
+func (in *ScyllaDBClusterDatacenterStatus) DeepCopy() *ScyllaDBClusterDatacenterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterDatacenterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterDatacenterTemplate) DeepCopyInto(out *ScyllaDBClusterDatacenterTemplate) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(ObjectTemplateMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DNSDomains != nil {
+		in, out := &in.DNSDomains, &out.DNSDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.RackTemplate != nil {
 		in, out := &in.RackTemplate, &out.RackTemplate
 		*out = new(RackTemplate)
@@ -1152,41 +2023,1077 @@ func (in *ScyllaDBDatacenterSpec) DeepCopyInto(out *ScyllaDBDatacenterSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.DisableAutomaticOrphanedNodeReplacement != nil {
-		in, out := &in.DisableAutomaticOrphanedNodeReplacement, &out.DisableAutomaticOrphanedNodeReplacement
-		*out = new(bool)
-		**out = **in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterDatacenterTemplate.
+func (in *ScyllaDBClusterDatacenterTemplate) DeepCopy() *ScyllaDBClusterDatacenterTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterDatacenterTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterList) DeepCopyInto(out *ScyllaDBClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScyllaDBCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterList.
+func (in *ScyllaDBClusterList) DeepCopy() *ScyllaDBClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScyllaDBClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterSpec) DeepCopyInto(out *ScyllaDBClusterSpec) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(ObjectTemplateMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterName != nil {
+		in, out := &in.ClusterName, &out.ClusterName
+		*out = new(string)
+		**out = **in
+	}
+	in.ScyllaDB.DeepCopyInto(&out.ScyllaDB)
+	if in.ScyllaDBManagerAgent != nil {
+		in, out := &in.ScyllaDBManagerAgent, &out.ScyllaDBManagerAgent
+		*out = new(ScyllaDBManagerAgent)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExposeOptions != nil {
+		in, out := &in.ExposeOptions, &out.ExposeOptions
+		*out = new(ExposeOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForceRedeploymentReason != nil {
+		in, out := &in.ForceRedeploymentReason, &out.ForceRedeploymentReason
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatacenterTemplate != nil {
+		in, out := &in.DatacenterTemplate, &out.DatacenterTemplate
+		*out = new(ScyllaDBClusterDatacenterTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Datacenters != nil {
+		in, out := &in.Datacenters, &out.Datacenters
+		*out = make([]ScyllaDBClusterDatacenter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DatacenterDecommission != nil {
+		in, out := &in.DatacenterDecommission, &out.DatacenterDecommission
+		*out = new(DatacenterDecommissionOptions)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterSpec.
+func (in *ScyllaDBClusterSpec) DeepCopy() *ScyllaDBClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterStatus) DeepCopyInto(out *ScyllaDBClusterStatus) {
+	*out = *in
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReadyNodes != nil {
+		in, out := &in.ReadyNodes, &out.ReadyNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AvailableNodes != nil {
+		in, out := &in.AvailableNodes, &out.AvailableNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Datacenters != nil {
+		in, out := &in.Datacenters, &out.Datacenters
+		*out = make([]ScyllaDBClusterDatacenterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterStatus.
+func (in *ScyllaDBClusterStatus) DeepCopy() *ScyllaDBClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBDatacenter) DeepCopyInto(out *ScyllaDBDatacenter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBDatacenter.
+func (in *ScyllaDBDatacenter) DeepCopy() *ScyllaDBDatacenter {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBDatacenter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScyllaDBDatacenter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBDatacenterAutoscaler) DeepCopyInto(out *ScyllaDBDatacenterAutoscaler) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBDatacenterAutoscaler.
+func (in *ScyllaDBDatacenterAutoscaler) DeepCopy() *ScyllaDBDatacenterAutoscaler {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBDatacenterAutoscaler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScyllaDBDatacenterAutoscaler) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBDatacenterAutoscalerList) DeepCopyInto(out *ScyllaDBDatacenterAutoscalerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScyllaDBDatacenterAutoscaler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBDatacenterAutoscalerList.
+func (in *ScyllaDBDatacenterAutoscalerList) DeepCopy() *ScyllaDBDatacenterAutoscalerList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBDatacenterAutoscalerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScyllaDBDatacenterAutoscalerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBDatacenterAutoscalerSpec) DeepCopyInto(out *ScyllaDBDatacenterAutoscalerSpec) {
+	*out = *in
+	out.ScyllaDBDatacenterRef = in.ScyllaDBDatacenterRef
+	in.MetricsSource.DeepCopyInto(&out.MetricsSource)
+	in.ScaleOut.DeepCopyInto(&out.ScaleOut)
+	in.ScaleIn.DeepCopyInto(&out.ScaleIn)
+	if in.ScaleOutCooldown != nil {
+		in, out := &in.ScaleOutCooldown, &out.ScaleOutCooldown
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ScaleInCooldown != nil {
+		in, out := &in.ScaleInCooldown, &out.ScaleInCooldown
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBDatacenterAutoscalerSpec.
+func (in *ScyllaDBDatacenterAutoscalerSpec) DeepCopy() *ScyllaDBDatacenterAutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBDatacenterAutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBDatacenterAutoscalerStatus) DeepCopyInto(out *ScyllaDBDatacenterAutoscalerStatus) {
+	*out = *in
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Racks != nil {
+		in, out := &in.Racks, &out.Racks
+		*out = make([]AutoscalerRackStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBDatacenterAutoscalerStatus.
+func (in *ScyllaDBDatacenterAutoscalerStatus) DeepCopy() *ScyllaDBDatacenterAutoscalerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBDatacenterAutoscalerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBDatacenterList) DeepCopyInto(out *ScyllaDBDatacenterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScyllaDBDatacenter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBDatacenterList.
+func (in *ScyllaDBDatacenterList) DeepCopy() *ScyllaDBDatacenterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBDatacenterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScyllaDBDatacenterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBDatacenterReference) DeepCopyInto(out *ScyllaDBDatacenterReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBDatacenterReference.
+func (in *ScyllaDBDatacenterReference) DeepCopy() *ScyllaDBDatacenterReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBDatacenterReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBDatacenterSpec) DeepCopyInto(out *ScyllaDBDatacenterSpec) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(ObjectTemplateMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatacenterName != nil {
+		in, out := &in.DatacenterName, &out.DatacenterName
+		*out = new(string)
+		**out = **in
+	}
+	in.ScyllaDB.DeepCopyInto(&out.ScyllaDB)
+	if in.ScyllaDBManagerAgent != nil {
+		in, out := &in.ScyllaDBManagerAgent, &out.ScyllaDBManagerAgent
+		*out = new(ScyllaDBManagerAgent)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.DNSPolicy != nil {
+		in, out := &in.DNSPolicy, &out.DNSPolicy
+		*out = new(v1.DNSPolicy)
+		**out = **in
+	}
+	if in.DNSDomains != nil {
+		in, out := &in.DNSDomains, &out.DNSDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ForceRedeploymentReason != nil {
+		in, out := &in.ForceRedeploymentReason, &out.ForceRedeploymentReason
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExposeOptions != nil {
+		in, out := &in.ExposeOptions, &out.ExposeOptions
+		*out = new(ExposeOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RackTemplate != nil {
+		in, out := &in.RackTemplate, &out.RackTemplate
+		*out = new(RackTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Racks != nil {
+		in, out := &in.Racks, &out.Racks
+		*out = make([]RackSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AutomaticRacks != nil {
+		in, out := &in.AutomaticRacks, &out.AutomaticRacks
+		*out = new(AutomaticRacks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DisableAutomaticOrphanedNodeReplacement != nil {
+		in, out := &in.DisableAutomaticOrphanedNodeReplacement, &out.DisableAutomaticOrphanedNodeReplacement
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OrphanedNodeDetection != nil {
+		in, out := &in.OrphanedNodeDetection, &out.OrphanedNodeDetection
+		*out = new(OrphanedNodeDetection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UnavailableNodeRemovalDelay != nil {
+		in, out := &in.UnavailableNodeRemovalDelay, &out.UnavailableNodeRemovalDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.OrphanedNodeReplacement != nil {
+		in, out := &in.OrphanedNodeReplacement, &out.OrphanedNodeReplacement
+		*out = new(OrphanedNodeReplacement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinTerminationGracePeriodSeconds != nil {
+		in, out := &in.MinTerminationGracePeriodSeconds, &out.MinTerminationGracePeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinReadySeconds != nil {
+		in, out := &in.MinReadySeconds, &out.MinReadySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReadinessGates != nil {
+		in, out := &in.ReadinessGates, &out.ReadinessGates
+		*out = make([]v1.PodReadinessGate, len(*in))
+		copy(*out, *in)
+	}
+	if in.UpgradeRollback != nil {
+		in, out := &in.UpgradeRollback, &out.UpgradeRollback
+		*out = new(UpgradeRollbackOptions)
+		**out = **in
+	}
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBDatacenterSpec.
+func (in *ScyllaDBDatacenterSpec) DeepCopy() *ScyllaDBDatacenterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBDatacenterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBDatacenterStatus) DeepCopyInto(out *ScyllaDBDatacenterStatus) {
+	*out = *in
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CurrentNodes != nil {
+		in, out := &in.CurrentNodes, &out.CurrentNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UpdatedNodes != nil {
+		in, out := &in.UpdatedNodes, &out.UpdatedNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReadyNodes != nil {
+		in, out := &in.ReadyNodes, &out.ReadyNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AvailableNodes != nil {
+		in, out := &in.AvailableNodes, &out.AvailableNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Racks != nil {
+		in, out := &in.Racks, &out.Racks
+		*out = make([]RackStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InternodeEncryption != nil {
+		in, out := &in.InternodeEncryption, &out.InternodeEncryption
+		*out = new(InternodeEncryptionStatus)
+		**out = **in
+	}
+	if in.DetectedVersion != nil {
+		in, out := &in.DetectedVersion, &out.DetectedVersion
+		*out = new(DetectedScyllaDBVersion)
+		**out = **in
+	}
+	if in.Keyspaces != nil {
+		in, out := &in.Keyspaces, &out.Keyspaces
+		*out = make([]KeyspaceStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.KeyspacesLastSyncTime != nil {
+		in, out := &in.KeyspacesLastSyncTime, &out.KeyspacesLastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.UpgradeRollback != nil {
+		in, out := &in.UpgradeRollback, &out.UpgradeRollback
+		*out = new(UpgradeRollbackStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutomaticRacks != nil {
+		in, out := &in.AutomaticRacks, &out.AutomaticRacks
+		*out = new(AutomaticRacksStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrphanedNodeReplacements != nil {
+		in, out := &in.OrphanedNodeReplacements, &out.OrphanedNodeReplacements
+		*out = make([]OrphanedNodeReplacementStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StorageMigrations != nil {
+		in, out := &in.StorageMigrations, &out.StorageMigrations
+		*out = make([]StorageMigrationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBDatacenterStatus.
+func (in *ScyllaDBDatacenterStatus) DeepCopy() *ScyllaDBDatacenterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBDatacenterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBKeyspace) DeepCopyInto(out *ScyllaDBKeyspace) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBKeyspace.
+func (in *ScyllaDBKeyspace) DeepCopy() *ScyllaDBKeyspace {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBKeyspace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScyllaDBKeyspace) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBKeyspaceList) DeepCopyInto(out *ScyllaDBKeyspaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScyllaDBKeyspace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBKeyspaceList.
+func (in *ScyllaDBKeyspaceList) DeepCopy() *ScyllaDBKeyspaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBKeyspaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScyllaDBKeyspaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBKeyspaceSpec) DeepCopyInto(out *ScyllaDBKeyspaceSpec) {
+	*out = *in
+	out.ScyllaDBDatacenterRef = in.ScyllaDBDatacenterRef
+	in.Replication.DeepCopyInto(&out.Replication)
+	if in.Tablets != nil {
+		in, out := &in.Tablets, &out.Tablets
+		*out = new(KeyspaceTablets)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DurableWrites != nil {
+		in, out := &in.DurableWrites, &out.DurableWrites
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBKeyspaceSpec.
+func (in *ScyllaDBKeyspaceSpec) DeepCopy() *ScyllaDBKeyspaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBKeyspaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBKeyspaceStatus) DeepCopyInto(out *ScyllaDBKeyspaceStatus) {
+	*out = *in
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = make([]DatacenterReplicationFactor, len(*in))
+		copy(*out, *in)
+	}
+	if in.TabletsEnabled != nil {
+		in, out := &in.TabletsEnabled, &out.TabletsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DurableWrites != nil {
+		in, out := &in.DurableWrites, &out.DurableWrites
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Repair != nil {
+		in, out := &in.Repair, &out.Repair
+		*out = new(KeyspaceRepairStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBKeyspaceStatus.
+func (in *ScyllaDBKeyspaceStatus) DeepCopy() *ScyllaDBKeyspaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBKeyspaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBManagerAgent) DeepCopyInto(out *ScyllaDBManagerAgent) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(ScyllaDBManagerAgentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBManagerAgent.
+func (in *ScyllaDBManagerAgent) DeepCopy() *ScyllaDBManagerAgent {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBManagerAgent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBManagerAgentAzureOptions) DeepCopyInto(out *ScyllaDBManagerAgentAzureOptions) {
+	*out = *in
+	if in.KeySecretKeyRef != nil {
+		in, out := &in.KeySecretKeyRef, &out.KeySecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBManagerAgentAzureOptions.
+func (in *ScyllaDBManagerAgentAzureOptions) DeepCopy() *ScyllaDBManagerAgentAzureOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBManagerAgentAzureOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBManagerAgentConfig) DeepCopyInto(out *ScyllaDBManagerAgentConfig) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(ScyllaDBManagerAgentS3Options)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GCS != nil {
+		in, out := &in.GCS, &out.GCS
+		*out = new(ScyllaDBManagerAgentGCSOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(ScyllaDBManagerAgentAzureOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimits != nil {
+		in, out := &in.RateLimits, &out.RateLimits
+		*out = new(ScyllaDBManagerAgentRateLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBManagerAgentConfig.
+func (in *ScyllaDBManagerAgentConfig) DeepCopy() *ScyllaDBManagerAgentConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBManagerAgentConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBManagerAgentGCSOptions) DeepCopyInto(out *ScyllaDBManagerAgentGCSOptions) {
+	*out = *in
+	if in.ServiceAccountSecretKeyRef != nil {
+		in, out := &in.ServiceAccountSecretKeyRef, &out.ServiceAccountSecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBManagerAgentGCSOptions.
+func (in *ScyllaDBManagerAgentGCSOptions) DeepCopy() *ScyllaDBManagerAgentGCSOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBManagerAgentGCSOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBManagerAgentRateLimits) DeepCopyInto(out *ScyllaDBManagerAgentRateLimits) {
+	*out = *in
+	if in.BandwidthMiBPerSecond != nil {
+		in, out := &in.BandwidthMiBPerSecond, &out.BandwidthMiBPerSecond
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Transfers != nil {
+		in, out := &in.Transfers, &out.Transfers
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBManagerAgentRateLimits.
+func (in *ScyllaDBManagerAgentRateLimits) DeepCopy() *ScyllaDBManagerAgentRateLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBManagerAgentRateLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBManagerAgentS3Options) DeepCopyInto(out *ScyllaDBManagerAgentS3Options) {
+	*out = *in
+	if in.AccessKeyIDSecretKeyRef != nil {
+		in, out := &in.AccessKeyIDSecretKeyRef, &out.AccessKeyIDSecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretAccessKeySecretKeyRef != nil {
+		in, out := &in.SecretAccessKeySecretKeyRef, &out.SecretAccessKeySecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBManagerAgentS3Options.
+func (in *ScyllaDBManagerAgentS3Options) DeepCopy() *ScyllaDBManagerAgentS3Options {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBManagerAgentS3Options)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBManagerAgentTemplate) DeepCopyInto(out *ScyllaDBManagerAgentTemplate) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomConfigSecretRef != nil {
+		in, out := &in.CustomConfigSecretRef, &out.CustomConfigSecretRef
+		*out = new(string)
+		**out = **in
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]v1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBManagerAgentTemplate.
+func (in *ScyllaDBManagerAgentTemplate) DeepCopy() *ScyllaDBManagerAgentTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBManagerAgentTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBManagerTaskStatus) DeepCopyInto(out *ScyllaDBManagerTaskStatus) {
+	*out = *in
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TaskID != nil {
+		in, out := &in.TaskID, &out.TaskID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(int64)
+		**out = **in
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBManagerTaskStatus.
+func (in *ScyllaDBManagerTaskStatus) DeepCopy() *ScyllaDBManagerTaskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBManagerTaskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBMonitoring) DeepCopyInto(out *ScyllaDBMonitoring) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBMonitoring.
+func (in *ScyllaDBMonitoring) DeepCopy() *ScyllaDBMonitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBMonitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScyllaDBMonitoring) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBMonitoringList) DeepCopyInto(out *ScyllaDBMonitoringList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScyllaDBMonitoring, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBMonitoringList.
+func (in *ScyllaDBMonitoringList) DeepCopy() *ScyllaDBMonitoringList {
+	if in == nil {
+		return nil
 	}
-	if in.MinTerminationGracePeriodSeconds != nil {
-		in, out := &in.MinTerminationGracePeriodSeconds, &out.MinTerminationGracePeriodSeconds
-		*out = new(int32)
-		**out = **in
+	out := new(ScyllaDBMonitoringList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScyllaDBMonitoringList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.MinReadySeconds != nil {
-		in, out := &in.MinReadySeconds, &out.MinReadySeconds
-		*out = new(int32)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBMonitoringSpec) DeepCopyInto(out *ScyllaDBMonitoringSpec) {
+	*out = *in
+	in.EndpointsSelector.DeepCopyInto(&out.EndpointsSelector)
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = new(Components)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ReadinessGates != nil {
-		in, out := &in.ReadinessGates, &out.ReadinessGates
-		*out = make([]v1.PodReadinessGate, len(*in))
-		copy(*out, *in)
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(ScyllaDBMonitoringType)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBDatacenterSpec.
-func (in *ScyllaDBDatacenterSpec) DeepCopy() *ScyllaDBDatacenterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBMonitoringSpec.
+func (in *ScyllaDBMonitoringSpec) DeepCopy() *ScyllaDBMonitoringSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ScyllaDBDatacenterSpec)
+	out := new(ScyllaDBMonitoringSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScyllaDBDatacenterStatus) DeepCopyInto(out *ScyllaDBDatacenterStatus) {
+func (in *ScyllaDBMonitoringStatus) DeepCopyInto(out *ScyllaDBMonitoringStatus) {
 	*out = *in
 	if in.ObservedGeneration != nil {
 		in, out := &in.ObservedGeneration, &out.ObservedGeneration
@@ -1200,34 +3107,55 @@ func (in *ScyllaDBDatacenterStatus) DeepCopyInto(out *ScyllaDBDatacenterStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Nodes != nil {
-		in, out := &in.Nodes, &out.Nodes
-		*out = new(int32)
-		**out = **in
-	}
-	if in.CurrentNodes != nil {
-		in, out := &in.CurrentNodes, &out.CurrentNodes
-		*out = new(int32)
-		**out = **in
-	}
-	if in.UpdatedNodes != nil {
-		in, out := &in.UpdatedNodes, &out.UpdatedNodes
-		*out = new(int32)
-		**out = **in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBMonitoringStatus.
+func (in *ScyllaDBMonitoringStatus) DeepCopy() *ScyllaDBMonitoringStatus {
+	if in == nil {
+		return nil
 	}
-	if in.ReadyNodes != nil {
-		in, out := &in.ReadyNodes, &out.ReadyNodes
-		*out = new(int32)
-		**out = **in
+	out := new(ScyllaDBMonitoringStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBRepair) DeepCopyInto(out *ScyllaDBRepair) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBRepair.
+func (in *ScyllaDBRepair) DeepCopy() *ScyllaDBRepair {
+	if in == nil {
+		return nil
 	}
-	if in.AvailableNodes != nil {
-		in, out := &in.AvailableNodes, &out.AvailableNodes
-		*out = new(int32)
-		**out = **in
+	out := new(ScyllaDBRepair)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScyllaDBRepair) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.Racks != nil {
-		in, out := &in.Racks, &out.Racks
-		*out = make([]RackStatus, len(*in))
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBRepairList) DeepCopyInto(out *ScyllaDBRepairList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScyllaDBRepair, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1235,79 +3163,84 @@ func (in *ScyllaDBDatacenterStatus) DeepCopyInto(out *ScyllaDBDatacenterStatus)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBDatacenterStatus.
-func (in *ScyllaDBDatacenterStatus) DeepCopy() *ScyllaDBDatacenterStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBRepairList.
+func (in *ScyllaDBRepairList) DeepCopy() *ScyllaDBRepairList {
 	if in == nil {
 		return nil
 	}
-	out := new(ScyllaDBDatacenterStatus)
+	out := new(ScyllaDBRepairList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScyllaDBRepairList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScyllaDBManagerAgent) DeepCopyInto(out *ScyllaDBManagerAgent) {
+func (in *ScyllaDBRepairSpec) DeepCopyInto(out *ScyllaDBRepairSpec) {
 	*out = *in
-	if in.Image != nil {
-		in, out := &in.Image, &out.Image
+	if in.DC != nil {
+		in, out := &in.DC, &out.DC
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Keyspace != nil {
+		in, out := &in.Keyspace, &out.Keyspace
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
 		*out = new(string)
 		**out = **in
 	}
+	if in.NumRetries != nil {
+		in, out := &in.NumRetries, &out.NumRetries
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBManagerAgent.
-func (in *ScyllaDBManagerAgent) DeepCopy() *ScyllaDBManagerAgent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBRepairSpec.
+func (in *ScyllaDBRepairSpec) DeepCopy() *ScyllaDBRepairSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ScyllaDBManagerAgent)
+	out := new(ScyllaDBRepairSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScyllaDBManagerAgentTemplate) DeepCopyInto(out *ScyllaDBManagerAgentTemplate) {
+func (in *ScyllaDBRepairStatus) DeepCopyInto(out *ScyllaDBRepairStatus) {
 	*out = *in
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CustomConfigSecretRef != nil {
-		in, out := &in.CustomConfigSecretRef, &out.CustomConfigSecretRef
-		*out = new(string)
-		**out = **in
-	}
-	if in.Volumes != nil {
-		in, out := &in.Volumes, &out.Volumes
-		*out = make([]v1.Volume, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.VolumeMounts != nil {
-		in, out := &in.VolumeMounts, &out.VolumeMounts
-		*out = make([]v1.VolumeMount, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ScyllaDBManagerTaskStatus.DeepCopyInto(&out.ScyllaDBManagerTaskStatus)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBManagerAgentTemplate.
-func (in *ScyllaDBManagerAgentTemplate) DeepCopy() *ScyllaDBManagerAgentTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBRepairStatus.
+func (in *ScyllaDBRepairStatus) DeepCopy() *ScyllaDBRepairStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ScyllaDBManagerAgentTemplate)
+	out := new(ScyllaDBRepairStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScyllaDBMonitoring) DeepCopyInto(out *ScyllaDBMonitoring) {
+func (in *ScyllaDBRole) DeepCopyInto(out *ScyllaDBRole) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1316,18 +3249,18 @@ func (in *ScyllaDBMonitoring) DeepCopyInto(out *ScyllaDBMonitoring) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBMonitoring.
-func (in *ScyllaDBMonitoring) DeepCopy() *ScyllaDBMonitoring {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBRole.
+func (in *ScyllaDBRole) DeepCopy() *ScyllaDBRole {
 	if in == nil {
 		return nil
 	}
-	out := new(ScyllaDBMonitoring)
+	out := new(ScyllaDBRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ScyllaDBMonitoring) DeepCopyObject() runtime.Object {
+func (in *ScyllaDBRole) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1335,13 +3268,13 @@ func (in *ScyllaDBMonitoring) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScyllaDBMonitoringList) DeepCopyInto(out *ScyllaDBMonitoringList) {
+func (in *ScyllaDBRoleList) DeepCopyInto(out *ScyllaDBRoleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ScyllaDBMonitoring, len(*in))
+		*out = make([]ScyllaDBRole, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1349,18 +3282,18 @@ func (in *ScyllaDBMonitoringList) DeepCopyInto(out *ScyllaDBMonitoringList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBMonitoringList.
-func (in *ScyllaDBMonitoringList) DeepCopy() *ScyllaDBMonitoringList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBRoleList.
+func (in *ScyllaDBRoleList) DeepCopy() *ScyllaDBRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(ScyllaDBMonitoringList)
+	out := new(ScyllaDBRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ScyllaDBMonitoringList) DeepCopyObject() runtime.Object {
+func (in *ScyllaDBRoleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1368,34 +3301,36 @@ func (in *ScyllaDBMonitoringList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScyllaDBMonitoringSpec) DeepCopyInto(out *ScyllaDBMonitoringSpec) {
+func (in *ScyllaDBRoleSpec) DeepCopyInto(out *ScyllaDBRoleSpec) {
 	*out = *in
-	in.EndpointsSelector.DeepCopyInto(&out.EndpointsSelector)
-	if in.Components != nil {
-		in, out := &in.Components, &out.Components
-		*out = new(Components)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(ScyllaDBMonitoringType)
+	out.ScyllaDBDatacenterRef = in.ScyllaDBDatacenterRef
+	if in.Login != nil {
+		in, out := &in.Login, &out.Login
+		*out = new(bool)
 		**out = **in
 	}
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]RoleGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBMonitoringSpec.
-func (in *ScyllaDBMonitoringSpec) DeepCopy() *ScyllaDBMonitoringSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBRoleSpec.
+func (in *ScyllaDBRoleSpec) DeepCopy() *ScyllaDBRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ScyllaDBMonitoringSpec)
+	out := new(ScyllaDBRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScyllaDBMonitoringStatus) DeepCopyInto(out *ScyllaDBMonitoringStatus) {
+func (in *ScyllaDBRoleStatus) DeepCopyInto(out *ScyllaDBRoleStatus) {
 	*out = *in
 	if in.ObservedGeneration != nil {
 		in, out := &in.ObservedGeneration, &out.ObservedGeneration
@@ -1409,15 +3344,24 @@ func (in *ScyllaDBMonitoringStatus) DeepCopyInto(out *ScyllaDBMonitoringStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LastPasswordRotationTime != nil {
+		in, out := &in.LastPasswordRotationTime, &out.LastPasswordRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.GrantedPermissions != nil {
+		in, out := &in.GrantedPermissions, &out.GrantedPermissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBMonitoringStatus.
-func (in *ScyllaDBMonitoringStatus) DeepCopy() *ScyllaDBMonitoringStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBRoleStatus.
+func (in *ScyllaDBRoleStatus) DeepCopy() *ScyllaDBRoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ScyllaDBMonitoringStatus)
+	out := new(ScyllaDBRoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1631,6 +3575,27 @@ func (in *Storage) DeepCopy() *Storage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageMigrationStatus) DeepCopyInto(out *StorageMigrationStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageMigrationStatus.
+func (in *StorageMigrationStatus) DeepCopy() *StorageMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageOptions) DeepCopyInto(out *StorageOptions) {
 	*out = *in
@@ -1683,6 +3648,54 @@ func (in *TLSCertificate) DeepCopy() *TLSCertificate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeRollbackOptions) DeepCopyInto(out *UpgradeRollbackOptions) {
+	*out = *in
+	out.NodeReadinessTimeout = in.NodeReadinessTimeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeRollbackOptions.
+func (in *UpgradeRollbackOptions) DeepCopy() *UpgradeRollbackOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeRollbackOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeRollbackStatus) DeepCopyInto(out *UpgradeRollbackStatus) {
+	*out = *in
+	if in.FailedNodes != nil {
+		in, out := &in.FailedNodes, &out.FailedNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RestoredSystemKeyspacesNodes != nil {
+		in, out := &in.RestoredSystemKeyspacesNodes, &out.RestoredSystemKeyspacesNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeRollbackStatus.
+func (in *UpgradeRollbackStatus) DeepCopy() *UpgradeRollbackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeRollbackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserManagedTLSCertificateOptions) DeepCopyInto(out *UserManagedTLSCertificateOptions) {
 	*out = *in
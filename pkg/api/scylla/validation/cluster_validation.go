@@ -3,6 +3,7 @@ package validation
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -37,6 +38,8 @@ var (
 		scyllav1.BroadcastAddressTypeServiceLoadBalancerIngress,
 	}
 
+	snapshotTagRegexp = regexp.MustCompile(`^sm_[0-9]{14}UTC$`)
+
 	schedulerTaskSpecCronParseOptions = cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor
 )
 
@@ -182,6 +185,16 @@ func ValidateScyllaClusterSpec(spec *scyllav1.ScyllaClusterSpec, fldPath *field.
 		allErrs = append(allErrs, ValidateBackupTaskSpec(&b, fldPath.Child("backups").Index(i))...)
 	}
 
+	managerRestoreTaskNames := sets.New[string]()
+	for i, r := range spec.Restores {
+		if managerRestoreTaskNames.Has(r.Name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("restores").Index(i).Child("name"), r.Name))
+		}
+		managerRestoreTaskNames.Insert(r.Name)
+
+		allErrs = append(allErrs, ValidateRestoreTaskSpec(&r, fldPath.Child("restores").Index(i))...)
+	}
+
 	if spec.GenericUpgrade != nil {
 		if spec.GenericUpgrade.FailureStrategy != scyllav1.GenericUpgradeFailureStrategyRetry {
 			allErrs = append(allErrs, field.NotSupported(fldPath.Child("genericUpgrade", "failureStrategy"), spec.GenericUpgrade.FailureStrategy, []string{string(scyllav1.GenericUpgradeFailureStrategyRetry)}))
@@ -234,6 +247,36 @@ func ValidateBackupTaskSpec(backupTaskSpec *scyllav1.BackupTaskSpec, fldPath *fi
 	return allErrs
 }
 
+func ValidateRestoreTaskSpec(restoreTaskSpec *scyllav1.RestoreTaskSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(restoreTaskSpec.Location) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("location"), ""))
+	}
+
+	if len(restoreTaskSpec.SnapshotTag) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("snapshotTag"), ""))
+	} else if !snapshotTagRegexp.MatchString(restoreTaskSpec.SnapshotTag) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("snapshotTag"), restoreTaskSpec.SnapshotTag, "must be in the format sm_YYYYMMDDhhmmssUTC"))
+	}
+
+	if restoreTaskSpec.RestoreSchema == restoreTaskSpec.RestoreTables {
+		allErrs = append(allErrs, field.Invalid(fldPath, restoreTaskSpec.RestoreSchema, "exactly one of restoreSchema and restoreTables has to be set"))
+	}
+
+	if restoreTaskSpec.BatchSize < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("batchSize"), restoreTaskSpec.BatchSize, "must be non-negative integer"))
+	}
+
+	if restoreTaskSpec.Parallel < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("parallel"), restoreTaskSpec.Parallel, "must be non-negative integer"))
+	}
+
+	allErrs = append(allErrs, ValidateTaskSpec(&restoreTaskSpec.TaskSpec, fldPath)...)
+
+	return allErrs
+}
+
 func ValidateTaskSpec(taskSpec *scyllav1.TaskSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -458,10 +501,19 @@ func ValidateScyllaClusterSpecUpdate(new, old *scyllav1.ScyllaCluster, fldPath *
 			continue
 		}
 
-		// Check that storage is the same as before.
-		// StatefulSet currently forbids the storage update.
-		if !reflect.DeepEqual(oldRack.Storage, newRack.Storage) {
-			allErrs = append(allErrs, field.Forbidden(fldPath.Child("datacenter", "racks").Index(i).Child("storage"), "changes in storage are currently not supported"))
+		allErrs = append(allErrs, validateStorageCapacityUpdate(oldRack.Storage.Capacity, newRack.Storage.Capacity, fldPath.Child("datacenter", "racks").Index(i).Child("storage", "capacity"))...)
+		allErrs = append(allErrs, validateStorageClassNameUpdate(oldRack.Storage.StorageClassName, newRack.Storage.StorageClassName, fldPath.Child("datacenter", "racks").Index(i).Child("storage", "storageClassName"))...)
+
+		// Only the capacity can be expanded and nodes can be migrated to another storage class,
+		// other storage options can't be changed.
+		oldRackStorage := oldRack.Storage
+		oldRackStorage.Capacity = ""
+		oldRackStorage.StorageClassName = nil
+		newRackStorage := newRack.Storage
+		newRackStorage.Capacity = ""
+		newRackStorage.StorageClassName = nil
+		if !reflect.DeepEqual(oldRackStorage, newRackStorage) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("datacenter", "racks").Index(i).Child("storage"), "changes in storage other than capacity and storage class are currently not supported"))
 		}
 	}
 
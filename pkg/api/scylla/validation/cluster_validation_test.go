@@ -144,6 +144,65 @@ func TestValidateScyllaCluster(t *testing.T) {
 			},
 			expectedErrorString: `spec.backups[1].name: Duplicate value: "task-name"`,
 		},
+		{
+			name: "valid manager restore tasks",
+			cluster: func() *scyllav1.ScyllaCluster {
+				cluster := validCluster.DeepCopy()
+				cluster.Spec.Restores = []scyllav1.RestoreTaskSpec{
+					{
+						TaskSpec: scyllav1.TaskSpec{
+							Name: "restore-schema",
+						},
+						Location:      []string{"s3:backups"},
+						SnapshotTag:   "sm_20240101000000UTC",
+						RestoreSchema: true,
+					},
+					{
+						TaskSpec: scyllav1.TaskSpec{
+							Name: "restore-tables",
+						},
+						Location:      []string{"s3:backups"},
+						SnapshotTag:   "sm_20240101000000UTC",
+						RestoreTables: true,
+					},
+				}
+				return cluster
+			}(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "invalid manager restore task spec",
+			cluster: func() *scyllav1.ScyllaCluster {
+				cluster := validCluster.DeepCopy()
+				cluster.Spec.Restores = []scyllav1.RestoreTaskSpec{
+					{
+						TaskSpec: scyllav1.TaskSpec{
+							Name: "restore",
+						},
+						SnapshotTag:   "latest",
+						RestoreSchema: true,
+						RestoreTables: true,
+					},
+					{
+						TaskSpec: scyllav1.TaskSpec{
+							Name: "restore",
+						},
+						Location: []string{"s3:backups"},
+					},
+				}
+				return cluster
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.restores[0].location", BadValue: ""},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.restores[0].snapshotTag", BadValue: "latest", Detail: "must be in the format sm_YYYYMMDDhhmmssUTC"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.restores[0]", BadValue: true, Detail: "exactly one of restoreSchema and restoreTables has to be set"},
+				&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.restores[1].name", BadValue: "restore"},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.restores[1].snapshotTag", BadValue: ""},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.restores[1]", BadValue: false, Detail: "exactly one of restoreSchema and restoreTables has to be set"},
+			},
+			expectedErrorString: `[spec.restores[0].location: Required value, spec.restores[0].snapshotTag: Invalid value: "latest": must be in the format sm_YYYYMMDDhhmmssUTC, spec.restores[0]: Invalid value: true: exactly one of restoreSchema and restoreTables has to be set, spec.restores[1].name: Duplicate value: "restore", spec.restores[1].snapshotTag: Required value, spec.restores[1]: Invalid value: false: exactly one of restoreSchema and restoreTables has to be set]`,
+		},
 		{
 			name: "invalid cron in manager repair task spec",
 			cluster: func() *scyllav1.ScyllaCluster {
@@ -1074,13 +1133,74 @@ func TestValidateScyllaClusterUpdate(t *testing.T) {
 			expectedErrorString: "",
 		},
 		{
-			name: "rackStorage changed",
+			name:                "rackStorage capacity increased",
+			old:                 unit.NewSingleRackCluster(3),
+			new:                 storageChanged(unit.NewSingleRackCluster(3)),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "rackStorage capacity decreased",
+			old:  storageChanged(unit.NewSingleRackCluster(3)),
+			new:  unit.NewSingleRackCluster(3),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.datacenter.racks[0].storage.capacity", BadValue: "", Detail: "capacity can't be decreased from 15Gi"},
+			},
+			expectedErrorString: "spec.datacenter.racks[0].storage.capacity: Forbidden: capacity can't be decreased from 15Gi",
+		},
+		{
+			name: "rackStorage storageClassName changed",
+			old: func() *scyllav1.ScyllaCluster {
+				c := unit.NewSingleRackCluster(3)
+				c.Spec.Datacenter.Racks[0].Storage.StorageClassName = pointer.Ptr("standard")
+				return c
+			}(),
+			new: func() *scyllav1.ScyllaCluster {
+				c := unit.NewSingleRackCluster(3)
+				c.Spec.Datacenter.Racks[0].Storage.StorageClassName = pointer.Ptr("other")
+				return c
+			}(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "rackStorage storageClassName set",
 			old:  unit.NewSingleRackCluster(3),
-			new:  storageChanged(unit.NewSingleRackCluster(3)),
+			new: func() *scyllav1.ScyllaCluster {
+				c := unit.NewSingleRackCluster(3)
+				c.Spec.Datacenter.Racks[0].Storage.StorageClassName = pointer.Ptr("other")
+				return c
+			}(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "rackStorage storageClassName cleared",
+			old: func() *scyllav1.ScyllaCluster {
+				c := unit.NewSingleRackCluster(3)
+				c.Spec.Datacenter.Racks[0].Storage.StorageClassName = pointer.Ptr("standard")
+				return c
+			}(),
+			new: unit.NewSingleRackCluster(3),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.datacenter.racks[0].storage.storageClassName", BadValue: "", Detail: "storage class can't be cleared once it's set"},
+			},
+			expectedErrorString: "spec.datacenter.racks[0].storage.storageClassName: Forbidden: storage class can't be cleared once it's set",
+		},
+		{
+			name: "rackStorage metadata changed",
+			old:  unit.NewSingleRackCluster(3),
+			new: func() *scyllav1.ScyllaCluster {
+				c := unit.NewSingleRackCluster(3)
+				c.Spec.Datacenter.Racks[0].Storage.Metadata = &scyllav1.ObjectTemplateMetadata{
+					Labels: map[string]string{"foo": "bar"},
+				}
+				return c
+			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.datacenter.racks[0].storage", BadValue: "", Detail: "changes in storage are currently not supported"},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.datacenter.racks[0].storage", BadValue: "", Detail: "changes in storage other than capacity and storage class are currently not supported"},
 			},
-			expectedErrorString: "spec.datacenter.racks[0].storage: Forbidden: changes in storage are currently not supported",
+			expectedErrorString: "spec.datacenter.racks[0].storage: Forbidden: changes in storage other than capacity and storage class are currently not supported",
 		},
 		{
 			name:                "rackResources changed",
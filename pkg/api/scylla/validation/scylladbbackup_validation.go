@@ -0,0 +1,48 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package validation
+
+import (
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func ValidateScyllaDBBackup(sb *scyllav1alpha1.ScyllaDBBackup) field.ErrorList {
+	return ValidateScyllaDBBackupSpec(&sb.Spec, field.NewPath("spec"))
+}
+
+func ValidateScyllaDBBackupSpec(spec *scyllav1alpha1.ScyllaDBBackupSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateScyllaDBManagerTaskSpecCommon(spec.ScyllaClusterName, spec.NumRetries, spec.TTLSecondsAfterFinished, fldPath)...)
+
+	if len(spec.Location) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("location"), ""))
+	}
+	for i, location := range spec.Location {
+		if len(location) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("location").Index(i), ""))
+		}
+	}
+
+	if spec.Retention < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retention"), spec.Retention, "must be non-negative integer"))
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBBackupUpdate(new, old *scyllav1alpha1.ScyllaDBBackup) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateScyllaDBBackup(new)...)
+
+	newSpec := new.Spec.DeepCopy()
+	newSpec.TTLSecondsAfterFinished = old.Spec.TTLSecondsAfterFinished
+	if !apiequality.Semantic.DeepEqual(newSpec, &old.Spec) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "fields other than ttlSecondsAfterFinished are immutable"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package validation_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/api/scylla/validation"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func newValidScyllaDBBackup() *scyllav1alpha1.ScyllaDBBackup {
+	return &scyllav1alpha1.ScyllaDBBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pre-upgrade",
+			Namespace: "scylla",
+		},
+		Spec: scyllav1alpha1.ScyllaDBBackupSpec{
+			ScyllaClusterName: "basic",
+			Location:          []string{"s3:backups"},
+			Retention:         1,
+			NumRetries:        pointer.Ptr[int64](3),
+		},
+	}
+}
+
+func TestValidateScyllaDBBackup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		backup              *scyllav1alpha1.ScyllaDBBackup
+		expectedErrorList   field.ErrorList
+		expectedErrorString string
+	}{
+		{
+			name:                "valid",
+			backup:              newValidScyllaDBBackup(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "missing location",
+			backup: func() *scyllav1alpha1.ScyllaDBBackup {
+				sb := newValidScyllaDBBackup()
+				sb.Spec.Location = nil
+				return sb
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.location", BadValue: ""},
+			},
+			expectedErrorString: `spec.location: Required value`,
+		},
+		{
+			name: "empty location and negative retention",
+			backup: func() *scyllav1alpha1.ScyllaDBBackup {
+				sb := newValidScyllaDBBackup()
+				sb.Spec.Location = []string{"s3:backups", ""}
+				sb.Spec.Retention = -1
+				return sb
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.location[1]", BadValue: ""},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.retention", BadValue: int64(-1), Detail: "must be non-negative integer"},
+			},
+			expectedErrorString: `[spec.location[1]: Required value, spec.retention: Invalid value: -1: must be non-negative integer]`,
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			errList := validation.ValidateScyllaDBBackup(test.backup)
+			if !reflect.DeepEqual(errList, test.expectedErrorList) {
+				t.Errorf("expected and actual error lists differ: %s", cmp.Diff(test.expectedErrorList, errList))
+			}
+
+			var errStr string
+			if agg := errList.ToAggregate(); agg != nil {
+				errStr = agg.Error()
+			}
+			if !reflect.DeepEqual(errStr, test.expectedErrorString) {
+				t.Errorf("expected and actual error strings differ: %s", cmp.Diff(test.expectedErrorString, errStr))
+			}
+		})
+	}
+}
+
+func TestValidateScyllaDBBackupUpdate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		old                 *scyllav1alpha1.ScyllaDBBackup
+		new                 *scyllav1alpha1.ScyllaDBBackup
+		expectedErrorList   field.ErrorList
+		expectedErrorString string
+	}{
+		{
+			name: "ttl change is allowed",
+			old:  newValidScyllaDBBackup(),
+			new: func() *scyllav1alpha1.ScyllaDBBackup {
+				sb := newValidScyllaDBBackup()
+				sb.Spec.TTLSecondsAfterFinished = pointer.Ptr[int32](3600)
+				return sb
+			}(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "location change is forbidden",
+			old:  newValidScyllaDBBackup(),
+			new: func() *scyllav1alpha1.ScyllaDBBackup {
+				sb := newValidScyllaDBBackup()
+				sb.Spec.Location = []string{"gcs:backups"}
+				return sb
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec", BadValue: "", Detail: "fields other than ttlSecondsAfterFinished are immutable"},
+			},
+			expectedErrorString: `spec: Forbidden: fields other than ttlSecondsAfterFinished are immutable`,
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			errList := validation.ValidateScyllaDBBackupUpdate(test.new, test.old)
+			if !reflect.DeepEqual(errList, test.expectedErrorList) {
+				t.Errorf("expected and actual error lists differ: %s", cmp.Diff(test.expectedErrorList, errList))
+			}
+
+			var errStr string
+			if agg := errList.ToAggregate(); agg != nil {
+				errStr = agg.Error()
+			}
+			if !reflect.DeepEqual(errStr, test.expectedErrorString) {
+				t.Errorf("expected and actual error strings differ: %s", cmp.Diff(test.expectedErrorString, errStr))
+			}
+		})
+	}
+}
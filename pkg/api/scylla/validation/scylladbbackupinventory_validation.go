@@ -0,0 +1,50 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package validation
+
+import (
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func ValidateScyllaDBBackupInventory(sdbi *scyllav1alpha1.ScyllaDBBackupInventory) field.ErrorList {
+	return ValidateScyllaDBBackupInventorySpec(&sdbi.Spec, field.NewPath("spec"))
+}
+
+func ValidateScyllaDBBackupInventorySpec(spec *scyllav1alpha1.ScyllaDBBackupInventorySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.ScyllaClusterName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("scyllaClusterName"), ""))
+	} else {
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(spec.ScyllaClusterName, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("scyllaClusterName"), spec.ScyllaClusterName, msg))
+		}
+	}
+
+	locations := sets.New[string]()
+	for i, location := range spec.Locations {
+		if len(location) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("locations").Index(i), ""))
+			continue
+		}
+
+		if locations.Has(location) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("locations").Index(i), location))
+		}
+		locations.Insert(location)
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBBackupInventoryUpdate(new, old *scyllav1alpha1.ScyllaDBBackupInventory) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateScyllaDBBackupInventory(new)...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(new.Spec.ScyllaClusterName, old.Spec.ScyllaClusterName, field.NewPath("spec", "scyllaClusterName"))...)
+
+	return allErrs
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package validation_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/api/scylla/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func newValidScyllaDBBackupInventory() *scyllav1alpha1.ScyllaDBBackupInventory {
+	return &scyllav1alpha1.ScyllaDBBackupInventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic",
+			Namespace: "scylla",
+		},
+		Spec: scyllav1alpha1.ScyllaDBBackupInventorySpec{
+			ScyllaClusterName: "basic",
+			Locations:         []string{"s3:backups", "dc1:gcs:backups"},
+		},
+	}
+}
+
+func TestValidateScyllaDBBackupInventory(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		inventory           *scyllav1alpha1.ScyllaDBBackupInventory
+		expectedErrorList   field.ErrorList
+		expectedErrorString string
+	}{
+		{
+			name:                "valid",
+			inventory:           newValidScyllaDBBackupInventory(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "missing cluster name",
+			inventory: func() *scyllav1alpha1.ScyllaDBBackupInventory {
+				sdbi := newValidScyllaDBBackupInventory()
+				sdbi.Spec.ScyllaClusterName = ""
+				return sdbi
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.scyllaClusterName", BadValue: ""},
+			},
+			expectedErrorString: `spec.scyllaClusterName: Required value`,
+		},
+		{
+			name: "empty and duplicate locations",
+			inventory: func() *scyllav1alpha1.ScyllaDBBackupInventory {
+				sdbi := newValidScyllaDBBackupInventory()
+				sdbi.Spec.Locations = []string{"s3:backups", "", "s3:backups"}
+				return sdbi
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.locations[1]", BadValue: ""},
+				&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.locations[2]", BadValue: "s3:backups"},
+			},
+			expectedErrorString: `[spec.locations[1]: Required value, spec.locations[2]: Duplicate value: "s3:backups"]`,
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			errList := validation.ValidateScyllaDBBackupInventory(test.inventory)
+			if !reflect.DeepEqual(errList, test.expectedErrorList) {
+				t.Errorf("expected and actual error lists differ: %s", cmp.Diff(test.expectedErrorList, errList))
+			}
+
+			var errStr string
+			if agg := errList.ToAggregate(); agg != nil {
+				errStr = agg.Error()
+			}
+			if !reflect.DeepEqual(errStr, test.expectedErrorString) {
+				t.Errorf("expected and actual error strings differ: %s", cmp.Diff(test.expectedErrorString, errStr))
+			}
+		})
+	}
+}
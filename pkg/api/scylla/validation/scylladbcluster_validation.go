@@ -0,0 +1,166 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package validation
+
+import (
+	"fmt"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	apimachineryutilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func ValidateScyllaDBCluster(sc *scyllav1alpha1.ScyllaDBCluster) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateScyllaDBClusterSpec(&sc.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidateScyllaDBClusterSpec(spec *scyllav1alpha1.ScyllaDBClusterSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateScyllaDBDatacenterScyllaDB(&spec.ScyllaDB, fldPath.Child("scyllaDB"))...)
+	allErrs = append(allErrs, ValidateScyllaDBDatacenterScyllaDBManagerAgent(spec.ScyllaDBManagerAgent, fldPath.Child("scyllaDBManagerAgent"))...)
+
+	if spec.ExposeOptions != nil {
+		allErrs = append(allErrs, ValidateScyllaDBDatacenterSpecExposeOptions(spec.ExposeOptions, fldPath.Child("exposeOptions"))...)
+	}
+
+	if spec.DatacenterTemplate != nil {
+		allErrs = append(allErrs, ValidateScyllaDBClusterDatacenterTemplate(spec.DatacenterTemplate, fldPath.Child("datacenterTemplate"))...)
+	}
+
+	if len(spec.Datacenters) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("datacenters"), "at least one datacenter is required"))
+	}
+
+	allErrs = append(allErrs, validateStructSliceFieldUniqueness(spec.Datacenters, func(dc scyllav1alpha1.ScyllaDBClusterDatacenter) string {
+		return dc.Name
+	}, "name", fldPath.Child("datacenters"))...)
+
+	for i := range spec.Datacenters {
+		allErrs = append(allErrs, ValidateScyllaDBClusterDatacenter(spec, &spec.Datacenters[i], fldPath.Child("datacenters").Index(i))...)
+	}
+
+	if spec.DatacenterDecommission != nil {
+		allErrs = append(allErrs, ValidateDatacenterDecommissionOptions(spec.DatacenterDecommission, fldPath.Child("datacenterDecommission"))...)
+	}
+
+	return allErrs
+}
+
+var SupportedDatacenterReplicationPolicies = []scyllav1alpha1.DatacenterReplicationPolicy{
+	scyllav1alpha1.DatacenterReplicationPolicyVerify,
+	scyllav1alpha1.DatacenterReplicationPolicyRewrite,
+}
+
+func ValidateDatacenterDecommissionOptions(options *scyllav1alpha1.DatacenterDecommissionOptions, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(options.ReplicationPolicy) != 0 && !slices.ContainsItem(SupportedDatacenterReplicationPolicies, options.ReplicationPolicy) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("replicationPolicy"), options.ReplicationPolicy, slices.ConvertSlice(SupportedDatacenterReplicationPolicies, slices.ToString[scyllav1alpha1.DatacenterReplicationPolicy])))
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBClusterDatacenterTemplate(template *scyllav1alpha1.ScyllaDBClusterDatacenterTemplate, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateStructSliceFieldUniqueness(template.Racks, func(rackSpec scyllav1alpha1.RackSpec) string {
+		return rackSpec.Name
+	}, "name", fldPath.Child("racks"))...)
+
+	if template.RackTemplate != nil {
+		allErrs = append(allErrs, ValidateScyllaDBDatacenterRackTemplate(template.RackTemplate, fldPath.Child("rackTemplate"))...)
+	}
+
+	for i, domain := range template.DNSDomains {
+		allErrs = append(allErrs, apimachineryutilvalidation.IsFullyQualifiedName(fldPath.Child("dnsDomains").Index(i), domain)...)
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBClusterDatacenter(spec *scyllav1alpha1.ScyllaDBClusterSpec, dc *scyllav1alpha1.ScyllaDBClusterDatacenter, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(dc.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	} else {
+		for _, msg := range apimachineryvalidation.NameIsDNSLabel(dc.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), dc.Name, msg))
+		}
+	}
+
+	allErrs = append(allErrs, ValidateScyllaDBClusterDatacenterTemplate(&dc.ScyllaDBClusterDatacenterTemplate, fldPath)...)
+
+	if len(dc.Racks) == 0 && (spec.DatacenterTemplate == nil || len(spec.DatacenterTemplate.Racks) == 0) {
+		allErrs = append(allErrs, field.Required(fldPath.Child("racks"), "at least one rack is required either in the datacenter or in the datacenter template"))
+	}
+
+	if dc.RemoteKubernetesCluster != nil {
+		allErrs = append(allErrs, ValidateRemoteKubernetesClusterReference(dc.RemoteKubernetesCluster, fldPath.Child("remoteKubernetesCluster"))...)
+	}
+
+	return allErrs
+}
+
+func ValidateRemoteKubernetesClusterReference(ref *scyllav1alpha1.RemoteKubernetesClusterReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(ref.KubeconfigSecretName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("kubeconfigSecretName"), ""))
+	} else {
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(ref.KubeconfigSecretName, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("kubeconfigSecretName"), ref.KubeconfigSecretName, msg))
+		}
+	}
+
+	if len(ref.Namespace) != 0 {
+		for _, msg := range apimachineryvalidation.ValidateNamespaceName(ref.Namespace, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), ref.Namespace, msg))
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBClusterUpdate(new, old *scyllav1alpha1.ScyllaDBCluster) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateScyllaDBCluster(new)...)
+	allErrs = append(allErrs, ValidateScyllaDBClusterSpecUpdate(new, old, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidateScyllaDBClusterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBCluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(new.Spec.ClusterName, old.Spec.ClusterName, fldPath.Child("clusterName"))...)
+
+	for i, newDC := range new.Spec.Datacenters {
+		oldDC, _, ok := slices.Find(old.Spec.Datacenters, func(dc scyllav1alpha1.ScyllaDBClusterDatacenter) bool {
+			return dc.Name == newDC.Name
+		})
+		if !ok {
+			// A datacenter with the same name can't be re-added until the removed one is gone.
+			_, _, decommissioning := slices.Find(old.Status.Datacenters, func(dcStatus scyllav1alpha1.ScyllaDBClusterDatacenterStatus) bool {
+				return dcStatus.Name == newDC.Name && dcStatus.Phase == scyllav1alpha1.ScyllaDBClusterDatacenterPhaseDecommissioning
+			})
+			if decommissioning {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("datacenters").Index(i), fmt.Sprintf("datacenter %q can't be added until its previous instance is decommissioned", newDC.Name)))
+			}
+			continue
+		}
+
+		allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newDC.RemoteKubernetesCluster, oldDC.RemoteKubernetesCluster, fldPath.Child("datacenters").Index(i).Child("remoteKubernetesCluster"))...)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,253 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package validation_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/api/scylla/validation"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func newValidScyllaDBCluster() *scyllav1alpha1.ScyllaDBCluster {
+	return &scyllav1alpha1.ScyllaDBCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic",
+			Namespace: "scylla",
+			UID:       "the-uid",
+		},
+		Spec: scyllav1alpha1.ScyllaDBClusterSpec{
+			ScyllaDB: scyllav1alpha1.ScyllaDB{
+				Image: "scylladb/scylla:latest",
+			},
+			ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgent{
+				Image: pointer.Ptr("scylladb/scylla-manager-agent:latest"),
+			},
+			DatacenterTemplate: &scyllav1alpha1.ScyllaDBClusterDatacenterTemplate{
+				Racks: []scyllav1alpha1.RackSpec{
+					{
+						Name: "a",
+					},
+				},
+			},
+			Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenter{
+				{
+					Name: "us-east-1",
+				},
+				{
+					Name: "us-west-1",
+					RemoteKubernetesCluster: &scyllav1alpha1.RemoteKubernetesClusterReference{
+						KubeconfigSecretName: "us-west-1-kubeconfig",
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateScyllaDBCluster(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		cluster             *scyllav1alpha1.ScyllaDBCluster
+		expectedErrorList   field.ErrorList
+		expectedErrorString string
+	}{
+		{
+			name:                "valid",
+			cluster:             newValidScyllaDBCluster(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "no datacenters",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.Datacenters = nil
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.datacenters", BadValue: "", Detail: "at least one datacenter is required"},
+			},
+			expectedErrorString: `spec.datacenters: Required value: at least one datacenter is required`,
+		},
+		{
+			name: "two datacenters with same name",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.Datacenters[1].Name = sc.Spec.Datacenters[0].Name
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.datacenters[1].name", BadValue: "us-east-1"},
+			},
+			expectedErrorString: `spec.datacenters[1].name: Duplicate value: "us-east-1"`,
+		},
+		{
+			name: "datacenter without racks",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.DatacenterTemplate = nil
+				sc.Spec.Datacenters[0].Racks = []scyllav1alpha1.RackSpec{
+					{
+						Name: "a",
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.datacenters[1].racks", BadValue: "", Detail: "at least one rack is required either in the datacenter or in the datacenter template"},
+			},
+			expectedErrorString: `spec.datacenters[1].racks: Required value: at least one rack is required either in the datacenter or in the datacenter template`,
+		},
+		{
+			name: "remote datacenter without kubeconfig Secret",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.Datacenters[1].RemoteKubernetesCluster.KubeconfigSecretName = ""
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.datacenters[1].remoteKubernetesCluster.kubeconfigSecretName", BadValue: ""},
+			},
+			expectedErrorString: `spec.datacenters[1].remoteKubernetesCluster.kubeconfigSecretName: Required value`,
+		},
+		{
+			name: "unsupported datacenter replication policy",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.DatacenterDecommission = &scyllav1alpha1.DatacenterDecommissionOptions{
+					ReplicationPolicy: "Drop",
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.datacenterDecommission.replicationPolicy", BadValue: scyllav1alpha1.DatacenterReplicationPolicy("Drop"), Detail: `supported values: "Verify", "Rewrite"`},
+			},
+			expectedErrorString: `spec.datacenterDecommission.replicationPolicy: Unsupported value: "Drop": supported values: "Verify", "Rewrite"`,
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			errList := validation.ValidateScyllaDBCluster(test.cluster)
+			if !reflect.DeepEqual(errList, test.expectedErrorList) {
+				t.Errorf("expected and actual error lists differ: %s", cmp.Diff(test.expectedErrorList, errList))
+			}
+
+			var errStr string
+			if agg := errList.ToAggregate(); agg != nil {
+				errStr = agg.Error()
+			}
+			if !reflect.DeepEqual(errStr, test.expectedErrorString) {
+				t.Errorf("expected and actual error strings differ: %s", cmp.Diff(test.expectedErrorString, errStr))
+			}
+		})
+	}
+}
+
+func TestValidateScyllaDBClusterUpdate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		old                 *scyllav1alpha1.ScyllaDBCluster
+		new                 *scyllav1alpha1.ScyllaDBCluster
+		expectedErrorList   field.ErrorList
+		expectedErrorString string
+	}{
+		{
+			name:                "same as old",
+			old:                 newValidScyllaDBCluster(),
+			new:                 newValidScyllaDBCluster(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "cluster name changed",
+			old:  newValidScyllaDBCluster(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.ClusterName = pointer.Ptr("other")
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.clusterName", BadValue: pointer.Ptr("other"), Detail: "field is immutable"},
+			},
+			expectedErrorString: `spec.clusterName: Invalid value: "other": field is immutable`,
+		},
+		{
+			name: "remote Kubernetes cluster of a datacenter changed",
+			old:  newValidScyllaDBCluster(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.Datacenters[1].RemoteKubernetesCluster.Namespace = "other"
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.datacenters[1].remoteKubernetesCluster",
+					BadValue: &scyllav1alpha1.RemoteKubernetesClusterReference{
+						KubeconfigSecretName: "us-west-1-kubeconfig",
+						Namespace:            "other",
+					},
+					Detail: "field is immutable",
+				},
+			},
+			expectedErrorString: `spec.datacenters[1].remoteKubernetesCluster: Invalid value: v1alpha1.RemoteKubernetesClusterReference{KubeconfigSecretName:"us-west-1-kubeconfig", Namespace:"other"}: field is immutable`,
+		},
+		{
+			name: "datacenter re-added while decommissioning",
+			old: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.Datacenters = sc.Spec.Datacenters[:1]
+				sc.Status.Datacenters = []scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+					{
+						Name:  "us-east-1",
+						Phase: scyllav1alpha1.ScyllaDBClusterDatacenterPhaseProvisioned,
+					},
+					{
+						Name:  "us-west-1",
+						Phase: scyllav1alpha1.ScyllaDBClusterDatacenterPhaseDecommissioning,
+					},
+				}
+				return sc
+			}(),
+			new: newValidScyllaDBCluster(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.datacenters[1]", BadValue: "", Detail: `datacenter "us-west-1" can't be added until its previous instance is decommissioned`},
+			},
+			expectedErrorString: `spec.datacenters[1]: Forbidden: datacenter "us-west-1" can't be added until its previous instance is decommissioned`,
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			errList := validation.ValidateScyllaDBClusterUpdate(test.new, test.old)
+			if !reflect.DeepEqual(errList, test.expectedErrorList) {
+				t.Errorf("expected and actual error lists differ: %s", cmp.Diff(test.expectedErrorList, errList))
+			}
+
+			var errStr string
+			if agg := errList.ToAggregate(); agg != nil {
+				errStr = agg.Error()
+			}
+			if !reflect.DeepEqual(errStr, test.expectedErrorString) {
+				t.Errorf("expected and actual error strings differ: %s", cmp.Diff(test.expectedErrorString, errStr))
+			}
+		})
+	}
+}
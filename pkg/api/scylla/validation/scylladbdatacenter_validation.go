@@ -4,14 +4,18 @@ package validation
 
 import (
 	"fmt"
+	"net/url"
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	imgreference "github.com/containers/image/v5/docker/reference"
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/maintenancewindow"
 	"github.com/scylladb/scylla-operator/pkg/pointer"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	apimachinerymetav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
@@ -26,6 +30,18 @@ var (
 		scyllav1alpha1.BroadcastAddressTypeServiceClusterIP,
 		scyllav1alpha1.BroadcastAddressTypeServiceLoadBalancerIngress,
 	}
+
+	SupportedScyllaV1Alpha1InternodeEncryptionModes = []scyllav1alpha1.InternodeEncryptionMode{
+		scyllav1alpha1.InternodeEncryptionModeNone,
+		scyllav1alpha1.InternodeEncryptionModeAll,
+		scyllav1alpha1.InternodeEncryptionModeDC,
+		scyllav1alpha1.InternodeEncryptionModeRack,
+	}
+
+	SupportedScyllaV1Alpha1RolloutStrategyTypes = []scyllav1alpha1.RolloutStrategyType{
+		scyllav1alpha1.RollingUpdateRolloutStrategyType,
+		scyllav1alpha1.CanaryRolloutStrategyType,
+	}
 )
 
 func ValidateScyllaDBDatacenter(sdc *scyllav1alpha1.ScyllaDBDatacenter) field.ErrorList {
@@ -72,6 +88,213 @@ func ValidateScyllaDBDatacenterSpec(spec *scyllav1alpha1.ScyllaDBDatacenterSpec,
 		allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(*spec.MinReadySeconds), fldPath.Child("minReadySeconds"))...)
 	}
 
+	if spec.UpgradeRollback != nil {
+		allErrs = append(allErrs, ValidateScyllaDBDatacenterUpgradeRollbackOptions(spec.UpgradeRollback, fldPath.Child("upgradeRollback"))...)
+	}
+
+	if spec.RolloutStrategy != nil {
+		allErrs = append(allErrs, ValidateScyllaDBDatacenterRolloutStrategy(spec.RolloutStrategy, fldPath.Child("rolloutStrategy"))...)
+	}
+
+	if spec.OrphanedNodeDetection != nil {
+		allErrs = append(allErrs, ValidateScyllaDBDatacenterOrphanedNodeDetection(spec.OrphanedNodeDetection, fldPath.Child("orphanedNodeDetection"))...)
+	}
+
+	if spec.OrphanedNodeReplacement != nil {
+		allErrs = append(allErrs, ValidateScyllaDBDatacenterOrphanedNodeReplacement(spec.OrphanedNodeReplacement, fldPath.Child("orphanedNodeReplacement"))...)
+	}
+
+	if spec.UnavailableNodeRemovalDelay != nil && spec.UnavailableNodeRemovalDelay.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("unavailableNodeRemovalDelay"), spec.UnavailableNodeRemovalDelay.Duration.String(), "must be greater than or equal to 0"))
+	}
+
+	if spec.AutomaticRacks != nil {
+		allErrs = append(allErrs, ValidateScyllaDBDatacenterAutomaticRacks(spec, fldPath)...)
+	}
+
+	for i := range spec.MaintenanceWindows {
+		allErrs = append(allErrs, ValidateMaintenanceWindow(&spec.MaintenanceWindows[i], fldPath.Child("maintenanceWindows").Index(i))...)
+	}
+
+	return allErrs
+}
+
+var supportedOrphanedVolumeDetectors = []scyllav1alpha1.OrphanedVolumeDetector{
+	scyllav1alpha1.PersistentVolumePhaseOrphanedVolumeDetector,
+	scyllav1alpha1.VolumeHealthOrphanedVolumeDetector,
+	scyllav1alpha1.MountFailuresOrphanedVolumeDetector,
+}
+
+func ValidateScyllaDBDatacenterOrphanedNodeDetection(detection *scyllav1alpha1.OrphanedNodeDetection, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	detectors := sets.New[scyllav1alpha1.OrphanedVolumeDetector]()
+	for i, detector := range detection.Detectors {
+		allErrs = append(allErrs, validateEnum(detector, supportedOrphanedVolumeDetectors, fldPath.Child("detectors").Index(i))...)
+
+		if detectors.Has(detector) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("detectors").Index(i), detector))
+		}
+		detectors.Insert(detector)
+	}
+
+	if detection.MountFailureThreshold != nil && *detection.MountFailureThreshold < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("mountFailureThreshold"), *detection.MountFailureThreshold, "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+var supportedOrphanedNodeReplacementModes = []scyllav1alpha1.OrphanedNodeReplacementMode{
+	scyllav1alpha1.AutomaticOrphanedNodeReplacementMode,
+	scyllav1alpha1.RequireApprovalOrphanedNodeReplacementMode,
+}
+
+func ValidateScyllaDBDatacenterOrphanedNodeReplacement(replacement *scyllav1alpha1.OrphanedNodeReplacement, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(replacement.Mode) != 0 {
+		allErrs = append(allErrs, validateEnum(replacement.Mode, supportedOrphanedNodeReplacementModes, fldPath.Child("mode"))...)
+	}
+
+	if replacement.RateLimit != nil {
+		if replacement.RateLimit.MaxReplacementsPerRack < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("rateLimit", "maxReplacementsPerRack"), replacement.RateLimit.MaxReplacementsPerRack, "must be greater than 0"))
+		}
+
+		if replacement.RateLimit.Period.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("rateLimit", "period"), replacement.RateLimit.Period.Duration.String(), "must be greater than 0"))
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBDatacenterAutomaticRacks(spec *scyllav1alpha1.ScyllaDBDatacenterSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(spec.AutomaticRacks.Nodes), fldPath.Child("automaticRacks", "nodes"))...)
+
+	if spec.AutomaticRacks.ZoneRemovalGracePeriodSeconds != nil {
+		allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(*spec.AutomaticRacks.ZoneRemovalGracePeriodSeconds, fldPath.Child("automaticRacks", "zoneRemovalGracePeriodSeconds"))...)
+	}
+
+	zones := sets.New[string]()
+	for i, zone := range spec.AutomaticRacks.Zones {
+		for _, msg := range apimachineryutilvalidation.IsValidLabelValue(zone) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("automaticRacks", "zones").Index(i), zone, msg))
+		}
+
+		if zones.Has(zone) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("automaticRacks", "zones").Index(i), zone))
+		}
+		zones.Insert(zone)
+	}
+
+	if spec.RackTemplate != nil {
+		_, ok := spec.RackTemplate.TopologyLabelSelector[corev1.LabelTopologyZone]
+		if ok {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("rackTemplate", "topologyLabelSelector").Key(corev1.LabelTopologyZone), "must not be set when racks are generated automatically"))
+		}
+	}
+
+	rackZones := sets.New[string]()
+	for i, rack := range spec.Racks {
+		zone, ok := rack.TopologyLabelSelector[corev1.LabelTopologyZone]
+		if !ok {
+			allErrs = append(allErrs, field.Required(fldPath.Child("racks").Index(i).Child("topologyLabelSelector").Key(corev1.LabelTopologyZone), "racks must select a zone when racks are generated automatically"))
+			continue
+		}
+
+		if rackZones.Has(zone) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("racks").Index(i).Child("topologyLabelSelector").Key(corev1.LabelTopologyZone), zone))
+		}
+		rackZones.Insert(zone)
+	}
+
+	return allErrs
+}
+
+func ValidateMaintenanceWindow(mw *scyllav1alpha1.MaintenanceWindow, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(mw.Cron) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("cron"), ""))
+	} else {
+		_, _, err := maintenancewindow.ParseSchedule(&scyllav1alpha1.MaintenanceWindow{Cron: mw.Cron})
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cron"), mw.Cron, err.Error()))
+		}
+	}
+
+	if mw.Timezone != nil {
+		_, err := time.LoadLocation(*mw.Timezone)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timezone"), *mw.Timezone, err.Error()))
+		}
+	}
+
+	if mw.Duration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("duration"), mw.Duration.Duration.String(), "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBDatacenterRolloutStrategy(strategy *scyllav1alpha1.RolloutStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(strategy.Type) != 0 {
+		allErrs = append(allErrs, validateEnum(strategy.Type, SupportedScyllaV1Alpha1RolloutStrategyTypes, fldPath.Child("type"))...)
+	}
+
+	if strategy.Canary != nil {
+		if strategy.Type != scyllav1alpha1.CanaryRolloutStrategyType {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("canary"), fmt.Sprintf("can only be set when type is %q", scyllav1alpha1.CanaryRolloutStrategyType)))
+		}
+
+		if strategy.Canary.SoakDuration.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("canary", "soakDuration"), strategy.Canary.SoakDuration.Duration.String(), "must be non-negative"))
+		}
+
+		if strategy.Canary.Prometheus != nil {
+			prometheusFldPath := fldPath.Child("canary", "prometheus")
+
+			if len(strategy.Canary.Prometheus.URL) == 0 {
+				allErrs = append(allErrs, field.Required(prometheusFldPath.Child("url"), ""))
+			} else {
+				u, err := url.Parse(strategy.Canary.Prometheus.URL)
+				if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+					allErrs = append(allErrs, field.Invalid(prometheusFldPath.Child("url"), strategy.Canary.Prometheus.URL, "must be a valid http or https URL"))
+				}
+			}
+
+			if len(strategy.Canary.Prometheus.Queries) == 0 {
+				allErrs = append(allErrs, field.Required(prometheusFldPath.Child("queries"), ""))
+			}
+
+			for i, q := range strategy.Canary.Prometheus.Queries {
+				if len(strings.TrimSpace(q)) == 0 {
+					allErrs = append(allErrs, field.Required(prometheusFldPath.Child("queries").Index(i), ""))
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBDatacenterUpgradeRollbackOptions(options *scyllav1alpha1.UpgradeRollbackOptions, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if options.FailedNodesThreshold < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("failedNodesThreshold"), options.FailedNodesThreshold, "must be greater than 0"))
+	}
+
+	if options.NodeReadinessTimeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeReadinessTimeout"), options.NodeReadinessTimeout.Duration.String(), "must be greater than 0"))
+	}
+
 	return allErrs
 }
 
@@ -141,6 +364,20 @@ func ValidateScyllaDBDatacenterScyllaDB(scyllaDB *scyllav1alpha1.ScyllaDB, fldPa
 		allErrs = append(allErrs, ValidateScyllaDBDatacenterAlternatorOptions(scyllaDB.AlternatorOptions, fldPath.Child("alternator"))...)
 	}
 
+	if scyllaDB.InternodeEncryptionOptions != nil {
+		allErrs = append(allErrs, ValidateScyllaDBDatacenterInternodeEncryptionOptions(scyllaDB.InternodeEncryptionOptions, fldPath.Child("internodeEncryptionOptions"))...)
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBDatacenterInternodeEncryptionOptions(options *scyllav1alpha1.InternodeEncryptionOptions, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(options.Mode) != 0 {
+		allErrs = append(allErrs, validateEnum(options.Mode, SupportedScyllaV1Alpha1InternodeEncryptionModes, fldPath.Child("mode"))...)
+	}
+
 	return allErrs
 }
 
@@ -156,6 +393,78 @@ func ValidateScyllaDBDatacenterScyllaDBManagerAgent(scyllaDBManagerAgent *scylla
 		}
 	}
 
+	if scyllaDBManagerAgent != nil && scyllaDBManagerAgent.Config != nil {
+		allErrs = append(allErrs, ValidateScyllaDBManagerAgentConfig(scyllaDBManagerAgent.Config, fldPath.Child("config"))...)
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBManagerAgentConfig(config *scyllav1alpha1.ScyllaDBManagerAgentConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.S3 != nil {
+		s3FldPath := fldPath.Child("s3")
+
+		if len(config.S3.Endpoint) != 0 {
+			u, err := url.Parse(config.S3.Endpoint)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+				allErrs = append(allErrs, field.Invalid(s3FldPath.Child("endpoint"), config.S3.Endpoint, "must be a valid http or https URL"))
+			}
+		}
+
+		if (config.S3.AccessKeyIDSecretKeyRef == nil) != (config.S3.SecretAccessKeySecretKeyRef == nil) {
+			allErrs = append(allErrs, field.Required(s3FldPath, "accessKeyIDSecretKeyRef and secretAccessKeySecretKeyRef must be set together"))
+		}
+
+		allErrs = append(allErrs, validateSecretKeySelector(config.S3.AccessKeyIDSecretKeyRef, s3FldPath.Child("accessKeyIDSecretKeyRef"))...)
+		allErrs = append(allErrs, validateSecretKeySelector(config.S3.SecretAccessKeySecretKeyRef, s3FldPath.Child("secretAccessKeySecretKeyRef"))...)
+	}
+
+	if config.GCS != nil {
+		allErrs = append(allErrs, validateSecretKeySelector(config.GCS.ServiceAccountSecretKeyRef, fldPath.Child("gcs", "serviceAccountSecretKeyRef"))...)
+	}
+
+	if config.Azure != nil {
+		if len(config.Azure.Account) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("azure", "account"), ""))
+		}
+
+		allErrs = append(allErrs, validateSecretKeySelector(config.Azure.KeySecretKeyRef, fldPath.Child("azure", "keySecretKeyRef"))...)
+	}
+
+	if config.RateLimits != nil {
+		if config.RateLimits.BandwidthMiBPerSecond != nil && *config.RateLimits.BandwidthMiBPerSecond < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("rateLimits", "bandwidthMiBPerSecond"), *config.RateLimits.BandwidthMiBPerSecond, "must be greater than 0"))
+		}
+
+		if config.RateLimits.Transfers != nil && *config.RateLimits.Transfers < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("rateLimits", "transfers"), *config.RateLimits.Transfers, "must be greater than 0"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateSecretKeySelector(selector *corev1.SecretKeySelector, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if selector == nil {
+		return allErrs
+	}
+
+	if len(selector.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	} else {
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(selector.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), selector.Name, msg))
+		}
+	}
+
+	if len(selector.Key) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("key"), ""))
+	}
+
 	return allErrs
 }
 
@@ -460,8 +769,17 @@ func ValidateScyllaDBDatacenterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBDatac
 			oldRackStorage = *oldRack.ScyllaDB.Storage
 		}
 
+		allErrs = append(allErrs, validateStorageCapacityUpdate(oldRackStorage.Capacity, newRackStorage.Capacity, fldPath.Child("racks").Index(i).Child("scyllaDB", "storage", "capacity"))...)
+		allErrs = append(allErrs, validateStorageClassNameUpdate(oldRackStorage.StorageClassName, newRackStorage.StorageClassName, fldPath.Child("racks").Index(i).Child("scyllaDB", "storage", "storageClassName"))...)
+
+		// Only the capacity can be expanded and nodes can be migrated to another storage class,
+		// other storage options can't be changed.
+		oldRackStorage.Capacity = ""
+		newRackStorage.Capacity = ""
+		oldRackStorage.StorageClassName = nil
+		newRackStorage.StorageClassName = nil
 		if !reflect.DeepEqual(oldRackStorage, newRackStorage) {
-			allErrs = append(allErrs, field.Forbidden(fldPath.Child("racks").Index(i).Child("scyllaDB", "storage"), "changes in storage are currently not supported"))
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("racks").Index(i).Child("scyllaDB", "storage"), "changes in storage other than capacity and storage class are currently not supported"))
 		}
 	}
 
@@ -520,3 +838,37 @@ func validateEnum[E ~string](value E, supported []E, fldPath *field.Path) field.
 
 	return allErrs
 }
+
+// validateStorageClassNameUpdate allows migrating nodes to an explicitly set storage class.
+// Setting the storage class is allowed, the controller compares it with the storage class recorded on the volumes.
+// Clearing it is forbidden, because the default storage class can change over time.
+func validateStorageClassNameUpdate(oldStorageClassName, newStorageClassName *string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if oldStorageClassName != nil && newStorageClassName == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "storage class can't be cleared once it's set"))
+	}
+
+	return allErrs
+}
+
+func validateStorageCapacityUpdate(oldCapacity, newCapacity string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	oldQuantity, err := resource.ParseQuantity(oldCapacity)
+	if err != nil {
+		return allErrs
+	}
+
+	// Invalid capacity is reported by the spec validation.
+	newQuantity, err := resource.ParseQuantity(newCapacity)
+	if err != nil {
+		return allErrs
+	}
+
+	if newQuantity.Cmp(oldQuantity) < 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("capacity can't be decreased from %s", oldCapacity)))
+	}
+
+	return allErrs
+}
@@ -5,11 +5,13 @@ package validation_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/api/scylla/validation"
 	"github.com/scylladb/scylla-operator/pkg/pointer"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -418,6 +420,277 @@ func TestValidateScyllaDBDatacenter(t *testing.T) {
 			},
 			expectedErrorString: `spec.scyllaDB.alternator.servingCertificate.type: Unsupported value: "foo": supported values: "OperatorManaged", "UserManaged"`,
 		},
+		{
+			name: "internode encryption with supported mode passes",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.InternodeEncryptionOptions = &scyllav1alpha1.InternodeEncryptionOptions{
+					Mode: scyllav1alpha1.InternodeEncryptionModeDC,
+				}
+				return sdc
+			}(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "internode encryption with unsupported mode",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.InternodeEncryptionOptions = &scyllav1alpha1.InternodeEncryptionOptions{
+					Mode: "foo",
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.scyllaDB.internodeEncryptionOptions.mode", BadValue: scyllav1alpha1.InternodeEncryptionMode("foo"), Detail: `supported values: "None", "All", "DC", "Rack"`},
+			},
+			expectedErrorString: `spec.scyllaDB.internodeEncryptionOptions.mode: Unsupported value: "foo": supported values: "None", "All", "DC", "Rack"`,
+		},
+		{
+			name: "upgrade rollback with invalid options",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.UpgradeRollback = &scyllav1alpha1.UpgradeRollbackOptions{
+					FailedNodesThreshold: 0,
+					NodeReadinessTimeout: metav1.Duration{Duration: -time.Minute},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.upgradeRollback.failedNodesThreshold", BadValue: int32(0), Detail: "must be greater than 0"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.upgradeRollback.nodeReadinessTimeout", BadValue: "-1m0s", Detail: "must be greater than 0"},
+			},
+			expectedErrorString: `[spec.upgradeRollback.failedNodesThreshold: Invalid value: 0: must be greater than 0, spec.upgradeRollback.nodeReadinessTimeout: Invalid value: "-1m0s": must be greater than 0]`,
+		},
+		{
+			name: "canary rollout strategy with invalid options",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RolloutStrategy = &scyllav1alpha1.RolloutStrategy{
+					Type: scyllav1alpha1.CanaryRolloutStrategyType,
+					Canary: &scyllav1alpha1.CanaryRolloutStrategy{
+						SoakDuration: metav1.Duration{Duration: -time.Minute},
+						Prometheus: &scyllav1alpha1.CanaryPrometheusOptions{
+							URL:     "prometheus:9090",
+							Queries: []string{" "},
+						},
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rolloutStrategy.canary.soakDuration", BadValue: "-1m0s", Detail: "must be non-negative"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rolloutStrategy.canary.prometheus.url", BadValue: "prometheus:9090", Detail: "must be a valid http or https URL"},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.rolloutStrategy.canary.prometheus.queries[0]", BadValue: "", Detail: ""},
+			},
+			expectedErrorString: `[spec.rolloutStrategy.canary.soakDuration: Invalid value: "-1m0s": must be non-negative, spec.rolloutStrategy.canary.prometheus.url: Invalid value: "prometheus:9090": must be a valid http or https URL, spec.rolloutStrategy.canary.prometheus.queries[0]: Required value]`,
+		},
+		{
+			name: "canary options with rolling update strategy",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RolloutStrategy = &scyllav1alpha1.RolloutStrategy{
+					Type: scyllav1alpha1.RollingUpdateRolloutStrategyType,
+					Canary: &scyllav1alpha1.CanaryRolloutStrategy{
+						SoakDuration: metav1.Duration{Duration: time.Minute},
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.rolloutStrategy.canary", BadValue: "", Detail: `can only be set when type is "Canary"`},
+			},
+			expectedErrorString: `spec.rolloutStrategy.canary: Forbidden: can only be set when type is "Canary"`,
+		},
+		{
+			name: "valid manager agent config",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDBManagerAgent.Config = &scyllav1alpha1.ScyllaDBManagerAgentConfig{
+					S3: &scyllav1alpha1.ScyllaDBManagerAgentS3Options{
+						Provider: "Minio",
+						Endpoint: "http://minio:9000",
+						AccessKeyIDSecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "minio"},
+							Key:                  "access-key-id",
+						},
+						SecretAccessKeySecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "minio"},
+							Key:                  "secret-access-key",
+						},
+					},
+					GCS: &scyllav1alpha1.ScyllaDBManagerAgentGCSOptions{},
+					RateLimits: &scyllav1alpha1.ScyllaDBManagerAgentRateLimits{
+						BandwidthMiBPerSecond: pointer.Ptr[int32](100),
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "invalid manager agent config",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDBManagerAgent.Config = &scyllav1alpha1.ScyllaDBManagerAgentConfig{
+					S3: &scyllav1alpha1.ScyllaDBManagerAgentS3Options{
+						Endpoint: "minio:9000",
+						AccessKeyIDSecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "minio"},
+						},
+					},
+					Azure: &scyllav1alpha1.ScyllaDBManagerAgentAzureOptions{},
+					RateLimits: &scyllav1alpha1.ScyllaDBManagerAgentRateLimits{
+						Transfers: pointer.Ptr[int32](0),
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.scyllaDBManagerAgent.config.s3.endpoint", BadValue: "minio:9000", Detail: "must be a valid http or https URL"},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.scyllaDBManagerAgent.config.s3", BadValue: "", Detail: "accessKeyIDSecretKeyRef and secretAccessKeySecretKeyRef must be set together"},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.scyllaDBManagerAgent.config.s3.accessKeyIDSecretKeyRef.key", BadValue: "", Detail: ""},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.scyllaDBManagerAgent.config.azure.account", BadValue: "", Detail: ""},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.scyllaDBManagerAgent.config.rateLimits.transfers", BadValue: int32(0), Detail: "must be greater than 0"},
+			},
+			expectedErrorString: `[spec.scyllaDBManagerAgent.config.s3.endpoint: Invalid value: "minio:9000": must be a valid http or https URL, spec.scyllaDBManagerAgent.config.s3: Required value: accessKeyIDSecretKeyRef and secretAccessKeySecretKeyRef must be set together, spec.scyllaDBManagerAgent.config.s3.accessKeyIDSecretKeyRef.key: Required value, spec.scyllaDBManagerAgent.config.azure.account: Required value, spec.scyllaDBManagerAgent.config.rateLimits.transfers: Invalid value: 0: must be greater than 0]`,
+		},
+		{
+			name: "invalid maintenance windows",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.MaintenanceWindows = []scyllav1alpha1.MaintenanceWindow{
+					{
+						Cron:     "0 2 * * SAT",
+						Timezone: pointer.Ptr("Europe/Warsaw"),
+						Duration: metav1.Duration{Duration: 4 * time.Hour},
+					},
+					{
+						Cron:     "CRON_TZ=UTC 0 2 * * SAT",
+						Timezone: pointer.Ptr("Mars/Olympus"),
+						Duration: metav1.Duration{},
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.maintenanceWindows[1].cron", BadValue: "CRON_TZ=UTC 0 2 * * SAT", Detail: "can't use TZ or CRON_TZ in cron, use timezone instead"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.maintenanceWindows[1].timezone", BadValue: "Mars/Olympus", Detail: "unknown time zone Mars/Olympus"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.maintenanceWindows[1].duration", BadValue: "0s", Detail: "must be greater than 0"},
+			},
+			expectedErrorString: `[spec.maintenanceWindows[1].cron: Invalid value: "CRON_TZ=UTC 0 2 * * SAT": can't use TZ or CRON_TZ in cron, use timezone instead, spec.maintenanceWindows[1].timezone: Invalid value: "Mars/Olympus": unknown time zone Mars/Olympus, spec.maintenanceWindows[1].duration: Invalid value: "0s": must be greater than 0]`,
+		},
+		{
+			name: "maintenance window with @every cron",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.MaintenanceWindows = []scyllav1alpha1.MaintenanceWindow{
+					{
+						Cron:     "@every 24h",
+						Duration: metav1.Duration{Duration: 4 * time.Hour},
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.maintenanceWindows[0].cron", BadValue: "@every 24h", Detail: "@every isn't supported, maintenance windows have to open at fixed times"},
+			},
+			expectedErrorString: `spec.maintenanceWindows[0].cron: Invalid value: "@every 24h": @every isn't supported, maintenance windows have to open at fixed times`,
+		},
+		{
+			name: "invalid orphaned node detection",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.OrphanedNodeDetection = &scyllav1alpha1.OrphanedNodeDetection{
+					Detectors: []scyllav1alpha1.OrphanedVolumeDetector{
+						scyllav1alpha1.MountFailuresOrphanedVolumeDetector,
+						"Unknown",
+						scyllav1alpha1.MountFailuresOrphanedVolumeDetector,
+					},
+					MountFailureThreshold: pointer.Ptr[int32](0),
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.orphanedNodeDetection.detectors[1]", BadValue: scyllav1alpha1.OrphanedVolumeDetector("Unknown"), Detail: `supported values: "PersistentVolumePhase", "VolumeHealth", "MountFailures"`},
+				&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.orphanedNodeDetection.detectors[2]", BadValue: scyllav1alpha1.MountFailuresOrphanedVolumeDetector},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.orphanedNodeDetection.mountFailureThreshold", BadValue: int32(0), Detail: "must be greater than 0"},
+			},
+			expectedErrorString: `[spec.orphanedNodeDetection.detectors[1]: Unsupported value: "Unknown": supported values: "PersistentVolumePhase", "VolumeHealth", "MountFailures", spec.orphanedNodeDetection.detectors[2]: Duplicate value: "MountFailures", spec.orphanedNodeDetection.mountFailureThreshold: Invalid value: 0: must be greater than 0]`,
+		},
+		{
+			name: "invalid orphaned node replacement",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.OrphanedNodeReplacement = &scyllav1alpha1.OrphanedNodeReplacement{
+					Mode: "Never",
+					RateLimit: &scyllav1alpha1.OrphanedNodeReplacementRateLimit{
+						MaxReplacementsPerRack: 0,
+						Period:                 metav1.Duration{},
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.orphanedNodeReplacement.mode", BadValue: scyllav1alpha1.OrphanedNodeReplacementMode("Never"), Detail: `supported values: "Automatic", "RequireApproval"`},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.orphanedNodeReplacement.rateLimit.maxReplacementsPerRack", BadValue: int32(0), Detail: "must be greater than 0"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.orphanedNodeReplacement.rateLimit.period", BadValue: "0s", Detail: "must be greater than 0"},
+			},
+			expectedErrorString: `[spec.orphanedNodeReplacement.mode: Unsupported value: "Never": supported values: "Automatic", "RequireApproval", spec.orphanedNodeReplacement.rateLimit.maxReplacementsPerRack: Invalid value: 0: must be greater than 0, spec.orphanedNodeReplacement.rateLimit.period: Invalid value: "0s": must be greater than 0]`,
+		},
+		{
+			name: "negative unavailable node removal delay",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.UnavailableNodeRemovalDelay = &metav1.Duration{Duration: -time.Minute}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.unavailableNodeRemovalDelay", BadValue: "-1m0s", Detail: "must be greater than or equal to 0"},
+			},
+			expectedErrorString: `spec.unavailableNodeRemovalDelay: Invalid value: "-1m0s": must be greater than or equal to 0`,
+		},
+		{
+			name: "invalid automatic racks",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.AutomaticRacks = &scyllav1alpha1.AutomaticRacks{
+					Nodes:                         -1,
+					Zones:                         []string{"us-east-1a", "us-east-1a"},
+					ZoneRemovalGracePeriodSeconds: pointer.Ptr(int64(-1)),
+				}
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					TopologyLabelSelector: map[string]string{
+						"topology.kubernetes.io/zone": "us-east-1a",
+					},
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.automaticRacks.nodes", BadValue: int64(-1), Detail: "must be greater than or equal to 0"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.automaticRacks.zoneRemovalGracePeriodSeconds", BadValue: int64(-1), Detail: "must be greater than or equal to 0"},
+				&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.automaticRacks.zones[1]", BadValue: "us-east-1a"},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.rackTemplate.topologyLabelSelector[topology.kubernetes.io/zone]", BadValue: "", Detail: "must not be set when racks are generated automatically"},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.racks[0].topologyLabelSelector[topology.kubernetes.io/zone]", BadValue: "", Detail: "racks must select a zone when racks are generated automatically"},
+			},
+			expectedErrorString: `[spec.automaticRacks.nodes: Invalid value: -1: must be greater than or equal to 0, spec.automaticRacks.zoneRemovalGracePeriodSeconds: Invalid value: -1: must be greater than or equal to 0, spec.automaticRacks.zones[1]: Duplicate value: "us-east-1a", spec.rackTemplate.topologyLabelSelector[topology.kubernetes.io/zone]: Forbidden: must not be set when racks are generated automatically, spec.racks[0].topologyLabelSelector[topology.kubernetes.io/zone]: Required value: racks must select a zone when racks are generated automatically]`,
+		},
+		{
+			name: "valid automatic racks with adopted rack",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.AutomaticRacks = &scyllav1alpha1.AutomaticRacks{
+					Nodes: 3,
+				}
+				sdc.Spec.Racks[0].TopologyLabelSelector = map[string]string{
+					"topology.kubernetes.io/zone": "us-east-1a",
+				}
+				return sdc
+			}(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
 		{
 			name: "alternator cluster with valid additional domains",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
@@ -757,17 +1030,86 @@ func TestValidateScyllaDBDatacenterUpdate(t *testing.T) {
 			expectedErrorString: `spec.clusterName: Invalid value: "foo": field is immutable`,
 		},
 		{
-			name: "rackStorage changed",
+			name: "rackStorage capacity increased",
 			old:  newValidScyllaDBDatacenter(),
 			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
 				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage.Capacity = "123Gi"
 				return sdc
 			}(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "rackStorage capacity decreased",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage.Capacity = "123Gi"
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage.Capacity = "100Gi"
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage.capacity", BadValue: "", Detail: "capacity can't be decreased from 123Gi"},
+			},
+			expectedErrorString: "spec.racks[0].scyllaDB.storage.capacity: Forbidden: capacity can't be decreased from 123Gi",
+		},
+		{
+			name: "rackStorage storageClassName changed",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage.StorageClassName = pointer.Ptr("standard")
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage.StorageClassName = pointer.Ptr("other")
+				return sdc
+			}(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "rackStorage storageClassName set",
+			old:  newValidScyllaDBDatacenter(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage.StorageClassName = pointer.Ptr("other")
+				return sdc
+			}(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "rackStorage storageClassName cleared",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage.StorageClassName = pointer.Ptr("standard")
+				return sdc
+			}(),
+			new: newValidScyllaDBDatacenter(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage.storageClassName", BadValue: "", Detail: "storage class can't be cleared once it's set"},
+			},
+			expectedErrorString: "spec.racks[0].scyllaDB.storage.storageClassName: Forbidden: storage class can't be cleared once it's set",
+		},
+		{
+			name: "rackStorage metadata changed",
+			old:  newValidScyllaDBDatacenter(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage.Metadata = &scyllav1alpha1.ObjectTemplateMetadata{
+					Labels: map[string]string{"foo": "bar"},
+				}
+				return sdc
+			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage", BadValue: "", Detail: "changes in storage are currently not supported"},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage", BadValue: "", Detail: "changes in storage other than capacity and storage class are currently not supported"},
 			},
-			expectedErrorString: "spec.racks[0].scyllaDB.storage: Forbidden: changes in storage are currently not supported",
+			expectedErrorString: "spec.racks[0].scyllaDB.storage: Forbidden: changes in storage other than capacity and storage class are currently not supported",
 		},
 		{
 			name: "empty rack removed",
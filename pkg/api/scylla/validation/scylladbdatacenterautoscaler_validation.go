@@ -0,0 +1,135 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package validation
+
+import (
+	"net/url"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	SupportedAutoscalerModes = []scyllav1alpha1.AutoscalerMode{
+		scyllav1alpha1.AutoscalerModeRecommend,
+		scyllav1alpha1.AutoscalerModeApply,
+	}
+
+	SupportedAutoscalerMetricsSourceTypes = []scyllav1alpha1.AutoscalerMetricsSourceType{
+		scyllav1alpha1.AutoscalerMetricsSourceTypePrometheus,
+		scyllav1alpha1.AutoscalerMetricsSourceTypeScyllaDBAPI,
+	}
+)
+
+func ValidateScyllaDBDatacenterAutoscaler(sdca *scyllav1alpha1.ScyllaDBDatacenterAutoscaler) field.ErrorList {
+	return ValidateScyllaDBDatacenterAutoscalerSpec(&sdca.Spec, field.NewPath("spec"))
+}
+
+func ValidateScyllaDBDatacenterAutoscalerSpec(spec *scyllav1alpha1.ScyllaDBDatacenterAutoscalerSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.ScyllaDBDatacenterRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("scyllaDBDatacenterRef", "name"), ""))
+	}
+
+	if len(spec.Mode) != 0 && !slices.ContainsItem(SupportedAutoscalerModes, spec.Mode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), spec.Mode, slices.ConvertSlice(SupportedAutoscalerModes, slices.ToString[scyllav1alpha1.AutoscalerMode])))
+	}
+
+	if spec.MinNodes < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minNodes"), spec.MinNodes, "must be greater than zero"))
+	}
+
+	if spec.MaxNodes < spec.MinNodes {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxNodes"), spec.MaxNodes, "must be greater than or equal to minNodes"))
+	}
+
+	allErrs = append(allErrs, ValidateAutoscalerMetricsSource(&spec.MetricsSource, fldPath.Child("metricsSource"))...)
+	allErrs = append(allErrs, ValidateAutoscalerThresholds(&spec.ScaleOut, fldPath.Child("scaleOut"))...)
+	allErrs = append(allErrs, ValidateAutoscalerThresholds(&spec.ScaleIn, fldPath.Child("scaleIn"))...)
+
+	if spec.ScaleOut.DiskUtilizationPercent == nil && spec.ScaleOut.LoadPercent == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("scaleOut"), "at least one threshold is required"))
+	}
+
+	if spec.MetricsSource.Type == scyllav1alpha1.AutoscalerMetricsSourceTypeScyllaDBAPI {
+		if spec.ScaleOut.LoadPercent != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("scaleOut", "loadPercent"), "load isn't available from the ScyllaDB API"))
+		}
+		if spec.ScaleIn.LoadPercent != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("scaleIn", "loadPercent"), "load isn't available from the ScyllaDB API"))
+		}
+	}
+
+	if spec.ScaleOut.DiskUtilizationPercent != nil && spec.ScaleIn.DiskUtilizationPercent != nil &&
+		*spec.ScaleIn.DiskUtilizationPercent >= *spec.ScaleOut.DiskUtilizationPercent {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleIn", "diskUtilizationPercent"), *spec.ScaleIn.DiskUtilizationPercent, "must be lower than scaleOut.diskUtilizationPercent"))
+	}
+
+	if spec.ScaleOut.LoadPercent != nil && spec.ScaleIn.LoadPercent != nil &&
+		*spec.ScaleIn.LoadPercent >= *spec.ScaleOut.LoadPercent {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleIn", "loadPercent"), *spec.ScaleIn.LoadPercent, "must be lower than scaleOut.loadPercent"))
+	}
+
+	if spec.ScaleOutCooldown != nil && spec.ScaleOutCooldown.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleOutCooldown"), spec.ScaleOutCooldown.Duration.String(), "must be non-negative"))
+	}
+
+	if spec.ScaleInCooldown != nil && spec.ScaleInCooldown.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleInCooldown"), spec.ScaleInCooldown.Duration.String(), "must be non-negative"))
+	}
+
+	return allErrs
+}
+
+func ValidateAutoscalerMetricsSource(source *scyllav1alpha1.AutoscalerMetricsSource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch source.Type {
+	case scyllav1alpha1.AutoscalerMetricsSourceTypePrometheus:
+		if source.Prometheus == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("prometheus"), "prometheus is required when type is Prometheus"))
+			break
+		}
+
+		u, err := url.Parse(source.Prometheus.URL)
+		if err != nil || len(u.Scheme) == 0 || len(u.Host) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("prometheus", "url"), source.Prometheus.URL, "must be an absolute URL"))
+		}
+
+	case scyllav1alpha1.AutoscalerMetricsSourceTypeScyllaDBAPI:
+		if source.Prometheus != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("prometheus"), "prometheus can only be set when type is Prometheus"))
+		}
+
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), source.Type, slices.ConvertSlice(SupportedAutoscalerMetricsSourceTypes, slices.ToString[scyllav1alpha1.AutoscalerMetricsSourceType])))
+	}
+
+	return allErrs
+}
+
+func ValidateAutoscalerThresholds(thresholds *scyllav1alpha1.AutoscalerThresholds, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if thresholds.DiskUtilizationPercent != nil && (*thresholds.DiskUtilizationPercent < 0 || *thresholds.DiskUtilizationPercent > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("diskUtilizationPercent"), *thresholds.DiskUtilizationPercent, "must be between 0 and 100"))
+	}
+
+	if thresholds.LoadPercent != nil && (*thresholds.LoadPercent < 0 || *thresholds.LoadPercent > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("loadPercent"), *thresholds.LoadPercent, "must be between 0 and 100"))
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBDatacenterAutoscalerUpdate(new, old *scyllav1alpha1.ScyllaDBDatacenterAutoscaler) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateScyllaDBDatacenterAutoscaler(new)...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(new.Spec.ScyllaDBDatacenterRef, old.Spec.ScyllaDBDatacenterRef, field.NewPath("spec", "scyllaDBDatacenterRef"))...)
+
+	return allErrs
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package validation_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/api/scylla/validation"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func newValidScyllaDBDatacenterAutoscaler() *scyllav1alpha1.ScyllaDBDatacenterAutoscaler {
+	return &scyllav1alpha1.ScyllaDBDatacenterAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic",
+			Namespace: "scylla",
+		},
+		Spec: scyllav1alpha1.ScyllaDBDatacenterAutoscalerSpec{
+			ScyllaDBDatacenterRef: scyllav1alpha1.ScyllaDBDatacenterReference{
+				Name: "basic",
+			},
+			Mode:     scyllav1alpha1.AutoscalerModeRecommend,
+			MinNodes: 3,
+			MaxNodes: 6,
+			MetricsSource: scyllav1alpha1.AutoscalerMetricsSource{
+				Type: scyllav1alpha1.AutoscalerMetricsSourceTypePrometheus,
+				Prometheus: &scyllav1alpha1.AutoscalerPrometheusOptions{
+					URL: "http://prometheus.monitoring.svc:9090",
+				},
+			},
+			ScaleOut: scyllav1alpha1.AutoscalerThresholds{
+				DiskUtilizationPercent: pointer.Ptr[int32](70),
+				LoadPercent:            pointer.Ptr[int32](80),
+			},
+			ScaleIn: scyllav1alpha1.AutoscalerThresholds{
+				DiskUtilizationPercent: pointer.Ptr[int32](30),
+				LoadPercent:            pointer.Ptr[int32](20),
+			},
+		},
+	}
+}
+
+func TestValidateScyllaDBDatacenterAutoscaler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		autoscaler          *scyllav1alpha1.ScyllaDBDatacenterAutoscaler
+		expectedErrorList   field.ErrorList
+		expectedErrorString string
+	}{
+		{
+			name:                "valid",
+			autoscaler:          newValidScyllaDBDatacenterAutoscaler(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "max nodes lower than min nodes",
+			autoscaler: func() *scyllav1alpha1.ScyllaDBDatacenterAutoscaler {
+				sdca := newValidScyllaDBDatacenterAutoscaler()
+				sdca.Spec.MaxNodes = 2
+				return sdca
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.maxNodes", BadValue: int32(2), Detail: "must be greater than or equal to minNodes"},
+			},
+			expectedErrorString: `spec.maxNodes: Invalid value: 2: must be greater than or equal to minNodes`,
+		},
+		{
+			name: "prometheus without url and no scale-out thresholds",
+			autoscaler: func() *scyllav1alpha1.ScyllaDBDatacenterAutoscaler {
+				sdca := newValidScyllaDBDatacenterAutoscaler()
+				sdca.Spec.MetricsSource.Prometheus.URL = ""
+				sdca.Spec.ScaleOut = scyllav1alpha1.AutoscalerThresholds{}
+				return sdca
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.metricsSource.prometheus.url", BadValue: "", Detail: "must be an absolute URL"},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.scaleOut", BadValue: "", Detail: "at least one threshold is required"},
+			},
+			expectedErrorString: `[spec.metricsSource.prometheus.url: Invalid value: "": must be an absolute URL, spec.scaleOut: Required value: at least one threshold is required]`,
+		},
+		{
+			name: "load thresholds with ScyllaDB API source",
+			autoscaler: func() *scyllav1alpha1.ScyllaDBDatacenterAutoscaler {
+				sdca := newValidScyllaDBDatacenterAutoscaler()
+				sdca.Spec.MetricsSource = scyllav1alpha1.AutoscalerMetricsSource{
+					Type: scyllav1alpha1.AutoscalerMetricsSourceTypeScyllaDBAPI,
+				}
+				return sdca
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.scaleOut.loadPercent", BadValue: "", Detail: "load isn't available from the ScyllaDB API"},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.scaleIn.loadPercent", BadValue: "", Detail: "load isn't available from the ScyllaDB API"},
+			},
+			expectedErrorString: `[spec.scaleOut.loadPercent: Forbidden: load isn't available from the ScyllaDB API, spec.scaleIn.loadPercent: Forbidden: load isn't available from the ScyllaDB API]`,
+		},
+		{
+			name: "scale-in threshold not lower than scale-out threshold",
+			autoscaler: func() *scyllav1alpha1.ScyllaDBDatacenterAutoscaler {
+				sdca := newValidScyllaDBDatacenterAutoscaler()
+				sdca.Spec.ScaleIn.DiskUtilizationPercent = pointer.Ptr[int32](70)
+				return sdca
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.scaleIn.diskUtilizationPercent", BadValue: int32(70), Detail: "must be lower than scaleOut.diskUtilizationPercent"},
+			},
+			expectedErrorString: `spec.scaleIn.diskUtilizationPercent: Invalid value: 70: must be lower than scaleOut.diskUtilizationPercent`,
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			errList := validation.ValidateScyllaDBDatacenterAutoscaler(test.autoscaler)
+			if !reflect.DeepEqual(errList, test.expectedErrorList) {
+				t.Errorf("expected and actual error lists differ: %s", cmp.Diff(test.expectedErrorList, errList))
+			}
+
+			var errStr string
+			if agg := errList.ToAggregate(); agg != nil {
+				errStr = agg.Error()
+			}
+			if !reflect.DeepEqual(errStr, test.expectedErrorString) {
+				t.Errorf("expected and actual error strings differ: %s", cmp.Diff(test.expectedErrorString, errStr))
+			}
+		})
+	}
+}
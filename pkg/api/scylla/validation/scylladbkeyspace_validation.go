@@ -0,0 +1,157 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	maxKeyspaceNameLength = 48
+	systemKeyspacePrefix  = "system"
+)
+
+var (
+	keyspaceNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+	SupportedKeyspaceDeletionPolicies = []scyllav1alpha1.KeyspaceDeletionPolicy{
+		scyllav1alpha1.KeyspaceDeletionPolicyRetain,
+		scyllav1alpha1.KeyspaceDeletionPolicyDelete,
+	}
+)
+
+func ValidateScyllaDBKeyspace(sk *scyllav1alpha1.ScyllaDBKeyspace) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(sk.Spec.KeyspaceName) == 0 {
+		allErrs = append(allErrs, ValidateKeyspaceName(sk.Name, field.NewPath("metadata", "name"))...)
+	}
+
+	allErrs = append(allErrs, ValidateScyllaDBKeyspaceSpec(&sk.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidateKeyspaceName(name string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(name) > maxKeyspaceNameLength {
+		allErrs = append(allErrs, field.TooLong(fldPath, name, maxKeyspaceNameLength))
+	}
+
+	if !keyspaceNameRegexp.MatchString(name) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, "keyspace name can only contain alphanumeric characters and underscores"))
+	}
+
+	if strings.HasPrefix(strings.ToLower(name), systemKeyspacePrefix) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("keyspace name can't start with %q", systemKeyspacePrefix)))
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBKeyspaceSpec(spec *scyllav1alpha1.ScyllaDBKeyspaceSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.ScyllaDBDatacenterRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("scyllaDBDatacenterRef", "name"), ""))
+	}
+
+	if len(spec.KeyspaceName) != 0 {
+		allErrs = append(allErrs, ValidateKeyspaceName(spec.KeyspaceName, fldPath.Child("keyspaceName"))...)
+	}
+
+	allErrs = append(allErrs, ValidateKeyspaceReplication(&spec.Replication, fldPath.Child("replication"))...)
+
+	if spec.Tablets != nil {
+		allErrs = append(allErrs, ValidateKeyspaceTablets(spec.Tablets, fldPath.Child("tablets"))...)
+	}
+
+	if len(spec.DeletionPolicy) != 0 && !slices.ContainsItem(SupportedKeyspaceDeletionPolicies, spec.DeletionPolicy) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("deletionPolicy"), spec.DeletionPolicy, slices.ConvertSlice(SupportedKeyspaceDeletionPolicies, slices.ToString[scyllav1alpha1.KeyspaceDeletionPolicy])))
+	}
+
+	return allErrs
+}
+
+func ValidateKeyspaceReplication(replication *scyllav1alpha1.KeyspaceReplication, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(replication.Datacenters) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("datacenters"), "at least one datacenter is required"))
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateStructSliceFieldUniqueness(replication.Datacenters, func(dc scyllav1alpha1.DatacenterReplicationFactor) string {
+		return dc.Name
+	}, "name", fldPath.Child("datacenters"))...)
+
+	hasReplicas := false
+	for i, dc := range replication.Datacenters {
+		if len(dc.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("datacenters").Index(i).Child("name"), ""))
+		}
+
+		if dc.ReplicationFactor < 0 {
+			allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(dc.ReplicationFactor), fldPath.Child("datacenters").Index(i).Child("replicationFactor"))...)
+		}
+
+		if dc.ReplicationFactor > 0 {
+			hasReplicas = true
+		}
+	}
+
+	if !hasReplicas {
+		allErrs = append(allErrs, field.Required(fldPath.Child("datacenters"), "at least one datacenter with a positive replication factor is required"))
+	}
+
+	return allErrs
+}
+
+func ValidateKeyspaceTablets(tablets *scyllav1alpha1.KeyspaceTablets, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if tablets.InitialTablets != nil {
+		allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(*tablets.InitialTablets), fldPath.Child("initialTablets"))...)
+
+		if tablets.Enabled != nil && !*tablets.Enabled {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("initialTablets"), "initialTablets can't be set when tablets are disabled"))
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBKeyspaceUpdate(new, old *scyllav1alpha1.ScyllaDBKeyspace) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateScyllaDBKeyspace(new)...)
+	allErrs = append(allErrs, ValidateScyllaDBKeyspaceSpecUpdate(new, old, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidateScyllaDBKeyspaceSpecUpdate(new, old *scyllav1alpha1.ScyllaDBKeyspace, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(new.Spec.ScyllaDBDatacenterRef, old.Spec.ScyllaDBDatacenterRef, fldPath.Child("scyllaDBDatacenterRef"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(new.Spec.KeyspaceName, old.Spec.KeyspaceName, fldPath.Child("keyspaceName"))...)
+
+	var newTabletsEnabled, oldTabletsEnabled *bool
+	if new.Spec.Tablets != nil {
+		newTabletsEnabled = new.Spec.Tablets.Enabled
+	}
+	if old.Spec.Tablets != nil {
+		oldTabletsEnabled = old.Spec.Tablets.Enabled
+	}
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newTabletsEnabled, oldTabletsEnabled, fldPath.Child("tablets", "enabled"))...)
+
+	return allErrs
+}
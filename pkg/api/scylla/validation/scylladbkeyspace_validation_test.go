@@ -0,0 +1,253 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package validation_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/api/scylla/validation"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func newValidScyllaDBKeyspace() *scyllav1alpha1.ScyllaDBKeyspace {
+	return &scyllav1alpha1.ScyllaDBKeyspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-data",
+			Namespace: "scylla",
+		},
+		Spec: scyllav1alpha1.ScyllaDBKeyspaceSpec{
+			ScyllaDBDatacenterRef: scyllav1alpha1.ScyllaDBDatacenterReference{
+				Name: "basic",
+			},
+			KeyspaceName: "app_data",
+			Replication: scyllav1alpha1.KeyspaceReplication{
+				Datacenters: []scyllav1alpha1.DatacenterReplicationFactor{
+					{
+						Name:              "us-east-1",
+						ReplicationFactor: 3,
+					},
+				},
+			},
+			Tablets: &scyllav1alpha1.KeyspaceTablets{
+				Enabled: pointer.Ptr(true),
+			},
+		},
+	}
+}
+
+func TestValidateScyllaDBKeyspace(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		keyspace            *scyllav1alpha1.ScyllaDBKeyspace
+		expectedErrorList   field.ErrorList
+		expectedErrorString string
+	}{
+		{
+			name:                "valid",
+			keyspace:            newValidScyllaDBKeyspace(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "object name that isn't a valid keyspace name is rejected without keyspace name",
+			keyspace: func() *scyllav1alpha1.ScyllaDBKeyspace {
+				sk := newValidScyllaDBKeyspace()
+				sk.Spec.KeyspaceName = ""
+				return sk
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "metadata.name", BadValue: "app-data", Detail: "keyspace name can only contain alphanumeric characters and underscores"},
+			},
+			expectedErrorString: `metadata.name: Invalid value: "app-data": keyspace name can only contain alphanumeric characters and underscores`,
+		},
+		{
+			name: "system keyspace name",
+			keyspace: func() *scyllav1alpha1.ScyllaDBKeyspace {
+				sk := newValidScyllaDBKeyspace()
+				sk.Spec.KeyspaceName = "system_auth"
+				return sk
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.keyspaceName", BadValue: "", Detail: `keyspace name can't start with "system"`},
+			},
+			expectedErrorString: `spec.keyspaceName: Forbidden: keyspace name can't start with "system"`,
+		},
+		{
+			name: "missing ScyllaDBDatacenter reference",
+			keyspace: func() *scyllav1alpha1.ScyllaDBKeyspace {
+				sk := newValidScyllaDBKeyspace()
+				sk.Spec.ScyllaDBDatacenterRef.Name = ""
+				return sk
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.scyllaDBDatacenterRef.name", BadValue: ""},
+			},
+			expectedErrorString: `spec.scyllaDBDatacenterRef.name: Required value`,
+		},
+		{
+			name: "no datacenters",
+			keyspace: func() *scyllav1alpha1.ScyllaDBKeyspace {
+				sk := newValidScyllaDBKeyspace()
+				sk.Spec.Replication.Datacenters = nil
+				return sk
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.replication.datacenters", BadValue: "", Detail: "at least one datacenter is required"},
+			},
+			expectedErrorString: `spec.replication.datacenters: Required value: at least one datacenter is required`,
+		},
+		{
+			name: "duplicate datacenters and no replicas",
+			keyspace: func() *scyllav1alpha1.ScyllaDBKeyspace {
+				sk := newValidScyllaDBKeyspace()
+				sk.Spec.Replication.Datacenters = []scyllav1alpha1.DatacenterReplicationFactor{
+					{
+						Name:              "us-east-1",
+						ReplicationFactor: 0,
+					},
+					{
+						Name:              "us-east-1",
+						ReplicationFactor: 0,
+					},
+				}
+				return sk
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.replication.datacenters[1].name", BadValue: "us-east-1"},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.replication.datacenters", BadValue: "", Detail: "at least one datacenter with a positive replication factor is required"},
+			},
+			expectedErrorString: `[spec.replication.datacenters[1].name: Duplicate value: "us-east-1", spec.replication.datacenters: Required value: at least one datacenter with a positive replication factor is required]`,
+		},
+		{
+			name: "initial tablets with tablets disabled",
+			keyspace: func() *scyllav1alpha1.ScyllaDBKeyspace {
+				sk := newValidScyllaDBKeyspace()
+				sk.Spec.Tablets = &scyllav1alpha1.KeyspaceTablets{
+					Enabled:        pointer.Ptr(false),
+					InitialTablets: pointer.Ptr[int32](8),
+				}
+				return sk
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.tablets.initialTablets", BadValue: "", Detail: "initialTablets can't be set when tablets are disabled"},
+			},
+			expectedErrorString: `spec.tablets.initialTablets: Forbidden: initialTablets can't be set when tablets are disabled`,
+		},
+		{
+			name: "unsupported deletion policy",
+			keyspace: func() *scyllav1alpha1.ScyllaDBKeyspace {
+				sk := newValidScyllaDBKeyspace()
+				sk.Spec.DeletionPolicy = "Orphan"
+				return sk
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.deletionPolicy", BadValue: scyllav1alpha1.KeyspaceDeletionPolicy("Orphan"), Detail: `supported values: "Retain", "Delete"`},
+			},
+			expectedErrorString: `spec.deletionPolicy: Unsupported value: "Orphan": supported values: "Retain", "Delete"`,
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			errList := validation.ValidateScyllaDBKeyspace(test.keyspace)
+			if !reflect.DeepEqual(errList, test.expectedErrorList) {
+				t.Errorf("expected and actual error lists differ: %s", cmp.Diff(test.expectedErrorList, errList))
+			}
+
+			var errStr string
+			if agg := errList.ToAggregate(); agg != nil {
+				errStr = agg.Error()
+			}
+			if !reflect.DeepEqual(errStr, test.expectedErrorString) {
+				t.Errorf("expected and actual error strings differ: %s", cmp.Diff(test.expectedErrorString, errStr))
+			}
+		})
+	}
+}
+
+func TestValidateScyllaDBKeyspaceUpdate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		old                 *scyllav1alpha1.ScyllaDBKeyspace
+		new                 *scyllav1alpha1.ScyllaDBKeyspace
+		expectedErrorList   field.ErrorList
+		expectedErrorString string
+	}{
+		{
+			name:                "same as old",
+			old:                 newValidScyllaDBKeyspace(),
+			new:                 newValidScyllaDBKeyspace(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "replication factor changed",
+			old:  newValidScyllaDBKeyspace(),
+			new: func() *scyllav1alpha1.ScyllaDBKeyspace {
+				sk := newValidScyllaDBKeyspace()
+				sk.Spec.Replication.Datacenters[0].ReplicationFactor = 5
+				return sk
+			}(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "keyspace name changed",
+			old:  newValidScyllaDBKeyspace(),
+			new: func() *scyllav1alpha1.ScyllaDBKeyspace {
+				sk := newValidScyllaDBKeyspace()
+				sk.Spec.KeyspaceName = "other"
+				return sk
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.keyspaceName", BadValue: "other", Detail: "field is immutable"},
+			},
+			expectedErrorString: `spec.keyspaceName: Invalid value: "other": field is immutable`,
+		},
+		{
+			name: "tablets disabled",
+			old:  newValidScyllaDBKeyspace(),
+			new: func() *scyllav1alpha1.ScyllaDBKeyspace {
+				sk := newValidScyllaDBKeyspace()
+				sk.Spec.Tablets.Enabled = pointer.Ptr(false)
+				return sk
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.tablets.enabled", BadValue: pointer.Ptr(false), Detail: "field is immutable"},
+			},
+			expectedErrorString: `spec.tablets.enabled: Invalid value: false: field is immutable`,
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			errList := validation.ValidateScyllaDBKeyspaceUpdate(test.new, test.old)
+			if !reflect.DeepEqual(errList, test.expectedErrorList) {
+				t.Errorf("expected and actual error lists differ: %s", cmp.Diff(test.expectedErrorList, errList))
+			}
+
+			var errStr string
+			if agg := errList.ToAggregate(); agg != nil {
+				errStr = agg.Error()
+			}
+			if !reflect.DeepEqual(errStr, test.expectedErrorString) {
+				t.Errorf("expected and actual error strings differ: %s", cmp.Diff(test.expectedErrorString, errStr))
+			}
+		})
+	}
+}
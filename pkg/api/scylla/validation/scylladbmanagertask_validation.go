@@ -0,0 +1,31 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package validation
+
+import (
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// validateScyllaDBManagerTaskSpecCommon validates fields shared by specs of one-off Scylla Manager tasks.
+func validateScyllaDBManagerTaskSpecCommon(scyllaClusterName string, numRetries *int64, ttlSecondsAfterFinished *int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(scyllaClusterName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("scyllaClusterName"), ""))
+	} else {
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(scyllaClusterName, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("scyllaClusterName"), scyllaClusterName, msg))
+		}
+	}
+
+	if numRetries != nil && *numRetries < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("numRetries"), *numRetries, "must be non-negative integer"))
+	}
+
+	if ttlSecondsAfterFinished != nil && *ttlSecondsAfterFinished < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ttlSecondsAfterFinished"), *ttlSecondsAfterFinished, "must be non-negative integer"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package validation
+
+import (
+	"strconv"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func ValidateScyllaDBRepair(sr *scyllav1alpha1.ScyllaDBRepair) field.ErrorList {
+	return ValidateScyllaDBRepairSpec(&sr.Spec, field.NewPath("spec"))
+}
+
+func ValidateScyllaDBRepairSpec(spec *scyllav1alpha1.ScyllaDBRepairSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateScyllaDBManagerTaskSpecCommon(spec.ScyllaClusterName, spec.NumRetries, spec.TTLSecondsAfterFinished, fldPath)...)
+
+	_, err := strconv.ParseFloat(spec.Intensity, 64)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("intensity"), spec.Intensity, "must be a float"))
+	}
+
+	if spec.Parallel < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("parallel"), spec.Parallel, "must be non-negative integer"))
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBRepairUpdate(new, old *scyllav1alpha1.ScyllaDBRepair) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateScyllaDBRepair(new)...)
+
+	newSpec := new.Spec.DeepCopy()
+	newSpec.TTLSecondsAfterFinished = old.Spec.TTLSecondsAfterFinished
+	if !apiequality.Semantic.DeepEqual(newSpec, &old.Spec) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "fields other than ttlSecondsAfterFinished are immutable"))
+	}
+
+	return allErrs
+}
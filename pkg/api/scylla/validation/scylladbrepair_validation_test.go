@@ -0,0 +1,172 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package validation_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/api/scylla/validation"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func newValidScyllaDBRepair() *scyllav1alpha1.ScyllaDBRepair {
+	return &scyllav1alpha1.ScyllaDBRepair{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pre-upgrade",
+			Namespace: "scylla",
+		},
+		Spec: scyllav1alpha1.ScyllaDBRepairSpec{
+			ScyllaClusterName:   "basic",
+			Intensity:           "1",
+			SmallTableThreshold: "1GiB",
+			NumRetries:          pointer.Ptr[int64](3),
+		},
+	}
+}
+
+func TestValidateScyllaDBRepair(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		repair              *scyllav1alpha1.ScyllaDBRepair
+		expectedErrorList   field.ErrorList
+		expectedErrorString string
+	}{
+		{
+			name:                "valid",
+			repair:              newValidScyllaDBRepair(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "missing cluster name",
+			repair: func() *scyllav1alpha1.ScyllaDBRepair {
+				sr := newValidScyllaDBRepair()
+				sr.Spec.ScyllaClusterName = ""
+				return sr
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.scyllaClusterName", BadValue: ""},
+			},
+			expectedErrorString: `spec.scyllaClusterName: Required value`,
+		},
+		{
+			name: "invalid intensity",
+			repair: func() *scyllav1alpha1.ScyllaDBRepair {
+				sr := newValidScyllaDBRepair()
+				sr.Spec.Intensity = "max"
+				return sr
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.intensity", BadValue: "max", Detail: "must be a float"},
+			},
+			expectedErrorString: `spec.intensity: Invalid value: "max": must be a float`,
+		},
+		{
+			name: "negative parallel, retries and ttl",
+			repair: func() *scyllav1alpha1.ScyllaDBRepair {
+				sr := newValidScyllaDBRepair()
+				sr.Spec.Parallel = -1
+				sr.Spec.NumRetries = pointer.Ptr[int64](-1)
+				sr.Spec.TTLSecondsAfterFinished = pointer.Ptr[int32](-1)
+				return sr
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.numRetries", BadValue: int64(-1), Detail: "must be non-negative integer"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.ttlSecondsAfterFinished", BadValue: int32(-1), Detail: "must be non-negative integer"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.parallel", BadValue: int64(-1), Detail: "must be non-negative integer"},
+			},
+			expectedErrorString: `[spec.numRetries: Invalid value: -1: must be non-negative integer, spec.ttlSecondsAfterFinished: Invalid value: -1: must be non-negative integer, spec.parallel: Invalid value: -1: must be non-negative integer]`,
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			errList := validation.ValidateScyllaDBRepair(test.repair)
+			if !reflect.DeepEqual(errList, test.expectedErrorList) {
+				t.Errorf("expected and actual error lists differ: %s", cmp.Diff(test.expectedErrorList, errList))
+			}
+
+			var errStr string
+			if agg := errList.ToAggregate(); agg != nil {
+				errStr = agg.Error()
+			}
+			if !reflect.DeepEqual(errStr, test.expectedErrorString) {
+				t.Errorf("expected and actual error strings differ: %s", cmp.Diff(test.expectedErrorString, errStr))
+			}
+		})
+	}
+}
+
+func TestValidateScyllaDBRepairUpdate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		old                 *scyllav1alpha1.ScyllaDBRepair
+		new                 *scyllav1alpha1.ScyllaDBRepair
+		expectedErrorList   field.ErrorList
+		expectedErrorString string
+	}{
+		{
+			name:                "identical",
+			old:                 newValidScyllaDBRepair(),
+			new:                 newValidScyllaDBRepair(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "ttl change is allowed",
+			old:  newValidScyllaDBRepair(),
+			new: func() *scyllav1alpha1.ScyllaDBRepair {
+				sr := newValidScyllaDBRepair()
+				sr.Spec.TTLSecondsAfterFinished = pointer.Ptr[int32](3600)
+				return sr
+			}(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "keyspace change is forbidden",
+			old:  newValidScyllaDBRepair(),
+			new: func() *scyllav1alpha1.ScyllaDBRepair {
+				sr := newValidScyllaDBRepair()
+				sr.Spec.Keyspace = []string{"ks"}
+				return sr
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec", BadValue: "", Detail: "fields other than ttlSecondsAfterFinished are immutable"},
+			},
+			expectedErrorString: `spec: Forbidden: fields other than ttlSecondsAfterFinished are immutable`,
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			errList := validation.ValidateScyllaDBRepairUpdate(test.new, test.old)
+			if !reflect.DeepEqual(errList, test.expectedErrorList) {
+				t.Errorf("expected and actual error lists differ: %s", cmp.Diff(test.expectedErrorList, errList))
+			}
+
+			var errStr string
+			if agg := errList.ToAggregate(); agg != nil {
+				errStr = agg.Error()
+			}
+			if !reflect.DeepEqual(errStr, test.expectedErrorString) {
+				t.Errorf("expected and actual error strings differ: %s", cmp.Diff(test.expectedErrorString, errStr))
+			}
+		})
+	}
+}
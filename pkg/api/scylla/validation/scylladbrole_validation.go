@@ -0,0 +1,149 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	maxRoleNameLength     = 256
+	defaultSuperuserRole  = "cassandra"
+	maxTableNameLength    = 48
+	roleNamePatternDetail = "role name can only contain alphanumeric characters, underscores, hyphens and dots"
+)
+
+var (
+	roleNameRegexp  = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+	tableNameRegexp = keyspaceNameRegexp
+
+	SupportedRolePermissions = []scyllav1alpha1.RolePermission{
+		scyllav1alpha1.RolePermissionCreate,
+		scyllav1alpha1.RolePermissionAlter,
+		scyllav1alpha1.RolePermissionDrop,
+		scyllav1alpha1.RolePermissionSelect,
+		scyllav1alpha1.RolePermissionModify,
+		scyllav1alpha1.RolePermissionAuthorize,
+	}
+)
+
+func ValidateScyllaDBRole(sr *scyllav1alpha1.ScyllaDBRole) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(sr.Spec.RoleName) == 0 {
+		allErrs = append(allErrs, ValidateRoleName(sr.Name, field.NewPath("metadata", "name"))...)
+	}
+
+	allErrs = append(allErrs, ValidateScyllaDBRoleSpec(&sr.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidateRoleName(name string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(name) > maxRoleNameLength {
+		allErrs = append(allErrs, field.TooLong(fldPath, name, maxRoleNameLength))
+	}
+
+	if !roleNameRegexp.MatchString(name) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, roleNamePatternDetail))
+	}
+
+	if name == defaultSuperuserRole {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("role %q is managed by the operator", defaultSuperuserRole)))
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBRoleSpec(spec *scyllav1alpha1.ScyllaDBRoleSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.ScyllaDBDatacenterRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("scyllaDBDatacenterRef", "name"), ""))
+	}
+
+	if len(spec.RoleName) != 0 {
+		allErrs = append(allErrs, ValidateRoleName(spec.RoleName, fldPath.Child("roleName"))...)
+	}
+
+	resources := sets.New[string]()
+	for i, grant := range spec.Grants {
+		allErrs = append(allErrs, ValidateRoleGrant(&grant, fldPath.Child("grants").Index(i))...)
+
+		resource := fmt.Sprintf("%s.%s", grant.Keyspace, grant.Table)
+		if resources.Has(resource) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("grants").Index(i), resource))
+		}
+		resources.Insert(resource)
+	}
+
+	return allErrs
+}
+
+func ValidateRoleGrant(grant *scyllav1alpha1.RoleGrant, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(grant.Keyspace) != 0 {
+		if !keyspaceNameRegexp.MatchString(grant.Keyspace) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("keyspace"), grant.Keyspace, "keyspace name can only contain alphanumeric characters and underscores"))
+		}
+	}
+
+	if len(grant.Table) != 0 {
+		if len(grant.Keyspace) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("keyspace"), "keyspace is required when table is set"))
+		}
+
+		if len(grant.Table) > maxTableNameLength {
+			allErrs = append(allErrs, field.TooLong(fldPath.Child("table"), grant.Table, maxTableNameLength))
+		}
+
+		if !tableNameRegexp.MatchString(grant.Table) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("table"), grant.Table, "table name can only contain alphanumeric characters and underscores"))
+		}
+	}
+
+	if len(grant.Permissions) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("permissions"), "at least one permission is required"))
+	}
+
+	for i, permission := range grant.Permissions {
+		if !slices.ContainsItem(SupportedRolePermissions, permission) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("permissions").Index(i), permission, slices.ConvertSlice(SupportedRolePermissions, slices.ToString[scyllav1alpha1.RolePermission])))
+			continue
+		}
+
+		if permission == scyllav1alpha1.RolePermissionCreate && len(grant.Table) != 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("permissions").Index(i), "Create permission can't be granted on a table"))
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBRoleUpdate(new, old *scyllav1alpha1.ScyllaDBRole) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateScyllaDBRole(new)...)
+	allErrs = append(allErrs, ValidateScyllaDBRoleSpecUpdate(new, old, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidateScyllaDBRoleSpecUpdate(new, old *scyllav1alpha1.ScyllaDBRole, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(new.Spec.ScyllaDBDatacenterRef, old.Spec.ScyllaDBDatacenterRef, fldPath.Child("scyllaDBDatacenterRef"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(new.Spec.RoleName, old.Spec.RoleName, fldPath.Child("roleName"))...)
+
+	return allErrs
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package validation_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/api/scylla/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func newValidScyllaDBRole() *scyllav1alpha1.ScyllaDBRole {
+	return &scyllav1alpha1.ScyllaDBRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app",
+			Namespace: "scylla",
+		},
+		Spec: scyllav1alpha1.ScyllaDBRoleSpec{
+			ScyllaDBDatacenterRef: scyllav1alpha1.ScyllaDBDatacenterReference{
+				Name: "basic",
+			},
+			Grants: []scyllav1alpha1.RoleGrant{
+				{
+					Keyspace: "app_data",
+					Permissions: []scyllav1alpha1.RolePermission{
+						scyllav1alpha1.RolePermissionSelect,
+						scyllav1alpha1.RolePermissionModify,
+					},
+				},
+				{
+					Keyspace: "app_data",
+					Table:    "events",
+					Permissions: []scyllav1alpha1.RolePermission{
+						scyllav1alpha1.RolePermissionAlter,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateScyllaDBRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		role                *scyllav1alpha1.ScyllaDBRole
+		expectedErrorList   field.ErrorList
+		expectedErrorString string
+	}{
+		{
+			name:                "valid",
+			role:                newValidScyllaDBRole(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "default superuser role",
+			role: func() *scyllav1alpha1.ScyllaDBRole {
+				sr := newValidScyllaDBRole()
+				sr.Spec.RoleName = "cassandra"
+				return sr
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.roleName", BadValue: "", Detail: `role "cassandra" is managed by the operator`},
+			},
+			expectedErrorString: `spec.roleName: Forbidden: role "cassandra" is managed by the operator`,
+		},
+		{
+			name: "table without keyspace",
+			role: func() *scyllav1alpha1.ScyllaDBRole {
+				sr := newValidScyllaDBRole()
+				sr.Spec.Grants[1].Keyspace = ""
+				return sr
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.grants[1].keyspace", BadValue: "", Detail: "keyspace is required when table is set"},
+			},
+			expectedErrorString: `spec.grants[1].keyspace: Required value: keyspace is required when table is set`,
+		},
+		{
+			name: "create permission on a table",
+			role: func() *scyllav1alpha1.ScyllaDBRole {
+				sr := newValidScyllaDBRole()
+				sr.Spec.Grants[1].Permissions = append(sr.Spec.Grants[1].Permissions, scyllav1alpha1.RolePermissionCreate)
+				return sr
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.grants[1].permissions[1]", BadValue: "", Detail: "Create permission can't be granted on a table"},
+			},
+			expectedErrorString: `spec.grants[1].permissions[1]: Forbidden: Create permission can't be granted on a table`,
+		},
+		{
+			name: "unsupported permission and no permissions",
+			role: func() *scyllav1alpha1.ScyllaDBRole {
+				sr := newValidScyllaDBRole()
+				sr.Spec.Grants[0].Permissions = []scyllav1alpha1.RolePermission{"Execute"}
+				sr.Spec.Grants[1].Permissions = nil
+				return sr
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.grants[0].permissions[0]", BadValue: scyllav1alpha1.RolePermission("Execute"), Detail: `supported values: "Create", "Alter", "Drop", "Select", "Modify", "Authorize"`},
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.grants[1].permissions", BadValue: "", Detail: "at least one permission is required"},
+			},
+			expectedErrorString: `[spec.grants[0].permissions[0]: Unsupported value: "Execute": supported values: "Create", "Alter", "Drop", "Select", "Modify", "Authorize", spec.grants[1].permissions: Required value: at least one permission is required]`,
+		},
+		{
+			name: "duplicate resources",
+			role: func() *scyllav1alpha1.ScyllaDBRole {
+				sr := newValidScyllaDBRole()
+				sr.Spec.Grants[1].Table = ""
+				return sr
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.grants[1]", BadValue: "app_data."},
+			},
+			expectedErrorString: `spec.grants[1]: Duplicate value: "app_data."`,
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			errList := validation.ValidateScyllaDBRole(test.role)
+			if !reflect.DeepEqual(errList, test.expectedErrorList) {
+				t.Errorf("expected and actual error lists differ: %s", cmp.Diff(test.expectedErrorList, errList))
+			}
+
+			var errStr string
+			if agg := errList.ToAggregate(); agg != nil {
+				errStr = agg.Error()
+			}
+			if !reflect.DeepEqual(errStr, test.expectedErrorString) {
+				t.Errorf("expected and actual error strings differ: %s", cmp.Diff(test.expectedErrorString, errStr))
+			}
+		})
+	}
+}
+
+func TestValidateScyllaDBRoleUpdate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		old                 *scyllav1alpha1.ScyllaDBRole
+		new                 *scyllav1alpha1.ScyllaDBRole
+		expectedErrorList   field.ErrorList
+		expectedErrorString string
+	}{
+		{
+			name: "grants and password rotation reason can change",
+			old:  newValidScyllaDBRole(),
+			new: func() *scyllav1alpha1.ScyllaDBRole {
+				sr := newValidScyllaDBRole()
+				sr.Spec.Grants = sr.Spec.Grants[:1]
+				sr.Spec.PasswordRotationReason = "leaked"
+				return sr
+			}(),
+			expectedErrorList:   field.ErrorList{},
+			expectedErrorString: "",
+		},
+		{
+			name: "role name changed",
+			old:  newValidScyllaDBRole(),
+			new: func() *scyllav1alpha1.ScyllaDBRole {
+				sr := newValidScyllaDBRole()
+				sr.Spec.RoleName = "other"
+				return sr
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.roleName", BadValue: "other", Detail: "field is immutable"},
+			},
+			expectedErrorString: `spec.roleName: Invalid value: "other": field is immutable`,
+		},
+	}
+
+	for i := range tests {
+		test := tests[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			errList := validation.ValidateScyllaDBRoleUpdate(test.new, test.old)
+			if !reflect.DeepEqual(errList, test.expectedErrorList) {
+				t.Errorf("expected and actual error lists differ: %s", cmp.Diff(test.expectedErrorList, errList))
+			}
+
+			var errStr string
+			if agg := errList.ToAggregate(); agg != nil {
+				errStr = agg.Error()
+			}
+			if !reflect.DeepEqual(errStr, test.expectedErrorString) {
+				t.Errorf("expected and actual error strings differ: %s", cmp.Diff(test.expectedErrorString, errStr))
+			}
+		})
+	}
+}
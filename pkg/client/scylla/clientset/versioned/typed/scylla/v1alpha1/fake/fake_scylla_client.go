@@ -16,14 +16,42 @@ func (c *FakeScyllaV1alpha1) NodeConfigs() v1alpha1.NodeConfigInterface {
 	return &FakeNodeConfigs{c}
 }
 
+func (c *FakeScyllaV1alpha1) ScyllaDBBackups(namespace string) v1alpha1.ScyllaDBBackupInterface {
+	return &FakeScyllaDBBackups{c, namespace}
+}
+
+func (c *FakeScyllaV1alpha1) ScyllaDBBackupInventories(namespace string) v1alpha1.ScyllaDBBackupInventoryInterface {
+	return &FakeScyllaDBBackupInventories{c, namespace}
+}
+
+func (c *FakeScyllaV1alpha1) ScyllaDBClusters(namespace string) v1alpha1.ScyllaDBClusterInterface {
+	return &FakeScyllaDBClusters{c, namespace}
+}
+
 func (c *FakeScyllaV1alpha1) ScyllaDBDatacenters(namespace string) v1alpha1.ScyllaDBDatacenterInterface {
 	return &FakeScyllaDBDatacenters{c, namespace}
 }
 
+func (c *FakeScyllaV1alpha1) ScyllaDBDatacenterAutoscalers(namespace string) v1alpha1.ScyllaDBDatacenterAutoscalerInterface {
+	return &FakeScyllaDBDatacenterAutoscalers{c, namespace}
+}
+
+func (c *FakeScyllaV1alpha1) ScyllaDBKeyspaces(namespace string) v1alpha1.ScyllaDBKeyspaceInterface {
+	return &FakeScyllaDBKeyspaces{c, namespace}
+}
+
 func (c *FakeScyllaV1alpha1) ScyllaDBMonitorings(namespace string) v1alpha1.ScyllaDBMonitoringInterface {
 	return &FakeScyllaDBMonitorings{c, namespace}
 }
 
+func (c *FakeScyllaV1alpha1) ScyllaDBRepairs(namespace string) v1alpha1.ScyllaDBRepairInterface {
+	return &FakeScyllaDBRepairs{c, namespace}
+}
+
+func (c *FakeScyllaV1alpha1) ScyllaDBRoles(namespace string) v1alpha1.ScyllaDBRoleInterface {
+	return &FakeScyllaDBRoles{c, namespace}
+}
+
 func (c *FakeScyllaV1alpha1) ScyllaOperatorConfigs() v1alpha1.ScyllaOperatorConfigInterface {
 	return &FakeScyllaOperatorConfigs{c}
 }
@@ -0,0 +1,125 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeScyllaDBBackups implements ScyllaDBBackupInterface
+type FakeScyllaDBBackups struct {
+	Fake *FakeScyllaV1alpha1
+	ns   string
+}
+
+var scylladbbackupsResource = v1alpha1.SchemeGroupVersion.WithResource("scylladbbackups")
+
+var scylladbbackupsKind = v1alpha1.SchemeGroupVersion.WithKind("ScyllaDBBackup")
+
+// Get takes name of the scyllaDBBackup, and returns the corresponding scyllaDBBackup object, and an error if there is any.
+func (c *FakeScyllaDBBackups) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ScyllaDBBackup, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(scylladbbackupsResource, c.ns, name), &v1alpha1.ScyllaDBBackup{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBBackup), err
+}
+
+// List takes label and field selectors, and returns the list of ScyllaDBBackups that match those selectors.
+func (c *FakeScyllaDBBackups) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ScyllaDBBackupList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(scylladbbackupsResource, scylladbbackupsKind, c.ns, opts), &v1alpha1.ScyllaDBBackupList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ScyllaDBBackupList{ListMeta: obj.(*v1alpha1.ScyllaDBBackupList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ScyllaDBBackupList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested scyllaDBBackups.
+func (c *FakeScyllaDBBackups) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(scylladbbackupsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a scyllaDBBackup and creates it.  Returns the server's representation of the scyllaDBBackup, and an error, if there is any.
+func (c *FakeScyllaDBBackups) Create(ctx context.Context, scyllaDBBackup *v1alpha1.ScyllaDBBackup, opts v1.CreateOptions) (result *v1alpha1.ScyllaDBBackup, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(scylladbbackupsResource, c.ns, scyllaDBBackup), &v1alpha1.ScyllaDBBackup{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBBackup), err
+}
+
+// Update takes the representation of a scyllaDBBackup and updates it. Returns the server's representation of the scyllaDBBackup, and an error, if there is any.
+func (c *FakeScyllaDBBackups) Update(ctx context.Context, scyllaDBBackup *v1alpha1.ScyllaDBBackup, opts v1.UpdateOptions) (result *v1alpha1.ScyllaDBBackup, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(scylladbbackupsResource, c.ns, scyllaDBBackup), &v1alpha1.ScyllaDBBackup{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBBackup), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeScyllaDBBackups) UpdateStatus(ctx context.Context, scyllaDBBackup *v1alpha1.ScyllaDBBackup, opts v1.UpdateOptions) (*v1alpha1.ScyllaDBBackup, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(scylladbbackupsResource, "status", c.ns, scyllaDBBackup), &v1alpha1.ScyllaDBBackup{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBBackup), err
+}
+
+// Delete takes name of the scyllaDBBackup and deletes it. Returns an error if one occurs.
+func (c *FakeScyllaDBBackups) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(scylladbbackupsResource, c.ns, name, opts), &v1alpha1.ScyllaDBBackup{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeScyllaDBBackups) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(scylladbbackupsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ScyllaDBBackupList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched scyllaDBBackup.
+func (c *FakeScyllaDBBackups) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ScyllaDBBackup, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(scylladbbackupsResource, c.ns, name, pt, data, subresources...), &v1alpha1.ScyllaDBBackup{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBBackup), err
+}
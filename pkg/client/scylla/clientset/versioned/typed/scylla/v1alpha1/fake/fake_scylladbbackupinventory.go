@@ -0,0 +1,125 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeScyllaDBBackupInventories implements ScyllaDBBackupInventoryInterface
+type FakeScyllaDBBackupInventories struct {
+	Fake *FakeScyllaV1alpha1
+	ns   string
+}
+
+var scylladbbackupinventoriesResource = v1alpha1.SchemeGroupVersion.WithResource("scylladbbackupinventories")
+
+var scylladbbackupinventoriesKind = v1alpha1.SchemeGroupVersion.WithKind("ScyllaDBBackupInventory")
+
+// Get takes name of the scyllaDBBackupInventory, and returns the corresponding scyllaDBBackupInventory object, and an error if there is any.
+func (c *FakeScyllaDBBackupInventories) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ScyllaDBBackupInventory, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(scylladbbackupinventoriesResource, c.ns, name), &v1alpha1.ScyllaDBBackupInventory{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBBackupInventory), err
+}
+
+// List takes label and field selectors, and returns the list of ScyllaDBBackupInventories that match those selectors.
+func (c *FakeScyllaDBBackupInventories) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ScyllaDBBackupInventoryList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(scylladbbackupinventoriesResource, scylladbbackupinventoriesKind, c.ns, opts), &v1alpha1.ScyllaDBBackupInventoryList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ScyllaDBBackupInventoryList{ListMeta: obj.(*v1alpha1.ScyllaDBBackupInventoryList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ScyllaDBBackupInventoryList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested scyllaDBBackupInventories.
+func (c *FakeScyllaDBBackupInventories) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(scylladbbackupinventoriesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a scyllaDBBackupInventory and creates it.  Returns the server's representation of the scyllaDBBackupInventory, and an error, if there is any.
+func (c *FakeScyllaDBBackupInventories) Create(ctx context.Context, scyllaDBBackupInventory *v1alpha1.ScyllaDBBackupInventory, opts v1.CreateOptions) (result *v1alpha1.ScyllaDBBackupInventory, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(scylladbbackupinventoriesResource, c.ns, scyllaDBBackupInventory), &v1alpha1.ScyllaDBBackupInventory{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBBackupInventory), err
+}
+
+// Update takes the representation of a scyllaDBBackupInventory and updates it. Returns the server's representation of the scyllaDBBackupInventory, and an error, if there is any.
+func (c *FakeScyllaDBBackupInventories) Update(ctx context.Context, scyllaDBBackupInventory *v1alpha1.ScyllaDBBackupInventory, opts v1.UpdateOptions) (result *v1alpha1.ScyllaDBBackupInventory, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(scylladbbackupinventoriesResource, c.ns, scyllaDBBackupInventory), &v1alpha1.ScyllaDBBackupInventory{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBBackupInventory), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeScyllaDBBackupInventories) UpdateStatus(ctx context.Context, scyllaDBBackupInventory *v1alpha1.ScyllaDBBackupInventory, opts v1.UpdateOptions) (*v1alpha1.ScyllaDBBackupInventory, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(scylladbbackupinventoriesResource, "status", c.ns, scyllaDBBackupInventory), &v1alpha1.ScyllaDBBackupInventory{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBBackupInventory), err
+}
+
+// Delete takes name of the scyllaDBBackupInventory and deletes it. Returns an error if one occurs.
+func (c *FakeScyllaDBBackupInventories) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(scylladbbackupinventoriesResource, c.ns, name, opts), &v1alpha1.ScyllaDBBackupInventory{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeScyllaDBBackupInventories) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(scylladbbackupinventoriesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ScyllaDBBackupInventoryList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched scyllaDBBackupInventory.
+func (c *FakeScyllaDBBackupInventories) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ScyllaDBBackupInventory, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(scylladbbackupinventoriesResource, c.ns, name, pt, data, subresources...), &v1alpha1.ScyllaDBBackupInventory{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBBackupInventory), err
+}
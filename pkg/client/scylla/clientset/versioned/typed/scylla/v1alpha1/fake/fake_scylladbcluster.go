@@ -0,0 +1,125 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeScyllaDBClusters implements ScyllaDBClusterInterface
+type FakeScyllaDBClusters struct {
+	Fake *FakeScyllaV1alpha1
+	ns   string
+}
+
+var scylladbclustersResource = v1alpha1.SchemeGroupVersion.WithResource("scylladbclusters")
+
+var scylladbclustersKind = v1alpha1.SchemeGroupVersion.WithKind("ScyllaDBCluster")
+
+// Get takes name of the scyllaDBCluster, and returns the corresponding scyllaDBCluster object, and an error if there is any.
+func (c *FakeScyllaDBClusters) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ScyllaDBCluster, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(scylladbclustersResource, c.ns, name), &v1alpha1.ScyllaDBCluster{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBCluster), err
+}
+
+// List takes label and field selectors, and returns the list of ScyllaDBClusters that match those selectors.
+func (c *FakeScyllaDBClusters) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ScyllaDBClusterList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(scylladbclustersResource, scylladbclustersKind, c.ns, opts), &v1alpha1.ScyllaDBClusterList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ScyllaDBClusterList{ListMeta: obj.(*v1alpha1.ScyllaDBClusterList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ScyllaDBClusterList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested scyllaDBClusters.
+func (c *FakeScyllaDBClusters) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(scylladbclustersResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a scyllaDBCluster and creates it.  Returns the server's representation of the scyllaDBCluster, and an error, if there is any.
+func (c *FakeScyllaDBClusters) Create(ctx context.Context, scyllaDBCluster *v1alpha1.ScyllaDBCluster, opts v1.CreateOptions) (result *v1alpha1.ScyllaDBCluster, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(scylladbclustersResource, c.ns, scyllaDBCluster), &v1alpha1.ScyllaDBCluster{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBCluster), err
+}
+
+// Update takes the representation of a scyllaDBCluster and updates it. Returns the server's representation of the scyllaDBCluster, and an error, if there is any.
+func (c *FakeScyllaDBClusters) Update(ctx context.Context, scyllaDBCluster *v1alpha1.ScyllaDBCluster, opts v1.UpdateOptions) (result *v1alpha1.ScyllaDBCluster, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(scylladbclustersResource, c.ns, scyllaDBCluster), &v1alpha1.ScyllaDBCluster{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBCluster), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeScyllaDBClusters) UpdateStatus(ctx context.Context, scyllaDBCluster *v1alpha1.ScyllaDBCluster, opts v1.UpdateOptions) (*v1alpha1.ScyllaDBCluster, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(scylladbclustersResource, "status", c.ns, scyllaDBCluster), &v1alpha1.ScyllaDBCluster{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBCluster), err
+}
+
+// Delete takes name of the scyllaDBCluster and deletes it. Returns an error if one occurs.
+func (c *FakeScyllaDBClusters) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(scylladbclustersResource, c.ns, name, opts), &v1alpha1.ScyllaDBCluster{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeScyllaDBClusters) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(scylladbclustersResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ScyllaDBClusterList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched scyllaDBCluster.
+func (c *FakeScyllaDBClusters) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ScyllaDBCluster, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(scylladbclustersResource, c.ns, name, pt, data, subresources...), &v1alpha1.ScyllaDBCluster{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBCluster), err
+}
@@ -0,0 +1,125 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeScyllaDBDatacenterAutoscalers implements ScyllaDBDatacenterAutoscalerInterface
+type FakeScyllaDBDatacenterAutoscalers struct {
+	Fake *FakeScyllaV1alpha1
+	ns   string
+}
+
+var scylladbdatacenterautoscalersResource = v1alpha1.SchemeGroupVersion.WithResource("scylladbdatacenterautoscalers")
+
+var scylladbdatacenterautoscalersKind = v1alpha1.SchemeGroupVersion.WithKind("ScyllaDBDatacenterAutoscaler")
+
+// Get takes name of the scyllaDBDatacenterAutoscaler, and returns the corresponding scyllaDBDatacenterAutoscaler object, and an error if there is any.
+func (c *FakeScyllaDBDatacenterAutoscalers) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ScyllaDBDatacenterAutoscaler, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(scylladbdatacenterautoscalersResource, c.ns, name), &v1alpha1.ScyllaDBDatacenterAutoscaler{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBDatacenterAutoscaler), err
+}
+
+// List takes label and field selectors, and returns the list of ScyllaDBDatacenterAutoscalers that match those selectors.
+func (c *FakeScyllaDBDatacenterAutoscalers) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ScyllaDBDatacenterAutoscalerList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(scylladbdatacenterautoscalersResource, scylladbdatacenterautoscalersKind, c.ns, opts), &v1alpha1.ScyllaDBDatacenterAutoscalerList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ScyllaDBDatacenterAutoscalerList{ListMeta: obj.(*v1alpha1.ScyllaDBDatacenterAutoscalerList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ScyllaDBDatacenterAutoscalerList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested scyllaDBDatacenterAutoscalers.
+func (c *FakeScyllaDBDatacenterAutoscalers) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(scylladbdatacenterautoscalersResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a scyllaDBDatacenterAutoscaler and creates it.  Returns the server's representation of the scyllaDBDatacenterAutoscaler, and an error, if there is any.
+func (c *FakeScyllaDBDatacenterAutoscalers) Create(ctx context.Context, scyllaDBDatacenterAutoscaler *v1alpha1.ScyllaDBDatacenterAutoscaler, opts v1.CreateOptions) (result *v1alpha1.ScyllaDBDatacenterAutoscaler, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(scylladbdatacenterautoscalersResource, c.ns, scyllaDBDatacenterAutoscaler), &v1alpha1.ScyllaDBDatacenterAutoscaler{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBDatacenterAutoscaler), err
+}
+
+// Update takes the representation of a scyllaDBDatacenterAutoscaler and updates it. Returns the server's representation of the scyllaDBDatacenterAutoscaler, and an error, if there is any.
+func (c *FakeScyllaDBDatacenterAutoscalers) Update(ctx context.Context, scyllaDBDatacenterAutoscaler *v1alpha1.ScyllaDBDatacenterAutoscaler, opts v1.UpdateOptions) (result *v1alpha1.ScyllaDBDatacenterAutoscaler, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(scylladbdatacenterautoscalersResource, c.ns, scyllaDBDatacenterAutoscaler), &v1alpha1.ScyllaDBDatacenterAutoscaler{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBDatacenterAutoscaler), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeScyllaDBDatacenterAutoscalers) UpdateStatus(ctx context.Context, scyllaDBDatacenterAutoscaler *v1alpha1.ScyllaDBDatacenterAutoscaler, opts v1.UpdateOptions) (*v1alpha1.ScyllaDBDatacenterAutoscaler, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(scylladbdatacenterautoscalersResource, "status", c.ns, scyllaDBDatacenterAutoscaler), &v1alpha1.ScyllaDBDatacenterAutoscaler{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBDatacenterAutoscaler), err
+}
+
+// Delete takes name of the scyllaDBDatacenterAutoscaler and deletes it. Returns an error if one occurs.
+func (c *FakeScyllaDBDatacenterAutoscalers) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(scylladbdatacenterautoscalersResource, c.ns, name, opts), &v1alpha1.ScyllaDBDatacenterAutoscaler{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeScyllaDBDatacenterAutoscalers) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(scylladbdatacenterautoscalersResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ScyllaDBDatacenterAutoscalerList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched scyllaDBDatacenterAutoscaler.
+func (c *FakeScyllaDBDatacenterAutoscalers) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ScyllaDBDatacenterAutoscaler, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(scylladbdatacenterautoscalersResource, c.ns, name, pt, data, subresources...), &v1alpha1.ScyllaDBDatacenterAutoscaler{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBDatacenterAutoscaler), err
+}
@@ -0,0 +1,125 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeScyllaDBRepairs implements ScyllaDBRepairInterface
+type FakeScyllaDBRepairs struct {
+	Fake *FakeScyllaV1alpha1
+	ns   string
+}
+
+var scylladbrepairsResource = v1alpha1.SchemeGroupVersion.WithResource("scylladbrepairs")
+
+var scylladbrepairsKind = v1alpha1.SchemeGroupVersion.WithKind("ScyllaDBRepair")
+
+// Get takes name of the scyllaDBRepair, and returns the corresponding scyllaDBRepair object, and an error if there is any.
+func (c *FakeScyllaDBRepairs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ScyllaDBRepair, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(scylladbrepairsResource, c.ns, name), &v1alpha1.ScyllaDBRepair{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBRepair), err
+}
+
+// List takes label and field selectors, and returns the list of ScyllaDBRepairs that match those selectors.
+func (c *FakeScyllaDBRepairs) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ScyllaDBRepairList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(scylladbrepairsResource, scylladbrepairsKind, c.ns, opts), &v1alpha1.ScyllaDBRepairList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ScyllaDBRepairList{ListMeta: obj.(*v1alpha1.ScyllaDBRepairList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ScyllaDBRepairList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested scyllaDBRepairs.
+func (c *FakeScyllaDBRepairs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(scylladbrepairsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a scyllaDBRepair and creates it.  Returns the server's representation of the scyllaDBRepair, and an error, if there is any.
+func (c *FakeScyllaDBRepairs) Create(ctx context.Context, scyllaDBRepair *v1alpha1.ScyllaDBRepair, opts v1.CreateOptions) (result *v1alpha1.ScyllaDBRepair, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(scylladbrepairsResource, c.ns, scyllaDBRepair), &v1alpha1.ScyllaDBRepair{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBRepair), err
+}
+
+// Update takes the representation of a scyllaDBRepair and updates it. Returns the server's representation of the scyllaDBRepair, and an error, if there is any.
+func (c *FakeScyllaDBRepairs) Update(ctx context.Context, scyllaDBRepair *v1alpha1.ScyllaDBRepair, opts v1.UpdateOptions) (result *v1alpha1.ScyllaDBRepair, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(scylladbrepairsResource, c.ns, scyllaDBRepair), &v1alpha1.ScyllaDBRepair{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBRepair), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeScyllaDBRepairs) UpdateStatus(ctx context.Context, scyllaDBRepair *v1alpha1.ScyllaDBRepair, opts v1.UpdateOptions) (*v1alpha1.ScyllaDBRepair, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(scylladbrepairsResource, "status", c.ns, scyllaDBRepair), &v1alpha1.ScyllaDBRepair{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBRepair), err
+}
+
+// Delete takes name of the scyllaDBRepair and deletes it. Returns an error if one occurs.
+func (c *FakeScyllaDBRepairs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(scylladbrepairsResource, c.ns, name, opts), &v1alpha1.ScyllaDBRepair{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeScyllaDBRepairs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(scylladbrepairsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ScyllaDBRepairList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched scyllaDBRepair.
+func (c *FakeScyllaDBRepairs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ScyllaDBRepair, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(scylladbrepairsResource, c.ns, name, pt, data, subresources...), &v1alpha1.ScyllaDBRepair{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBRepair), err
+}
@@ -0,0 +1,125 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeScyllaDBRoles implements ScyllaDBRoleInterface
+type FakeScyllaDBRoles struct {
+	Fake *FakeScyllaV1alpha1
+	ns   string
+}
+
+var scylladbrolesResource = v1alpha1.SchemeGroupVersion.WithResource("scylladbroles")
+
+var scylladbrolesKind = v1alpha1.SchemeGroupVersion.WithKind("ScyllaDBRole")
+
+// Get takes name of the scyllaDBRole, and returns the corresponding scyllaDBRole object, and an error if there is any.
+func (c *FakeScyllaDBRoles) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ScyllaDBRole, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(scylladbrolesResource, c.ns, name), &v1alpha1.ScyllaDBRole{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBRole), err
+}
+
+// List takes label and field selectors, and returns the list of ScyllaDBRoles that match those selectors.
+func (c *FakeScyllaDBRoles) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ScyllaDBRoleList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(scylladbrolesResource, scylladbrolesKind, c.ns, opts), &v1alpha1.ScyllaDBRoleList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ScyllaDBRoleList{ListMeta: obj.(*v1alpha1.ScyllaDBRoleList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ScyllaDBRoleList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested scyllaDBRoles.
+func (c *FakeScyllaDBRoles) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(scylladbrolesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a scyllaDBRole and creates it.  Returns the server's representation of the scyllaDBRole, and an error, if there is any.
+func (c *FakeScyllaDBRoles) Create(ctx context.Context, scyllaDBRole *v1alpha1.ScyllaDBRole, opts v1.CreateOptions) (result *v1alpha1.ScyllaDBRole, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(scylladbrolesResource, c.ns, scyllaDBRole), &v1alpha1.ScyllaDBRole{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBRole), err
+}
+
+// Update takes the representation of a scyllaDBRole and updates it. Returns the server's representation of the scyllaDBRole, and an error, if there is any.
+func (c *FakeScyllaDBRoles) Update(ctx context.Context, scyllaDBRole *v1alpha1.ScyllaDBRole, opts v1.UpdateOptions) (result *v1alpha1.ScyllaDBRole, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(scylladbrolesResource, c.ns, scyllaDBRole), &v1alpha1.ScyllaDBRole{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBRole), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeScyllaDBRoles) UpdateStatus(ctx context.Context, scyllaDBRole *v1alpha1.ScyllaDBRole, opts v1.UpdateOptions) (*v1alpha1.ScyllaDBRole, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(scylladbrolesResource, "status", c.ns, scyllaDBRole), &v1alpha1.ScyllaDBRole{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBRole), err
+}
+
+// Delete takes name of the scyllaDBRole and deletes it. Returns an error if one occurs.
+func (c *FakeScyllaDBRoles) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(scylladbrolesResource, c.ns, name, opts), &v1alpha1.ScyllaDBRole{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeScyllaDBRoles) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(scylladbrolesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ScyllaDBRoleList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched scyllaDBRole.
+func (c *FakeScyllaDBRoles) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ScyllaDBRole, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(scylladbrolesResource, c.ns, name, pt, data, subresources...), &v1alpha1.ScyllaDBRole{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ScyllaDBRole), err
+}
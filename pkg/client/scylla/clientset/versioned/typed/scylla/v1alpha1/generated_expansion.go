@@ -4,8 +4,22 @@ package v1alpha1
 
 type NodeConfigExpansion interface{}
 
+type ScyllaDBBackupExpansion interface{}
+
+type ScyllaDBBackupInventoryExpansion interface{}
+
+type ScyllaDBClusterExpansion interface{}
+
 type ScyllaDBDatacenterExpansion interface{}
 
+type ScyllaDBDatacenterAutoscalerExpansion interface{}
+
+type ScyllaDBKeyspaceExpansion interface{}
+
 type ScyllaDBMonitoringExpansion interface{}
 
+type ScyllaDBRepairExpansion interface{}
+
+type ScyllaDBRoleExpansion interface{}
+
 type ScyllaOperatorConfigExpansion interface{}
@@ -13,8 +13,15 @@ import (
 type ScyllaV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	NodeConfigsGetter
+	ScyllaDBBackupsGetter
+	ScyllaDBBackupInventoriesGetter
+	ScyllaDBClustersGetter
 	ScyllaDBDatacentersGetter
+	ScyllaDBDatacenterAutoscalersGetter
+	ScyllaDBKeyspacesGetter
 	ScyllaDBMonitoringsGetter
+	ScyllaDBRepairsGetter
+	ScyllaDBRolesGetter
 	ScyllaOperatorConfigsGetter
 }
 
@@ -27,14 +34,42 @@ func (c *ScyllaV1alpha1Client) NodeConfigs() NodeConfigInterface {
 	return newNodeConfigs(c)
 }
 
+func (c *ScyllaV1alpha1Client) ScyllaDBBackups(namespace string) ScyllaDBBackupInterface {
+	return newScyllaDBBackups(c, namespace)
+}
+
+func (c *ScyllaV1alpha1Client) ScyllaDBBackupInventories(namespace string) ScyllaDBBackupInventoryInterface {
+	return newScyllaDBBackupInventories(c, namespace)
+}
+
+func (c *ScyllaV1alpha1Client) ScyllaDBClusters(namespace string) ScyllaDBClusterInterface {
+	return newScyllaDBClusters(c, namespace)
+}
+
 func (c *ScyllaV1alpha1Client) ScyllaDBDatacenters(namespace string) ScyllaDBDatacenterInterface {
 	return newScyllaDBDatacenters(c, namespace)
 }
 
+func (c *ScyllaV1alpha1Client) ScyllaDBDatacenterAutoscalers(namespace string) ScyllaDBDatacenterAutoscalerInterface {
+	return newScyllaDBDatacenterAutoscalers(c, namespace)
+}
+
+func (c *ScyllaV1alpha1Client) ScyllaDBKeyspaces(namespace string) ScyllaDBKeyspaceInterface {
+	return newScyllaDBKeyspaces(c, namespace)
+}
+
 func (c *ScyllaV1alpha1Client) ScyllaDBMonitorings(namespace string) ScyllaDBMonitoringInterface {
 	return newScyllaDBMonitorings(c, namespace)
 }
 
+func (c *ScyllaV1alpha1Client) ScyllaDBRepairs(namespace string) ScyllaDBRepairInterface {
+	return newScyllaDBRepairs(c, namespace)
+}
+
+func (c *ScyllaV1alpha1Client) ScyllaDBRoles(namespace string) ScyllaDBRoleInterface {
+	return newScyllaDBRoles(c, namespace)
+}
+
 func (c *ScyllaV1alpha1Client) ScyllaOperatorConfigs() ScyllaOperatorConfigInterface {
 	return newScyllaOperatorConfigs(c)
 }
@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scheme "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ScyllaDBBackupsGetter has a method to return a ScyllaDBBackupInterface.
+// A group's client should implement this interface.
+type ScyllaDBBackupsGetter interface {
+	ScyllaDBBackups(namespace string) ScyllaDBBackupInterface
+}
+
+// ScyllaDBBackupInterface has methods to work with ScyllaDBBackup resources.
+type ScyllaDBBackupInterface interface {
+	Create(ctx context.Context, scyllaDBBackup *v1alpha1.ScyllaDBBackup, opts v1.CreateOptions) (*v1alpha1.ScyllaDBBackup, error)
+	Update(ctx context.Context, scyllaDBBackup *v1alpha1.ScyllaDBBackup, opts v1.UpdateOptions) (*v1alpha1.ScyllaDBBackup, error)
+	UpdateStatus(ctx context.Context, scyllaDBBackup *v1alpha1.ScyllaDBBackup, opts v1.UpdateOptions) (*v1alpha1.ScyllaDBBackup, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ScyllaDBBackup, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ScyllaDBBackupList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ScyllaDBBackup, err error)
+	ScyllaDBBackupExpansion
+}
+
+// scyllaDBBackups implements ScyllaDBBackupInterface
+type scyllaDBBackups struct {
+	client rest.Interface
+	ns     string
+}
+
+// newScyllaDBBackups returns a ScyllaDBBackups
+func newScyllaDBBackups(c *ScyllaV1alpha1Client, namespace string) *scyllaDBBackups {
+	return &scyllaDBBackups{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the scyllaDBBackup, and returns the corresponding scyllaDBBackup object, and an error if there is any.
+func (c *scyllaDBBackups) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ScyllaDBBackup, err error) {
+	result = &v1alpha1.ScyllaDBBackup{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("scylladbbackups").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ScyllaDBBackups that match those selectors.
+func (c *scyllaDBBackups) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ScyllaDBBackupList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ScyllaDBBackupList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("scylladbbackups").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested scyllaDBBackups.
+func (c *scyllaDBBackups) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("scylladbbackups").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a scyllaDBBackup and creates it.  Returns the server's representation of the scyllaDBBackup, and an error, if there is any.
+func (c *scyllaDBBackups) Create(ctx context.Context, scyllaDBBackup *v1alpha1.ScyllaDBBackup, opts v1.CreateOptions) (result *v1alpha1.ScyllaDBBackup, err error) {
+	result = &v1alpha1.ScyllaDBBackup{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("scylladbbackups").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scyllaDBBackup).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a scyllaDBBackup and updates it. Returns the server's representation of the scyllaDBBackup, and an error, if there is any.
+func (c *scyllaDBBackups) Update(ctx context.Context, scyllaDBBackup *v1alpha1.ScyllaDBBackup, opts v1.UpdateOptions) (result *v1alpha1.ScyllaDBBackup, err error) {
+	result = &v1alpha1.ScyllaDBBackup{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("scylladbbackups").
+		Name(scyllaDBBackup.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scyllaDBBackup).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *scyllaDBBackups) UpdateStatus(ctx context.Context, scyllaDBBackup *v1alpha1.ScyllaDBBackup, opts v1.UpdateOptions) (result *v1alpha1.ScyllaDBBackup, err error) {
+	result = &v1alpha1.ScyllaDBBackup{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("scylladbbackups").
+		Name(scyllaDBBackup.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scyllaDBBackup).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the scyllaDBBackup and deletes it. Returns an error if one occurs.
+func (c *scyllaDBBackups) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("scylladbbackups").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *scyllaDBBackups) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("scylladbbackups").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched scyllaDBBackup.
+func (c *scyllaDBBackups) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ScyllaDBBackup, err error) {
+	result = &v1alpha1.ScyllaDBBackup{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("scylladbbackups").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scheme "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ScyllaDBBackupInventoriesGetter has a method to return a ScyllaDBBackupInventoryInterface.
+// A group's client should implement this interface.
+type ScyllaDBBackupInventoriesGetter interface {
+	ScyllaDBBackupInventories(namespace string) ScyllaDBBackupInventoryInterface
+}
+
+// ScyllaDBBackupInventoryInterface has methods to work with ScyllaDBBackupInventory resources.
+type ScyllaDBBackupInventoryInterface interface {
+	Create(ctx context.Context, scyllaDBBackupInventory *v1alpha1.ScyllaDBBackupInventory, opts v1.CreateOptions) (*v1alpha1.ScyllaDBBackupInventory, error)
+	Update(ctx context.Context, scyllaDBBackupInventory *v1alpha1.ScyllaDBBackupInventory, opts v1.UpdateOptions) (*v1alpha1.ScyllaDBBackupInventory, error)
+	UpdateStatus(ctx context.Context, scyllaDBBackupInventory *v1alpha1.ScyllaDBBackupInventory, opts v1.UpdateOptions) (*v1alpha1.ScyllaDBBackupInventory, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ScyllaDBBackupInventory, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ScyllaDBBackupInventoryList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ScyllaDBBackupInventory, err error)
+	ScyllaDBBackupInventoryExpansion
+}
+
+// scyllaDBBackupInventories implements ScyllaDBBackupInventoryInterface
+type scyllaDBBackupInventories struct {
+	client rest.Interface
+	ns     string
+}
+
+// newScyllaDBBackupInventories returns a ScyllaDBBackupInventories
+func newScyllaDBBackupInventories(c *ScyllaV1alpha1Client, namespace string) *scyllaDBBackupInventories {
+	return &scyllaDBBackupInventories{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the scyllaDBBackupInventory, and returns the corresponding scyllaDBBackupInventory object, and an error if there is any.
+func (c *scyllaDBBackupInventories) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ScyllaDBBackupInventory, err error) {
+	result = &v1alpha1.ScyllaDBBackupInventory{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("scylladbbackupinventories").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ScyllaDBBackupInventories that match those selectors.
+func (c *scyllaDBBackupInventories) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ScyllaDBBackupInventoryList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ScyllaDBBackupInventoryList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("scylladbbackupinventories").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested scyllaDBBackupInventories.
+func (c *scyllaDBBackupInventories) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("scylladbbackupinventories").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a scyllaDBBackupInventory and creates it.  Returns the server's representation of the scyllaDBBackupInventory, and an error, if there is any.
+func (c *scyllaDBBackupInventories) Create(ctx context.Context, scyllaDBBackupInventory *v1alpha1.ScyllaDBBackupInventory, opts v1.CreateOptions) (result *v1alpha1.ScyllaDBBackupInventory, err error) {
+	result = &v1alpha1.ScyllaDBBackupInventory{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("scylladbbackupinventories").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scyllaDBBackupInventory).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a scyllaDBBackupInventory and updates it. Returns the server's representation of the scyllaDBBackupInventory, and an error, if there is any.
+func (c *scyllaDBBackupInventories) Update(ctx context.Context, scyllaDBBackupInventory *v1alpha1.ScyllaDBBackupInventory, opts v1.UpdateOptions) (result *v1alpha1.ScyllaDBBackupInventory, err error) {
+	result = &v1alpha1.ScyllaDBBackupInventory{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("scylladbbackupinventories").
+		Name(scyllaDBBackupInventory.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scyllaDBBackupInventory).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *scyllaDBBackupInventories) UpdateStatus(ctx context.Context, scyllaDBBackupInventory *v1alpha1.ScyllaDBBackupInventory, opts v1.UpdateOptions) (result *v1alpha1.ScyllaDBBackupInventory, err error) {
+	result = &v1alpha1.ScyllaDBBackupInventory{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("scylladbbackupinventories").
+		Name(scyllaDBBackupInventory.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scyllaDBBackupInventory).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the scyllaDBBackupInventory and deletes it. Returns an error if one occurs.
+func (c *scyllaDBBackupInventories) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("scylladbbackupinventories").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *scyllaDBBackupInventories) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("scylladbbackupinventories").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched scyllaDBBackupInventory.
+func (c *scyllaDBBackupInventories) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ScyllaDBBackupInventory, err error) {
+	result = &v1alpha1.ScyllaDBBackupInventory{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("scylladbbackupinventories").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scheme "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ScyllaDBDatacenterAutoscalersGetter has a method to return a ScyllaDBDatacenterAutoscalerInterface.
+// A group's client should implement this interface.
+type ScyllaDBDatacenterAutoscalersGetter interface {
+	ScyllaDBDatacenterAutoscalers(namespace string) ScyllaDBDatacenterAutoscalerInterface
+}
+
+// ScyllaDBDatacenterAutoscalerInterface has methods to work with ScyllaDBDatacenterAutoscaler resources.
+type ScyllaDBDatacenterAutoscalerInterface interface {
+	Create(ctx context.Context, scyllaDBDatacenterAutoscaler *v1alpha1.ScyllaDBDatacenterAutoscaler, opts v1.CreateOptions) (*v1alpha1.ScyllaDBDatacenterAutoscaler, error)
+	Update(ctx context.Context, scyllaDBDatacenterAutoscaler *v1alpha1.ScyllaDBDatacenterAutoscaler, opts v1.UpdateOptions) (*v1alpha1.ScyllaDBDatacenterAutoscaler, error)
+	UpdateStatus(ctx context.Context, scyllaDBDatacenterAutoscaler *v1alpha1.ScyllaDBDatacenterAutoscaler, opts v1.UpdateOptions) (*v1alpha1.ScyllaDBDatacenterAutoscaler, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ScyllaDBDatacenterAutoscaler, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ScyllaDBDatacenterAutoscalerList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ScyllaDBDatacenterAutoscaler, err error)
+	ScyllaDBDatacenterAutoscalerExpansion
+}
+
+// scyllaDBDatacenterAutoscalers implements ScyllaDBDatacenterAutoscalerInterface
+type scyllaDBDatacenterAutoscalers struct {
+	client rest.Interface
+	ns     string
+}
+
+// newScyllaDBDatacenterAutoscalers returns a ScyllaDBDatacenterAutoscalers
+func newScyllaDBDatacenterAutoscalers(c *ScyllaV1alpha1Client, namespace string) *scyllaDBDatacenterAutoscalers {
+	return &scyllaDBDatacenterAutoscalers{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the scyllaDBDatacenterAutoscaler, and returns the corresponding scyllaDBDatacenterAutoscaler object, and an error if there is any.
+func (c *scyllaDBDatacenterAutoscalers) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ScyllaDBDatacenterAutoscaler, err error) {
+	result = &v1alpha1.ScyllaDBDatacenterAutoscaler{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("scylladbdatacenterautoscalers").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ScyllaDBDatacenterAutoscalers that match those selectors.
+func (c *scyllaDBDatacenterAutoscalers) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ScyllaDBDatacenterAutoscalerList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ScyllaDBDatacenterAutoscalerList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("scylladbdatacenterautoscalers").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested scyllaDBDatacenterAutoscalers.
+func (c *scyllaDBDatacenterAutoscalers) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("scylladbdatacenterautoscalers").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a scyllaDBDatacenterAutoscaler and creates it.  Returns the server's representation of the scyllaDBDatacenterAutoscaler, and an error, if there is any.
+func (c *scyllaDBDatacenterAutoscalers) Create(ctx context.Context, scyllaDBDatacenterAutoscaler *v1alpha1.ScyllaDBDatacenterAutoscaler, opts v1.CreateOptions) (result *v1alpha1.ScyllaDBDatacenterAutoscaler, err error) {
+	result = &v1alpha1.ScyllaDBDatacenterAutoscaler{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("scylladbdatacenterautoscalers").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scyllaDBDatacenterAutoscaler).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a scyllaDBDatacenterAutoscaler and updates it. Returns the server's representation of the scyllaDBDatacenterAutoscaler, and an error, if there is any.
+func (c *scyllaDBDatacenterAutoscalers) Update(ctx context.Context, scyllaDBDatacenterAutoscaler *v1alpha1.ScyllaDBDatacenterAutoscaler, opts v1.UpdateOptions) (result *v1alpha1.ScyllaDBDatacenterAutoscaler, err error) {
+	result = &v1alpha1.ScyllaDBDatacenterAutoscaler{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("scylladbdatacenterautoscalers").
+		Name(scyllaDBDatacenterAutoscaler.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scyllaDBDatacenterAutoscaler).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *scyllaDBDatacenterAutoscalers) UpdateStatus(ctx context.Context, scyllaDBDatacenterAutoscaler *v1alpha1.ScyllaDBDatacenterAutoscaler, opts v1.UpdateOptions) (result *v1alpha1.ScyllaDBDatacenterAutoscaler, err error) {
+	result = &v1alpha1.ScyllaDBDatacenterAutoscaler{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("scylladbdatacenterautoscalers").
+		Name(scyllaDBDatacenterAutoscaler.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scyllaDBDatacenterAutoscaler).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the scyllaDBDatacenterAutoscaler and deletes it. Returns an error if one occurs.
+func (c *scyllaDBDatacenterAutoscalers) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("scylladbdatacenterautoscalers").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *scyllaDBDatacenterAutoscalers) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("scylladbdatacenterautoscalers").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched scyllaDBDatacenterAutoscaler.
+func (c *scyllaDBDatacenterAutoscalers) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ScyllaDBDatacenterAutoscaler, err error) {
+	result = &v1alpha1.ScyllaDBDatacenterAutoscaler{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("scylladbdatacenterautoscalers").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scheme "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ScyllaDBRepairsGetter has a method to return a ScyllaDBRepairInterface.
+// A group's client should implement this interface.
+type ScyllaDBRepairsGetter interface {
+	ScyllaDBRepairs(namespace string) ScyllaDBRepairInterface
+}
+
+// ScyllaDBRepairInterface has methods to work with ScyllaDBRepair resources.
+type ScyllaDBRepairInterface interface {
+	Create(ctx context.Context, scyllaDBRepair *v1alpha1.ScyllaDBRepair, opts v1.CreateOptions) (*v1alpha1.ScyllaDBRepair, error)
+	Update(ctx context.Context, scyllaDBRepair *v1alpha1.ScyllaDBRepair, opts v1.UpdateOptions) (*v1alpha1.ScyllaDBRepair, error)
+	UpdateStatus(ctx context.Context, scyllaDBRepair *v1alpha1.ScyllaDBRepair, opts v1.UpdateOptions) (*v1alpha1.ScyllaDBRepair, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ScyllaDBRepair, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ScyllaDBRepairList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ScyllaDBRepair, err error)
+	ScyllaDBRepairExpansion
+}
+
+// scyllaDBRepairs implements ScyllaDBRepairInterface
+type scyllaDBRepairs struct {
+	client rest.Interface
+	ns     string
+}
+
+// newScyllaDBRepairs returns a ScyllaDBRepairs
+func newScyllaDBRepairs(c *ScyllaV1alpha1Client, namespace string) *scyllaDBRepairs {
+	return &scyllaDBRepairs{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the scyllaDBRepair, and returns the corresponding scyllaDBRepair object, and an error if there is any.
+func (c *scyllaDBRepairs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ScyllaDBRepair, err error) {
+	result = &v1alpha1.ScyllaDBRepair{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("scylladbrepairs").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ScyllaDBRepairs that match those selectors.
+func (c *scyllaDBRepairs) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ScyllaDBRepairList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ScyllaDBRepairList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("scylladbrepairs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested scyllaDBRepairs.
+func (c *scyllaDBRepairs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("scylladbrepairs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a scyllaDBRepair and creates it.  Returns the server's representation of the scyllaDBRepair, and an error, if there is any.
+func (c *scyllaDBRepairs) Create(ctx context.Context, scyllaDBRepair *v1alpha1.ScyllaDBRepair, opts v1.CreateOptions) (result *v1alpha1.ScyllaDBRepair, err error) {
+	result = &v1alpha1.ScyllaDBRepair{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("scylladbrepairs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scyllaDBRepair).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a scyllaDBRepair and updates it. Returns the server's representation of the scyllaDBRepair, and an error, if there is any.
+func (c *scyllaDBRepairs) Update(ctx context.Context, scyllaDBRepair *v1alpha1.ScyllaDBRepair, opts v1.UpdateOptions) (result *v1alpha1.ScyllaDBRepair, err error) {
+	result = &v1alpha1.ScyllaDBRepair{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("scylladbrepairs").
+		Name(scyllaDBRepair.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scyllaDBRepair).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *scyllaDBRepairs) UpdateStatus(ctx context.Context, scyllaDBRepair *v1alpha1.ScyllaDBRepair, opts v1.UpdateOptions) (result *v1alpha1.ScyllaDBRepair, err error) {
+	result = &v1alpha1.ScyllaDBRepair{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("scylladbrepairs").
+		Name(scyllaDBRepair.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scyllaDBRepair).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the scyllaDBRepair and deletes it. Returns an error if one occurs.
+func (c *scyllaDBRepairs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("scylladbrepairs").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *scyllaDBRepairs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("scylladbrepairs").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched scyllaDBRepair.
+func (c *scyllaDBRepairs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ScyllaDBRepair, err error) {
+	result = &v1alpha1.ScyllaDBRepair{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("scylladbrepairs").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
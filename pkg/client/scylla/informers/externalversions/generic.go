@@ -44,10 +44,24 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		// Group=scylla.scylladb.com, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithResource("nodeconfigs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Scylla().V1alpha1().NodeConfigs().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("scylladbbackups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Scylla().V1alpha1().ScyllaDBBackups().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("scylladbbackupinventories"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Scylla().V1alpha1().ScyllaDBBackupInventories().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("scylladbclusters"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Scylla().V1alpha1().ScyllaDBClusters().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("scylladbdatacenters"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Scylla().V1alpha1().ScyllaDBDatacenters().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("scylladbdatacenterautoscalers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Scylla().V1alpha1().ScyllaDBDatacenterAutoscalers().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("scylladbkeyspaces"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Scylla().V1alpha1().ScyllaDBKeyspaces().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("scylladbmonitorings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Scylla().V1alpha1().ScyllaDBMonitorings().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("scylladbrepairs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Scylla().V1alpha1().ScyllaDBRepairs().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("scylladbroles"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Scylla().V1alpha1().ScyllaDBRoles().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("scyllaoperatorconfigs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Scylla().V1alpha1().ScyllaOperatorConfigs().Informer()}, nil
 
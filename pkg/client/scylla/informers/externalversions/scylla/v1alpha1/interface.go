@@ -10,10 +10,24 @@ import (
 type Interface interface {
 	// NodeConfigs returns a NodeConfigInformer.
 	NodeConfigs() NodeConfigInformer
+	// ScyllaDBBackups returns a ScyllaDBBackupInformer.
+	ScyllaDBBackups() ScyllaDBBackupInformer
+	// ScyllaDBBackupInventories returns a ScyllaDBBackupInventoryInformer.
+	ScyllaDBBackupInventories() ScyllaDBBackupInventoryInformer
+	// ScyllaDBClusters returns a ScyllaDBClusterInformer.
+	ScyllaDBClusters() ScyllaDBClusterInformer
 	// ScyllaDBDatacenters returns a ScyllaDBDatacenterInformer.
 	ScyllaDBDatacenters() ScyllaDBDatacenterInformer
+	// ScyllaDBDatacenterAutoscalers returns a ScyllaDBDatacenterAutoscalerInformer.
+	ScyllaDBDatacenterAutoscalers() ScyllaDBDatacenterAutoscalerInformer
+	// ScyllaDBKeyspaces returns a ScyllaDBKeyspaceInformer.
+	ScyllaDBKeyspaces() ScyllaDBKeyspaceInformer
 	// ScyllaDBMonitorings returns a ScyllaDBMonitoringInformer.
 	ScyllaDBMonitorings() ScyllaDBMonitoringInformer
+	// ScyllaDBRepairs returns a ScyllaDBRepairInformer.
+	ScyllaDBRepairs() ScyllaDBRepairInformer
+	// ScyllaDBRoles returns a ScyllaDBRoleInformer.
+	ScyllaDBRoles() ScyllaDBRoleInformer
 	// ScyllaOperatorConfigs returns a ScyllaOperatorConfigInformer.
 	ScyllaOperatorConfigs() ScyllaOperatorConfigInformer
 }
@@ -34,16 +48,51 @@ func (v *version) NodeConfigs() NodeConfigInformer {
 	return &nodeConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ScyllaDBBackups returns a ScyllaDBBackupInformer.
+func (v *version) ScyllaDBBackups() ScyllaDBBackupInformer {
+	return &scyllaDBBackupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ScyllaDBBackupInventories returns a ScyllaDBBackupInventoryInformer.
+func (v *version) ScyllaDBBackupInventories() ScyllaDBBackupInventoryInformer {
+	return &scyllaDBBackupInventoryInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ScyllaDBClusters returns a ScyllaDBClusterInformer.
+func (v *version) ScyllaDBClusters() ScyllaDBClusterInformer {
+	return &scyllaDBClusterInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ScyllaDBDatacenters returns a ScyllaDBDatacenterInformer.
 func (v *version) ScyllaDBDatacenters() ScyllaDBDatacenterInformer {
 	return &scyllaDBDatacenterInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ScyllaDBDatacenterAutoscalers returns a ScyllaDBDatacenterAutoscalerInformer.
+func (v *version) ScyllaDBDatacenterAutoscalers() ScyllaDBDatacenterAutoscalerInformer {
+	return &scyllaDBDatacenterAutoscalerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ScyllaDBKeyspaces returns a ScyllaDBKeyspaceInformer.
+func (v *version) ScyllaDBKeyspaces() ScyllaDBKeyspaceInformer {
+	return &scyllaDBKeyspaceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ScyllaDBMonitorings returns a ScyllaDBMonitoringInformer.
 func (v *version) ScyllaDBMonitorings() ScyllaDBMonitoringInformer {
 	return &scyllaDBMonitoringInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ScyllaDBRepairs returns a ScyllaDBRepairInformer.
+func (v *version) ScyllaDBRepairs() ScyllaDBRepairInformer {
+	return &scyllaDBRepairInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ScyllaDBRoles returns a ScyllaDBRoleInformer.
+func (v *version) ScyllaDBRoles() ScyllaDBRoleInformer {
+	return &scyllaDBRoleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ScyllaOperatorConfigs returns a ScyllaOperatorConfigInformer.
 func (v *version) ScyllaOperatorConfigs() ScyllaOperatorConfigInformer {
 	return &scyllaOperatorConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -0,0 +1,74 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	versioned "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned"
+	internalinterfaces "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/internalinterfaces"
+	v1alpha1 "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ScyllaDBBackupInformer provides access to a shared informer and lister for
+// ScyllaDBBackups.
+type ScyllaDBBackupInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.ScyllaDBBackupLister
+}
+
+type scyllaDBBackupInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewScyllaDBBackupInformer constructs a new informer for ScyllaDBBackup type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewScyllaDBBackupInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredScyllaDBBackupInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredScyllaDBBackupInformer constructs a new informer for ScyllaDBBackup type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredScyllaDBBackupInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ScyllaV1alpha1().ScyllaDBBackups(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ScyllaV1alpha1().ScyllaDBBackups(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&scyllav1alpha1.ScyllaDBBackup{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *scyllaDBBackupInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredScyllaDBBackupInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *scyllaDBBackupInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&scyllav1alpha1.ScyllaDBBackup{}, f.defaultInformer)
+}
+
+func (f *scyllaDBBackupInformer) Lister() v1alpha1.ScyllaDBBackupLister {
+	return v1alpha1.NewScyllaDBBackupLister(f.Informer().GetIndexer())
+}
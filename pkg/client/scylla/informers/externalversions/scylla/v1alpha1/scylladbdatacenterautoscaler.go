@@ -0,0 +1,74 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	versioned "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned"
+	internalinterfaces "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/internalinterfaces"
+	v1alpha1 "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ScyllaDBDatacenterAutoscalerInformer provides access to a shared informer and lister for
+// ScyllaDBDatacenterAutoscalers.
+type ScyllaDBDatacenterAutoscalerInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.ScyllaDBDatacenterAutoscalerLister
+}
+
+type scyllaDBDatacenterAutoscalerInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewScyllaDBDatacenterAutoscalerInformer constructs a new informer for ScyllaDBDatacenterAutoscaler type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewScyllaDBDatacenterAutoscalerInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredScyllaDBDatacenterAutoscalerInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredScyllaDBDatacenterAutoscalerInformer constructs a new informer for ScyllaDBDatacenterAutoscaler type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredScyllaDBDatacenterAutoscalerInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ScyllaV1alpha1().ScyllaDBDatacenterAutoscalers(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ScyllaV1alpha1().ScyllaDBDatacenterAutoscalers(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&scyllav1alpha1.ScyllaDBDatacenterAutoscaler{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *scyllaDBDatacenterAutoscalerInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredScyllaDBDatacenterAutoscalerInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *scyllaDBDatacenterAutoscalerInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&scyllav1alpha1.ScyllaDBDatacenterAutoscaler{}, f.defaultInformer)
+}
+
+func (f *scyllaDBDatacenterAutoscalerInformer) Lister() v1alpha1.ScyllaDBDatacenterAutoscalerLister {
+	return v1alpha1.NewScyllaDBDatacenterAutoscalerLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,74 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	versioned "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned"
+	internalinterfaces "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/internalinterfaces"
+	v1alpha1 "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ScyllaDBKeyspaceInformer provides access to a shared informer and lister for
+// ScyllaDBKeyspaces.
+type ScyllaDBKeyspaceInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.ScyllaDBKeyspaceLister
+}
+
+type scyllaDBKeyspaceInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewScyllaDBKeyspaceInformer constructs a new informer for ScyllaDBKeyspace type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewScyllaDBKeyspaceInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredScyllaDBKeyspaceInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredScyllaDBKeyspaceInformer constructs a new informer for ScyllaDBKeyspace type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredScyllaDBKeyspaceInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ScyllaV1alpha1().ScyllaDBKeyspaces(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ScyllaV1alpha1().ScyllaDBKeyspaces(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&scyllav1alpha1.ScyllaDBKeyspace{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *scyllaDBKeyspaceInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredScyllaDBKeyspaceInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *scyllaDBKeyspaceInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&scyllav1alpha1.ScyllaDBKeyspace{}, f.defaultInformer)
+}
+
+func (f *scyllaDBKeyspaceInformer) Lister() v1alpha1.ScyllaDBKeyspaceLister {
+	return v1alpha1.NewScyllaDBKeyspaceLister(f.Informer().GetIndexer())
+}
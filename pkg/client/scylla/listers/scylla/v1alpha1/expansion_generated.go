@@ -6,6 +6,30 @@ package v1alpha1
 // NodeConfigLister.
 type NodeConfigListerExpansion interface{}
 
+// ScyllaDBBackupListerExpansion allows custom methods to be added to
+// ScyllaDBBackupLister.
+type ScyllaDBBackupListerExpansion interface{}
+
+// ScyllaDBBackupNamespaceListerExpansion allows custom methods to be added to
+// ScyllaDBBackupNamespaceLister.
+type ScyllaDBBackupNamespaceListerExpansion interface{}
+
+// ScyllaDBBackupInventoryListerExpansion allows custom methods to be added to
+// ScyllaDBBackupInventoryLister.
+type ScyllaDBBackupInventoryListerExpansion interface{}
+
+// ScyllaDBBackupInventoryNamespaceListerExpansion allows custom methods to be added to
+// ScyllaDBBackupInventoryNamespaceLister.
+type ScyllaDBBackupInventoryNamespaceListerExpansion interface{}
+
+// ScyllaDBClusterListerExpansion allows custom methods to be added to
+// ScyllaDBClusterLister.
+type ScyllaDBClusterListerExpansion interface{}
+
+// ScyllaDBClusterNamespaceListerExpansion allows custom methods to be added to
+// ScyllaDBClusterNamespaceLister.
+type ScyllaDBClusterNamespaceListerExpansion interface{}
+
 // ScyllaDBDatacenterListerExpansion allows custom methods to be added to
 // ScyllaDBDatacenterLister.
 type ScyllaDBDatacenterListerExpansion interface{}
@@ -14,6 +38,22 @@ type ScyllaDBDatacenterListerExpansion interface{}
 // ScyllaDBDatacenterNamespaceLister.
 type ScyllaDBDatacenterNamespaceListerExpansion interface{}
 
+// ScyllaDBDatacenterAutoscalerListerExpansion allows custom methods to be added to
+// ScyllaDBDatacenterAutoscalerLister.
+type ScyllaDBDatacenterAutoscalerListerExpansion interface{}
+
+// ScyllaDBDatacenterAutoscalerNamespaceListerExpansion allows custom methods to be added to
+// ScyllaDBDatacenterAutoscalerNamespaceLister.
+type ScyllaDBDatacenterAutoscalerNamespaceListerExpansion interface{}
+
+// ScyllaDBKeyspaceListerExpansion allows custom methods to be added to
+// ScyllaDBKeyspaceLister.
+type ScyllaDBKeyspaceListerExpansion interface{}
+
+// ScyllaDBKeyspaceNamespaceListerExpansion allows custom methods to be added to
+// ScyllaDBKeyspaceNamespaceLister.
+type ScyllaDBKeyspaceNamespaceListerExpansion interface{}
+
 // ScyllaDBMonitoringListerExpansion allows custom methods to be added to
 // ScyllaDBMonitoringLister.
 type ScyllaDBMonitoringListerExpansion interface{}
@@ -22,6 +62,22 @@ type ScyllaDBMonitoringListerExpansion interface{}
 // ScyllaDBMonitoringNamespaceLister.
 type ScyllaDBMonitoringNamespaceListerExpansion interface{}
 
+// ScyllaDBRepairListerExpansion allows custom methods to be added to
+// ScyllaDBRepairLister.
+type ScyllaDBRepairListerExpansion interface{}
+
+// ScyllaDBRepairNamespaceListerExpansion allows custom methods to be added to
+// ScyllaDBRepairNamespaceLister.
+type ScyllaDBRepairNamespaceListerExpansion interface{}
+
+// ScyllaDBRoleListerExpansion allows custom methods to be added to
+// ScyllaDBRoleLister.
+type ScyllaDBRoleListerExpansion interface{}
+
+// ScyllaDBRoleNamespaceListerExpansion allows custom methods to be added to
+// ScyllaDBRoleNamespaceLister.
+type ScyllaDBRoleNamespaceListerExpansion interface{}
+
 // ScyllaOperatorConfigListerExpansion allows custom methods to be added to
 // ScyllaOperatorConfigLister.
 type ScyllaOperatorConfigListerExpansion interface{}
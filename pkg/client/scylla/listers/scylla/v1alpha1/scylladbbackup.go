@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ScyllaDBBackupLister helps list ScyllaDBBackups.
+// All objects returned here must be treated as read-only.
+type ScyllaDBBackupLister interface {
+	// List lists all ScyllaDBBackups in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBBackup, err error)
+	// ScyllaDBBackups returns an object that can list and get ScyllaDBBackups.
+	ScyllaDBBackups(namespace string) ScyllaDBBackupNamespaceLister
+	ScyllaDBBackupListerExpansion
+}
+
+// scyllaDBBackupLister implements the ScyllaDBBackupLister interface.
+type scyllaDBBackupLister struct {
+	indexer cache.Indexer
+}
+
+// NewScyllaDBBackupLister returns a new ScyllaDBBackupLister.
+func NewScyllaDBBackupLister(indexer cache.Indexer) ScyllaDBBackupLister {
+	return &scyllaDBBackupLister{indexer: indexer}
+}
+
+// List lists all ScyllaDBBackups in the indexer.
+func (s *scyllaDBBackupLister) List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBBackup, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ScyllaDBBackup))
+	})
+	return ret, err
+}
+
+// ScyllaDBBackups returns an object that can list and get ScyllaDBBackups.
+func (s *scyllaDBBackupLister) ScyllaDBBackups(namespace string) ScyllaDBBackupNamespaceLister {
+	return scyllaDBBackupNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ScyllaDBBackupNamespaceLister helps list and get ScyllaDBBackups.
+// All objects returned here must be treated as read-only.
+type ScyllaDBBackupNamespaceLister interface {
+	// List lists all ScyllaDBBackups in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBBackup, err error)
+	// Get retrieves the ScyllaDBBackup from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.ScyllaDBBackup, error)
+	ScyllaDBBackupNamespaceListerExpansion
+}
+
+// scyllaDBBackupNamespaceLister implements the ScyllaDBBackupNamespaceLister
+// interface.
+type scyllaDBBackupNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ScyllaDBBackups in the indexer for a given namespace.
+func (s scyllaDBBackupNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBBackup, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ScyllaDBBackup))
+	})
+	return ret, err
+}
+
+// Get retrieves the ScyllaDBBackup from the indexer for a given namespace and name.
+func (s scyllaDBBackupNamespaceLister) Get(name string) (*v1alpha1.ScyllaDBBackup, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("scylladbbackup"), name)
+	}
+	return obj.(*v1alpha1.ScyllaDBBackup), nil
+}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ScyllaDBBackupInventoryLister helps list ScyllaDBBackupInventories.
+// All objects returned here must be treated as read-only.
+type ScyllaDBBackupInventoryLister interface {
+	// List lists all ScyllaDBBackupInventories in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBBackupInventory, err error)
+	// ScyllaDBBackupInventories returns an object that can list and get ScyllaDBBackupInventories.
+	ScyllaDBBackupInventories(namespace string) ScyllaDBBackupInventoryNamespaceLister
+	ScyllaDBBackupInventoryListerExpansion
+}
+
+// scyllaDBBackupInventoryLister implements the ScyllaDBBackupInventoryLister interface.
+type scyllaDBBackupInventoryLister struct {
+	indexer cache.Indexer
+}
+
+// NewScyllaDBBackupInventoryLister returns a new ScyllaDBBackupInventoryLister.
+func NewScyllaDBBackupInventoryLister(indexer cache.Indexer) ScyllaDBBackupInventoryLister {
+	return &scyllaDBBackupInventoryLister{indexer: indexer}
+}
+
+// List lists all ScyllaDBBackupInventories in the indexer.
+func (s *scyllaDBBackupInventoryLister) List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBBackupInventory, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ScyllaDBBackupInventory))
+	})
+	return ret, err
+}
+
+// ScyllaDBBackupInventories returns an object that can list and get ScyllaDBBackupInventories.
+func (s *scyllaDBBackupInventoryLister) ScyllaDBBackupInventories(namespace string) ScyllaDBBackupInventoryNamespaceLister {
+	return scyllaDBBackupInventoryNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ScyllaDBBackupInventoryNamespaceLister helps list and get ScyllaDBBackupInventories.
+// All objects returned here must be treated as read-only.
+type ScyllaDBBackupInventoryNamespaceLister interface {
+	// List lists all ScyllaDBBackupInventories in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBBackupInventory, err error)
+	// Get retrieves the ScyllaDBBackupInventory from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.ScyllaDBBackupInventory, error)
+	ScyllaDBBackupInventoryNamespaceListerExpansion
+}
+
+// scyllaDBBackupInventoryNamespaceLister implements the ScyllaDBBackupInventoryNamespaceLister
+// interface.
+type scyllaDBBackupInventoryNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ScyllaDBBackupInventories in the indexer for a given namespace.
+func (s scyllaDBBackupInventoryNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBBackupInventory, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ScyllaDBBackupInventory))
+	})
+	return ret, err
+}
+
+// Get retrieves the ScyllaDBBackupInventory from the indexer for a given namespace and name.
+func (s scyllaDBBackupInventoryNamespaceLister) Get(name string) (*v1alpha1.ScyllaDBBackupInventory, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("scylladbbackupinventory"), name)
+	}
+	return obj.(*v1alpha1.ScyllaDBBackupInventory), nil
+}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ScyllaDBDatacenterAutoscalerLister helps list ScyllaDBDatacenterAutoscalers.
+// All objects returned here must be treated as read-only.
+type ScyllaDBDatacenterAutoscalerLister interface {
+	// List lists all ScyllaDBDatacenterAutoscalers in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBDatacenterAutoscaler, err error)
+	// ScyllaDBDatacenterAutoscalers returns an object that can list and get ScyllaDBDatacenterAutoscalers.
+	ScyllaDBDatacenterAutoscalers(namespace string) ScyllaDBDatacenterAutoscalerNamespaceLister
+	ScyllaDBDatacenterAutoscalerListerExpansion
+}
+
+// scyllaDBDatacenterAutoscalerLister implements the ScyllaDBDatacenterAutoscalerLister interface.
+type scyllaDBDatacenterAutoscalerLister struct {
+	indexer cache.Indexer
+}
+
+// NewScyllaDBDatacenterAutoscalerLister returns a new ScyllaDBDatacenterAutoscalerLister.
+func NewScyllaDBDatacenterAutoscalerLister(indexer cache.Indexer) ScyllaDBDatacenterAutoscalerLister {
+	return &scyllaDBDatacenterAutoscalerLister{indexer: indexer}
+}
+
+// List lists all ScyllaDBDatacenterAutoscalers in the indexer.
+func (s *scyllaDBDatacenterAutoscalerLister) List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBDatacenterAutoscaler, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ScyllaDBDatacenterAutoscaler))
+	})
+	return ret, err
+}
+
+// ScyllaDBDatacenterAutoscalers returns an object that can list and get ScyllaDBDatacenterAutoscalers.
+func (s *scyllaDBDatacenterAutoscalerLister) ScyllaDBDatacenterAutoscalers(namespace string) ScyllaDBDatacenterAutoscalerNamespaceLister {
+	return scyllaDBDatacenterAutoscalerNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ScyllaDBDatacenterAutoscalerNamespaceLister helps list and get ScyllaDBDatacenterAutoscalers.
+// All objects returned here must be treated as read-only.
+type ScyllaDBDatacenterAutoscalerNamespaceLister interface {
+	// List lists all ScyllaDBDatacenterAutoscalers in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBDatacenterAutoscaler, err error)
+	// Get retrieves the ScyllaDBDatacenterAutoscaler from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.ScyllaDBDatacenterAutoscaler, error)
+	ScyllaDBDatacenterAutoscalerNamespaceListerExpansion
+}
+
+// scyllaDBDatacenterAutoscalerNamespaceLister implements the ScyllaDBDatacenterAutoscalerNamespaceLister
+// interface.
+type scyllaDBDatacenterAutoscalerNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ScyllaDBDatacenterAutoscalers in the indexer for a given namespace.
+func (s scyllaDBDatacenterAutoscalerNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBDatacenterAutoscaler, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ScyllaDBDatacenterAutoscaler))
+	})
+	return ret, err
+}
+
+// Get retrieves the ScyllaDBDatacenterAutoscaler from the indexer for a given namespace and name.
+func (s scyllaDBDatacenterAutoscalerNamespaceLister) Get(name string) (*v1alpha1.ScyllaDBDatacenterAutoscaler, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("scylladbdatacenterautoscaler"), name)
+	}
+	return obj.(*v1alpha1.ScyllaDBDatacenterAutoscaler), nil
+}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ScyllaDBKeyspaceLister helps list ScyllaDBKeyspaces.
+// All objects returned here must be treated as read-only.
+type ScyllaDBKeyspaceLister interface {
+	// List lists all ScyllaDBKeyspaces in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBKeyspace, err error)
+	// ScyllaDBKeyspaces returns an object that can list and get ScyllaDBKeyspaces.
+	ScyllaDBKeyspaces(namespace string) ScyllaDBKeyspaceNamespaceLister
+	ScyllaDBKeyspaceListerExpansion
+}
+
+// scyllaDBKeyspaceLister implements the ScyllaDBKeyspaceLister interface.
+type scyllaDBKeyspaceLister struct {
+	indexer cache.Indexer
+}
+
+// NewScyllaDBKeyspaceLister returns a new ScyllaDBKeyspaceLister.
+func NewScyllaDBKeyspaceLister(indexer cache.Indexer) ScyllaDBKeyspaceLister {
+	return &scyllaDBKeyspaceLister{indexer: indexer}
+}
+
+// List lists all ScyllaDBKeyspaces in the indexer.
+func (s *scyllaDBKeyspaceLister) List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBKeyspace, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ScyllaDBKeyspace))
+	})
+	return ret, err
+}
+
+// ScyllaDBKeyspaces returns an object that can list and get ScyllaDBKeyspaces.
+func (s *scyllaDBKeyspaceLister) ScyllaDBKeyspaces(namespace string) ScyllaDBKeyspaceNamespaceLister {
+	return scyllaDBKeyspaceNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ScyllaDBKeyspaceNamespaceLister helps list and get ScyllaDBKeyspaces.
+// All objects returned here must be treated as read-only.
+type ScyllaDBKeyspaceNamespaceLister interface {
+	// List lists all ScyllaDBKeyspaces in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBKeyspace, err error)
+	// Get retrieves the ScyllaDBKeyspace from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.ScyllaDBKeyspace, error)
+	ScyllaDBKeyspaceNamespaceListerExpansion
+}
+
+// scyllaDBKeyspaceNamespaceLister implements the ScyllaDBKeyspaceNamespaceLister
+// interface.
+type scyllaDBKeyspaceNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ScyllaDBKeyspaces in the indexer for a given namespace.
+func (s scyllaDBKeyspaceNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBKeyspace, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ScyllaDBKeyspace))
+	})
+	return ret, err
+}
+
+// Get retrieves the ScyllaDBKeyspace from the indexer for a given namespace and name.
+func (s scyllaDBKeyspaceNamespaceLister) Get(name string) (*v1alpha1.ScyllaDBKeyspace, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("scylladbkeyspace"), name)
+	}
+	return obj.(*v1alpha1.ScyllaDBKeyspace), nil
+}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ScyllaDBRepairLister helps list ScyllaDBRepairs.
+// All objects returned here must be treated as read-only.
+type ScyllaDBRepairLister interface {
+	// List lists all ScyllaDBRepairs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBRepair, err error)
+	// ScyllaDBRepairs returns an object that can list and get ScyllaDBRepairs.
+	ScyllaDBRepairs(namespace string) ScyllaDBRepairNamespaceLister
+	ScyllaDBRepairListerExpansion
+}
+
+// scyllaDBRepairLister implements the ScyllaDBRepairLister interface.
+type scyllaDBRepairLister struct {
+	indexer cache.Indexer
+}
+
+// NewScyllaDBRepairLister returns a new ScyllaDBRepairLister.
+func NewScyllaDBRepairLister(indexer cache.Indexer) ScyllaDBRepairLister {
+	return &scyllaDBRepairLister{indexer: indexer}
+}
+
+// List lists all ScyllaDBRepairs in the indexer.
+func (s *scyllaDBRepairLister) List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBRepair, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ScyllaDBRepair))
+	})
+	return ret, err
+}
+
+// ScyllaDBRepairs returns an object that can list and get ScyllaDBRepairs.
+func (s *scyllaDBRepairLister) ScyllaDBRepairs(namespace string) ScyllaDBRepairNamespaceLister {
+	return scyllaDBRepairNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ScyllaDBRepairNamespaceLister helps list and get ScyllaDBRepairs.
+// All objects returned here must be treated as read-only.
+type ScyllaDBRepairNamespaceLister interface {
+	// List lists all ScyllaDBRepairs in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBRepair, err error)
+	// Get retrieves the ScyllaDBRepair from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.ScyllaDBRepair, error)
+	ScyllaDBRepairNamespaceListerExpansion
+}
+
+// scyllaDBRepairNamespaceLister implements the ScyllaDBRepairNamespaceLister
+// interface.
+type scyllaDBRepairNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ScyllaDBRepairs in the indexer for a given namespace.
+func (s scyllaDBRepairNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.ScyllaDBRepair, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ScyllaDBRepair))
+	})
+	return ret, err
+}
+
+// Get retrieves the ScyllaDBRepair from the indexer for a given namespace and name.
+func (s scyllaDBRepairNamespaceLister) Get(name string) (*v1alpha1.ScyllaDBRepair, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("scylladbrepair"), name)
+	}
+	return obj.(*v1alpha1.ScyllaDBRepair), nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/scylladb/scylla-operator/pkg/genericclioptions"
 	"github.com/scylladb/scylla-operator/pkg/helpers"
 	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+	"github.com/scylladb/scylla-operator/pkg/scyllafeatures"
 	"github.com/scylladb/scylla-operator/pkg/signals"
 	"github.com/scylladb/scylla-operator/pkg/version"
 	"github.com/spf13/cobra"
@@ -174,7 +175,7 @@ func (o *CleanupJobOptions) runCleanup(ctx context.Context) error {
 		return fmt.Errorf("can't stop the cleanup: %w", err)
 	}
 
-	keyspaces, err := o.scyllaClient.Keyspaces(ctx)
+	keyspaces, err := o.getCleanupKeyspaces(ctx)
 	if err != nil {
 		return fmt.Errorf("can't get list of keyspaces: %w", err)
 	}
@@ -203,3 +204,23 @@ func (o *CleanupJobOptions) runCleanup(ctx context.Context) error {
 
 	return nil
 }
+
+// getCleanupKeyspaces returns keyspaces which need a cleanup after a topology change.
+// Tablets are migrated together with their data, so keyspaces using them are never cleaned up.
+func (o *CleanupJobOptions) getCleanupKeyspaces(ctx context.Context) ([]string, error) {
+	version, err := o.scyllaClient.ScyllaVersion(ctx, o.NodeAddress)
+	if err != nil {
+		return nil, fmt.Errorf("can't get ScyllaDB version: %w", err)
+	}
+
+	supportsTablets, err := scyllafeatures.VersionSupports(version, scyllafeatures.Tablets)
+	if err != nil {
+		return nil, fmt.Errorf("can't determine whether ScyllaDB version %q supports tablets: %w", version, err)
+	}
+
+	if !supportsTablets {
+		return o.scyllaClient.Keyspaces(ctx)
+	}
+
+	return o.scyllaClient.KeyspacesWithReplication(ctx, o.NodeAddress, scyllaclient.VnodesReplicationType)
+}
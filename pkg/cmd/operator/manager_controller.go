@@ -4,13 +4,19 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	neturl "net/url"
 	"sync"
 	"time"
 
+	apiclient "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
 	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/v3/swagger/gen/scylla-manager/client/operations"
 	scyllaversionedclient "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned"
 	scyllainformers "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions"
 	"github.com/scylladb/scylla-operator/pkg/controller/manager"
+	"github.com/scylladb/scylla-operator/pkg/controller/scylladbbackup"
+	"github.com/scylladb/scylla-operator/pkg/controller/scylladbrepair"
 	"github.com/scylladb/scylla-operator/pkg/genericclioptions"
 	"github.com/scylladb/scylla-operator/pkg/leaderelection"
 	"github.com/scylladb/scylla-operator/pkg/naming"
@@ -32,6 +38,8 @@ type ManagerControllerOptions struct {
 	kubeClient    kubernetes.Interface
 	scyllaClient  scyllaversionedclient.Interface
 	managerClient *managerclient.Client
+	// managerOperations is used for calls that managerClient doesn't expose the results of.
+	managerOperations operations.ClientService
 
 	ConcurrentSyncs int
 }
@@ -135,6 +143,15 @@ func (o *ManagerControllerOptions) Complete() error {
 	}
 	o.managerClient = &managerClient
 
+	managerURL, err := neturl.Parse(url)
+	if err != nil {
+		return fmt.Errorf("can't parse manager url %q: %w", url, err)
+	}
+	o.managerOperations = operations.New(apiclient.NewWithClient(managerURL.Host, managerURL.Path, []string{managerURL.Scheme}, &http.Client{
+		Transport: http.DefaultTransport,
+		Timeout:   15 * time.Second,
+	}), strfmt.Default)
+
 	return nil
 }
 
@@ -175,14 +192,37 @@ func (o *ManagerControllerOptions) run(ctx context.Context, streams genericcliop
 	scc, err := manager.NewController(
 		o.kubeClient,
 		o.scyllaClient.ScyllaV1(),
+		o.scyllaClient.ScyllaV1alpha1(),
 		kubeInformers.Core().V1().Secrets(),
 		scyllaInformers.Scylla().V1().ScyllaClusters(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBBackupInventories(),
 		o.managerClient,
+		o.managerOperations,
 	)
 	if err != nil {
 		return err
 	}
 
+	sbrc, err := scylladbrepair.NewController(
+		o.scyllaClient,
+		scyllaInformers.Scylla().V1().ScyllaClusters(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBRepairs(),
+		o.managerClient,
+	)
+	if err != nil {
+		return fmt.Errorf("can't create ScyllaDBRepair controller: %w", err)
+	}
+
+	sbbc, err := scylladbbackup.NewController(
+		o.scyllaClient,
+		scyllaInformers.Scylla().V1().ScyllaClusters(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBBackups(),
+		o.managerClient,
+	)
+	if err != nil {
+		return fmt.Errorf("can't create ScyllaDBBackup controller: %w", err)
+	}
+
 	var wg sync.WaitGroup
 	defer wg.Wait()
 
@@ -203,6 +243,18 @@ func (o *ManagerControllerOptions) run(ctx context.Context, streams genericcliop
 		scc.Run(ctx, o.ConcurrentSyncs)
 	}()
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		sbrc.Run(ctx, o.ConcurrentSyncs)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		sbbc.Run(ctx, o.ConcurrentSyncs)
+	}()
+
 	<-ctx.Done()
 
 	return nil
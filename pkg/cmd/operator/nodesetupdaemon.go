@@ -4,6 +4,7 @@ package operator
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sync"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/scylladb/scylla-operator/pkg/kubelet"
 	"github.com/scylladb/scylla-operator/pkg/naming"
 	"github.com/scylladb/scylla-operator/pkg/signals"
+	"github.com/scylladb/scylla-operator/pkg/util/cloud"
 	"github.com/scylladb/scylla-operator/pkg/version"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
@@ -222,6 +224,34 @@ func (o *NodeSetupDaemonOptions) Run(streams genericclioptions.IOStreams, cmd *c
 		return fmt.Errorf("can't get node %q: %w", o.NodeName, err)
 	}
 
+	// Instance metadata only improves the setup, so failing to discover it mustn't prevent setting up the node.
+	var instanceMetadata *cloud.InstanceMetadata
+	var discoverErr error
+	err = wait.ExponentialBackoffWithContext(ctx, retry.DefaultBackoff, func(fCtx context.Context) (bool, error) {
+		instanceMetadata, discoverErr = cloud.Discover(fCtx)
+		if discoverErr != nil {
+			klog.V(2).InfoS("Can't discover instance metadata", "Error", discoverErr.Error())
+			return false, nil
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		klog.ErrorS(discoverErr, "Can't discover instance metadata, skipping default topology labels and cloud specific tuning")
+		instanceMetadata = nil
+	} else {
+		klog.InfoS("Discovered instance metadata", "CloudProvider", instanceMetadata.CloudProvider, "InstanceType", instanceMetadata.InstanceType, "Region", instanceMetadata.Region, "Zone", instanceMetadata.Zone)
+
+		err = setDefaultTopologyLabels(ctx, o.kubeClient, node, instanceMetadata)
+		if err != nil {
+			return fmt.Errorf("can't set default topology labels on node %q: %w", o.NodeName, err)
+		}
+	}
+
 	nsc, err := nodesetup.NewController(
 		ctx,
 		o.kubeClient,
@@ -255,6 +285,7 @@ func (o *NodeSetupDaemonOptions) Run(streams genericclioptions.IOStreams, cmd *c
 		types.UID(o.NodeConfigUID),
 		o.ScyllaImage,
 		o.OperatorImage,
+		instanceMetadata,
 	)
 	if err != nil {
 		return fmt.Errorf("can't create node config instance controller: %w", err)
@@ -284,3 +315,38 @@ func (o *NodeSetupDaemonOptions) Run(streams genericclioptions.IOStreams, cmd *c
 
 	return nil
 }
+
+// setDefaultTopologyLabels sets topology labels discovered from instance metadata, which are missing on the Node.
+// Clusters without a cloud provider integration don't label Nodes, while racks are commonly placed by them.
+// Existing labels are never overwritten.
+func setDefaultTopologyLabels(ctx context.Context, kubeClient kubernetes.Interface, node *corev1.Node, im *cloud.InstanceMetadata) error {
+	missingLabels := map[string]string{}
+	for k, v := range im.TopologyLabels() {
+		_, ok := node.Labels[k]
+		if !ok {
+			missingLabels[k] = v
+		}
+	}
+
+	if len(missingLabels) == 0 {
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"labels": missingLabels,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("can't marshal patch: %w", err)
+	}
+
+	_, err = kubeClient.CoreV1().Nodes().Patch(ctx, node.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("can't patch node: %w", err)
+	}
+
+	klog.InfoS("Set default topology labels", "Node", klog.KObj(node), "Labels", missingLabels)
+
+	return nil
+}
@@ -9,12 +9,17 @@ import (
 
 	scyllaversionedclient "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned"
 	scyllainformers "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions"
+	"github.com/scylladb/scylla-operator/pkg/controller/automaticracks"
 	"github.com/scylladb/scylla-operator/pkg/controller/nodeconfig"
 	"github.com/scylladb/scylla-operator/pkg/controller/nodeconfigpod"
 	"github.com/scylladb/scylla-operator/pkg/controller/orphanedpv"
 	"github.com/scylladb/scylla-operator/pkg/controller/scyllacluster"
+	"github.com/scylladb/scylla-operator/pkg/controller/scylladbcluster"
 	"github.com/scylladb/scylla-operator/pkg/controller/scylladbdatacenter"
+	"github.com/scylladb/scylla-operator/pkg/controller/scylladbdatacenterautoscaler"
+	"github.com/scylladb/scylla-operator/pkg/controller/scylladbkeyspace"
 	"github.com/scylladb/scylla-operator/pkg/controller/scylladbmonitoring"
+	"github.com/scylladb/scylla-operator/pkg/controller/scylladbrole"
 	"github.com/scylladb/scylla-operator/pkg/controller/scyllaoperatorconfig"
 	"github.com/scylladb/scylla-operator/pkg/crypto"
 	monitoringversionedclient "github.com/scylladb/scylla-operator/pkg/externalclient/monitoring/clientset/versioned"
@@ -268,6 +273,8 @@ func (o *OperatorOptions) run(ctx context.Context, streams genericclioptions.IOS
 		kubeInformers.Policy().V1().PodDisruptionBudgets(),
 		kubeInformers.Networking().V1().Ingresses(),
 		kubeInformers.Batch().V1().Jobs(),
+		kubeInformers.Core().V1().PersistentVolumeClaims(),
+		kubeInformers.Storage().V1().StorageClasses(),
 		scyllaInformers.Scylla().V1alpha1().ScyllaDBDatacenters(),
 		o.OperatorImage,
 		o.CQLSIngressPort,
@@ -296,10 +303,50 @@ func (o *OperatorOptions) run(ctx context.Context, streams genericclioptions.IOS
 		return fmt.Errorf("can't create scyllacluster controller: %w", err)
 	}
 
+	scdbc, err := scylladbcluster.NewController(
+		o.kubeClient,
+		o.scyllaClient,
+		kubeInformers.Core().V1().Secrets(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBClusters(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBDatacenters(),
+	)
+	if err != nil {
+		return fmt.Errorf("can't create scylladbcluster controller: %w", err)
+	}
+
+	skc, err := scylladbkeyspace.NewController(
+		o.kubeClient,
+		o.scyllaClient,
+		kubeInformers.Core().V1().Secrets(),
+		kubeInformers.Core().V1().Services(),
+		kubeInformers.Core().V1().Pods(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBDatacenters(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBKeyspaces(),
+	)
+	if err != nil {
+		return fmt.Errorf("can't create scylladbkeyspace controller: %w", err)
+	}
+
+	src, err := scylladbrole.NewController(
+		o.kubeClient,
+		o.scyllaClient,
+		kubeInformers.Core().V1().Secrets(),
+		kubeInformers.Core().V1().Services(),
+		kubeInformers.Core().V1().Pods(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBDatacenters(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBRoles(),
+	)
+	if err != nil {
+		return fmt.Errorf("can't create scylladbrole controller: %w", err)
+	}
+
 	opc, err := orphanedpv.NewController(
 		o.kubeClient,
+		o.scyllaClient.ScyllaV1alpha1(),
 		kubeInformers.Core().V1().PersistentVolumes(),
 		kubeInformers.Core().V1().PersistentVolumeClaims(),
+		kubeInformers.Core().V1().Pods(),
+		kubeInformers.Core().V1().Services(),
 		kubeInformers.Core().V1().Nodes(),
 		scyllaInformers.Scylla().V1alpha1().ScyllaDBDatacenters(),
 	)
@@ -307,6 +354,30 @@ func (o *OperatorOptions) run(ctx context.Context, streams genericclioptions.IOS
 		return fmt.Errorf("can't create orphanpv controller: %w", err)
 	}
 
+	arc, err := automaticracks.NewController(
+		o.kubeClient,
+		o.scyllaClient.ScyllaV1alpha1(),
+		kubeInformers.Core().V1().Nodes(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBDatacenters(),
+	)
+	if err != nil {
+		return fmt.Errorf("can't create automaticracks controller: %w", err)
+	}
+
+	sdcac, err := scylladbdatacenterautoscaler.NewController(
+		o.kubeClient,
+		o.scyllaClient,
+		kubeInformers.Core().V1().Secrets(),
+		kubeInformers.Core().V1().Services(),
+		kubeInformers.Core().V1().Pods(),
+		kubeInformers.Core().V1().PersistentVolumeClaims(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBDatacenters(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBDatacenterAutoscalers(),
+	)
+	if err != nil {
+		return fmt.Errorf("can't create scylladbdatacenterautoscaler controller: %w", err)
+	}
+
 	ncc, err := nodeconfig.NewController(
 		o.kubeClient,
 		o.scyllaClient.ScyllaV1alpha1(),
@@ -415,12 +486,42 @@ func (o *OperatorOptions) run(ctx context.Context, streams genericclioptions.IOS
 		scc.Run(ctx, o.ConcurrentSyncs)
 	}()
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		scdbc.Run(ctx, o.ConcurrentSyncs)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		skc.Run(ctx, o.ConcurrentSyncs)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		src.Run(ctx, o.ConcurrentSyncs)
+	}()
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
 		opc.Run(ctx, o.ConcurrentSyncs)
 	}()
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		arc.Run(ctx, o.ConcurrentSyncs)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		sdcac.Run(ctx, o.ConcurrentSyncs)
+	}()
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
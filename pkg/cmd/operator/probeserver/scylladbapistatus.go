@@ -30,6 +30,15 @@ type ScyllaDBAPIStatusOptions struct {
 	genericclioptions.InClusterReflection
 	ServiceName string
 
+	CQLReadinessCheck bool
+	CQLAddress        string
+	CQLTLSCAFile      string
+	CQLTLSServerName  string
+	CQLTLSCertFile    string
+	CQLTLSKeyFile     string
+	CQLUsername       string
+	CQLPasswordFile   string
+
 	mux        *http.ServeMux
 	kubeClient kubernetes.Interface
 }
@@ -40,6 +49,7 @@ func NewScyllaDBAPIStatusOptions(streams genericclioptions.IOStreams) *ScyllaDBA
 	return &ScyllaDBAPIStatusOptions{
 		ServeProbesOptions: *NewServeProbesOptions(streams, naming.ScyllaDBAPIStatusProbePort, mux),
 		ClientConfig:       genericclioptions.NewClientConfig("scylla-operator-scylladb-api-status-probe"),
+		CQLAddress:         "localhost:9042",
 		mux:                mux,
 	}
 }
@@ -50,6 +60,14 @@ func (o *ScyllaDBAPIStatusOptions) AddFlags(cmd *cobra.Command) {
 	o.InClusterReflection.AddFlags(cmd)
 
 	cmd.Flags().StringVarP(&o.ServiceName, "service-name", "", o.ServiceName, "Name of the service corresponding to the managed node.")
+	cmd.Flags().BoolVarP(&o.CQLReadinessCheck, "cql-readiness-check", "", o.CQLReadinessCheck, "Requires the node to serve CQL queries to be ready.")
+	cmd.Flags().StringVarP(&o.CQLAddress, "cql-address", "", o.CQLAddress, "Address of the CQL listener used by the readiness check.")
+	cmd.Flags().StringVarP(&o.CQLTLSCAFile, "cql-tls-ca-file", "", o.CQLTLSCAFile, "Path to the CA bundle verifying the serving certificate of the CQL listener. Enables TLS when set.")
+	cmd.Flags().StringVarP(&o.CQLTLSServerName, "cql-tls-server-name", "", o.CQLTLSServerName, "Server name used to verify the serving certificate of the CQL listener.")
+	cmd.Flags().StringVarP(&o.CQLTLSCertFile, "cql-tls-cert-file", "", o.CQLTLSCertFile, "Path to the client certificate presented to the CQL listener.")
+	cmd.Flags().StringVarP(&o.CQLTLSKeyFile, "cql-tls-key-file", "", o.CQLTLSKeyFile, "Path to the key of the client certificate presented to the CQL listener.")
+	cmd.Flags().StringVarP(&o.CQLUsername, "cql-username", "", o.CQLUsername, "Username used to authenticate the CQL readiness check.")
+	cmd.Flags().StringVarP(&o.CQLPasswordFile, "cql-password-file", "", o.CQLPasswordFile, "Path to the file holding the password used to authenticate the CQL readiness check.")
 }
 
 func NewScyllaDBAPIStatusCmd(streams genericclioptions.IOStreams) *cobra.Command {
@@ -102,6 +120,24 @@ func (o *ScyllaDBAPIStatusOptions) Validate(args []string) error {
 		}
 	}
 
+	if o.CQLReadinessCheck {
+		if len(o.CQLAddress) == 0 {
+			errs = append(errs, fmt.Errorf("cql-address can't be empty"))
+		}
+
+		if (len(o.CQLTLSCertFile) == 0) != (len(o.CQLTLSKeyFile) == 0) {
+			errs = append(errs, fmt.Errorf("cql-tls-cert-file and cql-tls-key-file have to be set together"))
+		}
+
+		if len(o.CQLTLSCertFile) != 0 && len(o.CQLTLSCAFile) == 0 {
+			errs = append(errs, fmt.Errorf("cql-tls-cert-file requires cql-tls-ca-file to be set"))
+		}
+
+		if (len(o.CQLUsername) == 0) != (len(o.CQLPasswordFile) == 0) {
+			errs = append(errs, fmt.Errorf("cql-username and cql-password-file have to be set together"))
+		}
+	}
+
 	return apierrors.NewAggregate(errs)
 }
 
@@ -157,10 +193,24 @@ func (o *ScyllaDBAPIStatusOptions) Execute(ctx context.Context, originalStreams
 	)
 	singleServiceInformer := singleServiceKubeInformers.Core().V1().Services()
 
+	var cqlReadinessCheck *scylladbapistatus.CQLReadinessCheck
+	if o.CQLReadinessCheck {
+		cqlReadinessCheck = &scylladbapistatus.CQLReadinessCheck{
+			Address:       o.CQLAddress,
+			TLSCAFile:     o.CQLTLSCAFile,
+			TLSServerName: o.CQLTLSServerName,
+			TLSCertFile:   o.CQLTLSCertFile,
+			TLSKeyFile:    o.CQLTLSKeyFile,
+			Username:      o.CQLUsername,
+			PasswordFile:  o.CQLPasswordFile,
+		}
+	}
+
 	prober := scylladbapistatus.NewProber(
 		o.Namespace,
 		o.ServiceName,
 		singleServiceInformer.Lister(),
+		cqlReadinessCheck,
 	)
 
 	o.mux.HandleFunc(naming.LivenessProbePath, prober.Healthz)
@@ -3,6 +3,7 @@ package operator
 import (
 	"context"
 	"fmt"
+	"path"
 	"sync"
 	"syscall"
 	"time"
@@ -13,18 +14,23 @@ import (
 	sidecarcontroller "github.com/scylladb/scylla-operator/pkg/controller/sidecar"
 	"github.com/scylladb/scylla-operator/pkg/genericclioptions"
 	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/naming"
 	"github.com/scylladb/scylla-operator/pkg/sidecar/config"
 	"github.com/scylladb/scylla-operator/pkg/sidecar/identity"
+	"github.com/scylladb/scylla-operator/pkg/sidecar/snapshot"
 	"github.com/scylladb/scylla-operator/pkg/signals"
 	"github.com/scylladb/scylla-operator/pkg/version"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
 	cliflag "k8s.io/component-base/cli/flag"
 	"k8s.io/klog/v2"
 )
@@ -38,6 +44,7 @@ type SidecarOptions struct {
 	ExternalSeeds                     []string
 	NodesBroadcastAddressTypeString   string
 	ClientsBroadcastAddressTypeString string
+	InternodeCertificatesDir          string
 
 	nodesBroadcastAddressType   scyllav1alpha1.BroadcastAddressType
 	clientsBroadcastAddressType scyllav1alpha1.BroadcastAddressType
@@ -96,6 +103,7 @@ func NewSidecarCmd(streams genericclioptions.IOStreams) *cobra.Command {
 	cmd.Flags().StringSliceVar(&o.ExternalSeeds, "external-seeds", o.ExternalSeeds, "The external seeds to propagate to ScyllaDB binary on startup as \"seeds\" parameter of seed-provider.")
 	cmd.Flags().StringVarP(&o.NodesBroadcastAddressTypeString, "nodes-broadcast-address-type", "", o.NodesBroadcastAddressTypeString, "Address type that is broadcasted for communication with other nodes.")
 	cmd.Flags().StringVarP(&o.ClientsBroadcastAddressTypeString, "clients-broadcast-address-type", "", o.ClientsBroadcastAddressTypeString, "Address type that is broadcasted for communication with clients.")
+	cmd.Flags().StringVarP(&o.InternodeCertificatesDir, "internode-certificates-dir", "", o.InternodeCertificatesDir, "Directory into which the internode certificate of the managed node is projected. Projection is disabled when empty.")
 
 	return cmd
 }
@@ -212,6 +220,27 @@ func (o *SidecarOptions) Run(streams genericclioptions.IOStreams, cmd *cobra.Com
 		return fmt.Errorf("can't create new member from objects: %w", err)
 	}
 
+	if len(o.InternodeCertificatesDir) != 0 {
+		klog.V(2).InfoS("Waiting for internode certificates", "Directory", o.InternodeCertificatesDir)
+		err = wait.PollUntilContextCancel(ctx, 5*time.Second, true, func(ctx context.Context) (bool, error) {
+			_, err := config.ProjectInternodeCertificates(ctx, o.kubeClient.CoreV1(), o.Namespace, o.ServiceName, o.InternodeCertificatesDir)
+			if err != nil {
+				klog.V(2).InfoS("Internode certificates are not available yet", "Error", err)
+				return false, nil
+			}
+
+			return true, nil
+		})
+		if err != nil {
+			return fmt.Errorf("can't wait for internode certificates: %w", err)
+		}
+	}
+
+	err = o.restoreSystemSnapshot(ctx, service)
+	if err != nil {
+		return fmt.Errorf("can't restore system snapshot: %w", err)
+	}
+
 	klog.V(2).InfoS("Starting scylla")
 
 	cfg := config.NewScyllaConfig(member, o.kubeClient, o.CPUCount, o.ExternalSeeds)
@@ -234,6 +263,25 @@ func (o *SidecarOptions) Run(streams genericclioptions.IOStreams, cmd *cobra.Com
 		sc.Run(ctx)
 	}()
 
+	// Keep the internode certificates up to date. ScyllaDB reloads them when the files change.
+	if len(o.InternodeCertificatesDir) != 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wait.UntilWithContext(ctx, func(ctx context.Context) {
+				changed, err := config.ProjectInternodeCertificates(ctx, o.kubeClient.CoreV1(), o.Namespace, o.ServiceName, o.InternodeCertificatesDir)
+				if err != nil {
+					klog.ErrorS(err, "Can't project internode certificates")
+					return
+				}
+
+				if changed {
+					klog.InfoS("Internode certificates have been updated", "Directory", o.InternodeCertificatesDir)
+				}
+			}, time.Minute)
+		}()
+	}
+
 	// Run scylla in a new process.
 	err = scyllaCmd.Start()
 	if err != nil {
@@ -250,6 +298,23 @@ func (o *SidecarOptions) Run(streams genericclioptions.IOStreams, cmd *cobra.Com
 		}
 	}()
 
+	configReloader, err := cfg.NewConfigReloader(scyllaCmd.Process.Pid)
+	if err != nil {
+		return fmt.Errorf("can't create config reloader: %w", err)
+	}
+
+	// Apply config changes that don't need a restart. Changes requiring a restart are rolled out by the operator.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		wait.UntilWithContext(ctx, func(ctx context.Context) {
+			err := configReloader.Reload()
+			if err != nil {
+				klog.ErrorS(err, "Can't reload scylla config")
+			}
+		}, 10*time.Second)
+	}()
+
 	// Terminate the scylla process.
 	wg.Add(1)
 	go func() {
@@ -270,3 +335,39 @@ func (o *SidecarOptions) Run(streams genericclioptions.IOStreams, cmd *cobra.Com
 
 	return nil
 }
+
+// restoreSystemSnapshot restores system keyspaces from the snapshot requested by the operator when an upgrade
+// is rolled back. It has to run before ScyllaDB starts.
+func (o *SidecarOptions) restoreSystemSnapshot(ctx context.Context, service *corev1.Service) error {
+	tag, ok := service.Annotations[naming.RestoreSystemSnapshotAnnotation]
+	if !ok || service.Annotations[naming.RestoredSystemSnapshotAnnotation] == tag {
+		return nil
+	}
+
+	klog.InfoS("Restoring system keyspaces from snapshot", "Tag", tag)
+	restored, err := snapshot.RestoreTables(path.Join(naming.DataDir, "data"), tag)
+	if err != nil {
+		return err
+	}
+	if restored == 0 {
+		// There is nothing to restore from, the node has likely been replaced since the snapshot was taken.
+		klog.InfoS("No table has a snapshot with the tag, skipping restore", "Tag", tag)
+		return nil
+	}
+	klog.InfoS("Restored system keyspaces from snapshot", "Tag", tag, "Tables", restored)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		svc, err := o.kubeClient.CoreV1().Services(o.Namespace).Get(ctx, o.ServiceName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if svc.Annotations == nil {
+			svc.Annotations = map[string]string{}
+		}
+		svc.Annotations[naming.RestoredSystemSnapshotAnnotation] = tag
+
+		_, err = o.kubeClient.CoreV1().Services(o.Namespace).Update(ctx, svc, metav1.UpdateOptions{})
+		return err
+	})
+}
@@ -52,6 +52,34 @@ var (
 			ValidateCreateFunc: validation.ValidateScyllaDBDatacenter,
 			ValidateUpdateFunc: validation.ValidateScyllaDBDatacenterUpdate,
 		},
+		scyllav1alpha1.GroupVersion.WithResource("scylladbclusters"): &GenericValidator[*scyllav1alpha1.ScyllaDBCluster]{
+			ValidateCreateFunc: validation.ValidateScyllaDBCluster,
+			ValidateUpdateFunc: validation.ValidateScyllaDBClusterUpdate,
+		},
+		scyllav1alpha1.GroupVersion.WithResource("scylladbkeyspaces"): &GenericValidator[*scyllav1alpha1.ScyllaDBKeyspace]{
+			ValidateCreateFunc: validation.ValidateScyllaDBKeyspace,
+			ValidateUpdateFunc: validation.ValidateScyllaDBKeyspaceUpdate,
+		},
+		scyllav1alpha1.GroupVersion.WithResource("scylladbroles"): &GenericValidator[*scyllav1alpha1.ScyllaDBRole]{
+			ValidateCreateFunc: validation.ValidateScyllaDBRole,
+			ValidateUpdateFunc: validation.ValidateScyllaDBRoleUpdate,
+		},
+		scyllav1alpha1.GroupVersion.WithResource("scylladbdatacenterautoscalers"): &GenericValidator[*scyllav1alpha1.ScyllaDBDatacenterAutoscaler]{
+			ValidateCreateFunc: validation.ValidateScyllaDBDatacenterAutoscaler,
+			ValidateUpdateFunc: validation.ValidateScyllaDBDatacenterAutoscalerUpdate,
+		},
+		scyllav1alpha1.GroupVersion.WithResource("scylladbbackupinventories"): &GenericValidator[*scyllav1alpha1.ScyllaDBBackupInventory]{
+			ValidateCreateFunc: validation.ValidateScyllaDBBackupInventory,
+			ValidateUpdateFunc: validation.ValidateScyllaDBBackupInventoryUpdate,
+		},
+		scyllav1alpha1.GroupVersion.WithResource("scylladbrepairs"): &GenericValidator[*scyllav1alpha1.ScyllaDBRepair]{
+			ValidateCreateFunc: validation.ValidateScyllaDBRepair,
+			ValidateUpdateFunc: validation.ValidateScyllaDBRepairUpdate,
+		},
+		scyllav1alpha1.GroupVersion.WithResource("scylladbbackups"): &GenericValidator[*scyllav1alpha1.ScyllaDBBackup]{
+			ValidateCreateFunc: validation.ValidateScyllaDBBackup,
+			ValidateUpdateFunc: validation.ValidateScyllaDBBackupUpdate,
+		},
 	}
 )
 
@@ -0,0 +1,252 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package automaticracks
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scyllav1alpha1client "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned/typed/scylla/v1alpha1"
+	scyllav1alpha1informers "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/scylla/v1alpha1"
+	scyllav1alpha1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/scheme"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+)
+
+const (
+	ControllerName = "AutomaticRacksController"
+	// maxSyncDuration enforces preemption. Do not raise the value! Controllers shouldn't actively wait,
+	// but rather use the queue.
+	maxSyncDuration = 30 * time.Second
+
+	// defaultZoneRemovalGracePeriod is used when the grace period isn't set, e.g. on objects created before it was added.
+	defaultZoneRemovalGracePeriod = 10 * time.Minute
+)
+
+var (
+	keyFunc = cache.DeletionHandlingMetaNamespaceKeyFunc
+)
+
+// Controller manages racks of ScyllaDBDatacenters which have automatic racks enabled, by generating one rack
+// for every zone of Nodes.
+type Controller struct {
+	kubeClient   kubernetes.Interface
+	scyllaClient scyllav1alpha1client.ScyllaV1alpha1Interface
+
+	nodeLister               corev1listers.NodeLister
+	scyllaDBDatacenterLister scyllav1alpha1listers.ScyllaDBDatacenterLister
+
+	cachesToSync []cache.InformerSynced
+
+	eventRecorder record.EventRecorder
+
+	queue workqueue.RateLimitingInterface
+
+	wg sync.WaitGroup
+}
+
+func NewController(
+	kubeClient kubernetes.Interface,
+	scyllaClient scyllav1alpha1client.ScyllaV1alpha1Interface,
+	nodeInformer corev1informers.NodeInformer,
+	scyllaDBDatacenterInformer scyllav1alpha1informers.ScyllaDBDatacenterInformer,
+) (*Controller, error) {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartStructuredLogging(0)
+	eventBroadcaster.StartRecordingToSink(&corev1client.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+
+	arc := &Controller{
+		kubeClient:               kubeClient,
+		scyllaClient:             scyllaClient,
+		nodeLister:               nodeInformer.Lister(),
+		scyllaDBDatacenterLister: scyllaDBDatacenterInformer.Lister(),
+
+		cachesToSync: []cache.InformerSynced{
+			nodeInformer.Informer().HasSynced,
+			scyllaDBDatacenterInformer.Informer().HasSynced,
+		},
+
+		eventRecorder: eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "automaticracks-controller"}),
+
+		queue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "automaticracks"),
+	}
+
+	nodeInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    arc.addNode,
+		UpdateFunc: arc.updateNode,
+		DeleteFunc: arc.deleteNode,
+	})
+
+	scyllaDBDatacenterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    arc.addScyllaDBDatacenter,
+		UpdateFunc: arc.updateScyllaDBDatacenter,
+	})
+
+	return arc, nil
+}
+
+func (arc *Controller) processNextItem(ctx context.Context) bool {
+	key, quit := arc.queue.Get()
+	if quit {
+		return false
+	}
+	defer arc.queue.Done(key)
+
+	ctx, cancel := context.WithTimeout(ctx, maxSyncDuration)
+	defer cancel()
+	err := arc.sync(ctx, key.(string))
+	// TODO: Do smarter filtering then just Reduce to handle cases like 2 conflict errors.
+	err = utilerrors.Reduce(err)
+	switch {
+	case err == nil:
+		arc.queue.Forget(key)
+		return true
+
+	case apierrors.IsConflict(err):
+		klog.V(2).InfoS("Hit conflict, will retry in a bit", "Key", key, "Error", err)
+
+	default:
+		utilruntime.HandleError(fmt.Errorf("syncing key '%v' failed: %v", key, err))
+	}
+
+	arc.queue.AddRateLimited(key)
+
+	return true
+}
+
+func (arc *Controller) runWorker(ctx context.Context) {
+	for arc.processNextItem(ctx) {
+	}
+}
+
+func (arc *Controller) Run(ctx context.Context, workers int) {
+	defer utilruntime.HandleCrash()
+
+	klog.InfoS("Starting controller", "controller", "AutomaticRacks")
+
+	defer func() {
+		klog.InfoS("Shutting down controller", "controller", "AutomaticRacks")
+		arc.queue.ShutDown()
+		arc.wg.Wait()
+		klog.InfoS("Shut down controller", "controller", "AutomaticRacks")
+	}()
+
+	if !cache.WaitForNamedCacheSync(ControllerName, ctx.Done(), arc.cachesToSync...) {
+		return
+	}
+
+	for range workers {
+		arc.wg.Add(1)
+		go func() {
+			defer arc.wg.Done()
+			wait.UntilWithContext(ctx, arc.runWorker, time.Second)
+		}()
+	}
+
+	<-ctx.Done()
+}
+
+func (arc *Controller) enqueue(sdc *scyllav1alpha1.ScyllaDBDatacenter) {
+	if sdc.Spec.AutomaticRacks == nil {
+		return
+	}
+
+	key, err := keyFunc(sdc)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %#v: %v", sdc, err))
+		return
+	}
+
+	klog.V(4).InfoS("Enqueuing", "ScyllaDBDatacenter", klog.KObj(sdc))
+	arc.queue.Add(key)
+}
+
+func (arc *Controller) enqueueAllScyllaDBDatacentersOnBackground() {
+	arc.wg.Add(1)
+	go func() {
+		defer arc.wg.Done()
+
+		klog.V(4).InfoS("Enqueuing all ScyllaDBDatacenters")
+
+		// This gets called from an informer handler which doesn't wait for cache sync,
+		// but any ScyllaDBDatacenter that won't list here is gonna be queued later on addition
+		// by the ScyllaDBDatacenter handler.
+		sdcs, err := arc.scyllaDBDatacenterLister.ScyllaDBDatacenters(corev1.NamespaceAll).List(labels.Everything())
+		if err != nil {
+			utilruntime.HandleError(err)
+			return
+		}
+
+		for _, sdc := range sdcs {
+			arc.enqueue(sdc)
+		}
+	}()
+}
+
+func (arc *Controller) addNode(obj interface{}) {
+	node := obj.(*corev1.Node)
+	klog.V(4).InfoS("Observed addition of Node", "Node", klog.KObj(node))
+	arc.enqueueAllScyllaDBDatacentersOnBackground()
+}
+
+func (arc *Controller) updateNode(old, cur interface{}) {
+	oldNode := old.(*corev1.Node)
+	currentNode := cur.(*corev1.Node)
+
+	// Only labels are used to discover zones.
+	if currentNode.UID == oldNode.UID && reflect.DeepEqual(currentNode.Labels, oldNode.Labels) {
+		return
+	}
+
+	klog.V(4).InfoS("Observed update of Node", "Node", klog.KObj(currentNode))
+	arc.enqueueAllScyllaDBDatacentersOnBackground()
+}
+
+func (arc *Controller) deleteNode(obj interface{}) {
+	node, ok := obj.(*corev1.Node)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("couldn't get object from tombstone %#v", obj))
+			return
+		}
+		node, ok = tombstone.Obj.(*corev1.Node)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("tombstone contained object that is not a Node %#v", obj))
+			return
+		}
+	}
+	klog.V(4).InfoS("Observed deletion of Node", "Node", klog.KObj(node))
+	arc.enqueueAllScyllaDBDatacentersOnBackground()
+}
+
+func (arc *Controller) addScyllaDBDatacenter(obj interface{}) {
+	sdc := obj.(*scyllav1alpha1.ScyllaDBDatacenter)
+	klog.V(4).InfoS("Observed addition of ScyllaDBDatacenter", "ScyllaDBDatacenter", klog.KObj(sdc))
+	arc.enqueue(sdc)
+}
+
+func (arc *Controller) updateScyllaDBDatacenter(old, cur interface{}) {
+	currentSDC := cur.(*scyllav1alpha1.ScyllaDBDatacenter)
+
+	// Status updates are observed as well, racks wait for them to be scaled down and removed.
+	klog.V(4).InfoS("Observed update of ScyllaDBDatacenter", "ScyllaDBDatacenter", klog.KObj(currentSDC))
+	arc.enqueue(currentSDC)
+}
@@ -0,0 +1,310 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package automaticracks
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
+)
+
+// getZones returns sorted zones of the Nodes matching the rack template placement.
+func getZones(sdc *scyllav1alpha1.ScyllaDBDatacenter, nodes []*corev1.Node) ([]string, error) {
+	var nodeSelector *nodeaffinity.LazyErrorNodeSelector
+	topologySelector := labels.Everything()
+	if sdc.Spec.RackTemplate != nil {
+		if sdc.Spec.RackTemplate.Placement != nil &&
+			sdc.Spec.RackTemplate.Placement.NodeAffinity != nil &&
+			sdc.Spec.RackTemplate.Placement.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+			nodeSelector = nodeaffinity.NewLazyErrorNodeSelector(sdc.Spec.RackTemplate.Placement.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+		}
+
+		topologySelector = labels.SelectorFromSet(sdc.Spec.RackTemplate.TopologyLabelSelector)
+	}
+
+	zones := map[string]struct{}{}
+	for _, node := range nodes {
+		zone, ok := node.Labels[corev1.LabelTopologyZone]
+		if !ok || len(zone) == 0 {
+			continue
+		}
+
+		if len(sdc.Spec.AutomaticRacks.Zones) != 0 && !slices.ContainsItem(sdc.Spec.AutomaticRacks.Zones, zone) {
+			continue
+		}
+
+		if !topologySelector.Matches(labels.Set(node.Labels)) {
+			continue
+		}
+
+		if nodeSelector != nil {
+			matches, err := nodeSelector.Match(node)
+			if err != nil {
+				return nil, err
+			}
+			if !matches {
+				continue
+			}
+		}
+
+		zones[zone] = struct{}{}
+	}
+
+	sortedZones := make([]string, 0, len(zones))
+	for zone := range zones {
+		sortedZones = append(sortedZones, zone)
+	}
+	sort.Strings(sortedZones)
+
+	return sortedZones, nil
+}
+
+// getZoneRemovalGracePeriod returns how long a zone has to be missing before it's removed.
+func getZoneRemovalGracePeriod(sdc *scyllav1alpha1.ScyllaDBDatacenter) time.Duration {
+	if sdc.Spec.AutomaticRacks.ZoneRemovalGracePeriodSeconds == nil {
+		return defaultZoneRemovalGracePeriod
+	}
+
+	return time.Duration(*sdc.Spec.AutomaticRacks.ZoneRemovalGracePeriodSeconds) * time.Second
+}
+
+// makeZoneStatuses tracks the zones across syncs, so a zone disappearing only for a while doesn't change the racks.
+// It returns the statuses of the tracked zones, the sorted zones to generate racks for and the duration after which
+// a missing zone outlives the grace period, or zero when no zone is missing.
+// Zones of existing racks are tracked even without a status, e.g. right after the operator upgrade.
+// Zones excluded by the zones field are removed right away.
+func makeZoneStatuses(sdc *scyllav1alpha1.ScyllaDBDatacenter, observedZones []string, now time.Time) ([]scyllav1alpha1.AutomaticRacksZoneStatus, []string, time.Duration) {
+	missingSince := map[string]*metav1.Time{}
+	for _, rack := range sdc.Spec.Racks {
+		zone := getRackZone(&rack)
+		if len(zone) != 0 {
+			missingSince[zone] = nil
+		}
+	}
+
+	if sdc.Status.AutomaticRacks != nil {
+		for _, zs := range sdc.Status.AutomaticRacks.Zones {
+			missingSince[zs.Name] = zs.MissingSince
+		}
+	}
+
+	for _, zone := range observedZones {
+		missingSince[zone] = nil
+	}
+
+	gracePeriod := getZoneRemovalGracePeriod(sdc)
+	var requeueAfter time.Duration
+	zoneStatuses := make([]scyllav1alpha1.AutomaticRacksZoneStatus, 0, len(missingSince))
+	zones := make([]string, 0, len(missingSince))
+	for zone, since := range missingSince {
+		if len(sdc.Spec.AutomaticRacks.Zones) != 0 && !slices.ContainsItem(sdc.Spec.AutomaticRacks.Zones, zone) {
+			continue
+		}
+
+		if !slices.ContainsItem(observedZones, zone) {
+			if since == nil {
+				since = &metav1.Time{Time: now}
+			}
+
+			remaining := gracePeriod - now.Sub(since.Time)
+			if remaining <= 0 {
+				continue
+			}
+
+			if requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+		}
+
+		zoneStatuses = append(zoneStatuses, scyllav1alpha1.AutomaticRacksZoneStatus{
+			Name:         zone,
+			MissingSince: since,
+		})
+		zones = append(zones, zone)
+	}
+
+	sort.Slice(zoneStatuses, func(i, j int) bool {
+		return zoneStatuses[i].Name < zoneStatuses[j].Name
+	})
+	sort.Strings(zones)
+
+	return zoneStatuses, zones, requeueAfter
+}
+
+// splitNodes splits the nodes evenly between sorted zones. Zones that are first get the remaining nodes.
+func splitNodes(nodes int32, zones []string) map[string]int32 {
+	nodesPerZone := make(map[string]int32, len(zones))
+	if len(zones) == 0 {
+		return nodesPerZone
+	}
+
+	base := nodes / int32(len(zones))
+	remainder := nodes % int32(len(zones))
+	for i, zone := range zones {
+		nodesPerZone[zone] = base
+		if int32(i) < remainder {
+			nodesPerZone[zone]++
+		}
+	}
+
+	return nodesPerZone
+}
+
+// rackNameForZone returns a rack name derived from the zone.
+func rackNameForZone(zone string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-':
+			return r
+		default:
+			return '-'
+		}
+	}, strings.ToLower(zone))
+
+	return strings.Trim(name, "-")
+}
+
+func getRackZone(rack *scyllav1alpha1.RackSpec) string {
+	return rack.TopologyLabelSelector[corev1.LabelTopologyZone]
+}
+
+func getRackNodes(sdc *scyllav1alpha1.ScyllaDBDatacenter, rack *scyllav1alpha1.RackSpec) int32 {
+	if rack.Nodes != nil {
+		return *rack.Nodes
+	}
+
+	if sdc.Spec.RackTemplate != nil && sdc.Spec.RackTemplate.Nodes != nil {
+		return *sdc.Spec.RackTemplate.Nodes
+	}
+
+	return 0
+}
+
+func getRackStatus(sdc *scyllav1alpha1.ScyllaDBDatacenter, rackName string) (scyllav1alpha1.RackStatus, bool) {
+	if sdc.Status.ObservedGeneration == nil || *sdc.Status.ObservedGeneration < sdc.Generation {
+		return scyllav1alpha1.RackStatus{}, false
+	}
+
+	rackStatus, _, ok := slices.Find(sdc.Status.Racks, func(rs scyllav1alpha1.RackStatus) bool {
+		return rs.Name == rackName
+	})
+	if !ok || rackStatus.Stale == nil || *rackStatus.Stale {
+		return scyllav1alpha1.RackStatus{}, false
+	}
+
+	return rackStatus, true
+}
+
+func getStatusNodes(nodes *int32) int32 {
+	if nodes == nil {
+		return 0
+	}
+
+	return *nodes
+}
+
+// isRackSettled returns whether all nodes of the rack are up and ready, according to a fresh status.
+func isRackSettled(sdc *scyllav1alpha1.ScyllaDBDatacenter, rack *scyllav1alpha1.RackSpec) bool {
+	rackStatus, ok := getRackStatus(sdc, rack.Name)
+	if !ok {
+		return false
+	}
+
+	nodes := getRackNodes(sdc, rack)
+	return getStatusNodes(rackStatus.Nodes) == nodes && getStatusNodes(rackStatus.ReadyNodes) == nodes
+}
+
+// isRackEmpty returns whether the rack has been scaled down to zero, according to a fresh status.
+func isRackEmpty(sdc *scyllav1alpha1.ScyllaDBDatacenter, rack *scyllav1alpha1.RackSpec) bool {
+	if getRackNodes(sdc, rack) != 0 {
+		return false
+	}
+
+	rackStatus, ok := getRackStatus(sdc, rack.Name)
+	if !ok {
+		return false
+	}
+
+	return getStatusNodes(rackStatus.Nodes) == 0
+}
+
+// makeRacks returns the racks reconciled with the zones.
+// Capacity is never reduced before it's available elsewhere. Racks are scaled up and added right away, while
+// racks are scaled down one at a time, and only once all racks of the current zones are settled. Racks of removed zones are removed
+// once they have been scaled down to zero.
+func makeRacks(sdc *scyllav1alpha1.ScyllaDBDatacenter, zones []string) []scyllav1alpha1.RackSpec {
+	nodesPerZone := splitNodes(sdc.Spec.AutomaticRacks.Nodes, zones)
+
+	racks := make([]scyllav1alpha1.RackSpec, 0, len(sdc.Spec.Racks)+len(zones))
+	rackZones := map[string]struct{}{}
+	scalingUp := false
+	for _, r := range sdc.Spec.Racks {
+		rack := *r.DeepCopy()
+		zone := getRackZone(&rack)
+		rackZones[zone] = struct{}{}
+
+		_, hasZone := nodesPerZone[zone]
+		if !hasZone && isRackEmpty(sdc, &rack) {
+			continue
+		}
+
+		if nodesPerZone[zone] > getRackNodes(sdc, &rack) {
+			rack.Nodes = pointer.Ptr(nodesPerZone[zone])
+			scalingUp = true
+		}
+
+		racks = append(racks, rack)
+	}
+
+	for _, zone := range zones {
+		_, ok := rackZones[zone]
+		if ok {
+			continue
+		}
+
+		racks = append(racks, scyllav1alpha1.RackSpec{
+			Name: rackNameForZone(zone),
+			RackTemplate: scyllav1alpha1.RackTemplate{
+				Nodes: pointer.Ptr(nodesPerZone[zone]),
+				TopologyLabelSelector: map[string]string{
+					corev1.LabelTopologyZone: zone,
+				},
+			},
+		})
+		scalingUp = true
+	}
+
+	if scalingUp {
+		return racks
+	}
+
+	// Racks of removed zones are left out, because their nodes may never become ready again.
+	for i := range sdc.Spec.Racks {
+		_, hasZone := nodesPerZone[getRackZone(&sdc.Spec.Racks[i])]
+		if !hasZone {
+			continue
+		}
+
+		if !isRackSettled(sdc, &sdc.Spec.Racks[i]) {
+			return racks
+		}
+	}
+
+	for i := range racks {
+		nodes := nodesPerZone[getRackZone(&racks[i])]
+		if nodes < getRackNodes(sdc, &racks[i]) {
+			racks[i].Nodes = pointer.Ptr(nodes)
+			break
+		}
+	}
+
+	return racks
+}
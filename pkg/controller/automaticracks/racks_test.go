@@ -0,0 +1,609 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package automaticracks
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newNode(name string, labels map[string]string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+	}
+}
+
+func newZoneRack(name, zone string, nodes int32) scyllav1alpha1.RackSpec {
+	return scyllav1alpha1.RackSpec{
+		Name: name,
+		RackTemplate: scyllav1alpha1.RackTemplate{
+			Nodes: pointer.Ptr(nodes),
+			TopologyLabelSelector: map[string]string{
+				"topology.kubernetes.io/zone": zone,
+			},
+		},
+	}
+}
+
+func newRackStatus(name string, nodes, readyNodes int32) scyllav1alpha1.RackStatus {
+	return scyllav1alpha1.RackStatus{
+		Name:       name,
+		Nodes:      pointer.Ptr(nodes),
+		ReadyNodes: pointer.Ptr(readyNodes),
+		Stale:      pointer.Ptr(false),
+	}
+}
+
+func newScyllaDBDatacenter(nodes int32, racks []scyllav1alpha1.RackSpec, rackStatuses []scyllav1alpha1.RackStatus) *scyllav1alpha1.ScyllaDBDatacenter {
+	return &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "basic",
+			Namespace:  "scylla",
+			Generation: 2,
+		},
+		Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+			AutomaticRacks: &scyllav1alpha1.AutomaticRacks{
+				Nodes: nodes,
+			},
+			Racks: racks,
+		},
+		Status: scyllav1alpha1.ScyllaDBDatacenterStatus{
+			ObservedGeneration: pointer.Ptr[int64](2),
+			Racks:              rackStatuses,
+		},
+	}
+}
+
+func TestSplitNodes(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		nodes    int32
+		zones    []string
+		expected map[string]int32
+	}{
+		{
+			name:     "no zones",
+			nodes:    3,
+			zones:    nil,
+			expected: map[string]int32{},
+		},
+		{
+			name:  "nodes are split evenly",
+			nodes: 6,
+			zones: []string{"a", "b", "c"},
+			expected: map[string]int32{
+				"a": 2,
+				"b": 2,
+				"c": 2,
+			},
+		},
+		{
+			name:  "first zones get the remaining nodes",
+			nodes: 5,
+			zones: []string{"a", "b", "c"},
+			expected: map[string]int32{
+				"a": 2,
+				"b": 2,
+				"c": 1,
+			},
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := splitNodes(tc.nodes, tc.zones)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got nodes per zone differ: %s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func TestRackNameForZone(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		zone     string
+		expected string
+	}{
+		{
+			zone:     "us-east-1a",
+			expected: "us-east-1a",
+		},
+		{
+			zone:     "eastus-1",
+			expected: "eastus-1",
+		},
+		{
+			zone:     "Zone_A.1",
+			expected: "zone-a-1",
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.zone, func(t *testing.T) {
+			t.Parallel()
+
+			got := rackNameForZone(tc.zone)
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetZones(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*corev1.Node{
+		newNode("node-1", map[string]string{"topology.kubernetes.io/zone": "b", "pool": "scylla"}),
+		newNode("node-2", map[string]string{"topology.kubernetes.io/zone": "a", "pool": "scylla"}),
+		newNode("node-3", map[string]string{"topology.kubernetes.io/zone": "a", "pool": "scylla"}),
+		newNode("node-4", map[string]string{"topology.kubernetes.io/zone": "c", "pool": "default"}),
+		newNode("node-5", map[string]string{"pool": "scylla"}),
+	}
+
+	tt := []struct {
+		name     string
+		sdc      *scyllav1alpha1.ScyllaDBDatacenter
+		expected []string
+	}{
+		{
+			name:     "zones of all nodes",
+			sdc:      newScyllaDBDatacenter(3, nil, nil),
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name: "zones of nodes matching rack template node affinity",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newScyllaDBDatacenter(3, nil, nil)
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					Placement: &scyllav1alpha1.Placement{
+						NodeAffinity: &corev1.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+								NodeSelectorTerms: []corev1.NodeSelectorTerm{
+									{
+										MatchExpressions: []corev1.NodeSelectorRequirement{
+											{
+												Key:      "pool",
+												Operator: corev1.NodeSelectorOpIn,
+												Values:   []string{"scylla"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				return sdc
+			}(),
+			expected: []string{"a", "b"},
+		},
+		{
+			name: "zones of nodes matching rack template topology label selector",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newScyllaDBDatacenter(3, nil, nil)
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					TopologyLabelSelector: map[string]string{
+						"pool": "default",
+					},
+				}
+				return sdc
+			}(),
+			expected: []string{"c"},
+		},
+		{
+			name: "zones are restricted",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newScyllaDBDatacenter(3, nil, nil)
+				sdc.Spec.AutomaticRacks.Zones = []string{"b", "c", "d"}
+				return sdc
+			}(),
+			expected: []string{"b", "c"},
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := getZones(tc.sdc, nodes)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got zones differ: %s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func TestMakeRacks(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		sdc      *scyllav1alpha1.ScyllaDBDatacenter
+		zones    []string
+		expected []scyllav1alpha1.RackSpec
+	}{
+		{
+			name:  "racks are generated for all zones",
+			sdc:   newScyllaDBDatacenter(5, nil, nil),
+			zones: []string{"us-east-1a", "us-east-1b", "us-east-1c"},
+			expected: []scyllav1alpha1.RackSpec{
+				newZoneRack("us-east-1a", "us-east-1a", 2),
+				newZoneRack("us-east-1b", "us-east-1b", 2),
+				newZoneRack("us-east-1c", "us-east-1c", 1),
+			},
+		},
+		{
+			name: "racks are adopted by their zone",
+			sdc: newScyllaDBDatacenter(
+				4,
+				[]scyllav1alpha1.RackSpec{
+					newZoneRack("rack-a", "us-east-1a", 2),
+				},
+				[]scyllav1alpha1.RackStatus{
+					newRackStatus("rack-a", 2, 2),
+				},
+			),
+			zones: []string{"us-east-1a", "us-east-1b"},
+			expected: []scyllav1alpha1.RackSpec{
+				newZoneRack("rack-a", "us-east-1a", 2),
+				newZoneRack("us-east-1b", "us-east-1b", 2),
+			},
+		},
+		{
+			name: "racks aren't scaled down while a new zone is scaled up",
+			sdc: newScyllaDBDatacenter(
+				6,
+				[]scyllav1alpha1.RackSpec{
+					newZoneRack("a", "a", 3),
+					newZoneRack("b", "b", 3),
+				},
+				[]scyllav1alpha1.RackStatus{
+					newRackStatus("a", 3, 3),
+					newRackStatus("b", 3, 3),
+				},
+			),
+			zones: []string{"a", "b", "c"},
+			expected: []scyllav1alpha1.RackSpec{
+				newZoneRack("a", "a", 3),
+				newZoneRack("b", "b", 3),
+				newZoneRack("c", "c", 2),
+			},
+		},
+		{
+			name: "racks aren't scaled down until all racks are settled",
+			sdc: newScyllaDBDatacenter(
+				6,
+				[]scyllav1alpha1.RackSpec{
+					newZoneRack("a", "a", 3),
+					newZoneRack("b", "b", 3),
+					newZoneRack("c", "c", 2),
+				},
+				[]scyllav1alpha1.RackStatus{
+					newRackStatus("a", 3, 3),
+					newRackStatus("b", 3, 3),
+					newRackStatus("c", 2, 1),
+				},
+			),
+			zones: []string{"a", "b", "c"},
+			expected: []scyllav1alpha1.RackSpec{
+				newZoneRack("a", "a", 3),
+				newZoneRack("b", "b", 3),
+				newZoneRack("c", "c", 2),
+			},
+		},
+		{
+			name: "racks are scaled down one at a time once all racks are settled",
+			sdc: newScyllaDBDatacenter(
+				6,
+				[]scyllav1alpha1.RackSpec{
+					newZoneRack("a", "a", 3),
+					newZoneRack("b", "b", 3),
+					newZoneRack("c", "c", 2),
+				},
+				[]scyllav1alpha1.RackStatus{
+					newRackStatus("a", 3, 3),
+					newRackStatus("b", 3, 3),
+					newRackStatus("c", 2, 2),
+				},
+			),
+			zones: []string{"a", "b", "c"},
+			expected: []scyllav1alpha1.RackSpec{
+				newZoneRack("a", "a", 2),
+				newZoneRack("b", "b", 3),
+				newZoneRack("c", "c", 2),
+			},
+		},
+		{
+			name: "racks aren't scaled down using a stale status",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newScyllaDBDatacenter(
+					2,
+					[]scyllav1alpha1.RackSpec{
+						newZoneRack("a", "a", 1),
+						newZoneRack("b", "b", 2),
+					},
+					[]scyllav1alpha1.RackStatus{
+						newRackStatus("a", 1, 1),
+						newRackStatus("b", 2, 2),
+					},
+				)
+				sdc.Status.ObservedGeneration = pointer.Ptr[int64](1)
+				return sdc
+			}(),
+			zones: []string{"a", "b"},
+			expected: []scyllav1alpha1.RackSpec{
+				newZoneRack("a", "a", 1),
+				newZoneRack("b", "b", 2),
+			},
+		},
+		{
+			name: "rack of removed zone is scaled down",
+			sdc: newScyllaDBDatacenter(
+				4,
+				[]scyllav1alpha1.RackSpec{
+					newZoneRack("a", "a", 2),
+					newZoneRack("b", "b", 2),
+					newZoneRack("c", "c", 1),
+				},
+				[]scyllav1alpha1.RackStatus{
+					newRackStatus("a", 2, 2),
+					newRackStatus("b", 2, 2),
+					newRackStatus("c", 1, 1),
+				},
+			),
+			zones: []string{"a", "b"},
+			expected: []scyllav1alpha1.RackSpec{
+				newZoneRack("a", "a", 2),
+				newZoneRack("b", "b", 2),
+				newZoneRack("c", "c", 0),
+			},
+		},
+		{
+			name: "rack of removed zone is scaled down when its nodes aren't ready",
+			sdc: newScyllaDBDatacenter(
+				4,
+				[]scyllav1alpha1.RackSpec{
+					newZoneRack("a", "a", 2),
+					newZoneRack("b", "b", 2),
+					newZoneRack("c", "c", 1),
+				},
+				[]scyllav1alpha1.RackStatus{
+					newRackStatus("a", 2, 2),
+					newRackStatus("b", 2, 2),
+					newRackStatus("c", 1, 0),
+				},
+			),
+			zones: []string{"a", "b"},
+			expected: []scyllav1alpha1.RackSpec{
+				newZoneRack("a", "a", 2),
+				newZoneRack("b", "b", 2),
+				newZoneRack("c", "c", 0),
+			},
+		},
+		{
+			name: "rack of removed zone isn't removed while it has nodes",
+			sdc: newScyllaDBDatacenter(
+				4,
+				[]scyllav1alpha1.RackSpec{
+					newZoneRack("a", "a", 2),
+					newZoneRack("b", "b", 2),
+					newZoneRack("c", "c", 0),
+				},
+				[]scyllav1alpha1.RackStatus{
+					newRackStatus("a", 2, 2),
+					newRackStatus("b", 2, 2),
+					newRackStatus("c", 1, 1),
+				},
+			),
+			zones: []string{"a", "b"},
+			expected: []scyllav1alpha1.RackSpec{
+				newZoneRack("a", "a", 2),
+				newZoneRack("b", "b", 2),
+				newZoneRack("c", "c", 0),
+			},
+		},
+		{
+			name: "empty rack of removed zone is removed",
+			sdc: newScyllaDBDatacenter(
+				4,
+				[]scyllav1alpha1.RackSpec{
+					newZoneRack("a", "a", 2),
+					newZoneRack("b", "b", 2),
+					newZoneRack("c", "c", 0),
+				},
+				[]scyllav1alpha1.RackStatus{
+					newRackStatus("a", 2, 2),
+					newRackStatus("b", 2, 2),
+					newRackStatus("c", 0, 0),
+				},
+			),
+			zones: []string{"a", "b"},
+			expected: []scyllav1alpha1.RackSpec{
+				newZoneRack("a", "a", 2),
+				newZoneRack("b", "b", 2),
+			},
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := makeRacks(tc.sdc, tc.zones)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got racks differ: %s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func TestMakeZoneStatuses(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	withZoneStatuses := func(sdc *scyllav1alpha1.ScyllaDBDatacenter, zoneStatuses ...scyllav1alpha1.AutomaticRacksZoneStatus) *scyllav1alpha1.ScyllaDBDatacenter {
+		sdc.Status.AutomaticRacks = &scyllav1alpha1.AutomaticRacksStatus{
+			Zones: zoneStatuses,
+		}
+		return sdc
+	}
+
+	tt := []struct {
+		name                 string
+		sdc                  *scyllav1alpha1.ScyllaDBDatacenter
+		observedZones        []string
+		expectedZoneStatuses []scyllav1alpha1.AutomaticRacksZoneStatus
+		expectedZones        []string
+		expectedRequeueAfter time.Duration
+	}{
+		{
+			name:          "observed zones are tracked",
+			sdc:           newScyllaDBDatacenter(3, nil, nil),
+			observedZones: []string{"us-east-1a", "us-east-1b"},
+			expectedZoneStatuses: []scyllav1alpha1.AutomaticRacksZoneStatus{
+				{Name: "us-east-1a"},
+				{Name: "us-east-1b"},
+			},
+			expectedZones:        []string{"us-east-1a", "us-east-1b"},
+			expectedRequeueAfter: 0,
+		},
+		{
+			name: "zone of an existing rack missing without a status is kept for the grace period",
+			sdc: newScyllaDBDatacenter(3, []scyllav1alpha1.RackSpec{
+				newZoneRack("a", "us-east-1a", 2),
+				newZoneRack("b", "us-east-1b", 1),
+			}, nil),
+			observedZones: []string{"us-east-1a"},
+			expectedZoneStatuses: []scyllav1alpha1.AutomaticRacksZoneStatus{
+				{Name: "us-east-1a"},
+				{Name: "us-east-1b", MissingSince: &metav1.Time{Time: now}},
+			},
+			expectedZones:        []string{"us-east-1a", "us-east-1b"},
+			expectedRequeueAfter: 10 * time.Minute,
+		},
+		{
+			name: "missing zone is kept until the grace period passes",
+			sdc: withZoneStatuses(
+				newScyllaDBDatacenter(3, nil, nil),
+				scyllav1alpha1.AutomaticRacksZoneStatus{Name: "us-east-1a"},
+				scyllav1alpha1.AutomaticRacksZoneStatus{Name: "us-east-1b", MissingSince: &metav1.Time{Time: now.Add(-4 * time.Minute)}},
+			),
+			observedZones: []string{"us-east-1a"},
+			expectedZoneStatuses: []scyllav1alpha1.AutomaticRacksZoneStatus{
+				{Name: "us-east-1a"},
+				{Name: "us-east-1b", MissingSince: &metav1.Time{Time: now.Add(-4 * time.Minute)}},
+			},
+			expectedZones:        []string{"us-east-1a", "us-east-1b"},
+			expectedRequeueAfter: 6 * time.Minute,
+		},
+		{
+			name: "missing zone is removed after the grace period",
+			sdc: withZoneStatuses(
+				newScyllaDBDatacenter(3, nil, nil),
+				scyllav1alpha1.AutomaticRacksZoneStatus{Name: "us-east-1a"},
+				scyllav1alpha1.AutomaticRacksZoneStatus{Name: "us-east-1b", MissingSince: &metav1.Time{Time: now.Add(-10 * time.Minute)}},
+			),
+			observedZones: []string{"us-east-1a"},
+			expectedZoneStatuses: []scyllav1alpha1.AutomaticRacksZoneStatus{
+				{Name: "us-east-1a"},
+			},
+			expectedZones:        []string{"us-east-1a"},
+			expectedRequeueAfter: 0,
+		},
+		{
+			name: "zone that reappears is no longer missing",
+			sdc: withZoneStatuses(
+				newScyllaDBDatacenter(3, nil, nil),
+				scyllav1alpha1.AutomaticRacksZoneStatus{Name: "us-east-1a", MissingSince: &metav1.Time{Time: now.Add(-time.Minute)}},
+			),
+			observedZones: []string{"us-east-1a"},
+			expectedZoneStatuses: []scyllav1alpha1.AutomaticRacksZoneStatus{
+				{Name: "us-east-1a"},
+			},
+			expectedZones:        []string{"us-east-1a"},
+			expectedRequeueAfter: 0,
+		},
+		{
+			name: "custom grace period is used",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := withZoneStatuses(
+					newScyllaDBDatacenter(3, nil, nil),
+					scyllav1alpha1.AutomaticRacksZoneStatus{Name: "us-east-1a"},
+					scyllav1alpha1.AutomaticRacksZoneStatus{Name: "us-east-1b", MissingSince: &metav1.Time{Time: now.Add(-4 * time.Minute)}},
+				)
+				sdc.Spec.AutomaticRacks.ZoneRemovalGracePeriodSeconds = pointer.Ptr(int64(60))
+				return sdc
+			}(),
+			observedZones: []string{"us-east-1a"},
+			expectedZoneStatuses: []scyllav1alpha1.AutomaticRacksZoneStatus{
+				{Name: "us-east-1a"},
+			},
+			expectedZones:        []string{"us-east-1a"},
+			expectedRequeueAfter: 0,
+		},
+		{
+			name: "zones excluded by the zones field are removed right away",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := withZoneStatuses(
+					newScyllaDBDatacenter(3, nil, nil),
+					scyllav1alpha1.AutomaticRacksZoneStatus{Name: "us-east-1a"},
+					scyllav1alpha1.AutomaticRacksZoneStatus{Name: "us-east-1b"},
+				)
+				sdc.Spec.AutomaticRacks.Zones = []string{"us-east-1a"}
+				return sdc
+			}(),
+			observedZones: []string{"us-east-1a"},
+			expectedZoneStatuses: []scyllav1alpha1.AutomaticRacksZoneStatus{
+				{Name: "us-east-1a"},
+			},
+			expectedZones:        []string{"us-east-1a"},
+			expectedRequeueAfter: 0,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotZoneStatuses, gotZones, gotRequeueAfter := makeZoneStatuses(tc.sdc, tc.observedZones, now)
+			if !reflect.DeepEqual(gotZoneStatuses, tc.expectedZoneStatuses) {
+				t.Errorf("expected and got zone statuses differ: %s", cmp.Diff(tc.expectedZoneStatuses, gotZoneStatuses))
+			}
+
+			if !reflect.DeepEqual(gotZones, tc.expectedZones) {
+				t.Errorf("expected and got zones differ: %s", cmp.Diff(tc.expectedZones, gotZones))
+			}
+
+			if gotRequeueAfter != tc.expectedRequeueAfter {
+				t.Errorf("expected requeue after %v, got %v", tc.expectedRequeueAfter, gotRequeueAfter)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package automaticracks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+func (arc *Controller) sync(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		klog.ErrorS(err, "Failed to split meta namespace cache key", "cacheKey", key)
+		return err
+	}
+
+	startTime := time.Now()
+	klog.V(4).InfoS("Started syncing ScyllaDBDatacenter", "ScyllaDBDatacenter", klog.KRef(namespace, name), "startTime", startTime)
+	defer func() {
+		klog.V(4).InfoS("Finished syncing ScyllaDBDatacenter", "ScyllaDBDatacenter", klog.KRef(namespace, name), "duration", time.Since(startTime))
+	}()
+
+	sdc, err := arc.scyllaDBDatacenterLister.ScyllaDBDatacenters(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		klog.V(2).InfoS("ScyllaDBDatacenter has been deleted", "ScyllaDBDatacenter", klog.KRef(namespace, name))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if sdc.DeletionTimestamp != nil || sdc.Spec.AutomaticRacks == nil {
+		return nil
+	}
+
+	nodes, err := arc.nodeLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("can't list nodes: %w", err)
+	}
+
+	observedZones, err := getZones(sdc, nodes)
+	if err != nil {
+		return fmt.Errorf("can't get zones of ScyllaDBDatacenter %q: %w", naming.ObjRef(sdc), err)
+	}
+
+	if len(observedZones) == 0 {
+		// Racks are kept as they are, Nodes missing the label don't mean that all zones have been removed.
+		arc.eventRecorder.Eventf(sdc, corev1.EventTypeWarning, "NoZonesFound", "No Nodes matching the rack template have the %q label", corev1.LabelTopologyZone)
+		return nil
+	}
+
+	zoneStatuses, zones, requeueAfter := makeZoneStatuses(sdc, observedZones, time.Now())
+	if requeueAfter > 0 {
+		arc.queue.AddAfter(key, requeueAfter)
+	}
+
+	automaticRacksStatus := &scyllav1alpha1.AutomaticRacksStatus{
+		Zones: zoneStatuses,
+	}
+	if !apiequality.Semantic.DeepEqual(automaticRacksStatus, sdc.Status.AutomaticRacks) {
+		sdcCopy := sdc.DeepCopy()
+		sdcCopy.Status.AutomaticRacks = automaticRacksStatus
+		sdc, err = arc.scyllaClient.ScyllaDBDatacenters(sdcCopy.Namespace).UpdateStatus(ctx, sdcCopy, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("can't update status of ScyllaDBDatacenter %q: %w", naming.ObjRef(sdcCopy), err)
+		}
+	}
+
+	racks := makeRacks(sdc, zones)
+	if apiequality.Semantic.DeepEqual(racks, sdc.Spec.Racks) {
+		return nil
+	}
+
+	sdcCopy := sdc.DeepCopy()
+	sdcCopy.Spec.Racks = racks
+	_, err = arc.scyllaClient.ScyllaDBDatacenters(sdcCopy.Namespace).Update(ctx, sdcCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("can't update racks of ScyllaDBDatacenter %q: %w", naming.ObjRef(sdc), err)
+	}
+
+	klog.V(2).InfoS("Updated racks", "ScyllaDBDatacenter", klog.KObj(sdc), "Zones", zones)
+	arc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "RacksUpdated", "Updated racks for zones %q", zones)
+
+	return nil
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/scylladb/scylla-manager/v3/swagger/gen/scylla-manager/client/operations"
+	"github.com/scylladb/scylla-manager/v3/swagger/gen/scylla-manager/models"
+	scyllav1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// snapshotTagTimeLayout is the layout of the time encoded in snapshot tags.
+	snapshotTagTimeLayout = "sm_20060102150405UTC"
+)
+
+// getBackupLocations returns sorted, unique locations of all backup tasks of the ScyllaCluster.
+func getBackupLocations(sc *scyllav1.ScyllaCluster) []string {
+	locations := sets.New[string]()
+	for _, bt := range sc.Spec.Backups {
+		locations.Insert(bt.Location...)
+	}
+
+	return sets.List(locations)
+}
+
+func parseSnapshotTagTime(snapshotTag string) *metav1.Time {
+	t, err := time.Parse(snapshotTagTimeLayout, snapshotTag)
+	if err != nil {
+		return nil
+	}
+
+	return pointer.Ptr(metav1.NewTime(t))
+}
+
+// makeBackupLocationInventory converts backups listed by Scylla Manager into an inventory of the location.
+// Snapshots are sorted from the newest one.
+func makeBackupLocationInventory(location string, items []*models.BackupListItem, backupTasks map[string]scyllav1.BackupTaskStatus) scyllav1alpha1.BackupLocationInventory {
+	backupTasksByID := make(map[string]scyllav1.BackupTaskStatus, len(backupTasks))
+	for _, bt := range backupTasks {
+		if bt.ID != nil {
+			backupTasksByID[*bt.ID] = bt
+		}
+	}
+
+	inventory := scyllav1alpha1.BackupLocationInventory{
+		Location: location,
+	}
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+
+		bt, hasTask := backupTasksByID[item.TaskID]
+		for _, si := range item.SnapshotInfo {
+			if si == nil {
+				continue
+			}
+
+			snapshot := scyllav1alpha1.BackupSnapshot{
+				SnapshotTag:  si.SnapshotTag,
+				CreationTime: parseSnapshotTagTime(si.SnapshotTag),
+				Size:         si.Size,
+				Nodes:        si.Nodes,
+				TaskID:       item.TaskID,
+			}
+			if hasTask {
+				snapshot.TaskName = bt.Name
+				snapshot.Retention = bt.Retention
+			}
+
+			inventory.Snapshots = append(inventory.Snapshots, snapshot)
+		}
+	}
+
+	sort.SliceStable(inventory.Snapshots, func(i, j int) bool {
+		if inventory.Snapshots[i].SnapshotTag != inventory.Snapshots[j].SnapshotTag {
+			return inventory.Snapshots[i].SnapshotTag > inventory.Snapshots[j].SnapshotTag
+		}
+
+		return inventory.Snapshots[i].TaskID < inventory.Snapshots[j].TaskID
+	})
+
+	return inventory
+}
+
+func MakeBackupInventory(sc *scyllav1.ScyllaCluster, locations []string) *scyllav1alpha1.ScyllaDBBackupInventory {
+	labels := map[string]string{}
+	maps.Copy(labels, naming.ClusterLabelsForScyllaCluster(sc))
+
+	return &scyllav1alpha1.ScyllaDBBackupInventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      naming.BackupInventoryNameForScyllaCluster(sc),
+			Namespace: sc.Namespace,
+			Labels:    labels,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(sc, scyllaClusterControllerGVK),
+			},
+		},
+		Spec: scyllav1alpha1.ScyllaDBBackupInventorySpec{
+			ScyllaClusterName: sc.Name,
+			Locations:         locations,
+		},
+	}
+}
+
+func (c *Controller) listBackups(ctx context.Context, clusterID string, location string) ([]*models.BackupListItem, error) {
+	resp, err := c.managerOperations.GetClusterClusterIDBackups(&operations.GetClusterClusterIDBackupsParams{
+		Context:        ctx,
+		ClusterID:      clusterID,
+		QueryClusterID: &clusterID,
+		Locations:      []string{location},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetPayload(), nil
+}
+
+// syncBackupInventory periodically lists snapshots available in backup locations of the ScyllaCluster
+// and reflects them in the status of its ScyllaDBBackupInventory.
+func (c *Controller) syncBackupInventory(ctx context.Context, key string, sc *scyllav1.ScyllaCluster, clusterID string, backupTasks map[string]scyllav1.BackupTaskStatus) error {
+	locations := getBackupLocations(sc)
+	if len(locations) == 0 {
+		return c.pruneBackupInventory(ctx, sc)
+	}
+
+	existing, err := c.backupInventoryLister.ScyllaDBBackupInventories(sc.Namespace).Get(naming.BackupInventoryNameForScyllaCluster(sc))
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("can't get backup inventory: %w", err)
+	}
+
+	required := MakeBackupInventory(sc, locations)
+	inventory, _, err := resourceapply.ApplyScyllaDBBackupInventory(ctx, c.scyllaV1alpha1Client, c.backupInventoryLister, c.eventRecorder, required, resourceapply.ApplyOptions{})
+	if err != nil {
+		return fmt.Errorf("can't apply backup inventory %q: %w", naming.ObjRef(required), err)
+	}
+
+	if existing != nil && inventory.Generation == existing.Generation &&
+		existing.Status.ObservedGeneration != nil && *existing.Status.ObservedGeneration == existing.Generation &&
+		existing.Status.LastSyncTime != nil {
+		nextSync := existing.Status.LastSyncTime.Add(backupInventorySyncInterval)
+		if time.Now().Before(nextSync) {
+			c.queue.AddAfter(key, time.Until(nextSync))
+			return nil
+		}
+	}
+
+	status := inventory.Status.DeepCopy()
+	status.ObservedGeneration = pointer.Ptr(inventory.Generation)
+	status.LastSyncTime = pointer.Ptr(metav1.Now())
+	status.Locations = make([]scyllav1alpha1.BackupLocationInventory, 0, len(locations))
+	for _, location := range locations {
+		items, err := c.listBackups(ctx, clusterID, location)
+		if err != nil {
+			klog.V(2).InfoS("Can't list backups", "ScyllaCluster", klog.KObj(sc), "Location", location, "Error", err)
+			status.Locations = append(status.Locations, scyllav1alpha1.BackupLocationInventory{
+				Location: location,
+				Error:    pointer.Ptr(strings.TrimSpace(messageOf(err))),
+			})
+			continue
+		}
+
+		status.Locations = append(status.Locations, makeBackupLocationInventory(location, items, backupTasks))
+	}
+
+	if !apiequality.Semantic.DeepEqual(&inventory.Status, status) {
+		inventory = inventory.DeepCopy()
+		inventory.Status = *status
+		_, err = c.scyllaV1alpha1Client.ScyllaDBBackupInventories(inventory.Namespace).UpdateStatus(ctx, inventory, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("can't update backup inventory status %q: %w", naming.ObjRef(inventory), err)
+		}
+	}
+
+	c.queue.AddAfter(key, backupInventorySyncInterval)
+
+	return nil
+}
+
+func (c *Controller) pruneBackupInventory(ctx context.Context, sc *scyllav1.ScyllaCluster) error {
+	existing, err := c.backupInventoryLister.ScyllaDBBackupInventories(sc.Namespace).Get(naming.BackupInventoryNameForScyllaCluster(sc))
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("can't get backup inventory: %w", err)
+	}
+
+	if existing.DeletionTimestamp != nil || !metav1.IsControlledBy(existing, sc) {
+		return nil
+	}
+
+	propagationPolicy := metav1.DeletePropagationBackground
+	err = c.scyllaV1alpha1Client.ScyllaDBBackupInventories(existing.Namespace).Delete(ctx, existing.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{
+			UID: &existing.UID,
+		},
+		PropagationPolicy: &propagationPolicy,
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("can't delete backup inventory %q: %w", naming.ObjRef(existing), err)
+	}
+
+	return nil
+}
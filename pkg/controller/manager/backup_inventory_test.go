@@ -0,0 +1,132 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package manager
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-manager/v3/swagger/gen/scylla-manager/models"
+	scyllav1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_getBackupLocations(t *testing.T) {
+	t.Parallel()
+
+	sc := &scyllav1.ScyllaCluster{
+		Spec: scyllav1.ScyllaClusterSpec{
+			Backups: []scyllav1.BackupTaskSpec{
+				{
+					TaskSpec: scyllav1.TaskSpec{Name: "daily"},
+					Location: []string{"s3:backups", "dc1:gcs:backups"},
+				},
+				{
+					TaskSpec: scyllav1.TaskSpec{Name: "weekly"},
+					Location: []string{"s3:backups"},
+				},
+			},
+		},
+	}
+
+	expected := []string{"dc1:gcs:backups", "s3:backups"}
+	got := getBackupLocations(sc)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected and got locations differ: %s", cmp.Diff(expected, got))
+	}
+}
+
+func Test_makeBackupLocationInventory(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name        string
+		location    string
+		items       []*models.BackupListItem
+		backupTasks map[string]scyllav1.BackupTaskStatus
+		expected    scyllav1alpha1.BackupLocationInventory
+	}{
+		{
+			name:     "no backups",
+			location: "s3:backups",
+			items:    nil,
+			expected: scyllav1alpha1.BackupLocationInventory{
+				Location: "s3:backups",
+			},
+		},
+		{
+			name:     "snapshots are sorted from the newest and annotated with task name and retention",
+			location: "s3:backups",
+			items: []*models.BackupListItem{
+				{
+					TaskID: "daily-id",
+					SnapshotInfo: []*models.SnapshotInfo{
+						{SnapshotTag: "sm_20240101000000UTC", Size: 1024, Nodes: 3},
+						{SnapshotTag: "sm_20240102000000UTC", Size: 2048, Nodes: 3},
+					},
+				},
+				{
+					TaskID: "removed-id",
+					SnapshotInfo: []*models.SnapshotInfo{
+						{SnapshotTag: "sm_20231231000000UTC", Size: 512, Nodes: 3},
+					},
+				},
+			},
+			backupTasks: map[string]scyllav1.BackupTaskStatus{
+				"daily": {
+					TaskStatus: scyllav1.TaskStatus{
+						Name: "daily",
+						ID:   pointer.Ptr("daily-id"),
+					},
+					Retention: pointer.Ptr[int64](7),
+				},
+			},
+			expected: scyllav1alpha1.BackupLocationInventory{
+				Location: "s3:backups",
+				Snapshots: []scyllav1alpha1.BackupSnapshot{
+					{
+						SnapshotTag:  "sm_20240102000000UTC",
+						CreationTime: pointer.Ptr(metav1.NewTime(time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC))),
+						Size:         2048,
+						Nodes:        3,
+						TaskID:       "daily-id",
+						TaskName:     "daily",
+						Retention:    pointer.Ptr[int64](7),
+					},
+					{
+						SnapshotTag:  "sm_20240101000000UTC",
+						CreationTime: pointer.Ptr(metav1.NewTime(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))),
+						Size:         1024,
+						Nodes:        3,
+						TaskID:       "daily-id",
+						TaskName:     "daily",
+						Retention:    pointer.Ptr[int64](7),
+					},
+					{
+						SnapshotTag:  "sm_20231231000000UTC",
+						CreationTime: pointer.Ptr(metav1.NewTime(time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC))),
+						Size:         512,
+						Nodes:        3,
+						TaskID:       "removed-id",
+					},
+				},
+			},
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := makeBackupLocationInventory(tc.location, tc.items, tc.backupTasks)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got inventories differ: %s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
@@ -7,10 +7,15 @@ import (
 	"time"
 
 	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/v3/swagger/gen/scylla-manager/client/operations"
 	scyllav1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	scyllav1client "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned/typed/scylla/v1"
+	scyllav1alpha1client "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned/typed/scylla/v1alpha1"
 	scyllav1informers "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/scylla/v1"
+	scyllav1alpha1informers "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/scylla/v1alpha1"
 	scyllav1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1"
+	scyllav1alpha1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
 	"github.com/scylladb/scylla-operator/pkg/kubeinterfaces"
 	"github.com/scylladb/scylla-operator/pkg/scheme"
@@ -39,6 +44,12 @@ const (
 	// Given we reconcile tasks individually with an API call,
 	// this may not be enough for N tasks but should eventually make it.
 	maxSyncDuration = 2 * time.Minute
+
+	// restoreProgressResyncInterval is how often the progress of unfinished restore tasks is reflected in the status.
+	restoreProgressResyncInterval = 30 * time.Second
+
+	// backupInventorySyncInterval is how often snapshots available in backup locations are listed.
+	backupInventorySyncInterval = 10 * time.Minute
 )
 
 var (
@@ -47,13 +58,17 @@ var (
 )
 
 type Controller struct {
-	kubeClient   kubernetes.Interface
-	scyllaClient scyllav1client.ScyllaV1Interface
+	kubeClient           kubernetes.Interface
+	scyllaClient         scyllav1client.ScyllaV1Interface
+	scyllaV1alpha1Client scyllav1alpha1client.ScyllaV1alpha1Interface
 
-	secretLister corev1listers.SecretLister
-	scyllaLister scyllav1listers.ScyllaClusterLister
+	secretLister          corev1listers.SecretLister
+	scyllaLister          scyllav1listers.ScyllaClusterLister
+	backupInventoryLister scyllav1alpha1listers.ScyllaDBBackupInventoryLister
 
 	managerClient *managerclient.Client
+	// managerOperations is used for calls that managerClient doesn't expose the results of.
+	managerOperations operations.ClientService
 
 	cachesToSync []cache.InformerSynced
 
@@ -66,26 +81,33 @@ type Controller struct {
 func NewController(
 	kubeClient kubernetes.Interface,
 	scyllaClient scyllav1client.ScyllaV1Interface,
+	scyllaV1alpha1Client scyllav1alpha1client.ScyllaV1alpha1Interface,
 	secretInformer corev1informers.SecretInformer,
 	scyllaClusterInformer scyllav1informers.ScyllaClusterInformer,
+	backupInventoryInformer scyllav1alpha1informers.ScyllaDBBackupInventoryInformer,
 	managerClient *managerclient.Client,
+	managerOperations operations.ClientService,
 ) (*Controller, error) {
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartStructuredLogging(0)
 	eventBroadcaster.StartRecordingToSink(&corev1client.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
 
 	c := &Controller{
-		kubeClient:   kubeClient,
-		scyllaClient: scyllaClient,
+		kubeClient:           kubeClient,
+		scyllaClient:         scyllaClient,
+		scyllaV1alpha1Client: scyllaV1alpha1Client,
 
-		secretLister: secretInformer.Lister(),
-		scyllaLister: scyllaClusterInformer.Lister(),
+		secretLister:          secretInformer.Lister(),
+		scyllaLister:          scyllaClusterInformer.Lister(),
+		backupInventoryLister: backupInventoryInformer.Lister(),
 
-		managerClient: managerClient,
+		managerClient:     managerClient,
+		managerOperations: managerOperations,
 
 		cachesToSync: []cache.InformerSynced{
 			secretInformer.Informer().HasSynced,
 			scyllaClusterInformer.Informer().HasSynced,
+			backupInventoryInformer.Informer().HasSynced,
 		},
 
 		eventRecorder: eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "manager-controller"}),
@@ -124,6 +146,12 @@ func NewController(
 		DeleteFunc: c.deleteSecret,
 	})
 
+	backupInventoryInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addBackupInventory,
+		UpdateFunc: c.updateBackupInventory,
+		DeleteFunc: c.deleteBackupInventory,
+	})
+
 	return c, nil
 }
 
@@ -237,3 +265,26 @@ func (c *Controller) deleteSecret(obj interface{}) {
 		c.handlers.EnqueueOwner,
 	)
 }
+
+func (c *Controller) addBackupInventory(obj interface{}) {
+	c.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.ScyllaDBBackupInventory),
+		c.handlers.EnqueueOwner,
+	)
+}
+
+func (c *Controller) updateBackupInventory(old, cur interface{}) {
+	c.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.ScyllaDBBackupInventory),
+		cur.(*scyllav1alpha1.ScyllaDBBackupInventory),
+		c.handlers.EnqueueOwner,
+		c.deleteBackupInventory,
+	)
+}
+
+func (c *Controller) deleteBackupInventory(obj interface{}) {
+	c.handlers.HandleDelete(
+		obj,
+		c.handlers.EnqueueOwner,
+	)
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/v3/swagger/gen/scylla-manager/models"
+	scyllav1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// MakeOneOffRepairTask returns a Scylla Manager task running the repair described by the ScyllaDBRepair once.
+func MakeOneOffRepairTask(sr *scyllav1alpha1.ScyllaDBRepair) (*managerclient.Task, error) {
+	rts := &RepairTaskSpec{
+		TaskSpec: scyllav1.TaskSpec{
+			Name: sr.Name,
+			SchedulerTaskSpec: scyllav1.SchedulerTaskSpec{
+				NumRetries: sr.Spec.NumRetries,
+			},
+		},
+		DC:                  sr.Spec.DC,
+		FailFast:            sr.Spec.FailFast,
+		Intensity:           sr.Spec.Intensity,
+		Parallel:            sr.Spec.Parallel,
+		Keyspace:            sr.Spec.Keyspace,
+		SmallTableThreshold: sr.Spec.SmallTableThreshold,
+		Host:                sr.Spec.Host,
+	}
+
+	t, err := rts.ToManager()
+	if err != nil {
+		return nil, err
+	}
+	t.Labels = map[string]string{
+		naming.OwnerUIDLabel: string(sr.UID),
+	}
+
+	return t, nil
+}
+
+// MakeOneOffBackupTask returns a Scylla Manager task running the backup described by the ScyllaDBBackup once.
+func MakeOneOffBackupTask(sb *scyllav1alpha1.ScyllaDBBackup) (*managerclient.Task, error) {
+	bts := &BackupTaskSpec{
+		TaskSpec: scyllav1.TaskSpec{
+			Name: sb.Name,
+			SchedulerTaskSpec: scyllav1.SchedulerTaskSpec{
+				NumRetries: sb.Spec.NumRetries,
+			},
+		},
+		DC:               sb.Spec.DC,
+		Keyspace:         sb.Spec.Keyspace,
+		Location:         sb.Spec.Location,
+		RateLimit:        sb.Spec.RateLimit,
+		Retention:        sb.Spec.Retention,
+		SnapshotParallel: sb.Spec.SnapshotParallel,
+		UploadParallel:   sb.Spec.UploadParallel,
+	}
+
+	t, err := bts.ToManager()
+	if err != nil {
+		return nil, err
+	}
+	t.Labels = map[string]string{
+		naming.OwnerUIDLabel: string(sb.UID),
+	}
+
+	return t, nil
+}
+
+// FindOneOffTask returns the Scylla Manager task of the given type owned by the object with the given UID,
+// or nil if there is none.
+func FindOneOffTask(ctx context.Context, client *managerclient.Client, clusterID, taskType string, ownerUID types.UID) (*managerclient.TaskListItem, error) {
+	tasks, err := client.ListTasks(ctx, clusterID, taskType, true, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("can't list %s tasks registered with manager: %w", taskType, err)
+	}
+
+	task, _, found := slices.Find(tasks.TaskListItemSlice, func(t *managerclient.TaskListItem) bool {
+		return t.Labels[naming.OwnerUIDLabel] == string(ownerUID)
+	})
+	if !found {
+		return nil, nil
+	}
+
+	return task, nil
+}
+
+// DeleteOneOffTask stops and deletes the Scylla Manager task of the given type owned by the object with the given UID, if any.
+func DeleteOneOffTask(ctx context.Context, client *managerclient.Client, clusterID, taskType string, ownerUID types.UID) error {
+	task, err := FindOneOffTask(ctx, client, clusterID, taskType, ownerUID)
+	if err != nil {
+		return err
+	}
+	if task == nil {
+		return nil
+	}
+
+	a := &deleteTaskAction{
+		ClusterID: clusterID,
+		TaskType:  taskType,
+		TaskID:    task.ID,
+		TaskName:  task.Name,
+	}
+	return a.stopAndDeleteTask(ctx, client)
+}
+
+// CreateOneOffTask creates the task in Scylla Manager and returns its ID.
+func CreateOneOffTask(ctx context.Context, client *managerclient.Client, clusterID string, task *managerclient.Task) (string, error) {
+	id, err := client.CreateTask(ctx, clusterID, task)
+	if err != nil {
+		return "", fmt.Errorf("can't create task %q: %s", task.Name, messageOf(err))
+	}
+
+	return id.String(), nil
+}
+
+// IsFinishedPhase returns whether the task has finished and won't be run again.
+func IsFinishedPhase(phase scyllav1alpha1.ScyllaDBManagerTaskPhase) bool {
+	return phase == scyllav1alpha1.ScyllaDBManagerTaskPhaseSucceeded || phase == scyllav1alpha1.ScyllaDBManagerTaskPhaseFailed
+}
+
+// GetOneOffTaskPhase maps the status of a Scylla Manager task run once onto its lifecycle phase.
+func GetOneOffTaskPhase(t *managerclient.TaskListItem) scyllav1alpha1.ScyllaDBManagerTaskPhase {
+	switch t.Status {
+	case managerclient.TaskStatusRunning, managerclient.TaskStatusStopping, managerclient.TaskStatusWaiting:
+		return scyllav1alpha1.ScyllaDBManagerTaskPhaseRunning
+
+	case managerclient.TaskStatusDone:
+		return scyllav1alpha1.ScyllaDBManagerTaskPhaseSucceeded
+
+	case managerclient.TaskStatusError:
+		// A failed run is retried as long as there are retries left, in which case the next activation is set.
+		if t.NextActivation != nil {
+			return scyllav1alpha1.ScyllaDBManagerTaskPhaseRunning
+		}
+		return scyllav1alpha1.ScyllaDBManagerTaskPhaseFailed
+
+	case managerclient.TaskStatusAborted, managerclient.TaskStatusStopped:
+		return scyllav1alpha1.ScyllaDBManagerTaskPhaseFailed
+
+	default:
+		return scyllav1alpha1.ScyllaDBManagerTaskPhasePending
+	}
+}
+
+// SetOneOffTaskRunStatus reflects the run of a Scylla Manager task in the status.
+func SetOneOffTaskRunStatus(status *scyllav1alpha1.ScyllaDBManagerTaskStatus, run *models.TaskRun, now time.Time) {
+	if run != nil && !time.Time(run.StartTime).IsZero() {
+		status.StartTime = pointer.Ptr(metav1.NewTime(time.Time(run.StartTime)))
+	}
+
+	if !IsFinishedPhase(status.Phase) {
+		status.CompletionTime = nil
+		status.Message = nil
+		return
+	}
+
+	if status.CompletionTime == nil {
+		completionTime := now
+		if run != nil && !time.Time(run.EndTime).IsZero() {
+			completionTime = time.Time(run.EndTime)
+		}
+		status.CompletionTime = pointer.Ptr(metav1.NewTime(completionTime))
+	}
+
+	if status.Phase == scyllav1alpha1.ScyllaDBManagerTaskPhaseFailed && run != nil && len(run.Cause) != 0 {
+		status.Message = pointer.Ptr(run.Cause)
+	}
+}
+
+// GetBackupProgressPercentage returns the percentage of the data that is already uploaded or didn't need to be.
+func GetBackupProgressPercentage(p *models.BackupProgress) int64 {
+	if p == nil || p.Size == 0 {
+		return 0
+	}
+
+	return (p.Uploaded + p.Skipped) * 100 / p.Size
+}
@@ -0,0 +1,508 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	scyllav1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scyllav1informers "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/scylla/v1"
+	scyllav1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/kubeinterfaces"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/scheme"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// oneOffTaskProgressResyncInterval is how often the progress of an unfinished one-off task is reflected in the status.
+	oneOffTaskProgressResyncInterval = 30 * time.Second
+)
+
+// OneOffTaskControl describes a kind of objects, each of which runs a single Scylla Manager task once.
+// T is the object type and S its status type, which embeds ScyllaDBManagerTaskStatus.
+type OneOffTaskControl[T kubeinterfaces.ObjectInterface, S any] struct {
+	// Kind is the kind of the objects, e.g. ScyllaDBRepair.
+	Kind string
+	// Finalizer makes sure the task is removed from Scylla Manager when the object is deleted.
+	Finalizer string
+	// TaskType is the type of the Scylla Manager task, e.g. repair.
+	TaskType string
+
+	ProgressingCondition string
+	DegradedCondition    string
+
+	GetterLister     kubeinterfaces.GetterLister[T]
+	UpdateFunc       func(ctx context.Context, obj T, opts metav1.UpdateOptions) (T, error)
+	UpdateStatusFunc func(ctx context.Context, obj T, opts metav1.UpdateOptions) (T, error)
+	DeleteFunc       func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error
+
+	GetScyllaClusterName       func(obj T) string
+	GetTTLSecondsAfterFinished func(obj T) *int32
+	// GetStatus returns a pointer to the status of the object.
+	GetStatus func(obj T) *S
+	// GetTaskStatus returns a pointer to the task status embedded in the status.
+	GetTaskStatus func(status *S) *scyllav1alpha1.ScyllaDBManagerTaskStatus
+
+	// SyncTask creates the task in Scylla Manager once and reflects its progress in the status.
+	// It's called only for unfinished tasks of ScyllaClusters registered with Scylla Manager.
+	SyncTask func(ctx context.Context, obj T, clusterID string, status *S) ([]metav1.Condition, error)
+}
+
+// OneOffTaskController handles the lifecycle shared by objects running a single Scylla Manager task once:
+// the finalizer, the owner reference to the ScyllaCluster, the time to live of finished objects and the status.
+type OneOffTaskController[T kubeinterfaces.ObjectInterface, S any] struct {
+	name    string
+	control OneOffTaskControl[T, S]
+
+	scyllaClusterLister scyllav1listers.ScyllaClusterLister
+
+	managerClient *managerclient.Client
+
+	cachesToSync []cache.InformerSynced
+
+	queue    workqueue.RateLimitingInterface
+	handlers *controllerhelpers.Handlers[T]
+}
+
+func NewOneOffTaskController[T kubeinterfaces.ObjectInterface, S any](
+	name string,
+	gvk schema.GroupVersionKind,
+	scyllaClusterInformer scyllav1informers.ScyllaClusterInformer,
+	informer cache.SharedIndexInformer,
+	managerClient *managerclient.Client,
+	control OneOffTaskControl[T, S],
+) (*OneOffTaskController[T, S], error) {
+	c := &OneOffTaskController[T, S]{
+		name:    name,
+		control: control,
+
+		scyllaClusterLister: scyllaClusterInformer.Lister(),
+
+		managerClient: managerClient,
+
+		cachesToSync: []cache.InformerSynced{
+			scyllaClusterInformer.Informer().HasSynced,
+			informer.HasSynced,
+		},
+
+		queue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), name),
+	}
+
+	var err error
+	c.handlers, err = controllerhelpers.NewHandlers[T](
+		c.queue,
+		keyFunc,
+		scheme.Scheme,
+		gvk,
+		control.GetterLister,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("can't create handlers: %w", err)
+	}
+
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addObject,
+		UpdateFunc: c.updateObject,
+		DeleteFunc: c.deleteObject,
+	})
+
+	scyllaClusterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addScyllaCluster,
+		UpdateFunc: c.updateScyllaCluster,
+		DeleteFunc: c.deleteScyllaCluster,
+	})
+
+	return c, nil
+}
+
+func (c *OneOffTaskController[T, S]) processNextItem(ctx context.Context) bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	ctx, cancel := context.WithTimeout(ctx, maxSyncDuration)
+	defer cancel()
+	err := c.sync(ctx, key.(string))
+	// TODO: Do smarter filtering then just Reduce to handle cases like 2 conflict errors.
+	err = utilerrors.Reduce(err)
+	switch {
+	case err == nil:
+		c.queue.Forget(key)
+		return true
+
+	case apierrors.IsConflict(err):
+		klog.V(2).InfoS("Hit conflict, will retry in a bit", "Key", key, "Error", err)
+
+	case apierrors.IsAlreadyExists(err):
+		klog.V(2).InfoS("Hit already exists, will retry in a bit", "Key", key, "Error", err)
+
+	default:
+		utilruntime.HandleError(fmt.Errorf("syncing key '%v' failed: %v", key, err))
+	}
+
+	c.queue.AddRateLimited(key)
+
+	return true
+}
+
+func (c *OneOffTaskController[T, S]) runWorker(ctx context.Context) {
+	for c.processNextItem(ctx) {
+	}
+}
+
+func (c *OneOffTaskController[T, S]) Run(ctx context.Context, workers int) {
+	defer utilruntime.HandleCrash()
+
+	klog.InfoS("Starting controller", "controller", c.name)
+
+	var wg sync.WaitGroup
+	defer func() {
+		klog.InfoS("Shutting down controller", "controller", c.name)
+		c.queue.ShutDown()
+		wg.Wait()
+		klog.InfoS("Shut down controller", "controller", c.name)
+	}()
+
+	if !cache.WaitForNamedCacheSync(c.name, ctx.Done(), c.cachesToSync...) {
+		return
+	}
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wait.UntilWithContext(ctx, c.runWorker, time.Second)
+		}()
+	}
+
+	<-ctx.Done()
+}
+
+func (c *OneOffTaskController[T, S]) sync(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		klog.ErrorS(err, "Failed to split meta namespace cache key", "cacheKey", key)
+		return err
+	}
+
+	startTime := time.Now()
+	klog.V(4).InfoS("Started syncing "+c.control.Kind, c.control.Kind, klog.KRef(namespace, name), "startTime", startTime)
+	defer func() {
+		klog.V(4).InfoS("Finished syncing "+c.control.Kind, c.control.Kind, klog.KRef(namespace, name), "duration", time.Since(startTime))
+	}()
+
+	obj, err := c.control.GetterLister.Get(namespace, name)
+	if apierrors.IsNotFound(err) {
+		klog.V(2).InfoS(c.control.Kind+" has been deleted", c.control.Kind, klog.KRef(namespace, name))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	scName := c.control.GetScyllaClusterName(obj)
+	sc, err := c.scyllaClusterLister.ScyllaClusters(obj.GetNamespace()).Get(scName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("can't get ScyllaCluster %q: %w", naming.ManualRef(obj.GetNamespace(), scName), err)
+	}
+	if apierrors.IsNotFound(err) {
+		sc = nil
+	}
+
+	if obj.GetDeletionTimestamp() != nil {
+		return c.syncFinalizer(ctx, obj, sc)
+	}
+
+	updated, err := c.ensureMetadata(ctx, obj, sc)
+	if err != nil || updated {
+		// The update triggers another sync.
+		return err
+	}
+
+	status := c.calculateStatus(obj)
+	taskStatus := c.control.GetTaskStatus(status)
+
+	var errs []error
+
+	if IsFinishedPhase(taskStatus.Phase) {
+		deleted, err := c.syncTTL(ctx, key, obj)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't sync ttl: %w", err))
+		}
+		if deleted {
+			return utilerrors.NewAggregate(errs)
+		}
+	} else {
+		err = controllerhelpers.RunSync(
+			&taskStatus.Conditions,
+			c.control.ProgressingCondition,
+			c.control.DegradedCondition,
+			obj.GetGeneration(),
+			func() ([]metav1.Condition, error) {
+				return c.syncTask(ctx, key, obj, sc, status)
+			},
+		)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't sync %s task: %w", c.control.TaskType, err))
+		}
+	}
+
+	// Aggregate conditions.
+	err = controllerhelpers.SetAggregatedWorkloadConditions(&taskStatus.Conditions, obj.GetGeneration())
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't aggregate workload conditions: %w", err))
+	} else {
+		err = c.updateStatus(ctx, obj, status)
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// syncTask waits for the ScyllaCluster to be registered with Scylla Manager before syncing the task
+// and keeps reflecting the progress of the task until it finishes.
+func (c *OneOffTaskController[T, S]) syncTask(ctx context.Context, key string, obj T, sc *scyllav1.ScyllaCluster, status *S) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if sc == nil {
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               c.control.ProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForScyllaCluster",
+			Message:            fmt.Sprintf("Waiting for ScyllaCluster %q to exist.", naming.ManualRef(obj.GetNamespace(), c.control.GetScyllaClusterName(obj))),
+			ObservedGeneration: obj.GetGeneration(),
+		})
+		return progressingConditions, nil
+	}
+
+	if sc.Status.ManagerID == nil {
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               c.control.ProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForManagerRegistration",
+			Message:            fmt.Sprintf("Waiting for ScyllaCluster %q to be registered with Scylla Manager.", naming.ObjRef(sc)),
+			ObservedGeneration: obj.GetGeneration(),
+		})
+		return progressingConditions, nil
+	}
+
+	progressingConditions, err := c.control.SyncTask(ctx, obj, *sc.Status.ManagerID, status)
+	if err != nil {
+		return progressingConditions, err
+	}
+
+	if !IsFinishedPhase(c.control.GetTaskStatus(status).Phase) {
+		c.queue.AddAfter(key, oneOffTaskProgressResyncInterval)
+	}
+
+	return progressingConditions, nil
+}
+
+// syncTTL deletes a finished object once its time to live expires.
+// It returns true if the object was deleted.
+func (c *OneOffTaskController[T, S]) syncTTL(ctx context.Context, key string, obj T) (bool, error) {
+	ttlSecondsAfterFinished := c.control.GetTTLSecondsAfterFinished(obj)
+	completionTime := c.control.GetTaskStatus(c.control.GetStatus(obj)).CompletionTime
+	if ttlSecondsAfterFinished == nil || completionTime == nil {
+		return false, nil
+	}
+
+	expirationTime := completionTime.Add(time.Duration(*ttlSecondsAfterFinished) * time.Second)
+	if time.Now().Before(expirationTime) {
+		c.queue.AddAfter(key, time.Until(expirationTime))
+		return false, nil
+	}
+
+	klog.V(2).InfoS("Deleting finished "+c.control.Kind+" after its time to live has expired", c.control.Kind, klog.KObj(obj))
+	propagationPolicy := metav1.DeletePropagationBackground
+	uid := obj.GetUID()
+	err := c.control.DeleteFunc(ctx, obj.GetNamespace(), obj.GetName(), metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{
+			UID: &uid,
+		},
+		PropagationPolicy: &propagationPolicy,
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, fmt.Errorf("can't delete %s %q: %w", c.control.Kind, naming.ObjRef(obj), err)
+	}
+
+	return true, nil
+}
+
+// syncFinalizer removes the task from Scylla Manager and releases the object.
+func (c *OneOffTaskController[T, S]) syncFinalizer(ctx context.Context, obj T, sc *scyllav1.ScyllaCluster) error {
+	if !slices.ContainsItem(obj.GetFinalizers(), c.control.Finalizer) {
+		return nil
+	}
+
+	if sc == nil || sc.DeletionTimestamp != nil || sc.Status.ManagerID == nil {
+		// The task goes away together with the cluster registration.
+		klog.V(2).InfoS("ScyllaCluster isn't registered with Scylla Manager, not deleting the task", c.control.Kind, klog.KObj(obj))
+	} else {
+		err := DeleteOneOffTask(ctx, c.managerClient, *sc.Status.ManagerID, c.control.TaskType, obj.GetUID())
+		if err != nil {
+			return fmt.Errorf("can't delete %s task of %s %q: %w", c.control.TaskType, c.control.Kind, naming.ObjRef(obj), err)
+		}
+	}
+
+	objCopy := obj.DeepCopyObject().(T)
+	objCopy.SetFinalizers(slices.FilterOut(objCopy.GetFinalizers(), func(f string) bool {
+		return f == c.control.Finalizer
+	}))
+	_, err := c.control.UpdateFunc(ctx, objCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("can't remove finalizer from %s %q: %w", c.control.Kind, naming.ObjRef(obj), err)
+	}
+
+	return nil
+}
+
+// ensureMetadata adds a finalizer making sure the task is removed when the object is deleted,
+// and an owner reference making sure the object is garbage-collected together with its ScyllaCluster.
+// It returns true if the object was updated.
+func (c *OneOffTaskController[T, S]) ensureMetadata(ctx context.Context, obj T, sc *scyllav1.ScyllaCluster) (bool, error) {
+	hasFinalizer := slices.ContainsItem(obj.GetFinalizers(), c.control.Finalizer)
+	hasOwnerReference := sc == nil || slices.Contains(obj.GetOwnerReferences(), func(ref metav1.OwnerReference) bool {
+		return ref.UID == sc.UID
+	})
+	if hasFinalizer && hasOwnerReference {
+		return false, nil
+	}
+
+	objCopy := obj.DeepCopyObject().(T)
+	if !hasFinalizer {
+		objCopy.SetFinalizers(append(objCopy.GetFinalizers(), c.control.Finalizer))
+	}
+	if !hasOwnerReference {
+		objCopy.SetOwnerReferences(append(objCopy.GetOwnerReferences(), metav1.OwnerReference{
+			APIVersion: scyllaClusterControllerGVK.GroupVersion().String(),
+			Kind:       scyllaClusterControllerGVK.Kind,
+			Name:       sc.Name,
+			UID:        sc.UID,
+		}))
+	}
+	_, err := c.control.UpdateFunc(ctx, objCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("can't update metadata of %s %q: %w", c.control.Kind, naming.ObjRef(obj), err)
+	}
+
+	return true, nil
+}
+
+// calculateStatus returns a copy of the status of the object, which the sync fills in.
+func (c *OneOffTaskController[T, S]) calculateStatus(obj T) *S {
+	status := c.control.GetStatus(obj.DeepCopyObject().(T))
+
+	taskStatus := c.control.GetTaskStatus(status)
+	taskStatus.ObservedGeneration = pointer.Ptr(obj.GetGeneration())
+	if len(taskStatus.Phase) == 0 {
+		taskStatus.Phase = scyllav1alpha1.ScyllaDBManagerTaskPhasePending
+	}
+
+	return status
+}
+
+func (c *OneOffTaskController[T, S]) updateStatus(ctx context.Context, currentObj T, status *S) error {
+	if apiequality.Semantic.DeepEqual(c.control.GetStatus(currentObj), status) {
+		return nil
+	}
+
+	obj := currentObj.DeepCopyObject().(T)
+	*c.control.GetStatus(obj) = *status
+
+	klog.V(2).InfoS("Updating status", c.control.Kind, klog.KObj(obj))
+
+	_, err := c.control.UpdateStatusFunc(ctx, obj, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	klog.V(2).InfoS("Status updated", c.control.Kind, klog.KObj(obj))
+
+	return nil
+}
+
+// enqueueObjectsReferencingScyllaCluster enqueues objects of the ScyllaCluster,
+// e.g. when it gets registered with Scylla Manager.
+func (c *OneOffTaskController[T, S]) enqueueObjectsReferencingScyllaCluster(depth int, obj kubeinterfaces.ObjectInterface, op controllerhelpers.HandlerOperationType) {
+	sc := obj.(*scyllav1.ScyllaCluster)
+
+	objs, err := c.control.GetterLister.List(sc.Namespace, labels.Everything())
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("can't list %ss: %w", c.control.Kind, err))
+		return
+	}
+
+	for _, o := range objs {
+		if c.control.GetScyllaClusterName(o) == sc.Name {
+			c.handlers.Enqueue(depth+1, o, op)
+		}
+	}
+}
+
+func (c *OneOffTaskController[T, S]) addObject(obj interface{}) {
+	c.handlers.HandleAdd(
+		obj.(T),
+		c.handlers.Enqueue,
+	)
+}
+
+func (c *OneOffTaskController[T, S]) updateObject(old, cur interface{}) {
+	c.handlers.HandleUpdate(
+		old.(T),
+		cur.(T),
+		c.handlers.Enqueue,
+		c.deleteObject,
+	)
+}
+
+func (c *OneOffTaskController[T, S]) deleteObject(obj interface{}) {
+	c.handlers.HandleDelete(
+		obj,
+		c.handlers.Enqueue,
+	)
+}
+
+func (c *OneOffTaskController[T, S]) addScyllaCluster(obj interface{}) {
+	c.handlers.HandleAdd(
+		obj.(*scyllav1.ScyllaCluster),
+		c.enqueueObjectsReferencingScyllaCluster,
+	)
+}
+
+func (c *OneOffTaskController[T, S]) updateScyllaCluster(old, cur interface{}) {
+	c.handlers.HandleUpdate(
+		old.(*scyllav1.ScyllaCluster),
+		cur.(*scyllav1.ScyllaCluster),
+		c.enqueueObjectsReferencingScyllaCluster,
+		c.deleteScyllaCluster,
+	)
+}
+
+func (c *OneOffTaskController[T, S]) deleteScyllaCluster(obj interface{}) {
+	c.handlers.HandleDelete(
+		obj,
+		c.enqueueObjectsReferencingScyllaCluster,
+	)
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package manager
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/v3/swagger/gen/scylla-manager/models"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMakeOneOffRepairTask(t *testing.T) {
+	t.Parallel()
+
+	sr := &scyllav1alpha1.ScyllaDBRepair{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pre-upgrade",
+			UID:  "repair-uid",
+		},
+		Spec: scyllav1alpha1.ScyllaDBRepairSpec{
+			ScyllaClusterName:   "basic",
+			Keyspace:            []string{"ks"},
+			Intensity:           "0.5",
+			Parallel:            2,
+			SmallTableThreshold: "1GiB",
+			NumRetries:          pointer.Ptr[int64](5),
+		},
+	}
+
+	expected := &managerclient.Task{
+		Name:    "pre-upgrade",
+		Type:    managerclient.RepairTask,
+		Enabled: true,
+		Labels: map[string]string{
+			naming.OwnerUIDLabel: "repair-uid",
+		},
+		Schedule: &managerclient.Schedule{
+			NumRetries: 5,
+		},
+		Properties: map[string]interface{}{
+			"keyspace":              []string{"ks"},
+			"intensity":             0.5,
+			"parallel":              int64(2),
+			"small_table_threshold": int64(1073741824),
+		},
+	}
+
+	got, err := MakeOneOffRepairTask(sr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected and got tasks differ: %s", cmp.Diff(expected, got))
+	}
+}
+
+func TestMakeOneOffBackupTask(t *testing.T) {
+	t.Parallel()
+
+	sb := &scyllav1alpha1.ScyllaDBBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pre-upgrade",
+			UID:  "backup-uid",
+		},
+		Spec: scyllav1alpha1.ScyllaDBBackupSpec{
+			ScyllaClusterName: "basic",
+			Location:          []string{"s3:backups"},
+			Retention:         1,
+		},
+	}
+
+	expected := &managerclient.Task{
+		Name:    "pre-upgrade",
+		Type:    managerclient.BackupTask,
+		Enabled: true,
+		Labels: map[string]string{
+			naming.OwnerUIDLabel: "backup-uid",
+		},
+		Schedule: &managerclient.Schedule{},
+		Properties: map[string]interface{}{
+			"location":  []string{"s3:backups"},
+			"retention": int64(1),
+		},
+	}
+
+	got, err := MakeOneOffBackupTask(sb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected and got tasks differ: %s", cmp.Diff(expected, got))
+	}
+}
+
+func TestGetOneOffTaskPhase(t *testing.T) {
+	t.Parallel()
+
+	nextActivation := strfmt.DateTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	tt := []struct {
+		name     string
+		task     *managerclient.TaskListItem
+		expected scyllav1alpha1.ScyllaDBManagerTaskPhase
+	}{
+		{
+			name:     "new task is pending",
+			task:     &managerclient.TaskListItem{Status: managerclient.TaskStatusNew},
+			expected: scyllav1alpha1.ScyllaDBManagerTaskPhasePending,
+		},
+		{
+			name:     "running task is running",
+			task:     &managerclient.TaskListItem{Status: managerclient.TaskStatusRunning},
+			expected: scyllav1alpha1.ScyllaDBManagerTaskPhaseRunning,
+		},
+		{
+			name:     "done task has succeeded",
+			task:     &managerclient.TaskListItem{Status: managerclient.TaskStatusDone},
+			expected: scyllav1alpha1.ScyllaDBManagerTaskPhaseSucceeded,
+		},
+		{
+			name:     "failed task to be retried is running",
+			task:     &managerclient.TaskListItem{Status: managerclient.TaskStatusError, NextActivation: &nextActivation},
+			expected: scyllav1alpha1.ScyllaDBManagerTaskPhaseRunning,
+		},
+		{
+			name:     "failed task out of retries has failed",
+			task:     &managerclient.TaskListItem{Status: managerclient.TaskStatusError},
+			expected: scyllav1alpha1.ScyllaDBManagerTaskPhaseFailed,
+		},
+		{
+			name:     "stopped task has failed",
+			task:     &managerclient.TaskListItem{Status: managerclient.TaskStatusStopped},
+			expected: scyllav1alpha1.ScyllaDBManagerTaskPhaseFailed,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := GetOneOffTaskPhase(tc.task)
+			if got != tc.expected {
+				t.Errorf("expected phase %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestSetOneOffTaskRunStatus(t *testing.T) {
+	t.Parallel()
+
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name     string
+		status   *scyllav1alpha1.ScyllaDBManagerTaskStatus
+		run      *models.TaskRun
+		expected *scyllav1alpha1.ScyllaDBManagerTaskStatus
+	}{
+		{
+			name: "running task has a start time",
+			status: &scyllav1alpha1.ScyllaDBManagerTaskStatus{
+				Phase: scyllav1alpha1.ScyllaDBManagerTaskPhaseRunning,
+			},
+			run: &models.TaskRun{
+				StartTime: strfmt.DateTime(startTime),
+			},
+			expected: &scyllav1alpha1.ScyllaDBManagerTaskStatus{
+				Phase:     scyllav1alpha1.ScyllaDBManagerTaskPhaseRunning,
+				StartTime: pointer.Ptr(metav1.NewTime(startTime)),
+			},
+		},
+		{
+			name: "failed task has a completion time and a message",
+			status: &scyllav1alpha1.ScyllaDBManagerTaskStatus{
+				Phase: scyllav1alpha1.ScyllaDBManagerTaskPhaseFailed,
+			},
+			run: &models.TaskRun{
+				StartTime: strfmt.DateTime(startTime),
+				EndTime:   strfmt.DateTime(endTime),
+				Cause:     "no space left on device",
+			},
+			expected: &scyllav1alpha1.ScyllaDBManagerTaskStatus{
+				Phase:          scyllav1alpha1.ScyllaDBManagerTaskPhaseFailed,
+				StartTime:      pointer.Ptr(metav1.NewTime(startTime)),
+				CompletionTime: pointer.Ptr(metav1.NewTime(endTime)),
+				Message:        pointer.Ptr("no space left on device"),
+			},
+		},
+		{
+			name: "finished task without a run completes now",
+			status: &scyllav1alpha1.ScyllaDBManagerTaskStatus{
+				Phase: scyllav1alpha1.ScyllaDBManagerTaskPhaseFailed,
+			},
+			run: nil,
+			expected: &scyllav1alpha1.ScyllaDBManagerTaskStatus{
+				Phase:          scyllav1alpha1.ScyllaDBManagerTaskPhaseFailed,
+				CompletionTime: pointer.Ptr(metav1.NewTime(now)),
+			},
+		},
+		{
+			name: "completion time is kept",
+			status: &scyllav1alpha1.ScyllaDBManagerTaskStatus{
+				Phase:          scyllav1alpha1.ScyllaDBManagerTaskPhaseSucceeded,
+				CompletionTime: pointer.Ptr(metav1.NewTime(endTime)),
+			},
+			run: &models.TaskRun{
+				StartTime: strfmt.DateTime(startTime),
+				EndTime:   strfmt.DateTime(now),
+			},
+			expected: &scyllav1alpha1.ScyllaDBManagerTaskStatus{
+				Phase:          scyllav1alpha1.ScyllaDBManagerTaskPhaseSucceeded,
+				StartTime:      pointer.Ptr(metav1.NewTime(startTime)),
+				CompletionTime: pointer.Ptr(metav1.NewTime(endTime)),
+			},
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.status.DeepCopy()
+			SetOneOffTaskRunStatus(got, tc.run, now)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got statuses differ: %s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func TestGetBackupProgressPercentage(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		progress *models.BackupProgress
+		expected int64
+	}{
+		{
+			name:     "no progress",
+			progress: nil,
+			expected: 0,
+		},
+		{
+			name:     "empty backup",
+			progress: &models.BackupProgress{},
+			expected: 0,
+		},
+		{
+			name: "uploaded and skipped data counts as done",
+			progress: &models.BackupProgress{
+				Size:     200,
+				Uploaded: 50,
+				Skipped:  50,
+			},
+			expected: 50,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := GetBackupProgressPercentage(tc.progress)
+			if got != tc.expected {
+				t.Errorf("expected progress %d, got %d", tc.expected, got)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ func (c *Controller) calculateStatus(sc *scyllav1.ScyllaCluster, state *managerC
 	status.ManagerID = nil
 	status.Backups = []scyllav1.BackupTaskStatus{}
 	status.Repairs = []scyllav1.RepairTaskStatus{}
+	status.Restores = []scyllav1.RestoreTaskStatus{}
 
 	if state.Cluster == nil {
 		return status
@@ -96,6 +97,36 @@ func (c *Controller) calculateStatus(sc *scyllav1.ScyllaCluster, state *managerC
 		status.Backups = append(status.Backups, backupTaskStatus)
 	}
 
+	restoreTaskClientErrorMap := map[string]string{}
+	for _, rts := range sc.Status.Restores {
+		if rts.Error != nil {
+			restoreTaskClientErrorMap[rts.Name] = *rts.Error
+		}
+	}
+
+	for _, rt := range sc.Spec.Restores {
+		restoreTaskStatus := scyllav1.RestoreTaskStatus{
+			TaskStatus: scyllav1.TaskStatus{
+				Name: rt.Name,
+			},
+		}
+
+		managerTaskStatus, isInManagerState := state.RestoreTasks[rt.Name]
+		if isInManagerState {
+			restoreTaskStatus = managerTaskStatus
+		} else {
+			// Retain the error from client.
+			err, hasClientError := restoreTaskClientErrorMap[rt.Name]
+			if !hasClientError {
+				continue
+			}
+
+			restoreTaskStatus.Error = &err
+		}
+
+		status.Restores = append(status.Restores, restoreTaskStatus)
+	}
+
 	return status
 }
 
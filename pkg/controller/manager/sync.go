@@ -57,6 +57,7 @@ func (c *Controller) getManagerClusterState(ctx context.Context, sc *scyllav1.Sc
 
 	var repairTaskStatuses map[string]scyllav1.RepairTaskStatus
 	var backupTaskStatuses map[string]scyllav1.BackupTaskStatus
+	var restoreTaskStatuses map[string]scyllav1.RestoreTaskStatus
 
 	var managerRepairTasks managerclient.TaskListItems
 	managerRepairTasks, err = c.managerClient.ListTasks(ctx, managerCluster.ID, "repair", true, "", "")
@@ -66,6 +67,10 @@ func (c *Controller) getManagerClusterState(ctx context.Context, sc *scyllav1.Sc
 
 	repairTaskStatuses = make(map[string]scyllav1.RepairTaskStatus, len(managerRepairTasks.TaskListItemSlice))
 	for _, managerRepairTask := range managerRepairTasks.TaskListItemSlice {
+		if isOwnedByTaskResource(managerRepairTask) {
+			continue
+		}
+
 		var repairTaskStatus *scyllav1.RepairTaskStatus
 		repairTaskStatus, err = NewRepairStatusFromManager(managerRepairTask)
 		if err != nil {
@@ -82,6 +87,10 @@ func (c *Controller) getManagerClusterState(ctx context.Context, sc *scyllav1.Sc
 
 	backupTaskStatuses = make(map[string]scyllav1.BackupTaskStatus, len(managerBackupTasks.TaskListItemSlice))
 	for _, managerBackupTask := range managerBackupTasks.TaskListItemSlice {
+		if isOwnedByTaskResource(managerBackupTask) {
+			continue
+		}
+
 		var backupTaskStatus *scyllav1.BackupTaskStatus
 		backupTaskStatus, err = NewBackupStatusFromManager(managerBackupTask)
 		if err != nil {
@@ -90,13 +99,41 @@ func (c *Controller) getManagerClusterState(ctx context.Context, sc *scyllav1.Sc
 		backupTaskStatuses[backupTaskStatus.Name] = *backupTaskStatus
 	}
 
+	var managerRestoreTasks managerclient.TaskListItems
+	managerRestoreTasks, err = c.managerClient.ListTasks(ctx, managerCluster.ID, "restore", true, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("can't list restore tasks registered with manager: %w", err)
+	}
+
+	restoreTaskStatuses = make(map[string]scyllav1.RestoreTaskStatus, len(managerRestoreTasks.TaskListItemSlice))
+	for _, managerRestoreTask := range managerRestoreTasks.TaskListItemSlice {
+		if isOwnedByTaskResource(managerRestoreTask) {
+			continue
+		}
+
+		var restoreTaskStatus *scyllav1.RestoreTaskStatus
+		restoreTaskStatus, err = NewRestoreStatusFromManager(managerRestoreTask)
+		if err != nil {
+			return nil, fmt.Errorf("can't get restore task status from manager restore task: %w", err)
+		}
+		restoreTaskStatuses[restoreTaskStatus.Name] = *restoreTaskStatus
+	}
+
 	return &managerClusterState{
-		Cluster:     managerCluster,
-		BackupTasks: backupTaskStatuses,
-		RepairTasks: repairTaskStatuses,
+		Cluster:      managerCluster,
+		BackupTasks:  backupTaskStatuses,
+		RepairTasks:  repairTaskStatuses,
+		RestoreTasks: restoreTaskStatuses,
 	}, nil
 }
 
+// isOwnedByTaskResource returns whether the manager task belongs to a standalone task resource, e.g. a ScyllaDBRepair,
+// in which case its lifecycle isn't managed through the ScyllaCluster.
+func isOwnedByTaskResource(t *managerclient.TaskListItem) bool {
+	_, ok := t.Labels[naming.OwnerUIDLabel]
+	return ok
+}
+
 func (c *Controller) sync(ctx context.Context, key string) error {
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -152,6 +189,13 @@ func (c *Controller) sync(ctx context.Context, key string) error {
 		}
 	}
 
+	if status.ManagerID != nil {
+		err = c.syncBackupInventory(ctx, key, sc, *status.ManagerID, state.BackupTasks)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't sync backup inventory: %w", err))
+		}
+	}
+
 	err = c.updateStatus(ctx, sc, status)
 	if err != nil {
 		errs = append(errs, fmt.Errorf("can't update status: %w", err))
@@ -168,5 +212,26 @@ func (c *Controller) sync(ctx context.Context, key string) error {
 		return nil
 	}
 
+	if hasUnfinishedRestoreTasks(status) {
+		c.queue.AddAfter(key, restoreProgressResyncInterval)
+	}
+
 	return nil
 }
+
+// hasUnfinishedRestoreTasks returns whether any restore task is yet to finish,
+// in which case its progress needs to be periodically reflected in the status.
+func hasUnfinishedRestoreTasks(status *scyllav1.ScyllaClusterStatus) bool {
+	return slices.Contains(status.Restores, func(rts scyllav1.RestoreTaskStatus) bool {
+		if rts.State == nil {
+			return false
+		}
+
+		switch *rts.State {
+		case managerclient.TaskStatusDone, managerclient.TaskStatusError, managerclient.TaskStatusAborted, managerclient.TaskStatusStopped:
+			return false
+		default:
+			return true
+		}
+	})
+}
@@ -21,9 +21,10 @@ import (
 )
 
 type managerClusterState struct {
-	Cluster     *managerclient.Cluster
-	RepairTasks map[string]scyllav1.RepairTaskStatus
-	BackupTasks map[string]scyllav1.BackupTaskStatus
+	Cluster      *managerclient.Cluster
+	RepairTasks  map[string]scyllav1.RepairTaskStatus
+	BackupTasks  map[string]scyllav1.BackupTaskStatus
+	RestoreTasks map[string]scyllav1.RestoreTaskStatus
 }
 
 func runSync(ctx context.Context, sc *scyllav1.ScyllaCluster, authToken string, managerClusterState *managerClusterState) ([]action, bool, error) {
@@ -135,6 +136,26 @@ func syncTasks(clusterID string, sc *scyllav1.ScyllaCluster, state *managerClust
 		})
 	}
 
+	restoreTaskSpecNames := sets.New(slices.ConvertSlice(sc.Spec.Restores, func(r scyllav1.RestoreTaskSpec) string {
+		return r.Name
+	})...)
+	for taskName, task := range state.RestoreTasks {
+		if restoreTaskSpecNames.Has(taskName) {
+			continue
+		}
+
+		if _, ok := task.Labels[naming.ManagedHash]; !ok {
+			// Restores started by hand, e.g. with sctool, aren't managed through the ScyllaCluster.
+			continue
+		}
+
+		actions = append(actions, &deleteTaskAction{
+			ClusterID: clusterID,
+			TaskType:  managerclient.RestoreTask,
+			TaskID:    *task.ID,
+		})
+	}
+
 	repairActions, err := syncRepairTasks(clusterID, sc, state)
 	if err != nil {
 		errs = append(errs, fmt.Errorf("can't sync repair tasks: %w", err))
@@ -147,6 +168,12 @@ func syncTasks(clusterID string, sc *scyllav1.ScyllaCluster, state *managerClust
 	}
 	actions = append(actions, backupActions...)
 
+	restoreActions, err := syncRestoreTasks(clusterID, sc, state)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync restore tasks: %w", err))
+	}
+	actions = append(actions, restoreActions...)
+
 	err = utilerrors.NewAggregate(errs)
 	if err != nil {
 		return nil, err
@@ -227,6 +254,42 @@ func syncRepairTasks(clusterID string, cluster *scyllav1.ScyllaCluster, state *m
 	return actions, nil
 }
 
+func syncRestoreTasks(clusterID string, cluster *scyllav1.ScyllaCluster, state *managerClusterState) ([]action, error) {
+	var errs []error
+	var actions []action
+
+	for _, rt := range cluster.Spec.Restores {
+		taskStatusFunc := func() (*scyllav1.TaskStatus, bool) {
+			s, ok := state.RestoreTasks[rt.Name]
+			if !ok {
+				return nil, false
+			}
+
+			return &s.TaskStatus, true
+		}
+
+		restoreTaskSpecCopy := rt.DeepCopy()
+		restoreTaskSpec := RestoreTaskSpec(*restoreTaskSpecCopy)
+
+		a, err := syncTask(clusterID, &restoreTaskSpec, taskStatusFunc)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't sync restore task %q: %w", rt.Name, err))
+			continue
+		}
+
+		if a != nil {
+			actions = append(actions, a)
+		}
+	}
+
+	err := utilerrors.NewAggregate(errs)
+	if err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+}
+
 func syncTask(clusterID string, spec taskSpecInterface, statusFunc func() (*scyllav1.TaskStatus, bool)) (action, error) {
 	managedHash, err := spec.GetObjectHash()
 	if err != nil {
@@ -452,6 +515,15 @@ func setTaskStatusError(taskType string, taskName string, taskErr string, status
 		}
 
 		updateBackupTaskStatusError(&status.Backups, bts)
+	case managerclient.RestoreTask:
+		rts := scyllav1.RestoreTaskStatus{
+			TaskStatus: scyllav1.TaskStatus{
+				Name:  taskName,
+				Error: &taskErr,
+			},
+		}
+
+		updateRestoreTaskStatusError(&status.Restores, rts)
 	}
 }
 
@@ -481,6 +553,19 @@ func updateBackupTaskStatusError(backupTaskStatuses *[]scyllav1.BackupTaskStatus
 	*backupTaskStatuses = append(*backupTaskStatuses, backupTaskStatus)
 }
 
+func updateRestoreTaskStatusError(restoreTaskStatuses *[]scyllav1.RestoreTaskStatus, restoreTaskStatus scyllav1.RestoreTaskStatus) {
+	_, i, ok := slices.Find(*restoreTaskStatuses, func(rts scyllav1.RestoreTaskStatus) bool {
+		return rts.Name == restoreTaskStatus.Name
+	})
+
+	if ok {
+		(*restoreTaskStatuses)[i].Error = restoreTaskStatus.Error
+		return
+	}
+
+	*restoreTaskStatuses = append(*restoreTaskStatuses, restoreTaskStatus)
+}
+
 func setManagerClientTaskManagedHashLabel(task *managerclient.Task, hash string) {
 	if task.Labels == nil {
 		task.Labels = map[string]string{}
@@ -802,6 +802,55 @@ func Test_runSync(t *testing.T) {
 			expectedRequeue: false,
 			expectedErr:     nil,
 		},
+		{
+			name:      "matching cluster in state, superfluous restore tasks in state, return delete task actions only for managed tasks",
+			sc:        newBasicScyllaCluster(),
+			authToken: "token",
+			state: &managerClusterState{
+				Cluster: &managerclient.Cluster{
+					AuthToken:              "token",
+					ForceNonSslSessionPort: true,
+					ForceTLSDisabled:       true,
+					Host:                   "test-client.test.svc",
+					Labels: map[string]string{
+						"scylla-operator.scylladb.com/owner-uid":    "1bbeb48b-101f-4d49-8ba4-67adc9878721",
+						"scylla-operator.scylladb.com/managed-hash": "UfHEc1kxt3UHl1r2ETXinXfhAtyYrha5RRJn544zkvY6bLDyzl1Q7wSskU355iHlIuwFHyeePE80I0ZOSmoChA==",
+					},
+					Name: "test/test",
+					ID:   "bead6247-d9e4-401c-84b4-ad0bffe36eac",
+				},
+				RestoreTasks: map[string]scyllav1.RestoreTaskStatus{
+					"restore": {
+						TaskStatus: scyllav1.TaskStatus{
+							Name: "restore",
+							ID:   pointer.Ptr("restore-id"),
+							Labels: map[string]string{
+								"scylla-operator.scylladb.com/managed-hash": "hash",
+							},
+						},
+						Location:    []string{"gcs:location"},
+						SnapshotTag: pointer.Ptr("sm_20240101000000UTC"),
+					},
+					"sctool-restore": {
+						TaskStatus: scyllav1.TaskStatus{
+							Name: "sctool-restore",
+							ID:   pointer.Ptr("sctool-restore-id"),
+						},
+						Location:    []string{"gcs:location"},
+						SnapshotTag: pointer.Ptr("sm_20240101000000UTC"),
+					},
+				},
+			},
+			expectedActions: []action{
+				&deleteTaskAction{
+					ClusterID: "bead6247-d9e4-401c-84b4-ad0bffe36eac",
+					TaskType:  "restore",
+					TaskID:    "restore-id",
+				},
+			},
+			expectedRequeue: false,
+			expectedErr:     nil,
+		},
 	}
 
 	for _, tc := range tt {
@@ -210,6 +210,98 @@ func NewBackupStatusFromManager(t *managerclient.TaskListItem) (*scyllav1.Backup
 	return bts, nil
 }
 
+type RestoreTaskSpec scyllav1.RestoreTaskSpec
+
+var _ taskSpecInterface = &RestoreTaskSpec{}
+
+func (r *RestoreTaskSpec) GetTaskSpec() *scyllav1.TaskSpec {
+	return &r.TaskSpec
+}
+
+func (r *RestoreTaskSpec) ToManager() (*managerclient.Task, error) {
+	t := &managerclient.Task{
+		Name:    r.Name,
+		Type:    managerclient.RestoreTask,
+		Enabled: true,
+	}
+
+	schedule, err := schedulerTaskSpecToManager(&r.SchedulerTaskSpec)
+	if err != nil {
+		return nil, err
+	}
+	t.Schedule = schedule
+
+	props := map[string]interface{}{
+		"location":     r.Location,
+		"snapshot_tag": r.SnapshotTag,
+		"batch_size":   r.BatchSize,
+		"parallel":     r.Parallel,
+	}
+
+	if r.Keyspace != nil {
+		props["keyspace"] = unescapeFilters(r.Keyspace)
+	}
+
+	if r.RestoreSchema {
+		props["restore_schema"] = true
+	}
+
+	if r.RestoreTables {
+		props["restore_tables"] = true
+	}
+
+	if r.RateLimit != nil {
+		props["rate_limit"] = r.RateLimit
+	}
+
+	t.Properties = props
+
+	return t, nil
+}
+
+func (r *RestoreTaskSpec) GetObjectHash() (string, error) {
+	return hashutil.HashObjects(r)
+}
+
+func (r *RestoreTaskSpec) ToStatus() *scyllav1.RestoreTaskStatus {
+	rts := &scyllav1.RestoreTaskStatus{
+		Location:      r.Location,
+		SnapshotTag:   pointer.Ptr(r.SnapshotTag),
+		Keyspace:      r.Keyspace,
+		RestoreSchema: pointer.Ptr(r.RestoreSchema),
+		RestoreTables: pointer.Ptr(r.RestoreTables),
+		BatchSize:     pointer.Ptr(r.BatchSize),
+		Parallel:      pointer.Ptr(r.Parallel),
+		RateLimit:     r.RateLimit,
+	}
+
+	rts.TaskStatus = taskSpecToStatus(&r.TaskSpec)
+
+	return rts
+}
+
+func NewRestoreStatusFromManager(t *managerclient.TaskListItem) (*scyllav1.RestoreTaskStatus, error) {
+	rts := &scyllav1.RestoreTaskStatus{}
+
+	rts.TaskStatus = newTaskStatusFromManager(t)
+
+	if t.Properties != nil {
+		props := t.Properties.(map[string]interface{})
+		if err := mapstructure.Decode(props, rts); err != nil {
+			return nil, fmt.Errorf("can't decode properties: %w", err)
+		}
+	}
+
+	// Restore tasks are usually run once, so the progress of the task is reflected as well.
+	if len(t.Status) != 0 {
+		rts.State = pointer.Ptr(t.Status)
+	}
+	rts.SuccessCount = pointer.Ptr(t.SuccessCount)
+	rts.ErrorCount = pointer.Ptr(t.ErrorCount)
+
+	return rts, nil
+}
+
 func schedulerTaskSpecToManager(schedulerTaskSpec *scyllav1.SchedulerTaskSpec) (*managerclient.Schedule, error) {
 	schedule := &managerclient.Schedule{}
 
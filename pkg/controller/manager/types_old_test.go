@@ -435,3 +435,166 @@ func TestBackupTask_FromManager(t *testing.T) {
 		})
 	}
 }
+
+func TestRestoreTask_ToManager(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name            string
+		restoreTaskSpec *RestoreTaskSpec
+		expected        *managerclient.Task
+		expectedError   error
+	}{
+		{
+			name: "fields and properties are propagated",
+			restoreTaskSpec: &RestoreTaskSpec{
+				TaskSpec: scyllav1.TaskSpec{
+					Name: "restore_task_name",
+					SchedulerTaskSpec: scyllav1.SchedulerTaskSpec{
+						StartDate:  pointer.Ptr(validDate),
+						NumRetries: pointer.Ptr[int64](3),
+					},
+				},
+				Location:      []string{"gcs:test"},
+				SnapshotTag:   "sm_20240101000000UTC",
+				Keyspace:      []string{"test"},
+				RestoreTables: true,
+				BatchSize:     2,
+				Parallel:      1,
+				RateLimit: []string{
+					"10",
+					"us-east1:100",
+				},
+			},
+			expected: &managerclient.Task{
+				ClusterID: "",
+				Enabled:   true,
+				ID:        "",
+				Name:      "restore_task_name",
+				Properties: map[string]interface{}{
+					"location":       []string{"gcs:test"},
+					"snapshot_tag":   "sm_20240101000000UTC",
+					"keyspace":       []string{"test"},
+					"restore_tables": true,
+					"batch_size":     int64(2),
+					"parallel":       int64(1),
+					"rate_limit": []string{
+						"10",
+						"us-east1:100",
+					},
+				},
+				Schedule: &managerclient.Schedule{
+					NumRetries: 3,
+					StartDate:  validDateTime,
+				},
+				Type: managerclient.RestoreTask,
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			managerClientTask, err := tc.restoreTaskSpec.ToManager()
+			if !equality.Semantic.DeepEqual(err, tc.expectedError) {
+				t.Errorf("expected error %v, got %v", tc.expectedError, err)
+			}
+
+			if !reflect.DeepEqual(managerClientTask, tc.expected) {
+				t.Errorf("expected and got manager client tasks differ: %s", cmp.Diff(tc.expected, managerClientTask))
+			}
+		})
+	}
+}
+
+func TestRestoreTask_FromManager(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name          string
+		managerTask   *managerclient.TaskListItem
+		expected      *scyllav1.RestoreTaskStatus
+		expectedError error
+	}{
+		{
+			name: "fields, properties and progress are propagated",
+			managerTask: &managerclient.TaskListItem{
+				ClusterID:  "cluster_id",
+				Enabled:    true,
+				ErrorCount: 1,
+				ID:         "restore_task_id",
+				LastError:  validDateTime,
+				Name:       "restore_task_name",
+				Labels: map[string]string{
+					"scylla-operator.scylladb.com/managed-hash": "managed-hash-value",
+				},
+				Properties: map[string]interface{}{
+					"location":       []string{"gcs:test"},
+					"snapshot_tag":   "sm_20240101000000UTC",
+					"keyspace":       []string{"test"},
+					"restore_schema": true,
+					"batch_size":     2,
+					"parallel":       1,
+					"rate_limit": []string{
+						"10",
+						"us-east1:100",
+					},
+				},
+				Retry: 1,
+				Schedule: &managerclient.Schedule{
+					NumRetries: 3,
+					StartDate:  validDateTime,
+				},
+				Status:       managerclient.TaskStatusRunning,
+				SuccessCount: 0,
+				Suspended:    false,
+				Type:         managerclient.RestoreTask,
+			},
+			expected: &scyllav1.RestoreTaskStatus{
+				TaskStatus: scyllav1.TaskStatus{
+					Name: "restore_task_name",
+					SchedulerTaskStatus: scyllav1.SchedulerTaskStatus{
+						StartDate:  pointer.Ptr(validDate),
+						NumRetries: pointer.Ptr[int64](3),
+						Interval:   pointer.Ptr(""),
+						Cron:       pointer.Ptr(""),
+						Timezone:   pointer.Ptr(""),
+					},
+					ID:    pointer.Ptr("restore_task_id"),
+					Error: nil,
+					Labels: map[string]string{
+						"scylla-operator.scylladb.com/managed-hash": "managed-hash-value",
+					},
+				},
+				Location:      []string{"gcs:test"},
+				SnapshotTag:   pointer.Ptr("sm_20240101000000UTC"),
+				Keyspace:      []string{"test"},
+				RestoreSchema: pointer.Ptr(true),
+				BatchSize:     pointer.Ptr[int64](2),
+				Parallel:      pointer.Ptr[int64](1),
+				RateLimit:     []string{"10", "us-east1:100"},
+				State:         pointer.Ptr(managerclient.TaskStatusRunning),
+				SuccessCount:  pointer.Ptr[int64](0),
+				ErrorCount:    pointer.Ptr[int64](1),
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rts, err := NewRestoreStatusFromManager(tc.managerTask)
+			if !equality.Semantic.DeepEqual(err, tc.expectedError) {
+				t.Errorf("expected error %v, got %v", tc.expectedError, err)
+			}
+
+			if !reflect.DeepEqual(rts, tc.expected) {
+				t.Errorf("expected and got restore task statuses differ: %s", cmp.Diff(tc.expected, rts))
+			}
+		})
+	}
+}
@@ -90,7 +90,7 @@ func NodeConfigClusterRole() *rbacv1.ClusterRole {
 			{
 				APIGroups: []string{""},
 				Resources: []string{"nodes"},
-				Verbs:     []string{"get"},
+				Verbs:     []string{"get", "patch"},
 			},
 			{
 				APIGroups: []string{"apps"},
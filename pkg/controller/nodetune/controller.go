@@ -16,6 +16,7 @@ import (
 	"github.com/scylladb/scylla-operator/pkg/kubelet"
 	"github.com/scylladb/scylla-operator/pkg/naming"
 	"github.com/scylladb/scylla-operator/pkg/scheme"
+	"github.com/scylladb/scylla-operator/pkg/util/cloud"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -77,6 +78,9 @@ type Controller struct {
 	scyllaImage    string
 	operatorImage  string
 
+	// instanceMetadata is nil when it couldn't be discovered.
+	instanceMetadata *cloud.InstanceMetadata
+
 	cachesToSync []cache.InformerSynced
 
 	eventRecorder record.EventRecorder
@@ -102,6 +106,7 @@ func NewController(
 	nodeConfigUID types.UID,
 	scyllaImage string,
 	operatorImage string,
+	instanceMetadata *cloud.InstanceMetadata,
 ) (*Controller, error) {
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartStructuredLogging(0)
@@ -128,6 +133,8 @@ func NewController(
 		scyllaImage:    scyllaImage,
 		operatorImage:  operatorImage,
 
+		instanceMetadata: instanceMetadata,
+
 		cachesToSync: []cache.InformerSynced{
 			nodeConfigInformer.Informer().HasSynced,
 			localScyllaPodsInformer.Informer().HasSynced,
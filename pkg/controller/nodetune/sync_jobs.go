@@ -84,7 +84,7 @@ func (ncdc *Controller) makePerftuneJobForContainers(ctx context.Context, podSpe
 	}
 
 	disableWritebackCache := false
-	if cloud.OnGKE() {
+	if ncdc.instanceMetadata != nil && ncdc.instanceMetadata.CloudProvider == cloud.GCPCloud {
 		scyllaVersion, err := naming.ImageToVersion(ncdc.scyllaImage)
 		if err != nil {
 			return nil, fmt.Errorf("can't determine scylla image version %q: %w", ncdc.scyllaImage, err)
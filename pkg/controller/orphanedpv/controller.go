@@ -8,6 +8,7 @@ import (
 	"time"
 
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scyllav1alpha1client "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned/typed/scylla/v1alpha1"
 	scyllav1alpha1informers "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/scylla/v1alpha1"
 	scyllav1alpha1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
@@ -41,7 +42,8 @@ var (
 
 // Controller watches all PVs actively belonging to a ScyllaDBDatacenter and replace scylla node
 // on any PV that is orphaned, if enabled on the ScyllaDBDatacenter.
-// Orphaned PV is a volume that is hard bound to a node that doesn't exist anymore.
+// Orphaned PV is a volume that is hard bound to a node that doesn't exist anymore. Depending on the detectors
+// enabled on the ScyllaDBDatacenter, volumes that are lost, unhealthy or repeatedly fail to mount are orphaned as well.
 // The controller is based on a ScyllaDBDatacenter key and listing matching PVs because using a PV key and trying to
 // find a corresponding ScyllaDBDatacenter would be quite hard, there are no ownerRefs and we can't
 // propagate the "enabled" information from a ScyllaDBDatacenter to a PVC annotation because PVCs are not
@@ -52,10 +54,13 @@ var (
 //	It would also process PVs instead of ScyllaDBDatacenter which is currently complicating the logic
 //	that has to handle multiple PVs at once, artificial requeues / not watching PVs and different error paths.
 type Controller struct {
-	kubeClient kubernetes.Interface
+	kubeClient   kubernetes.Interface
+	scyllaClient scyllav1alpha1client.ScyllaV1alpha1Interface
 
 	pvLister                 corev1listers.PersistentVolumeLister
 	pvcLister                corev1listers.PersistentVolumeClaimLister
+	podLister                corev1listers.PodLister
+	serviceLister            corev1listers.ServiceLister
 	nodeLister               corev1listers.NodeLister
 	scyllaDBDatacenterLister scyllav1alpha1listers.ScyllaDBDatacenterLister
 
@@ -70,8 +75,11 @@ type Controller struct {
 
 func NewController(
 	kubeClient kubernetes.Interface,
+	scyllaClient scyllav1alpha1client.ScyllaV1alpha1Interface,
 	pvInformer corev1informers.PersistentVolumeInformer,
 	pvcInformer corev1informers.PersistentVolumeClaimInformer,
+	podInformer corev1informers.PodInformer,
+	serviceInformer corev1informers.ServiceInformer,
 	nodeInformer corev1informers.NodeInformer,
 	scyllaDBDatacenterInformer scyllav1alpha1informers.ScyllaDBDatacenterInformer,
 ) (*Controller, error) {
@@ -81,14 +89,19 @@ func NewController(
 
 	opc := &Controller{
 		kubeClient:               kubeClient,
+		scyllaClient:             scyllaClient,
 		pvLister:                 pvInformer.Lister(),
 		pvcLister:                pvcInformer.Lister(),
+		podLister:                podInformer.Lister(),
+		serviceLister:            serviceInformer.Lister(),
 		nodeLister:               nodeInformer.Lister(),
 		scyllaDBDatacenterLister: scyllaDBDatacenterInformer.Lister(),
 
 		cachesToSync: []cache.InformerSynced{
 			pvInformer.Informer().HasSynced,
 			pvcInformer.Informer().HasSynced,
+			podInformer.Informer().HasSynced,
+			serviceInformer.Informer().HasSynced,
 			nodeInformer.Informer().HasSynced,
 			scyllaDBDatacenterInformer.Informer().HasSynced,
 		},
@@ -0,0 +1,192 @@
+package orphanedpv
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	corev1 "k8s.io/api/core/v1"
+	corev1schedulinghelpers "k8s.io/component-helpers/scheduling/corev1"
+)
+
+const (
+	defaultMountFailureThreshold = 10
+
+	// orphanedVolumeResyncInterval is how often volumes are checked for being orphaned.
+	orphanedVolumeResyncInterval = time.Minute
+
+	// volumeConditionAbnormalReason is the reason of events reported by the CSI external health monitor
+	// and kubelet when a volume is unhealthy.
+	volumeConditionAbnormalReason = "VolumeConditionAbnormal"
+
+	// volumeConditionMaxAge limits how old abnormal volume condition reports are taken into account.
+	// The health monitor keeps reporting unhealthy volumes periodically.
+	volumeConditionMaxAge = 10 * time.Minute
+)
+
+var mountFailureReasons = []string{
+	"FailedMount",
+	"FailedAttachVolume",
+}
+
+func getMountFailureThreshold(sdc *scyllav1alpha1.ScyllaDBDatacenter) int32 {
+	if sdc.Spec.OrphanedNodeDetection == nil || sdc.Spec.OrphanedNodeDetection.MountFailureThreshold == nil {
+		return defaultMountFailureThreshold
+	}
+
+	return *sdc.Spec.OrphanedNodeDetection.MountFailureThreshold
+}
+
+func getOrphanedVolumeDetectors(sdc *scyllav1alpha1.ScyllaDBDatacenter) []scyllav1alpha1.OrphanedVolumeDetector {
+	if sdc.Spec.OrphanedNodeDetection == nil {
+		return nil
+	}
+
+	return sdc.Spec.OrphanedNodeDetection.Detectors
+}
+
+// isVolumeLost returns whether the PersistentVolume of a bound claim is gone or has failed.
+// pv is nil when the PersistentVolume doesn't exist.
+func isVolumeLost(pvc *corev1.PersistentVolumeClaim, pv *corev1.PersistentVolume) bool {
+	if pvc.Status.Phase == corev1.ClaimLost {
+		return true
+	}
+
+	if pv == nil {
+		return len(pvc.Spec.VolumeName) != 0
+	}
+
+	return pv.Status.Phase == corev1.VolumeFailed
+}
+
+// isVolumeNodeReplaced returns whether a Node matching the node affinity of the PersistentVolume was created
+// after the volume, i.e. the Node was replaced under the same name while the volume stayed bound.
+func isVolumeNodeReplaced(pv *corev1.PersistentVolume, nodes []*corev1.Node) (bool, error) {
+	if pv.Spec.NodeAffinity == nil || pv.Spec.NodeAffinity.Required == nil {
+		return false, nil
+	}
+
+	for _, node := range nodes {
+		match, err := corev1schedulinghelpers.MatchNodeSelectorTerms(node, pv.Spec.NodeAffinity.Required)
+		if err != nil {
+			return false, err
+		}
+
+		if match && pv.CreationTimestamp.Before(&node.CreationTimestamp) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func getEventTime(e *corev1.Event) time.Time {
+	switch {
+	case e.Series != nil:
+		return e.Series.LastObservedTime.Time
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
+
+func getEventCount(e *corev1.Event) int32 {
+	switch {
+	case e.Series != nil:
+		return e.Series.Count
+	case e.Count > 0:
+		return e.Count
+	default:
+		return 1
+	}
+}
+
+// hasAbnormalVolumeCondition returns whether any of the events recently reported an abnormal volume condition.
+func hasAbnormalVolumeCondition(events []*corev1.Event, now time.Time) bool {
+	for _, e := range events {
+		if e.Type != corev1.EventTypeWarning || e.Reason != volumeConditionAbnormalReason {
+			continue
+		}
+
+		if now.Sub(getEventTime(e)) <= volumeConditionMaxAge {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getDataVolumeNames returns names the data volume of the Pod is referred to by in mount failure events.
+// Kubelet uses the name of the Pod volume when listing volumes it couldn't mount and the name of the PersistentVolume
+// when reporting failures of individual attach and mount operations.
+func getDataVolumeNames(pod *corev1.Pod, pvc *corev1.PersistentVolumeClaim) []string {
+	var names []string
+	for _, v := range pod.Spec.Volumes {
+		if v.PersistentVolumeClaim != nil && v.PersistentVolumeClaim.ClaimName == pvc.Name {
+			names = append(names, v.Name)
+		}
+	}
+
+	if len(pvc.Spec.VolumeName) != 0 {
+		names = append(names, pvc.Spec.VolumeName)
+	}
+
+	return names
+}
+
+// isEventAboutVolumes returns whether the event message names any of the volumes.
+func isEventAboutVolumes(message string, volumeNames []string) bool {
+	for _, name := range volumeNames {
+		if strings.Contains(message, fmt.Sprintf("volume %q", name)) {
+			return true
+		}
+	}
+
+	// Timeouts list all volumes that aren't mounted yet, e.g. "unmounted volumes=[data], unattached volumes=[...]".
+	const unmountedVolumesPrefix = "unmounted volumes=["
+	idx := strings.Index(message, unmountedVolumesPrefix)
+	if idx < 0 {
+		return false
+	}
+	unmounted := message[idx+len(unmountedVolumesPrefix):]
+	end := strings.Index(unmounted, "]")
+	if end < 0 {
+		return false
+	}
+
+	return slices.Contains(strings.Fields(unmounted[:end]), func(name string) bool {
+		return slices.ContainsItem(volumeNames, name)
+	})
+}
+
+// getMountFailureCount returns the number of failed attempts to attach or mount the data volume of a pending Pod.
+// Failures of other volumes, e.g. of missing Secrets, and events of previous Pods with the same name are ignored.
+func getMountFailureCount(pod *corev1.Pod, pvc *corev1.PersistentVolumeClaim, events []*corev1.Event) int32 {
+	if pod.Status.Phase != corev1.PodPending {
+		return 0
+	}
+
+	volumeNames := getDataVolumeNames(pod, pvc)
+
+	var count int32
+	for _, e := range events {
+		if e.Type != corev1.EventTypeWarning || e.InvolvedObject.UID != pod.UID {
+			continue
+		}
+
+		if !slices.ContainsItem(mountFailureReasons, e.Reason) {
+			continue
+		}
+
+		if isEventAboutVolumes(e.Message, volumeNames) {
+			count += getEventCount(e)
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,274 @@
+package orphanedpv
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsVolumeLost(t *testing.T) {
+	t.Parallel()
+
+	boundPVC := &corev1.PersistentVolumeClaim{
+		Spec: corev1.PersistentVolumeClaimSpec{
+			VolumeName: "pv-1",
+		},
+		Status: corev1.PersistentVolumeClaimStatus{
+			Phase: corev1.ClaimBound,
+		},
+	}
+
+	tt := []struct {
+		name     string
+		pvc      *corev1.PersistentVolumeClaim
+		pv       *corev1.PersistentVolume
+		expected bool
+	}{
+		{
+			name: "bound volume isn't lost",
+			pvc:  boundPVC,
+			pv: &corev1.PersistentVolume{
+				Status: corev1.PersistentVolumeStatus{
+					Phase: corev1.VolumeBound,
+				},
+			},
+			expected: false,
+		},
+		{
+			name:     "missing volume is lost",
+			pvc:      boundPVC,
+			pv:       nil,
+			expected: true,
+		},
+		{
+			name: "failed volume is lost",
+			pvc:  boundPVC,
+			pv: &corev1.PersistentVolume{
+				Status: corev1.PersistentVolumeStatus{
+					Phase: corev1.VolumeFailed,
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "volume of lost claim is lost",
+			pvc: &corev1.PersistentVolumeClaim{
+				Spec: corev1.PersistentVolumeClaimSpec{
+					VolumeName: "pv-1",
+				},
+				Status: corev1.PersistentVolumeClaimStatus{
+					Phase: corev1.ClaimLost,
+				},
+			},
+			pv:       nil,
+			expected: true,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := isVolumeLost(tc.pvc, tc.pv)
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestHasAbnormalVolumeCondition(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name     string
+		events   []*corev1.Event
+		expected bool
+	}{
+		{
+			name:     "no events",
+			events:   nil,
+			expected: false,
+		},
+		{
+			name: "recent abnormal condition",
+			events: []*corev1.Event{
+				{
+					Type:          corev1.EventTypeWarning,
+					Reason:        volumeConditionAbnormalReason,
+					LastTimestamp: metav1.NewTime(now.Add(-time.Minute)),
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "stale abnormal condition",
+			events: []*corev1.Event{
+				{
+					Type:          corev1.EventTypeWarning,
+					Reason:        volumeConditionAbnormalReason,
+					LastTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "recent series of abnormal conditions",
+			events: []*corev1.Event{
+				{
+					Type:          corev1.EventTypeWarning,
+					Reason:        volumeConditionAbnormalReason,
+					LastTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+					Series: &corev1.EventSeries{
+						Count:            3,
+						LastObservedTime: metav1.NewMicroTime(now.Add(-time.Minute)),
+					},
+				},
+			},
+			expected: true,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := hasAbnormalVolumeCondition(tc.events, now)
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetMountFailureCount(t *testing.T) {
+	t.Parallel()
+
+	newPod := func(phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "basic-dc-rack-0",
+				UID:  "uid-2",
+			},
+			Spec: corev1.PodSpec{
+				Volumes: []corev1.Volume{
+					{
+						Name: "data",
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "data-basic-dc-rack-0",
+							},
+						},
+					},
+					{
+						Name: "scylla-agent-config-volume",
+						VolumeSource: corev1.VolumeSource{
+							Secret: &corev1.SecretVolumeSource{
+								SecretName: "basic-agent-config",
+							},
+						},
+					},
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: phase,
+			},
+		}
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "data-basic-dc-rack-0",
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			VolumeName: "pvc-1234",
+		},
+	}
+
+	events := []*corev1.Event{
+		{
+			InvolvedObject: corev1.ObjectReference{UID: "uid-2"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "FailedMount",
+			Message:        `MountVolume.MountDevice failed for volume "pvc-1234" : rpc error: code = Internal`,
+			Count:          4,
+		},
+		{
+			InvolvedObject: corev1.ObjectReference{UID: "uid-2"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "FailedMount",
+			Message:        `Unable to attach or mount volumes: unmounted volumes=[data], unattached volumes=[data kube-api-access]: timed out waiting for the condition`,
+			Count:          3,
+		},
+		{
+			InvolvedObject: corev1.ObjectReference{UID: "uid-2"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "FailedAttachVolume",
+			Message:        `AttachVolume.Attach failed for volume "pvc-1234" : volume not found`,
+			Count:          2,
+		},
+		{
+			InvolvedObject: corev1.ObjectReference{UID: "uid-2"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "FailedMount",
+			Message:        `MountVolume.SetUp failed for volume "scylla-agent-config-volume" : secret "basic-agent-config" not found`,
+			Count:          30,
+		},
+		{
+			InvolvedObject: corev1.ObjectReference{UID: "uid-2"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "FailedMount",
+			Message:        `Unable to attach or mount volumes: unmounted volumes=[scylla-agent-config-volume], unattached volumes=[data scylla-agent-config-volume]: timed out waiting for the condition`,
+			Count:          30,
+		},
+		{
+			InvolvedObject: corev1.ObjectReference{UID: "uid-2"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "FailedScheduling",
+			Message:        `0/3 nodes are available: volume "pvc-1234" node affinity conflict`,
+			Count:          5,
+		},
+		{
+			InvolvedObject: corev1.ObjectReference{UID: "uid-1"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "FailedMount",
+			Message:        `MountVolume.MountDevice failed for volume "pvc-1234" : rpc error: code = Internal`,
+			Count:          20,
+		},
+	}
+
+	tt := []struct {
+		name     string
+		pod      *corev1.Pod
+		expected int32
+	}{
+		{
+			name:     "data volume failures of pending pod are counted",
+			pod:      newPod(corev1.PodPending),
+			expected: 9,
+		},
+		{
+			name:     "failures of running pod are ignored",
+			pod:      newPod(corev1.PodRunning),
+			expected: 0,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getMountFailureCount(tc.pod, pvc, events)
+			if got != tc.expected {
+				t.Errorf("expected %d, got %d", tc.expected, got)
+			}
+		})
+	}
+}
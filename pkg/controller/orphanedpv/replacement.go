@@ -0,0 +1,235 @@
+package orphanedpv
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+)
+
+type orphanedNode struct {
+	serviceName string
+	rack        string
+	reason      string
+}
+
+func getOrphanedNodeReplacementMode(sdc *scyllav1alpha1.ScyllaDBDatacenter) scyllav1alpha1.OrphanedNodeReplacementMode {
+	if sdc.Spec.OrphanedNodeReplacement == nil || len(sdc.Spec.OrphanedNodeReplacement.Mode) == 0 {
+		return scyllav1alpha1.AutomaticOrphanedNodeReplacementMode
+	}
+
+	return sdc.Spec.OrphanedNodeReplacement.Mode
+}
+
+func getOrphanedNodeReplacementRateLimit(sdc *scyllav1alpha1.ScyllaDBDatacenter) *scyllav1alpha1.OrphanedNodeReplacementRateLimit {
+	if sdc.Spec.OrphanedNodeReplacement == nil {
+		return nil
+	}
+
+	return sdc.Spec.OrphanedNodeReplacement.RateLimit
+}
+
+func isReplacementApproved(svc *corev1.Service) bool {
+	return svc != nil && svc.Annotations[naming.ApproveReplacementAnnotation] == naming.LabelValueTrue
+}
+
+func isMarkedForReplacement(svc *corev1.Service) bool {
+	if svc == nil {
+		return false
+	}
+
+	_, ok := svc.Labels[naming.ReplaceLabel]
+	return ok
+}
+
+// planReplacements returns the replacement statuses of orphaned nodes and the names of member Services that have
+// to be marked for replacement.
+// Replacements are kept in the status while the node is orphaned, or while they count towards the rate limit.
+// A node that is still orphaned but isn't marked for replacement is marked again, as the previous attempt
+// didn't succeed. The replace label is only removed once the new node is ready, and then it's not orphaned anymore.
+func planReplacements(
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	orphanedNodes []orphanedNode,
+	services map[string]*corev1.Service,
+	now time.Time,
+) ([]scyllav1alpha1.OrphanedNodeReplacementStatus, []string) {
+	mode := getOrphanedNodeReplacementMode(sdc)
+	rateLimit := getOrphanedNodeReplacementRateLimit(sdc)
+
+	orphanedNodesMap := make(map[string]orphanedNode, len(orphanedNodes))
+	for _, on := range orphanedNodes {
+		orphanedNodesMap[on.serviceName] = on
+	}
+
+	var statuses []scyllav1alpha1.OrphanedNodeReplacementStatus
+	var toReplace []string
+	previousStatuses := map[string]scyllav1alpha1.OrphanedNodeReplacementStatus{}
+	replacementsPerRack := map[string]int32{}
+
+	for _, s := range sdc.Status.OrphanedNodeReplacements {
+		previousStatuses[s.ServiceName] = s
+
+		if s.Phase != scyllav1alpha1.OrphanedNodeReplacementPhaseReplacing || s.ReplacementTime == nil {
+			continue
+		}
+
+		_, stillOrphaned := orphanedNodesMap[s.ServiceName]
+		countsTowardsRateLimit := rateLimit != nil && now.Sub(s.ReplacementTime.Time) < rateLimit.Period.Duration
+		if !stillOrphaned && !countsTowardsRateLimit {
+			continue
+		}
+
+		statuses = append(statuses, s)
+		if countsTowardsRateLimit {
+			replacementsPerRack[s.Rack]++
+		}
+
+		if stillOrphaned && !isMarkedForReplacement(services[s.ServiceName]) {
+			toReplace = append(toReplace, s.ServiceName)
+		}
+	}
+
+	sort.Slice(orphanedNodes, func(i, j int) bool {
+		return orphanedNodes[i].serviceName < orphanedNodes[j].serviceName
+	})
+
+	for _, on := range orphanedNodes {
+		previousStatus, hasPreviousStatus := previousStatuses[on.serviceName]
+		if hasPreviousStatus && previousStatus.Phase == scyllav1alpha1.OrphanedNodeReplacementPhaseReplacing {
+			continue
+		}
+
+		status := scyllav1alpha1.OrphanedNodeReplacementStatus{
+			ServiceName:   on.serviceName,
+			Rack:          on.rack,
+			Reason:        on.reason,
+			DetectionTime: metav1.NewTime(now),
+		}
+		if hasPreviousStatus {
+			status.DetectionTime = previousStatus.DetectionTime
+		}
+
+		switch {
+		case mode == scyllav1alpha1.RequireApprovalOrphanedNodeReplacementMode && !isReplacementApproved(services[on.serviceName]):
+			status.Phase = scyllav1alpha1.OrphanedNodeReplacementPhasePendingApproval
+
+		case rateLimit != nil && replacementsPerRack[on.rack] >= rateLimit.MaxReplacementsPerRack:
+			status.Phase = scyllav1alpha1.OrphanedNodeReplacementPhaseRateLimited
+
+		default:
+			status.Phase = scyllav1alpha1.OrphanedNodeReplacementPhaseReplacing
+			status.ReplacementTime = &metav1.Time{Time: now}
+			replacementsPerRack[on.rack]++
+			toReplace = append(toReplace, on.serviceName)
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].ServiceName < statuses[j].ServiceName
+	})
+
+	return statuses, toReplace
+}
+
+// syncReplacements records the replacements of orphaned nodes in the status and marks the approved ones
+// for replacement, within the rate limit.
+func (opc *Controller) syncReplacements(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, key string, orphanedNodes []orphanedNode) error {
+	services := map[string]*corev1.Service{}
+	for _, on := range orphanedNodes {
+		svc, err := opc.serviceLister.Services(sdc.Namespace).Get(on.serviceName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		services[svc.Name] = svc
+	}
+
+	statuses, toReplace := planReplacements(sdc, orphanedNodes, services, time.Now())
+
+	previousPhases := map[string]scyllav1alpha1.OrphanedNodeReplacementPhase{}
+	for _, s := range sdc.Status.OrphanedNodeReplacements {
+		previousPhases[s.ServiceName] = s.Phase
+	}
+
+	for _, s := range statuses {
+		if s.Phase == previousPhases[s.ServiceName] {
+			continue
+		}
+
+		switch s.Phase {
+		case scyllav1alpha1.OrphanedNodeReplacementPhasePendingApproval:
+			opc.eventRecorder.Eventf(
+				sdc,
+				corev1.EventTypeWarning,
+				"OrphanedNodeReplacementPendingApproval",
+				"Node %q is orphaned: %s. Annotate Service %q with %s=true to replace it.",
+				s.ServiceName, s.Reason, s.ServiceName, naming.ApproveReplacementAnnotation,
+			)
+
+		case scyllav1alpha1.OrphanedNodeReplacementPhaseRateLimited:
+			opc.eventRecorder.Eventf(
+				sdc,
+				corev1.EventTypeWarning,
+				"OrphanedNodeReplacementRateLimited",
+				"Node %q is orphaned: %s. Its replacement waits for the rate limit of rack %q.",
+				s.ServiceName, s.Reason, s.Rack,
+			)
+		}
+	}
+
+	for _, s := range statuses {
+		if s.Phase != scyllav1alpha1.OrphanedNodeReplacementPhaseReplacing {
+			opc.queue.AddAfter(key, orphanedVolumeResyncInterval)
+			break
+		}
+	}
+
+	if !apiequality.Semantic.DeepEqual(statuses, sdc.Status.OrphanedNodeReplacements) {
+		sdcCopy := sdc.DeepCopy()
+		sdcCopy.Status.OrphanedNodeReplacements = statuses
+		_, err := opc.scyllaClient.ScyllaDBDatacenters(sdcCopy.Namespace).UpdateStatus(ctx, sdcCopy, metav1.UpdateOptions{})
+		if err != nil {
+			// Replacements are only started once they are recorded, so they count towards the rate limit.
+			return fmt.Errorf("can't update status of ScyllaDBDatacenter %q: %w", naming.ObjRef(sdc), err)
+		}
+	}
+
+	reasons := map[string]string{}
+	for _, s := range statuses {
+		reasons[s.ServiceName] = s.Reason
+	}
+
+	var errs []error
+	for _, svcName := range toReplace {
+		_, err := opc.kubeClient.CoreV1().Services(sdc.Namespace).Patch(
+			ctx,
+			svcName,
+			types.MergePatchType,
+			[]byte(fmt.Sprintf(`{"metadata": {"labels": {%q: ""}, "annotations": {%q: null} } }`, naming.ReplaceLabel, naming.ApproveReplacementAnnotation)),
+			metav1.PatchOptions{},
+		)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		klog.V(2).InfoS("Marked service for replacement", "ScyllaDBDatacenter", klog.KObj(sdc), "Service", klog.KRef(sdc.Namespace, svcName))
+		opc.eventRecorder.Eventf(sdc, corev1.EventTypeWarning, "OrphanedNodeReplacement", "Marked node %q for replacement: %s", svcName, reasons[svcName])
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
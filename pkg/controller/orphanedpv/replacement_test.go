@@ -0,0 +1,319 @@
+package orphanedpv
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPlanReplacements(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	detectionTime := metav1.NewTime(now.Add(-time.Hour))
+
+	newSDC := func(replacement *scyllav1alpha1.OrphanedNodeReplacement, statuses []scyllav1alpha1.OrphanedNodeReplacementStatus) *scyllav1alpha1.ScyllaDBDatacenter {
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "basic",
+				Namespace: "scylla",
+			},
+			Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+				OrphanedNodeReplacement: replacement,
+			},
+			Status: scyllav1alpha1.ScyllaDBDatacenterStatus{
+				OrphanedNodeReplacements: statuses,
+			},
+		}
+	}
+
+	newService := func(name string, labels, annotations map[string]string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "scylla",
+				Labels:      labels,
+				Annotations: annotations,
+			},
+		}
+	}
+
+	tt := []struct {
+		name              string
+		sdc               *scyllav1alpha1.ScyllaDBDatacenter
+		orphanedNodes     []orphanedNode
+		services          map[string]*corev1.Service
+		expectedStatuses  []scyllav1alpha1.OrphanedNodeReplacementStatus
+		expectedToReplace []string
+	}{
+		{
+			name: "orphaned node is replaced right away by default",
+			sdc:  newSDC(nil, nil),
+			orphanedNodes: []orphanedNode{
+				{serviceName: "basic-a-0", rack: "a", reason: "lost"},
+			},
+			services: map[string]*corev1.Service{
+				"basic-a-0": newService("basic-a-0", nil, nil),
+			},
+			expectedStatuses: []scyllav1alpha1.OrphanedNodeReplacementStatus{
+				{
+					ServiceName:     "basic-a-0",
+					Rack:            "a",
+					Reason:          "lost",
+					Phase:           scyllav1alpha1.OrphanedNodeReplacementPhaseReplacing,
+					DetectionTime:   metav1.NewTime(now),
+					ReplacementTime: &metav1.Time{Time: now},
+				},
+			},
+			expectedToReplace: []string{"basic-a-0"},
+		},
+		{
+			name: "orphaned node waits for approval",
+			sdc: newSDC(&scyllav1alpha1.OrphanedNodeReplacement{
+				Mode: scyllav1alpha1.RequireApprovalOrphanedNodeReplacementMode,
+			}, nil),
+			orphanedNodes: []orphanedNode{
+				{serviceName: "basic-a-0", rack: "a", reason: "lost"},
+			},
+			services: map[string]*corev1.Service{
+				"basic-a-0": newService("basic-a-0", nil, nil),
+			},
+			expectedStatuses: []scyllav1alpha1.OrphanedNodeReplacementStatus{
+				{
+					ServiceName:   "basic-a-0",
+					Rack:          "a",
+					Reason:        "lost",
+					Phase:         scyllav1alpha1.OrphanedNodeReplacementPhasePendingApproval,
+					DetectionTime: metav1.NewTime(now),
+				},
+			},
+			expectedToReplace: nil,
+		},
+		{
+			name: "approved orphaned node is replaced and keeps its detection time",
+			sdc: newSDC(&scyllav1alpha1.OrphanedNodeReplacement{
+				Mode: scyllav1alpha1.RequireApprovalOrphanedNodeReplacementMode,
+			}, []scyllav1alpha1.OrphanedNodeReplacementStatus{
+				{
+					ServiceName:   "basic-a-0",
+					Rack:          "a",
+					Reason:        "lost",
+					Phase:         scyllav1alpha1.OrphanedNodeReplacementPhasePendingApproval,
+					DetectionTime: detectionTime,
+				},
+			}),
+			orphanedNodes: []orphanedNode{
+				{serviceName: "basic-a-0", rack: "a", reason: "lost"},
+			},
+			services: map[string]*corev1.Service{
+				"basic-a-0": newService("basic-a-0", nil, map[string]string{naming.ApproveReplacementAnnotation: naming.LabelValueTrue}),
+			},
+			expectedStatuses: []scyllav1alpha1.OrphanedNodeReplacementStatus{
+				{
+					ServiceName:     "basic-a-0",
+					Rack:            "a",
+					Reason:          "lost",
+					Phase:           scyllav1alpha1.OrphanedNodeReplacementPhaseReplacing,
+					DetectionTime:   detectionTime,
+					ReplacementTime: &metav1.Time{Time: now},
+				},
+			},
+			expectedToReplace: []string{"basic-a-0"},
+		},
+		{
+			name: "replacements over the rate limit of a rack wait, other racks aren't affected",
+			sdc: newSDC(&scyllav1alpha1.OrphanedNodeReplacement{
+				RateLimit: &scyllav1alpha1.OrphanedNodeReplacementRateLimit{
+					MaxReplacementsPerRack: 1,
+					Period:                 metav1.Duration{Duration: 24 * time.Hour},
+				},
+			}, []scyllav1alpha1.OrphanedNodeReplacementStatus{
+				{
+					ServiceName:     "basic-a-2",
+					Rack:            "a",
+					Reason:          "lost",
+					Phase:           scyllav1alpha1.OrphanedNodeReplacementPhaseReplacing,
+					DetectionTime:   detectionTime,
+					ReplacementTime: &detectionTime,
+				},
+			}),
+			orphanedNodes: []orphanedNode{
+				{serviceName: "basic-b-0", rack: "b", reason: "lost"},
+				{serviceName: "basic-a-0", rack: "a", reason: "lost"},
+			},
+			services: map[string]*corev1.Service{
+				"basic-a-0": newService("basic-a-0", nil, nil),
+				"basic-b-0": newService("basic-b-0", nil, nil),
+			},
+			expectedStatuses: []scyllav1alpha1.OrphanedNodeReplacementStatus{
+				{
+					ServiceName:   "basic-a-0",
+					Rack:          "a",
+					Reason:        "lost",
+					Phase:         scyllav1alpha1.OrphanedNodeReplacementPhaseRateLimited,
+					DetectionTime: metav1.NewTime(now),
+				},
+				{
+					ServiceName:     "basic-a-2",
+					Rack:            "a",
+					Reason:          "lost",
+					Phase:           scyllav1alpha1.OrphanedNodeReplacementPhaseReplacing,
+					DetectionTime:   detectionTime,
+					ReplacementTime: &detectionTime,
+				},
+				{
+					ServiceName:     "basic-b-0",
+					Rack:            "b",
+					Reason:          "lost",
+					Phase:           scyllav1alpha1.OrphanedNodeReplacementPhaseReplacing,
+					DetectionTime:   metav1.NewTime(now),
+					ReplacementTime: &metav1.Time{Time: now},
+				},
+			},
+			expectedToReplace: []string{"basic-b-0"},
+		},
+		{
+			name: "replacements outside of the rate limit period are forgotten",
+			sdc: newSDC(&scyllav1alpha1.OrphanedNodeReplacement{
+				RateLimit: &scyllav1alpha1.OrphanedNodeReplacementRateLimit{
+					MaxReplacementsPerRack: 1,
+					Period:                 metav1.Duration{Duration: 30 * time.Minute},
+				},
+			}, []scyllav1alpha1.OrphanedNodeReplacementStatus{
+				{
+					ServiceName:     "basic-a-2",
+					Rack:            "a",
+					Reason:          "lost",
+					Phase:           scyllav1alpha1.OrphanedNodeReplacementPhaseReplacing,
+					DetectionTime:   detectionTime,
+					ReplacementTime: &detectionTime,
+				},
+			}),
+			orphanedNodes: []orphanedNode{
+				{serviceName: "basic-a-0", rack: "a", reason: "lost"},
+			},
+			services: map[string]*corev1.Service{
+				"basic-a-0": newService("basic-a-0", nil, nil),
+			},
+			expectedStatuses: []scyllav1alpha1.OrphanedNodeReplacementStatus{
+				{
+					ServiceName:     "basic-a-0",
+					Rack:            "a",
+					Reason:          "lost",
+					Phase:           scyllav1alpha1.OrphanedNodeReplacementPhaseReplacing,
+					DetectionTime:   metav1.NewTime(now),
+					ReplacementTime: &metav1.Time{Time: now},
+				},
+			},
+			expectedToReplace: []string{"basic-a-0"},
+		},
+		{
+			name: "node being replaced isn't marked again",
+			sdc: newSDC(nil, []scyllav1alpha1.OrphanedNodeReplacementStatus{
+				{
+					ServiceName:     "basic-a-0",
+					Rack:            "a",
+					Reason:          "lost",
+					Phase:           scyllav1alpha1.OrphanedNodeReplacementPhaseReplacing,
+					DetectionTime:   detectionTime,
+					ReplacementTime: &detectionTime,
+				},
+			}),
+			orphanedNodes: []orphanedNode{
+				{serviceName: "basic-a-0", rack: "a", reason: "lost"},
+			},
+			services: map[string]*corev1.Service{
+				"basic-a-0": newService("basic-a-0", map[string]string{naming.ReplaceLabel: ""}, nil),
+			},
+			expectedStatuses: []scyllav1alpha1.OrphanedNodeReplacementStatus{
+				{
+					ServiceName:     "basic-a-0",
+					Rack:            "a",
+					Reason:          "lost",
+					Phase:           scyllav1alpha1.OrphanedNodeReplacementPhaseReplacing,
+					DetectionTime:   detectionTime,
+					ReplacementTime: &detectionTime,
+				},
+			},
+			expectedToReplace: nil,
+		},
+		{
+			name: "node that failed to be marked is marked again",
+			sdc: newSDC(nil, []scyllav1alpha1.OrphanedNodeReplacementStatus{
+				{
+					ServiceName:     "basic-a-0",
+					Rack:            "a",
+					Reason:          "lost",
+					Phase:           scyllav1alpha1.OrphanedNodeReplacementPhaseReplacing,
+					DetectionTime:   detectionTime,
+					ReplacementTime: &detectionTime,
+				},
+			}),
+			orphanedNodes: []orphanedNode{
+				{serviceName: "basic-a-0", rack: "a", reason: "lost"},
+			},
+			services: map[string]*corev1.Service{
+				"basic-a-0": newService("basic-a-0", nil, nil),
+			},
+			expectedStatuses: []scyllav1alpha1.OrphanedNodeReplacementStatus{
+				{
+					ServiceName:     "basic-a-0",
+					Rack:            "a",
+					Reason:          "lost",
+					Phase:           scyllav1alpha1.OrphanedNodeReplacementPhaseReplacing,
+					DetectionTime:   detectionTime,
+					ReplacementTime: &detectionTime,
+				},
+			},
+			expectedToReplace: []string{"basic-a-0"},
+		},
+		{
+			name: "nodes that aren't orphaned anymore are forgotten",
+			sdc: newSDC(&scyllav1alpha1.OrphanedNodeReplacement{
+				Mode: scyllav1alpha1.RequireApprovalOrphanedNodeReplacementMode,
+			}, []scyllav1alpha1.OrphanedNodeReplacementStatus{
+				{
+					ServiceName:   "basic-a-0",
+					Rack:          "a",
+					Reason:        "lost",
+					Phase:         scyllav1alpha1.OrphanedNodeReplacementPhasePendingApproval,
+					DetectionTime: detectionTime,
+				},
+				{
+					ServiceName:     "basic-a-1",
+					Rack:            "a",
+					Reason:          "lost",
+					Phase:           scyllav1alpha1.OrphanedNodeReplacementPhaseReplacing,
+					DetectionTime:   detectionTime,
+					ReplacementTime: &detectionTime,
+				},
+			}),
+			orphanedNodes:     nil,
+			services:          map[string]*corev1.Service{},
+			expectedStatuses:  nil,
+			expectedToReplace: nil,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			statuses, toReplace := planReplacements(tc.sdc, tc.orphanedNodes, tc.services, now)
+			if !reflect.DeepEqual(statuses, tc.expectedStatuses) {
+				t.Errorf("expected and got statuses differ: %s", cmp.Diff(tc.expectedStatuses, statuses))
+			}
+
+			if !reflect.DeepEqual(toReplace, tc.expectedToReplace) {
+				t.Errorf("expected and got services to replace differ: %s", cmp.Diff(tc.expectedToReplace, toReplace))
+			}
+		})
+	}
+}
@@ -7,20 +7,25 @@ import (
 
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
 	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
-	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 )
 
 type PVItem struct {
+	PVC *corev1.PersistentVolumeClaim
+	// PV is nil when the PersistentVolume bound to the claim doesn't exist.
 	PV          *corev1.PersistentVolume
 	ServiceName string
+	Rack        string
 }
 
 func (opc *Controller) getPVsForScyllaDBDatacenter(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter) ([]*PVItem, []string, error) {
@@ -57,13 +62,19 @@ func (opc *Controller) getPVsForScyllaDBDatacenter(ctx context.Context, sdc *scy
 
 			pv, err := opc.pvLister.Get(pvc.Spec.VolumeName)
 			if err != nil {
-				errs = append(errs, err)
-				continue
+				if !apierrors.IsNotFound(err) || !slices.ContainsItem(getOrphanedVolumeDetectors(sdc), scyllav1alpha1.PersistentVolumePhaseOrphanedVolumeDetector) {
+					errs = append(errs, err)
+					continue
+				}
+
+				pv = nil
 			}
 
 			pis = append(pis, &PVItem{
+				PVC:         pvc,
 				PV:          pv,
 				ServiceName: svcName,
+				Rack:        rack.Name,
 			})
 		}
 	}
@@ -71,6 +82,184 @@ func (opc *Controller) getPVsForScyllaDBDatacenter(ctx context.Context, sdc *scy
 	return pis, requeueReasons, utilerrors.NewAggregate(errs)
 }
 
+// getOrphanedReason returns why the volume is orphaned, or an empty string if it isn't.
+// Conditions observed in caches are verified with live calls before the volume is reported.
+func (opc *Controller) getOrphanedReason(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, pi *PVItem, nodes []*corev1.Node) (string, error) {
+	if pi.PV != nil {
+		orphaned, err := controllerhelpers.IsOrphanedPV(pi.PV, nodes)
+		if err != nil {
+			return "", err
+		}
+
+		if orphaned {
+			klog.V(2).InfoS("PV is orphaned", "ScyllaDBDatacenter", klog.KObj(sdc), "PV", klog.KObj(pi.PV))
+
+			// Verify that the node doesn't exist with a live call.
+			freshNodes, err := opc.kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{
+				LabelSelector: labels.Everything().String(),
+			})
+			if err != nil {
+				return "", err
+			}
+
+			freshOrphaned, err := controllerhelpers.IsOrphanedPV(pi.PV, controllerhelpers.GetNodePointerArrayFromArray(freshNodes.Items))
+			if err != nil {
+				return "", err
+			}
+
+			if freshOrphaned {
+				return fmt.Sprintf("node of PV %q doesn't exist", pi.PV.Name), nil
+			}
+		}
+	}
+
+	for _, detector := range getOrphanedVolumeDetectors(sdc) {
+		var reason string
+		var err error
+		switch detector {
+		case scyllav1alpha1.PersistentVolumePhaseOrphanedVolumeDetector:
+			reason, err = opc.detectLostVolume(ctx, pi)
+
+		case scyllav1alpha1.VolumeHealthOrphanedVolumeDetector:
+			reason, err = opc.detectAbnormalVolumeCondition(ctx, pi)
+
+		case scyllav1alpha1.MountFailuresOrphanedVolumeDetector:
+			reason, err = opc.detectMountFailures(ctx, sdc, pi, nodes)
+
+		default:
+			klog.Warningf("Unsupported orphaned volume detector %q", detector)
+		}
+		if err != nil {
+			return "", fmt.Errorf("can't run %q orphaned volume detector on PVC %q: %w", detector, naming.ObjRef(pi.PVC), err)
+		}
+
+		if len(reason) != 0 {
+			return reason, nil
+		}
+	}
+
+	return "", nil
+}
+
+func (opc *Controller) detectLostVolume(ctx context.Context, pi *PVItem) (string, error) {
+	if !isVolumeLost(pi.PVC, pi.PV) {
+		return "", nil
+	}
+
+	klog.V(2).InfoS("Volume is lost", "PVC", klog.KObj(pi.PVC), "PV", pi.PVC.Spec.VolumeName)
+
+	// Verify the volume is lost with live calls.
+	freshPVC, err := opc.kubeClient.CoreV1().PersistentVolumeClaims(pi.PVC.Namespace).Get(ctx, pi.PVC.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	var freshPV *corev1.PersistentVolume
+	if len(freshPVC.Spec.VolumeName) != 0 {
+		freshPV, err = opc.kubeClient.CoreV1().PersistentVolumes().Get(ctx, freshPVC.Spec.VolumeName, metav1.GetOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return "", err
+			}
+			freshPV = nil
+		}
+	}
+
+	if !isVolumeLost(freshPVC, freshPV) {
+		return "", nil
+	}
+
+	return fmt.Sprintf("PV %q of PVC %q is lost", freshPVC.Spec.VolumeName, freshPVC.Name), nil
+}
+
+func (opc *Controller) listEvents(ctx context.Context, namespace, kind, name string) ([]*corev1.Event, error) {
+	eventList, err := opc.kubeClient.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.Set{
+			"involvedObject.kind": kind,
+			"involvedObject.name": name,
+		}.AsSelector().String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can't list events of %s %q: %w", kind, naming.ManualRef(namespace, name), err)
+	}
+
+	return slices.ConvertSlice(eventList.Items, pointer.Ptr[corev1.Event]), nil
+}
+
+func (opc *Controller) detectAbnormalVolumeCondition(ctx context.Context, pi *PVItem) (string, error) {
+	events, err := opc.listEvents(ctx, pi.PVC.Namespace, "PersistentVolumeClaim", pi.PVC.Name)
+	if err != nil {
+		return "", err
+	}
+
+	if !hasAbnormalVolumeCondition(events, time.Now()) {
+		return "", nil
+	}
+
+	return fmt.Sprintf("PVC %q reports an abnormal volume condition", pi.PVC.Name), nil
+}
+
+func (opc *Controller) detectMountFailures(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, pi *PVItem, nodes []*corev1.Node) (string, error) {
+	// Mount failures can have transient causes, so they only count when the Node of the volume was replaced
+	// under the same name. The volume stays bound in that case, but its data is gone with the old Node.
+	if pi.PV == nil {
+		return "", nil
+	}
+
+	replaced, err := isVolumeNodeReplaced(pi.PV, nodes)
+	if err != nil {
+		return "", err
+	}
+
+	if !replaced {
+		return "", nil
+	}
+
+	// Pods are named after their member services.
+	pod, err := opc.podLister.Pods(sdc.Namespace).Get(pi.ServiceName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	if pod.Status.Phase != corev1.PodPending {
+		return "", nil
+	}
+
+	events, err := opc.listEvents(ctx, pod.Namespace, "Pod", pod.Name)
+	if err != nil {
+		return "", err
+	}
+
+	count := getMountFailureCount(pod, pi.PVC, events)
+	threshold := getMountFailureThreshold(sdc)
+	if count < threshold {
+		klog.V(4).InfoS("Pod failed to mount volumes", "Pod", klog.KObj(pod), "Count", count, "Threshold", threshold)
+		return "", nil
+	}
+
+	// Verify that the Node was replaced with a live call.
+	freshNodes, err := opc.kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Everything().String(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	freshReplaced, err := isVolumeNodeReplaced(pi.PV, controllerhelpers.GetNodePointerArrayFromArray(freshNodes.Items))
+	if err != nil {
+		return "", err
+	}
+
+	if !freshReplaced {
+		return "", nil
+	}
+
+	return fmt.Sprintf("node of PV %q was replaced and pod %q failed to attach or mount volume of PVC %q %d times", pi.PV.Name, pod.Name, pi.PVC.Name, count), nil
+}
+
 func (opc *Controller) sync(ctx context.Context, key string) error {
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -102,6 +291,10 @@ func (opc *Controller) sync(ctx context.Context, key string) error {
 		return nil
 	}
 
+	if len(getOrphanedVolumeDetectors(sdc)) != 0 {
+		opc.queue.AddAfter(key, orphanedVolumeResyncInterval)
+	}
+
 	nodes, err := opc.nodeLister.List(labels.Everything())
 	if err != nil {
 		return err
@@ -115,53 +308,29 @@ func (opc *Controller) sync(ctx context.Context, key string) error {
 		errs = append(errs, err)
 	}
 
+	var orphanedNodes []orphanedNode
 	for _, pi := range pis {
-		orphaned, err := controllerhelpers.IsOrphanedPV(pi.PV, nodes)
+		reason, err := opc.getOrphanedReason(ctx, sdc, pi, nodes)
 		if err != nil {
 			errs = append(errs, err)
 			continue
 		}
 
-		if !orphaned {
+		if len(reason) == 0 {
 			continue
 		}
 
-		klog.V(2).InfoS("PV is orphaned", "ScyllaDBDatacenter", klog.KObj(sdc), "PV", klog.KObj(pi.PV))
-
-		// Verify that the node doesn't exist with a live call.
-		freshNodes, err := opc.kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{
-			LabelSelector: labels.Everything().String(),
+		klog.V(2).InfoS("Volume is verified as orphaned.", "ScyllaDBDatacenter", klog.KObj(sdc), "PVC", klog.KObj(pi.PVC), "Reason", reason)
+		orphanedNodes = append(orphanedNodes, orphanedNode{
+			serviceName: pi.ServiceName,
+			rack:        pi.Rack,
+			reason:      reason,
 		})
-		if err != nil {
-			errs = append(errs, err)
-			continue
-		}
-
-		freshOrphaned, err := controllerhelpers.IsOrphanedPV(pi.PV, controllerhelpers.GetNodePointerArrayFromArray(freshNodes.Items))
-		if err != nil {
-			errs = append(errs, err)
-			continue
-		}
-
-		if !freshOrphaned {
-			continue
-		}
-
-		klog.V(2).InfoS("PV is verified as orphaned.", "ScyllaDBDatacenter", klog.KObj(sdc), "PV", klog.KObj(pi.PV))
-
-		_, err = opc.kubeClient.CoreV1().Services(sdc.Namespace).Patch(
-			ctx,
-			pi.ServiceName,
-			types.MergePatchType,
-			[]byte(fmt.Sprintf(`{"metadata": {"labels": {%q: ""} } }`, naming.ReplaceLabel)),
-			metav1.PatchOptions{},
-		)
-		if err != nil {
-			errs = append(errs, err)
-			continue
-		}
+	}
 
-		klog.V(2).InfoS("Marked service for replacement", "ScyllaDBDatacenter", klog.KObj(sdc), "Service", klog.KRef(sdc.Namespace, pi.ServiceName))
+	err = opc.syncReplacements(ctx, sdc, key, orphanedNodes)
+	if err != nil {
+		errs = append(errs, err)
 	}
 
 	err = utilerrors.NewAggregate(errs)
@@ -0,0 +1,187 @@
+package orphanedpv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestDetectMountFailures(t *testing.T) {
+	t.Parallel()
+
+	pvCreationTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic",
+			Namespace: "scylla",
+		},
+		Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+			OrphanedNodeDetection: &scyllav1alpha1.OrphanedNodeDetection{
+				Detectors: []scyllav1alpha1.OrphanedVolumeDetector{
+					scyllav1alpha1.MountFailuresOrphanedVolumeDetector,
+				},
+				MountFailureThreshold: pointer.Ptr[int32](5),
+			},
+		},
+	}
+
+	pv := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "pvc-1234",
+			CreationTimestamp: metav1.NewTime(pvCreationTime),
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			NodeAffinity: &corev1.VolumeNodeAffinity{
+				Required: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{
+						{
+							MatchFields: []corev1.NodeSelectorRequirement{
+								{
+									Key:      "metadata.name",
+									Operator: corev1.NodeSelectorOpIn,
+									Values:   []string{"node-1"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Status: corev1.PersistentVolumeStatus{
+			Phase: corev1.VolumeBound,
+		},
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "data-basic-dc-rack-0",
+			Namespace: "scylla",
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			VolumeName: "pvc-1234",
+		},
+		Status: corev1.PersistentVolumeClaimStatus{
+			Phase: corev1.ClaimBound,
+		},
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic-dc-rack-0",
+			Namespace: "scylla",
+			UID:       "pod-uid",
+		},
+		Spec: corev1.PodSpec{
+			NodeName: "node-1",
+			Volumes: []corev1.Volume{
+				{
+					Name: "data",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "data-basic-dc-rack-0",
+						},
+					},
+				},
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+		},
+	}
+
+	newNode := func(creationTime time.Time) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "node-1",
+				CreationTimestamp: metav1.NewTime(creationTime),
+			},
+		}
+	}
+
+	newMountFailureEvent := func(count int32) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "basic-dc-rack-0.failed-mount",
+				Namespace: "scylla",
+			},
+			InvolvedObject: corev1.ObjectReference{
+				Kind:      "Pod",
+				Namespace: "scylla",
+				Name:      "basic-dc-rack-0",
+				UID:       "pod-uid",
+			},
+			Type:    corev1.EventTypeWarning,
+			Reason:  "FailedMount",
+			Message: `MountVolume.NewMounter initialization failed for volume "pvc-1234" : path "/mnt/disks/raid" does not exist`,
+			Count:   count,
+		}
+	}
+
+	tt := []struct {
+		name           string
+		node           *corev1.Node
+		event          *corev1.Event
+		expectOrphaned bool
+	}{
+		{
+			name:           "volume is orphaned when its node was replaced and the pod repeatedly fails to mount it",
+			node:           newNode(pvCreationTime.Add(time.Hour)),
+			event:          newMountFailureEvent(5),
+			expectOrphaned: true,
+		},
+		{
+			name:           "volume isn't orphaned when its node wasn't replaced",
+			node:           newNode(pvCreationTime.Add(-time.Hour)),
+			event:          newMountFailureEvent(5),
+			expectOrphaned: false,
+		},
+		{
+			name:           "volume isn't orphaned when its node was replaced but mount failures are below the threshold",
+			node:           newNode(pvCreationTime.Add(time.Hour)),
+			event:          newMountFailureEvent(4),
+			expectOrphaned: false,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			podCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			err := podCache.Add(pod)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			opc := &Controller{
+				kubeClient: fake.NewSimpleClientset(tc.node, tc.event),
+				podLister:  corev1listers.NewPodLister(podCache),
+			}
+
+			reason, err := opc.detectMountFailures(context.Background(), sdc, &PVItem{
+				PVC:         pvc,
+				PV:          pv,
+				ServiceName: "basic-dc-rack-0",
+				Rack:        "rack",
+			}, []*corev1.Node{tc.node})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			orphaned := len(reason) != 0
+			if orphaned != tc.expectOrphaned {
+				t.Errorf("expected volume to be orphaned: %t, got %t with reason %q", tc.expectOrphaned, orphaned, reason)
+			}
+		})
+	}
+}
@@ -442,6 +442,7 @@ func migrateV1Alpha1ScyllaDBDatacenterStatusToV1ScyllaClusterStatus(sdc *scyllav
 		// These are not handled by v1alpha1.ScyllaDBDatacenter.
 		Repairs:   nil,
 		Backups:   nil,
+		Restores:  nil,
 		ManagerID: nil,
 	}
 }
@@ -61,6 +61,7 @@ func (scmc *Controller) calculateStatus(sc *scyllav1.ScyllaCluster, sdcs map[str
 		ManagerID:          status.ManagerID,
 		Repairs:            status.Repairs,
 		Backups:            status.Backups,
+		Restores:           status.Restores,
 	}
 }
 
@@ -0,0 +1,8 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbbackup
+
+const (
+	backupControllerProgressingCondition = "BackupControllerProgressing"
+	backupControllerDegradedCondition    = "BackupControllerDegraded"
+)
@@ -0,0 +1,94 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbbackup
+
+import (
+	"context"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scyllaclient "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned"
+	scyllav1informers "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/scylla/v1"
+	scyllav1alpha1informers "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controller/manager"
+	"github.com/scylladb/scylla-operator/pkg/kubeinterfaces"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	ControllerName = "ScyllaDBBackupController"
+)
+
+var (
+	scyllaDBBackupControllerGVK = scyllav1alpha1.GroupVersion.WithKind("ScyllaDBBackup")
+)
+
+type Controller struct {
+	*manager.OneOffTaskController[*scyllav1alpha1.ScyllaDBBackup, scyllav1alpha1.ScyllaDBBackupStatus]
+
+	managerClient *managerclient.Client
+}
+
+func NewController(
+	scyllaClient scyllaclient.Interface,
+	scyllaClusterInformer scyllav1informers.ScyllaClusterInformer,
+	scyllaDBBackupInformer scyllav1alpha1informers.ScyllaDBBackupInformer,
+	managerClient *managerclient.Client,
+) (*Controller, error) {
+	sbc := &Controller{
+		managerClient: managerClient,
+	}
+
+	var err error
+	sbc.OneOffTaskController, err = manager.NewOneOffTaskController[*scyllav1alpha1.ScyllaDBBackup, scyllav1alpha1.ScyllaDBBackupStatus](
+		ControllerName,
+		scyllaDBBackupControllerGVK,
+		scyllaClusterInformer,
+		scyllaDBBackupInformer.Informer(),
+		managerClient,
+		manager.OneOffTaskControl[*scyllav1alpha1.ScyllaDBBackup, scyllav1alpha1.ScyllaDBBackupStatus]{
+			Kind:                 scyllaDBBackupControllerGVK.Kind,
+			Finalizer:            naming.ScyllaDBBackupFinalizer,
+			TaskType:             managerclient.BackupTask,
+			ProgressingCondition: backupControllerProgressingCondition,
+			DegradedCondition:    backupControllerDegradedCondition,
+			GetterLister: kubeinterfaces.NamespacedGetList[*scyllav1alpha1.ScyllaDBBackup]{
+				GetFunc: func(namespace, name string) (*scyllav1alpha1.ScyllaDBBackup, error) {
+					return scyllaDBBackupInformer.Lister().ScyllaDBBackups(namespace).Get(name)
+				},
+				ListFunc: func(namespace string, selector labels.Selector) (ret []*scyllav1alpha1.ScyllaDBBackup, err error) {
+					return scyllaDBBackupInformer.Lister().ScyllaDBBackups(namespace).List(selector)
+				},
+			},
+			UpdateFunc: func(ctx context.Context, sb *scyllav1alpha1.ScyllaDBBackup, opts metav1.UpdateOptions) (*scyllav1alpha1.ScyllaDBBackup, error) {
+				return scyllaClient.ScyllaV1alpha1().ScyllaDBBackups(sb.Namespace).Update(ctx, sb, opts)
+			},
+			UpdateStatusFunc: func(ctx context.Context, sb *scyllav1alpha1.ScyllaDBBackup, opts metav1.UpdateOptions) (*scyllav1alpha1.ScyllaDBBackup, error) {
+				return scyllaClient.ScyllaV1alpha1().ScyllaDBBackups(sb.Namespace).UpdateStatus(ctx, sb, opts)
+			},
+			DeleteFunc: func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+				return scyllaClient.ScyllaV1alpha1().ScyllaDBBackups(namespace).Delete(ctx, name, opts)
+			},
+			GetScyllaClusterName: func(sb *scyllav1alpha1.ScyllaDBBackup) string {
+				return sb.Spec.ScyllaClusterName
+			},
+			GetTTLSecondsAfterFinished: func(sb *scyllav1alpha1.ScyllaDBBackup) *int32 {
+				return sb.Spec.TTLSecondsAfterFinished
+			},
+			GetStatus: func(sb *scyllav1alpha1.ScyllaDBBackup) *scyllav1alpha1.ScyllaDBBackupStatus {
+				return &sb.Status
+			},
+			GetTaskStatus: func(status *scyllav1alpha1.ScyllaDBBackupStatus) *scyllav1alpha1.ScyllaDBManagerTaskStatus {
+				return &status.ScyllaDBManagerTaskStatus
+			},
+			SyncTask: sbc.syncBackup,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return sbc, nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbbackup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/v3/swagger/gen/scylla-manager/models"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controller/manager"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// syncBackup creates the backup task in Scylla Manager once and reflects its progress in the status.
+func (sbc *Controller) syncBackup(ctx context.Context, sb *scyllav1alpha1.ScyllaDBBackup, clusterID string, status *scyllav1alpha1.ScyllaDBBackupStatus) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	task, err := manager.FindOneOffTask(ctx, sbc.managerClient, clusterID, managerclient.BackupTask, sb.UID)
+	if err != nil {
+		return progressingConditions, err
+	}
+
+	if task == nil {
+		if status.TaskID != nil {
+			// The task was removed outside of the operator, e.g. together with the cluster registration.
+			status.Phase = scyllav1alpha1.ScyllaDBManagerTaskPhaseFailed
+			status.Message = pointer.Ptr(fmt.Sprintf("Task %q was removed from Scylla Manager.", *status.TaskID))
+			manager.SetOneOffTaskRunStatus(&status.ScyllaDBManagerTaskStatus, nil, time.Now())
+			return progressingConditions, nil
+		}
+
+		t, err := manager.MakeOneOffBackupTask(sb)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't make backup task: %w", err)
+		}
+
+		taskID, err := manager.CreateOneOffTask(ctx, sbc.managerClient, clusterID, t)
+		if err != nil {
+			return progressingConditions, err
+		}
+
+		status.TaskID = pointer.Ptr(taskID)
+		status.Phase = scyllav1alpha1.ScyllaDBManagerTaskPhasePending
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               backupControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "CreatedTask",
+			Message:            fmt.Sprintf("Created backup task %q.", taskID),
+			ObservedGeneration: sb.Generation,
+		})
+		return progressingConditions, nil
+	}
+
+	status.TaskID = pointer.Ptr(task.ID)
+	status.Phase = manager.GetOneOffTaskPhase(task)
+
+	var run *models.TaskRun
+	if status.Phase != scyllav1alpha1.ScyllaDBManagerTaskPhasePending {
+		progress, err := sbc.managerClient.BackupProgress(ctx, clusterID, task.ID, "latest")
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't get progress of backup task %q: %w", task.ID, err)
+		}
+
+		if progress.TaskRunBackupProgress != nil {
+			run = progress.Run
+			if progress.Progress != nil {
+				status.Progress = pointer.Ptr(manager.GetBackupProgressPercentage(progress.Progress))
+				if len(progress.Progress.SnapshotTag) != 0 {
+					status.SnapshotTag = pointer.Ptr(progress.Progress.SnapshotTag)
+				}
+			}
+		}
+	}
+	manager.SetOneOffTaskRunStatus(&status.ScyllaDBManagerTaskStatus, run, time.Now())
+
+	if !manager.IsFinishedPhase(status.Phase) {
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               backupControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForTask",
+			Message:            fmt.Sprintf("Waiting for backup task %q to finish.", task.ID),
+			ObservedGeneration: sb.Generation,
+		})
+	}
+
+	return progressingConditions, nil
+}
@@ -0,0 +1,14 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbcluster
+
+const (
+	scyllaDBDatacenterControllerProgressingCondition = "ScyllaDBDatacenterControllerProgressing"
+	scyllaDBDatacenterControllerDegradedCondition    = "ScyllaDBDatacenterControllerDegraded"
+	datacentersAvailableCondition                    = "DatacentersAvailable"
+
+	// Conditions reporting the steps of decommissioning a removed datacenter.
+	datacenterDecommissionReplicationRemovedCondition  = "DatacenterDecommissionReplicationRemoved"
+	datacenterDecommissionRacksDecommissionedCondition = "DatacenterDecommissionRacksDecommissioned"
+	datacenterDecommissionDegradedCondition            = "DatacenterDecommissionDegraded"
+)
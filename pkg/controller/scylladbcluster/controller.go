@@ -0,0 +1,285 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbcluster
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scyllaclient "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned"
+	scyllav1alpha1informers "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/scylla/v1alpha1"
+	scyllav1alpha1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/kubeinterfaces"
+	"github.com/scylladb/scylla-operator/pkg/scheme"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+)
+
+const (
+	ControllerName = "ScyllaDBClusterController"
+
+	// remoteDatacenterResyncInterval is how often ScyllaDBClusters with remote datacenters are resynced.
+	remoteDatacenterResyncInterval = 30 * time.Second
+
+	// datacenterDecommissionResyncInterval is how often ScyllaDBClusters with decommissioning datacenters are resynced.
+	datacenterDecommissionResyncInterval = 30 * time.Second
+)
+
+var (
+	keyFunc                      = cache.DeletionHandlingMetaNamespaceKeyFunc
+	scyllaDBClusterControllerGVK = scyllav1alpha1.GroupVersion.WithKind("ScyllaDBCluster")
+)
+
+type Controller struct {
+	kubeClient   kubernetes.Interface
+	scyllaClient scyllaclient.Interface
+
+	secretLister             corev1listers.SecretLister
+	scyllaDBClusterLister    scyllav1alpha1listers.ScyllaDBClusterLister
+	scyllaDBDatacenterLister scyllav1alpha1listers.ScyllaDBDatacenterLister
+
+	cachesToSync []cache.InformerSynced
+
+	eventRecorder record.EventRecorder
+
+	queue    workqueue.RateLimitingInterface
+	handlers *controllerhelpers.Handlers[*scyllav1alpha1.ScyllaDBCluster]
+}
+
+func NewController(
+	kubeClient kubernetes.Interface,
+	scyllaClient scyllaclient.Interface,
+	secretInformer corev1informers.SecretInformer,
+	scyllaDBClusterInformer scyllav1alpha1informers.ScyllaDBClusterInformer,
+	scyllaDBDatacenterInformer scyllav1alpha1informers.ScyllaDBDatacenterInformer,
+) (*Controller, error) {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartStructuredLogging(0)
+	eventBroadcaster.StartRecordingToSink(&corev1client.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+
+	scc := &Controller{
+		kubeClient:   kubeClient,
+		scyllaClient: scyllaClient,
+
+		secretLister:             secretInformer.Lister(),
+		scyllaDBClusterLister:    scyllaDBClusterInformer.Lister(),
+		scyllaDBDatacenterLister: scyllaDBDatacenterInformer.Lister(),
+
+		cachesToSync: []cache.InformerSynced{
+			secretInformer.Informer().HasSynced,
+			scyllaDBClusterInformer.Informer().HasSynced,
+			scyllaDBDatacenterInformer.Informer().HasSynced,
+		},
+
+		eventRecorder: eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "scylladbcluster-controller"}),
+
+		queue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "scylladbcluster"),
+	}
+
+	var err error
+	scc.handlers, err = controllerhelpers.NewHandlers[*scyllav1alpha1.ScyllaDBCluster](
+		scc.queue,
+		keyFunc,
+		scheme.Scheme,
+		scyllaDBClusterControllerGVK,
+		kubeinterfaces.NamespacedGetList[*scyllav1alpha1.ScyllaDBCluster]{
+			GetFunc: func(namespace, name string) (*scyllav1alpha1.ScyllaDBCluster, error) {
+				return scc.scyllaDBClusterLister.ScyllaDBClusters(namespace).Get(name)
+			},
+			ListFunc: func(namespace string, selector labels.Selector) (ret []*scyllav1alpha1.ScyllaDBCluster, err error) {
+				return scc.scyllaDBClusterLister.ScyllaDBClusters(namespace).List(selector)
+			},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("can't create handlers: %w", err)
+	}
+
+	secretInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    scc.addSecret,
+		UpdateFunc: scc.updateSecret,
+		DeleteFunc: scc.deleteSecret,
+	})
+
+	scyllaDBClusterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    scc.addScyllaDBCluster,
+		UpdateFunc: scc.updateScyllaDBCluster,
+		DeleteFunc: scc.deleteScyllaDBCluster,
+	})
+
+	scyllaDBDatacenterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    scc.addScyllaDBDatacenter,
+		UpdateFunc: scc.updateScyllaDBDatacenter,
+		DeleteFunc: scc.deleteScyllaDBDatacenter,
+	})
+
+	return scc, nil
+}
+
+func (scc *Controller) processNextItem(ctx context.Context) bool {
+	key, quit := scc.queue.Get()
+	if quit {
+		return false
+	}
+	defer scc.queue.Done(key)
+
+	err := scc.sync(ctx, key.(string))
+	// TODO: Do smarter filtering then just Reduce to handle cases like 2 conflict errors.
+	err = utilerrors.Reduce(err)
+	switch {
+	case err == nil:
+		scc.queue.Forget(key)
+		return true
+
+	case apierrors.IsConflict(err):
+		klog.V(2).InfoS("Hit conflict, will retry in a bit", "Key", key, "Error", err)
+
+	case apierrors.IsAlreadyExists(err):
+		klog.V(2).InfoS("Hit already exists, will retry in a bit", "Key", key, "Error", err)
+
+	default:
+		utilruntime.HandleError(fmt.Errorf("syncing key '%v' failed: %v", key, err))
+	}
+
+	scc.queue.AddRateLimited(key)
+
+	return true
+}
+
+func (scc *Controller) runWorker(ctx context.Context) {
+	for scc.processNextItem(ctx) {
+	}
+}
+
+func (scc *Controller) Run(ctx context.Context, workers int) {
+	defer utilruntime.HandleCrash()
+
+	klog.InfoS("Starting controller", "controller", ControllerName)
+
+	var wg sync.WaitGroup
+	defer func() {
+		klog.InfoS("Shutting down controller", "controller", ControllerName)
+		scc.queue.ShutDown()
+		wg.Wait()
+		klog.InfoS("Shut down controller", "controller", ControllerName)
+	}()
+
+	if !cache.WaitForNamedCacheSync(ControllerName, ctx.Done(), scc.cachesToSync...) {
+		return
+	}
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wait.UntilWithContext(ctx, scc.runWorker, time.Second)
+		}()
+	}
+
+	<-ctx.Done()
+}
+
+// enqueueScyllaDBClustersUsingSecret enqueues ScyllaDBClusters that reference the Secret as a kubeconfig of a remote Kubernetes cluster.
+func (scc *Controller) enqueueScyllaDBClustersUsingSecret(depth int, obj kubeinterfaces.ObjectInterface, op controllerhelpers.HandlerOperationType) {
+	secret := obj.(*corev1.Secret)
+
+	scs, err := scc.scyllaDBClusterLister.ScyllaDBClusters(secret.Namespace).List(labels.Everything())
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("can't list ScyllaDBClusters: %w", err))
+		return
+	}
+
+	for _, sc := range scs {
+		for _, dc := range sc.Spec.Datacenters {
+			if dc.RemoteKubernetesCluster != nil && dc.RemoteKubernetesCluster.KubeconfigSecretName == secret.Name {
+				scc.handlers.Enqueue(depth+1, sc, op)
+				break
+			}
+		}
+	}
+}
+
+func (scc *Controller) addSecret(obj interface{}) {
+	scc.handlers.HandleAdd(
+		obj.(*corev1.Secret),
+		scc.enqueueScyllaDBClustersUsingSecret,
+	)
+}
+
+func (scc *Controller) updateSecret(old, cur interface{}) {
+	scc.handlers.HandleUpdate(
+		old.(*corev1.Secret),
+		cur.(*corev1.Secret),
+		scc.enqueueScyllaDBClustersUsingSecret,
+		scc.deleteSecret,
+	)
+}
+
+func (scc *Controller) deleteSecret(obj interface{}) {
+	scc.handlers.HandleDelete(
+		obj,
+		scc.enqueueScyllaDBClustersUsingSecret,
+	)
+}
+
+func (scc *Controller) addScyllaDBCluster(obj interface{}) {
+	scc.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.ScyllaDBCluster),
+		scc.handlers.Enqueue,
+	)
+}
+
+func (scc *Controller) updateScyllaDBCluster(old, cur interface{}) {
+	scc.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.ScyllaDBCluster),
+		cur.(*scyllav1alpha1.ScyllaDBCluster),
+		scc.handlers.Enqueue,
+		scc.deleteScyllaDBCluster,
+	)
+}
+
+func (scc *Controller) deleteScyllaDBCluster(obj interface{}) {
+	scc.handlers.HandleDelete(
+		obj,
+		scc.handlers.Enqueue,
+	)
+}
+
+func (scc *Controller) addScyllaDBDatacenter(obj interface{}) {
+	scc.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.ScyllaDBDatacenter),
+		scc.handlers.EnqueueOwner,
+	)
+}
+
+func (scc *Controller) updateScyllaDBDatacenter(old, cur interface{}) {
+	scc.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.ScyllaDBDatacenter),
+		cur.(*scyllav1alpha1.ScyllaDBDatacenter),
+		scc.handlers.EnqueueOwner,
+		scc.deleteScyllaDBDatacenter,
+	)
+}
+
+func (scc *Controller) deleteScyllaDBDatacenter(obj interface{}) {
+	scc.handlers.HandleDelete(
+		obj,
+		scc.handlers.EnqueueOwner,
+	)
+}
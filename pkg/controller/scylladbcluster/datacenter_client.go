@@ -0,0 +1,167 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbcluster
+
+import (
+	"context"
+	"fmt"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scyllaclient "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// datacenterClient gives access to the Kubernetes cluster a datacenter is deployed to.
+type datacenterClient struct {
+	kubeClient   kubernetes.Interface
+	scyllaClient scyllaclient.Interface
+	namespace    string
+	remote       bool
+}
+
+// restConfigFromKubeconfig makes a rest config from a user provided kubeconfig.
+// Kubeconfigs come from Secrets in user namespaces, so only inline data is allowed. Exec and auth provider plugins
+// would run binaries in the operator's Pod and file references could read its service account token.
+func restConfigFromKubeconfig(kubeconfig []byte) (*rest.Config, error) {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	for name, cluster := range config.Clusters {
+		if len(cluster.CertificateAuthority) != 0 {
+			errs = append(errs, fmt.Errorf("cluster %q: certificate-authority file reference isn't supported, use certificate-authority-data", name))
+		}
+	}
+	for name, authInfo := range config.AuthInfos {
+		if authInfo.Exec != nil {
+			errs = append(errs, fmt.Errorf("user %q: exec plugins aren't supported", name))
+		}
+		if authInfo.AuthProvider != nil {
+			errs = append(errs, fmt.Errorf("user %q: auth providers aren't supported", name))
+		}
+		if len(authInfo.TokenFile) != 0 {
+			errs = append(errs, fmt.Errorf("user %q: tokenFile reference isn't supported, use token", name))
+		}
+		if len(authInfo.ClientCertificate) != 0 {
+			errs = append(errs, fmt.Errorf("user %q: client-certificate file reference isn't supported, use client-certificate-data", name))
+		}
+		if len(authInfo.ClientKey) != 0 {
+			errs = append(errs, fmt.Errorf("user %q: client-key file reference isn't supported, use client-key-data", name))
+		}
+	}
+	err = utilerrors.NewAggregate(errs)
+	if err != nil {
+		return nil, err
+	}
+
+	return clientcmd.NewDefaultClientConfig(*config, &clientcmd.ConfigOverrides{}).ClientConfig()
+}
+
+func (scc *Controller) getDatacenterClient(sc *scyllav1alpha1.ScyllaDBCluster, remote *scyllav1alpha1.RemoteKubernetesClusterReference) (*datacenterClient, error) {
+	namespace := getDatacenterNamespace(sc, remote)
+
+	if remote == nil {
+		return &datacenterClient{
+			kubeClient:   scc.kubeClient,
+			scyllaClient: scc.scyllaClient,
+			namespace:    namespace,
+			remote:       false,
+		}, nil
+	}
+
+	secret, err := scc.secretLister.Secrets(sc.Namespace).Get(remote.KubeconfigSecretName)
+	if err != nil {
+		return nil, fmt.Errorf("can't get kubeconfig Secret %q: %w", naming.ManualRef(sc.Namespace, remote.KubeconfigSecretName), err)
+	}
+
+	kubeconfig, ok := secret.Data[naming.KubeconfigSecretKey]
+	if !ok {
+		return nil, fmt.Errorf("kubeconfig Secret %q is missing key %q", naming.ObjRef(secret), naming.KubeconfigSecretKey)
+	}
+
+	restConfig, err := restConfigFromKubeconfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse kubeconfig from Secret %q: %w", naming.ObjRef(secret), err)
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("can't create kube client for Secret %q: %w", naming.ObjRef(secret), err)
+	}
+
+	scyllaClient, err := scyllaclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("can't create scylla client for Secret %q: %w", naming.ObjRef(secret), err)
+	}
+
+	return &datacenterClient{
+		kubeClient:   kubeClient,
+		scyllaClient: scyllaClient,
+		namespace:    namespace,
+		remote:       true,
+	}, nil
+}
+
+// getScyllaDBDatacenter returns the ScyllaDBDatacenter, or nil if it doesn't exist.
+// Local ScyllaDBDatacenters are read from the cache, remote ones are read with a live call.
+func (scc *Controller) getScyllaDBDatacenter(ctx context.Context, sc *scyllav1alpha1.ScyllaDBCluster, dcc *datacenterClient, name string) (*scyllav1alpha1.ScyllaDBDatacenter, error) {
+	var sdc *scyllav1alpha1.ScyllaDBDatacenter
+	var err error
+	if dcc.remote {
+		sdc, err = dcc.scyllaClient.ScyllaV1alpha1().ScyllaDBDatacenters(dcc.namespace).Get(ctx, name, metav1.GetOptions{})
+	} else {
+		sdc, err = scc.scyllaDBDatacenterLister.ScyllaDBDatacenters(dcc.namespace).Get(name)
+	}
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("can't get ScyllaDBDatacenter %q: %w", naming.ManualRef(dcc.namespace, name), err)
+	}
+
+	if dcc.remote {
+		if sdc.Labels[naming.OwnerUIDLabel] != string(sc.UID) {
+			return nil, fmt.Errorf("remote ScyllaDBDatacenter %q isn't controlled by us", naming.ObjRef(sdc))
+		}
+	} else {
+		controllerRef := metav1.GetControllerOfNoCopy(sdc)
+		if controllerRef == nil || controllerRef.UID != sc.UID {
+			return nil, fmt.Errorf("ScyllaDBDatacenter %q isn't controlled by us", naming.ObjRef(sdc))
+		}
+	}
+
+	return sdc, nil
+}
+
+func (scc *Controller) applyScyllaDBDatacenter(ctx context.Context, dcc *datacenterClient, required *scyllav1alpha1.ScyllaDBDatacenter) (*scyllav1alpha1.ScyllaDBDatacenter, bool, error) {
+	if !dcc.remote {
+		return resourceapply.ApplyScyllaDBDatacenter(ctx, dcc.scyllaClient.ScyllaV1alpha1(), scc.scyllaDBDatacenterLister, scc.eventRecorder, required, resourceapply.ApplyOptions{})
+	}
+
+	client := dcc.scyllaClient.ScyllaV1alpha1().ScyllaDBDatacenters(required.Namespace)
+	return resourceapply.ApplyScyllaDBDatacenterWithControl(
+		ctx,
+		resourceapply.ApplyControlFuncs[*scyllav1alpha1.ScyllaDBDatacenter]{
+			GetCachedFunc: func(name string) (*scyllav1alpha1.ScyllaDBDatacenter, error) {
+				return client.Get(ctx, name, metav1.GetOptions{})
+			},
+			CreateFunc: client.Create,
+			UpdateFunc: client.Update,
+			DeleteFunc: client.Delete,
+		},
+		scc.eventRecorder,
+		required,
+		resourceapply.ApplyOptions{
+			AllowMissingControllerRef: true,
+		},
+	)
+}
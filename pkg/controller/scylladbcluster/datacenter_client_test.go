@@ -0,0 +1,94 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbcluster
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRestConfigFromKubeconfig(t *testing.T) {
+	t.Parallel()
+
+	makeKubeconfig := func(cluster, user string) []byte {
+		return []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: remote
+  cluster:
+    server: https://remote.example.com:6443
+%s
+users:
+- name: remote
+  user:
+%s
+contexts:
+- name: remote
+  context:
+    cluster: remote
+    user: remote
+current-context: remote
+`, cluster, user))
+	}
+
+	tt := []struct {
+		name          string
+		kubeconfig    []byte
+		expectedHost  string
+		expectedError string
+	}{
+		{
+			name:         "inline data is allowed",
+			kubeconfig:   makeKubeconfig("    insecure-skip-tls-verify: true", "    token: secret-token"),
+			expectedHost: "https://remote.example.com:6443",
+		},
+		{
+			name:          "exec plugin is rejected",
+			kubeconfig:    makeKubeconfig("", "    exec:\n      apiVersion: client.authentication.k8s.io/v1\n      command: /bin/sh"),
+			expectedError: `user "remote": exec plugins aren't supported`,
+		},
+		{
+			name:          "auth provider is rejected",
+			kubeconfig:    makeKubeconfig("", "    auth-provider:\n      name: oidc"),
+			expectedError: `user "remote": auth providers aren't supported`,
+		},
+		{
+			name:          "token file is rejected",
+			kubeconfig:    makeKubeconfig("", "    tokenFile: /var/run/secrets/kubernetes.io/serviceaccount/token"),
+			expectedError: `user "remote": tokenFile reference isn't supported, use token`,
+		},
+		{
+			name:          "client key files are rejected",
+			kubeconfig:    makeKubeconfig("", "    client-certificate: /tmp/tls.crt\n    client-key: /tmp/tls.key"),
+			expectedError: `[user "remote": client-certificate file reference isn't supported, use client-certificate-data, user "remote": client-key file reference isn't supported, use client-key-data]`,
+		},
+		{
+			name:          "certificate authority file is rejected",
+			kubeconfig:    makeKubeconfig("    certificate-authority: /var/run/secrets/kubernetes.io/serviceaccount/ca.crt", "    token: secret-token"),
+			expectedError: `cluster "remote": certificate-authority file reference isn't supported, use certificate-authority-data`,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			restConfig, err := restConfigFromKubeconfig(tc.kubeconfig)
+			var errStr string
+			if err != nil {
+				errStr = err.Error()
+			}
+			if errStr != tc.expectedError {
+				t.Fatalf("expected error %q, got %q", tc.expectedError, errStr)
+			}
+			if err != nil {
+				return
+			}
+
+			if restConfig.Host != tc.expectedHost {
+				t.Errorf("expected host %q, got %q", tc.expectedHost, restConfig.Host)
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbcluster
+
+import (
+	"context"
+	"fmt"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+func getDatacenterReplicationPolicy(sc *scyllav1alpha1.ScyllaDBCluster) scyllav1alpha1.DatacenterReplicationPolicy {
+	if sc.Spec.DatacenterDecommission != nil && len(sc.Spec.DatacenterDecommission.ReplicationPolicy) != 0 {
+		return sc.Spec.DatacenterDecommission.ReplicationPolicy
+	}
+
+	return scyllav1alpha1.DatacenterReplicationPolicyVerify
+}
+
+func setDatacenterDecommissionCondition(sc *scyllav1alpha1.ScyllaDBCluster, status *scyllav1alpha1.ScyllaDBClusterStatus, conditionType string, conditionStatus metav1.ConditionStatus, reason, message string) {
+	apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             conditionStatus,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: sc.Generation,
+	})
+}
+
+// setDefaultDatacenterDecommissionConditions resets the decommission conditions to the state when no datacenter is
+// being decommissioned. Decommissioning a datacenter overrides them.
+func setDefaultDatacenterDecommissionConditions(sc *scyllav1alpha1.ScyllaDBCluster, status *scyllav1alpha1.ScyllaDBClusterStatus) {
+	setDatacenterDecommissionCondition(sc, status, datacenterDecommissionReplicationRemovedCondition, metav1.ConditionTrue, internalapi.AsExpectedReason, "")
+	setDatacenterDecommissionCondition(sc, status, datacenterDecommissionRacksDecommissionedCondition, metav1.ConditionTrue, internalapi.AsExpectedReason, "")
+	setDatacenterDecommissionCondition(sc, status, datacenterDecommissionDegradedCondition, metav1.ConditionFalse, internalapi.AsExpectedReason, "")
+}
+
+// isDatacenterScaledDown returns true when all racks of the ScyllaDBDatacenter are scaled down to zero nodes.
+func isDatacenterScaledDown(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+	for _, rack := range sdc.Spec.Racks {
+		if rack.Nodes == nil || *rack.Nodes != 0 {
+			return false
+		}
+	}
+
+	isStatusUpToDate := sdc.Status.ObservedGeneration != nil && *sdc.Status.ObservedGeneration >= sdc.Generation
+	return isStatusUpToDate && sdc.Status.Nodes != nil && *sdc.Status.Nodes == 0
+}
+
+// removeDatacenterReplication makes sure no keyspace replicates to the datacenter, rewriting the replication
+// if the ScyllaDBCluster allows it. Keyspaces are inspected through a node of the first available remaining datacenter.
+// It returns true once it's safe to decommission the datacenter.
+func (scc *Controller) removeDatacenterReplication(
+	ctx context.Context,
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	status *scyllav1alpha1.ScyllaDBClusterStatus,
+	dc *datacenter,
+	remainingDCs []*datacenter,
+) (bool, []metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	dcName := naming.GetScyllaDBDatacenterGossipDatacenterName(dc.sdc)
+
+	coordinatorDC, _, ok := slices.Find(remainingDCs, func(remainingDC *datacenter) bool {
+		return remainingDC.err == nil && remainingDC.sdc != nil && isDatacenterRolledOut(remainingDC.sdc)
+	})
+	if !ok {
+		message := fmt.Sprintf("Waiting for a remaining datacenter to be available to verify that no keyspace replicates to datacenter %q.", dcName)
+		setDatacenterDecommissionCondition(sc, status, datacenterDecommissionReplicationRemovedCondition, metav1.ConditionFalse, "WaitingForAvailableDatacenter", message)
+		progressingConditions = append(progressingConditions, makeDatacenterProgressingCondition(sc, "WaitingForAvailableDatacenter", message))
+		return false, progressingConditions, nil
+	}
+
+	hosts, err := getDatacenterSeeds(ctx, coordinatorDC)
+	if err != nil {
+		return false, progressingConditions, fmt.Errorf("can't get hosts of datacenter %q: %w", coordinatorDC.name, err)
+	}
+	if len(hosts) == 0 {
+		message := fmt.Sprintf("Waiting for nodes of datacenter %q to be reachable.", coordinatorDC.name)
+		setDatacenterDecommissionCondition(sc, status, datacenterDecommissionReplicationRemovedCondition, metav1.ConditionFalse, "WaitingForAvailableDatacenter", message)
+		progressingConditions = append(progressingConditions, makeDatacenterProgressingCondition(sc, "WaitingForAvailableDatacenter", message))
+		return false, progressingConditions, nil
+	}
+
+	session, err := controllerhelpers.NewScyllaDBDatacenterCQLSession(ctx, coordinatorDC.client.kubeClient, coordinatorDC.sdc, hosts)
+	if err != nil {
+		return false, progressingConditions, fmt.Errorf("can't connect to datacenter %q: %w", coordinatorDC.name, err)
+	}
+	defer session.Close()
+
+	keyspaces, err := getKeyspaceReplications(ctx, session)
+	if err != nil {
+		return false, progressingConditions, err
+	}
+
+	plan, err := makeDatacenterReplicationPlan(keyspaces, dcName)
+	if err != nil {
+		return false, progressingConditions, err
+	}
+
+	if len(plan.exclusive) != 0 {
+		message := fmt.Sprintf("Refusing to decommission datacenter %q: keyspaces %q are replicated only to it and their data would be lost. Replicate them to another datacenter or drop them.", dcName, plan.exclusive)
+		setDatacenterDecommissionCondition(sc, status, datacenterDecommissionReplicationRemovedCondition, metav1.ConditionFalse, "DataLossRisk", message)
+		setDatacenterDecommissionCondition(sc, status, datacenterDecommissionDegradedCondition, metav1.ConditionTrue, "DataLossRisk", message)
+		scc.eventRecorder.Event(sc, corev1.EventTypeWarning, "DatacenterDecommissionRefused", message)
+		return false, progressingConditions, nil
+	}
+
+	if len(plan.replicated) == 0 {
+		setDatacenterDecommissionCondition(sc, status, datacenterDecommissionReplicationRemovedCondition, metav1.ConditionTrue, internalapi.AsExpectedReason, fmt.Sprintf("No keyspace replicates to datacenter %q.", dcName))
+		return true, progressingConditions, nil
+	}
+
+	replicatedKeyspaces := slices.ConvertSlice(plan.replicated, func(ks keyspaceReplication) string {
+		return ks.name
+	})
+
+	if getDatacenterReplicationPolicy(sc) != scyllav1alpha1.DatacenterReplicationPolicyRewrite {
+		message := fmt.Sprintf("Refusing to decommission datacenter %q: keyspaces %q still replicate to it. Alter their replication or set the replication policy to %q.", dcName, replicatedKeyspaces, scyllav1alpha1.DatacenterReplicationPolicyRewrite)
+		setDatacenterDecommissionCondition(sc, status, datacenterDecommissionReplicationRemovedCondition, metav1.ConditionFalse, "KeyspacesReplicatedToDatacenter", message)
+		setDatacenterDecommissionCondition(sc, status, datacenterDecommissionDegradedCondition, metav1.ConditionTrue, "KeyspacesReplicatedToDatacenter", message)
+		scc.eventRecorder.Event(sc, corev1.EventTypeWarning, "DatacenterDecommissionRefused", message)
+		return false, progressingConditions, nil
+	}
+
+	message := fmt.Sprintf("Removing datacenter %q from the replication of keyspaces %q.", dcName, replicatedKeyspaces)
+	setDatacenterDecommissionCondition(sc, status, datacenterDecommissionReplicationRemovedCondition, metav1.ConditionFalse, "RewritingReplication", message)
+	progressingConditions = append(progressingConditions, makeDatacenterProgressingCondition(sc, "RewritingReplication", message))
+
+	for _, ks := range plan.replicated {
+		stmt := makeRemoveDatacenterReplicationStatement(ks, dcName)
+		klog.V(2).InfoS("Removing datacenter from keyspace replication", "ScyllaDBCluster", klog.KObj(sc), "Datacenter", dcName, "Keyspace", ks.name)
+		err = session.Query(stmt).WithContext(ctx).Exec()
+		if err != nil {
+			return false, progressingConditions, fmt.Errorf("can't remove datacenter %q from the replication of keyspace %q: %w", dcName, ks.name, err)
+		}
+		scc.eventRecorder.Eventf(sc, corev1.EventTypeNormal, "KeyspaceReplicationRewritten", "Removed datacenter %q from the replication of keyspace %q", dcName, ks.name)
+	}
+
+	// The replication is verified again in the next sync.
+	return false, progressingConditions, nil
+}
+
+// decommissionDatacenterRacks scales the racks of the datacenter down to zero nodes, one at a time,
+// in the reverse order they are specified in. Every node is decommissioned by its sidecar as it's scaled down.
+// It returns true once all racks are decommissioned.
+func (scc *Controller) decommissionDatacenterRacks(
+	ctx context.Context,
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	status *scyllav1alpha1.ScyllaDBClusterStatus,
+	dc *datacenter,
+) (bool, []metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	isStatusUpToDate := dc.sdc.Status.ObservedGeneration != nil && *dc.sdc.Status.ObservedGeneration >= dc.sdc.Generation
+
+	for i := len(dc.sdc.Spec.Racks) - 1; i >= 0; i-- {
+		rack := dc.sdc.Spec.Racks[i]
+
+		if rack.Nodes == nil || *rack.Nodes != 0 {
+			sdcCopy := dc.sdc.DeepCopy()
+			sdcCopy.Spec.Racks[i].Nodes = pointer.Ptr[int32](0)
+
+			setDatacenterDecommissionCondition(sc, status, datacenterDecommissionRacksDecommissionedCondition, metav1.ConditionFalse, "DecommissioningRack", fmt.Sprintf("Decommissioning rack %q of datacenter %q.", rack.Name, dc.name))
+			controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, scyllaDBDatacenterControllerProgressingCondition, sdcCopy, "update", sc.Generation)
+			_, err := dc.client.scyllaClient.ScyllaV1alpha1().ScyllaDBDatacenters(sdcCopy.Namespace).Update(ctx, sdcCopy, metav1.UpdateOptions{})
+			resourceapply.ReportUpdateEvent(scc.eventRecorder, sdcCopy, err)
+			if err != nil {
+				return false, progressingConditions, fmt.Errorf("can't scale down rack %q of ScyllaDBDatacenter %q: %w", rack.Name, naming.ObjRef(sdcCopy), err)
+			}
+
+			return false, progressingConditions, nil
+		}
+
+		rackStatus, _, ok := slices.Find(dc.sdc.Status.Racks, func(rackStatus scyllav1alpha1.RackStatus) bool {
+			return rackStatus.Name == rack.Name
+		})
+		isRackScaledDown := !ok || (rackStatus.Nodes != nil && *rackStatus.Nodes == 0 && (rackStatus.Stale == nil || !*rackStatus.Stale))
+		if !isStatusUpToDate || !isRackScaledDown {
+			message := fmt.Sprintf("Waiting for nodes of rack %q of datacenter %q to be decommissioned.", rack.Name, dc.name)
+			setDatacenterDecommissionCondition(sc, status, datacenterDecommissionRacksDecommissionedCondition, metav1.ConditionFalse, "DecommissioningRack", message)
+			progressingConditions = append(progressingConditions, makeDatacenterProgressingCondition(sc, "WaitingForRackDecommission", message))
+			return false, progressingConditions, nil
+		}
+	}
+
+	return true, progressingConditions, nil
+}
+
+// decommissionDatacenter safely removes a datacenter from the cluster. It makes sure no keyspace replicates
+// to the datacenter, decommissions its racks and then deletes the ScyllaDBDatacenter.
+// It returns true once the datacenter is gone.
+func (scc *Controller) decommissionDatacenter(
+	ctx context.Context,
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	status *scyllav1alpha1.ScyllaDBClusterStatus,
+	dc *datacenter,
+	remainingDCs []*datacenter,
+) (bool, []metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if dc.sdc == nil {
+		return true, progressingConditions, nil
+	}
+
+	if dc.sdc.DeletionTimestamp != nil {
+		progressingConditions = append(progressingConditions, makeDatacenterProgressingCondition(sc, "WaitingForDatacenterDeletion", fmt.Sprintf("Waiting for ScyllaDBDatacenter %q to be deleted.", naming.ObjRef(dc.sdc))))
+		return false, progressingConditions, nil
+	}
+
+	if !isDatacenterScaledDown(dc.sdc) {
+		// Keyspaces are verified before every rack, so keyspaces created in the meantime can't lose data either.
+		replicationRemoved, replicationProgressingConditions, err := scc.removeDatacenterReplication(ctx, sc, status, dc, remainingDCs)
+		progressingConditions = append(progressingConditions, replicationProgressingConditions...)
+		if err != nil || !replicationRemoved {
+			return false, progressingConditions, err
+		}
+
+		racksDecommissioned, racksProgressingConditions, err := scc.decommissionDatacenterRacks(ctx, sc, status, dc)
+		progressingConditions = append(progressingConditions, racksProgressingConditions...)
+		if err != nil || !racksDecommissioned {
+			return false, progressingConditions, err
+		}
+	}
+
+	setDatacenterDecommissionCondition(sc, status, datacenterDecommissionRacksDecommissionedCondition, metav1.ConditionTrue, internalapi.AsExpectedReason, fmt.Sprintf("All racks of datacenter %q are decommissioned.", dc.name))
+
+	controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, scyllaDBDatacenterControllerProgressingCondition, dc.sdc, "delete", sc.Generation)
+	err := dc.client.scyllaClient.ScyllaV1alpha1().ScyllaDBDatacenters(dc.sdc.Namespace).Delete(ctx, dc.sdc.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{
+			UID: &dc.sdc.UID,
+		},
+		PropagationPolicy: pointer.Ptr(metav1.DeletePropagationBackground),
+	})
+	resourceapply.ReportDeleteEvent(scc.eventRecorder, dc.sdc, err)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, progressingConditions, fmt.Errorf("can't delete ScyllaDBDatacenter %q: %w", naming.ObjRef(dc.sdc), err)
+	}
+
+	return false, progressingConditions, nil
+}
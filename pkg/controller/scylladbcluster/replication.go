@@ -0,0 +1,148 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbcluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gocql/gocql"
+	"github.com/scylladb/scylla-operator/pkg/util/cql"
+)
+
+const (
+	replicationClassKey             = "class"
+	networkTopologyStrategyClass    = "NetworkTopologyStrategy"
+	replicationFactorKey            = "replication_factor"
+	selectKeyspaceReplicationsQuery = "SELECT keyspace_name, replication FROM system_schema.keyspaces"
+)
+
+// keyspaceReplication is the replication of a keyspace, as stored in system_schema.keyspaces.
+type keyspaceReplication struct {
+	name        string
+	replication map[string]string
+}
+
+// datacenterReplicationPlan describes the keyspaces that block removing a datacenter.
+type datacenterReplicationPlan struct {
+	// replicated holds keyspaces that are replicated to the datacenter and to at least one other datacenter.
+	replicated []keyspaceReplication
+
+	// exclusive holds names of keyspaces that are replicated only to the datacenter.
+	// Removing the datacenter would lose their data.
+	exclusive []string
+}
+
+func isNetworkTopologyStrategy(replication map[string]string) bool {
+	class := replication[replicationClassKey]
+	return class == networkTopologyStrategyClass || strings.HasSuffix(class, "."+networkTopologyStrategyClass)
+}
+
+// getDatacenterReplicationFactors returns the replication factors of datacenters of a NetworkTopologyStrategy keyspace.
+func getDatacenterReplicationFactors(replication map[string]string) (map[string]int, error) {
+	rfs := make(map[string]int, len(replication))
+	for k, v := range replication {
+		if k == replicationClassKey || k == replicationFactorKey {
+			continue
+		}
+
+		rf, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("can't parse replication factor %q of datacenter %q: %w", v, k, err)
+		}
+
+		rfs[k] = rf
+	}
+
+	return rfs, nil
+}
+
+// makeDatacenterReplicationPlan finds keyspaces that still replicate to the datacenter.
+// Only NetworkTopologyStrategy keyspaces place replicas per datacenter, keyspaces using other strategies
+// are streamed to the remaining nodes by the decommission.
+func makeDatacenterReplicationPlan(keyspaces []keyspaceReplication, dcName string) (*datacenterReplicationPlan, error) {
+	plan := &datacenterReplicationPlan{}
+
+	for _, ks := range keyspaces {
+		if !isNetworkTopologyStrategy(ks.replication) {
+			continue
+		}
+
+		rfs, err := getDatacenterReplicationFactors(ks.replication)
+		if err != nil {
+			return nil, fmt.Errorf("can't get replication factors of keyspace %q: %w", ks.name, err)
+		}
+
+		if rfs[dcName] == 0 {
+			continue
+		}
+
+		replicatedElsewhere := false
+		for dc, rf := range rfs {
+			if dc != dcName && rf > 0 {
+				replicatedElsewhere = true
+				break
+			}
+		}
+
+		if !replicatedElsewhere {
+			plan.exclusive = append(plan.exclusive, ks.name)
+			continue
+		}
+
+		plan.replicated = append(plan.replicated, ks)
+	}
+
+	return plan, nil
+}
+
+// makeRemoveDatacenterReplicationStatement returns a statement altering the keyspace so that it no longer replicates
+// to the datacenter. NetworkTopologyStrategy replaces the replication options as a whole, so datacenters that
+// aren't listed lose their replicas.
+func makeRemoveDatacenterReplicationStatement(ks keyspaceReplication, dcName string) string {
+	keys := make([]string, 0, len(ks.replication))
+	for k := range ks.replication {
+		if k == dcName || k == replicationClassKey {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	options := make([]string, 0, len(keys)+1)
+	options = append(options, fmt.Sprintf("%s: %s", cql.QuoteString(replicationClassKey), cql.QuoteString(ks.replication[replicationClassKey])))
+	for _, k := range keys {
+		options = append(options, fmt.Sprintf("%s: %s", cql.QuoteString(k), cql.QuoteString(ks.replication[k])))
+	}
+
+	return fmt.Sprintf("ALTER KEYSPACE %s WITH replication = {%s}", cql.QuoteIdentifier(ks.name), strings.Join(options, ", "))
+}
+
+func getKeyspaceReplications(ctx context.Context, session *gocql.Session) ([]keyspaceReplication, error) {
+	iter := session.Query(selectKeyspaceReplicationsQuery).WithContext(ctx).Iter()
+
+	var keyspaces []keyspaceReplication
+	var name string
+	var replication map[string]string
+	for iter.Scan(&name, &replication) {
+		keyspaces = append(keyspaces, keyspaceReplication{
+			name:        name,
+			replication: replication,
+		})
+		replication = nil
+	}
+
+	err := iter.Close()
+	if err != nil {
+		return nil, fmt.Errorf("can't get keyspace replications: %w", err)
+	}
+
+	sort.Slice(keyspaces, func(i, j int) bool {
+		return keyspaces[i].name < keyspaces[j].name
+	})
+
+	return keyspaces, nil
+}
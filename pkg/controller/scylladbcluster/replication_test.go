@@ -0,0 +1,154 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbcluster
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMakeDatacenterReplicationPlan(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name          string
+		keyspaces     []keyspaceReplication
+		dcName        string
+		expectedPlan  *datacenterReplicationPlan
+		expectedError string
+	}{
+		{
+			name: "keyspaces not replicated to the datacenter are ignored",
+			keyspaces: []keyspaceReplication{
+				{
+					name:        "system",
+					replication: map[string]string{"class": "org.apache.cassandra.locator.LocalStrategy"},
+				},
+				{
+					name:        "system_traces",
+					replication: map[string]string{"class": "org.apache.cassandra.locator.SimpleStrategy", "replication_factor": "2"},
+				},
+				{
+					name:        "other",
+					replication: map[string]string{"class": "org.apache.cassandra.locator.NetworkTopologyStrategy", "us-east-1": "3"},
+				},
+				{
+					name:        "zero",
+					replication: map[string]string{"class": "org.apache.cassandra.locator.NetworkTopologyStrategy", "us-east-1": "3", "us-west-1": "0"},
+				},
+			},
+			dcName:        "us-west-1",
+			expectedPlan:  &datacenterReplicationPlan{},
+			expectedError: "",
+		},
+		{
+			name: "keyspaces replicated to the datacenter and elsewhere are replicated, the ones only in the datacenter are exclusive",
+			keyspaces: []keyspaceReplication{
+				{
+					name:        "exclusive",
+					replication: map[string]string{"class": "NetworkTopologyStrategy", "us-east-1": "0", "us-west-1": "3"},
+				},
+				{
+					name:        "shared",
+					replication: map[string]string{"class": "org.apache.cassandra.locator.NetworkTopologyStrategy", "us-east-1": "3", "us-west-1": "3"},
+				},
+			},
+			dcName: "us-west-1",
+			expectedPlan: &datacenterReplicationPlan{
+				replicated: []keyspaceReplication{
+					{
+						name:        "shared",
+						replication: map[string]string{"class": "org.apache.cassandra.locator.NetworkTopologyStrategy", "us-east-1": "3", "us-west-1": "3"},
+					},
+				},
+				exclusive: []string{"exclusive"},
+			},
+			expectedError: "",
+		},
+		{
+			name: "invalid replication factor results in an error",
+			keyspaces: []keyspaceReplication{
+				{
+					name:        "broken",
+					replication: map[string]string{"class": "org.apache.cassandra.locator.NetworkTopologyStrategy", "us-west-1": "three"},
+				},
+			},
+			dcName:        "us-west-1",
+			expectedPlan:  nil,
+			expectedError: `can't get replication factors of keyspace "broken": can't parse replication factor "three" of datacenter "us-west-1": strconv.Atoi: parsing "three": invalid syntax`,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan, err := makeDatacenterReplicationPlan(tc.keyspaces, tc.dcName)
+			var errStr string
+			if err != nil {
+				errStr = err.Error()
+			}
+			if errStr != tc.expectedError {
+				t.Fatalf("expected error %q, got %q", tc.expectedError, errStr)
+			}
+
+			if !reflect.DeepEqual(plan, tc.expectedPlan) {
+				t.Errorf("expected and got plans differ:\n%s", cmp.Diff(tc.expectedPlan, plan, cmp.AllowUnexported(datacenterReplicationPlan{}, keyspaceReplication{})))
+			}
+		})
+	}
+}
+
+func TestMakeRemoveDatacenterReplicationStatement(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		keyspace keyspaceReplication
+		dcName   string
+		expected string
+	}{
+		{
+			name: "datacenter is removed and the remaining options are sorted",
+			keyspace: keyspaceReplication{
+				name: "data",
+				replication: map[string]string{
+					"class":     "org.apache.cassandra.locator.NetworkTopologyStrategy",
+					"us-west-1": "3",
+					"us-east-1": "3",
+					"eu-west-1": "2",
+				},
+			},
+			dcName:   "us-west-1",
+			expected: `ALTER KEYSPACE "data" WITH replication = {'class': 'org.apache.cassandra.locator.NetworkTopologyStrategy', 'eu-west-1': '2', 'us-east-1': '3'}`,
+		},
+		{
+			name: "identifiers and values are quoted",
+			keyspace: keyspaceReplication{
+				name: `My"Keyspace`,
+				replication: map[string]string{
+					"class":     "org.apache.cassandra.locator.NetworkTopologyStrategy",
+					"dc'1":      "3",
+					"us-west-1": "3",
+				},
+			},
+			dcName:   "us-west-1",
+			expected: `ALTER KEYSPACE "My""Keyspace" WITH replication = {'class': 'org.apache.cassandra.locator.NetworkTopologyStrategy', 'dc''1': '3'}`,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := makeRemoveDatacenterReplicationStatement(tc.keyspace, tc.dcName)
+			if got != tc.expected {
+				t.Errorf("expected statement %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
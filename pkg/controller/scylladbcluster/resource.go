@@ -0,0 +1,151 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbcluster
+
+import (
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/helpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func getClusterName(sc *scyllav1alpha1.ScyllaDBCluster) string {
+	if sc.Spec.ClusterName != nil && len(*sc.Spec.ClusterName) != 0 {
+		return *sc.Spec.ClusterName
+	}
+
+	return sc.Name
+}
+
+func getDatacenterNamespace(sc *scyllav1alpha1.ScyllaDBCluster, remote *scyllav1alpha1.RemoteKubernetesClusterReference) string {
+	if remote != nil && len(remote.Namespace) != 0 {
+		return remote.Namespace
+	}
+
+	return sc.Namespace
+}
+
+func makeDatacenterSelectorLabels(sc *scyllav1alpha1.ScyllaDBCluster) map[string]string {
+	return map[string]string{
+		naming.ParentClusterNameLabel:      sc.Name,
+		naming.ParentClusterNamespaceLabel: sc.Namespace,
+		naming.OwnerUIDLabel:               string(sc.UID),
+	}
+}
+
+func makeDatacenterLabels(sc *scyllav1alpha1.ScyllaDBCluster, dcName string) map[string]string {
+	labels := makeDatacenterSelectorLabels(sc)
+	labels[naming.ParentClusterDatacenterNameLabel] = dcName
+	return labels
+}
+
+func getMetadataLabels(metadata *scyllav1alpha1.ObjectTemplateMetadata) map[string]string {
+	if metadata == nil {
+		return nil
+	}
+
+	return metadata.Labels
+}
+
+func getMetadataAnnotations(metadata *scyllav1alpha1.ObjectTemplateMetadata) map[string]string {
+	if metadata == nil {
+		return nil
+	}
+
+	return metadata.Annotations
+}
+
+// makeDatacenterTemplate returns the datacenter template with the values specified by the datacenter taking precedence
+// over the ones from the datacenter template of the cluster.
+func makeDatacenterTemplate(sc *scyllav1alpha1.ScyllaDBCluster, dc *scyllav1alpha1.ScyllaDBClusterDatacenter) *scyllav1alpha1.ScyllaDBClusterDatacenterTemplate {
+	template := &scyllav1alpha1.ScyllaDBClusterDatacenterTemplate{}
+	if sc.Spec.DatacenterTemplate != nil {
+		template = sc.Spec.DatacenterTemplate.DeepCopy()
+	}
+
+	template.Metadata = &scyllav1alpha1.ObjectTemplateMetadata{
+		Labels: helpers.MergeMaps(
+			getMetadataLabels(sc.Spec.Metadata),
+			getMetadataLabels(template.Metadata),
+			getMetadataLabels(dc.Metadata),
+		),
+		Annotations: helpers.MergeMaps(
+			getMetadataAnnotations(sc.Spec.Metadata),
+			getMetadataAnnotations(template.Metadata),
+			getMetadataAnnotations(dc.Metadata),
+		),
+	}
+
+	if len(dc.DNSDomains) != 0 {
+		template.DNSDomains = dc.DNSDomains
+	}
+
+	if dc.RackTemplate != nil {
+		template.RackTemplate = dc.RackTemplate
+	}
+
+	if len(dc.Racks) != 0 {
+		template.Racks = dc.Racks
+	}
+
+	return template.DeepCopy()
+}
+
+func MakeScyllaDBDatacenter(sc *scyllav1alpha1.ScyllaDBCluster, dc *scyllav1alpha1.ScyllaDBClusterDatacenter, seeds []string) *scyllav1alpha1.ScyllaDBDatacenter {
+	template := makeDatacenterTemplate(sc, dc)
+
+	var ownerReferences []metav1.OwnerReference
+	// Objects in remote Kubernetes clusters can't be owned, they are removed by the controller instead.
+	if dc.RemoteKubernetesCluster == nil {
+		ownerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(sc, scyllaDBClusterControllerGVK),
+		}
+	}
+
+	scyllaDB := sc.Spec.ScyllaDB.DeepCopy()
+	scyllaDB.ExternalSeeds = seeds
+
+	var scyllaDBManagerAgent *scyllav1alpha1.ScyllaDBManagerAgent
+	if sc.Spec.ScyllaDBManagerAgent != nil {
+		scyllaDBManagerAgent = sc.Spec.ScyllaDBManagerAgent.DeepCopy()
+	}
+
+	var exposeOptions *scyllav1alpha1.ExposeOptions
+	if sc.Spec.ExposeOptions != nil {
+		exposeOptions = sc.Spec.ExposeOptions.DeepCopy()
+	}
+
+	var forceRedeploymentReason *string
+	if sc.Spec.ForceRedeploymentReason != nil {
+		forceRedeploymentReason = pointer.Ptr(*sc.Spec.ForceRedeploymentReason)
+	}
+
+	racks := template.Racks
+	if racks == nil {
+		racks = []scyllav1alpha1.RackSpec{}
+	}
+
+	return &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            naming.ScyllaDBDatacenterNameForScyllaDBCluster(sc, dc.Name),
+			Namespace:       getDatacenterNamespace(sc, dc.RemoteKubernetesCluster),
+			Labels:          helpers.MergeMaps(template.Metadata.Labels, makeDatacenterLabels(sc, dc.Name)),
+			Annotations:     helpers.MergeMaps(template.Metadata.Annotations),
+			OwnerReferences: ownerReferences,
+		},
+		Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+			Metadata:                template.Metadata,
+			ClusterName:             getClusterName(sc),
+			DatacenterName:          pointer.Ptr(dc.Name),
+			ScyllaDB:                *scyllaDB,
+			ScyllaDBManagerAgent:    scyllaDBManagerAgent,
+			ImagePullSecrets:        sc.Spec.ImagePullSecrets,
+			DNSDomains:              template.DNSDomains,
+			ForceRedeploymentReason: forceRedeploymentReason,
+			ExposeOptions:           exposeOptions,
+			RackTemplate:            template.RackTemplate,
+			Racks:                   racks,
+		},
+	}
+}
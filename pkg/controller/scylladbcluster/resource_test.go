@@ -0,0 +1,389 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbcluster
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMakeScyllaDBDatacenter(t *testing.T) {
+	t.Parallel()
+
+	newScyllaDBCluster := func() *scyllav1alpha1.ScyllaDBCluster {
+		return &scyllav1alpha1.ScyllaDBCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "basic",
+				Namespace: "scylla",
+				UID:       "the-uid",
+			},
+			Spec: scyllav1alpha1.ScyllaDBClusterSpec{
+				Metadata: &scyllav1alpha1.ObjectTemplateMetadata{
+					Labels: map[string]string{
+						"cluster-label": "cluster",
+						"shared-label":  "cluster",
+					},
+				},
+				ScyllaDB: scyllav1alpha1.ScyllaDB{
+					Image:         "scylladb/scylla:latest",
+					ExternalSeeds: []string{"10.0.0.1"},
+				},
+				ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgent{
+					Image: pointer.Ptr("scylladb/scylla-manager-agent:latest"),
+				},
+				DatacenterTemplate: &scyllav1alpha1.ScyllaDBClusterDatacenterTemplate{
+					Metadata: &scyllav1alpha1.ObjectTemplateMetadata{
+						Labels: map[string]string{
+							"shared-label": "template",
+						},
+					},
+					DNSDomains: []string{"template.example.com"},
+					RackTemplate: &scyllav1alpha1.RackTemplate{
+						Nodes: pointer.Ptr[int32](3),
+					},
+					Racks: []scyllav1alpha1.RackSpec{
+						{
+							Name: "a",
+						},
+					},
+				},
+				Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenter{
+					{
+						Name: "us-east-1",
+					},
+					{
+						ScyllaDBClusterDatacenterTemplate: scyllav1alpha1.ScyllaDBClusterDatacenterTemplate{
+							Metadata: &scyllav1alpha1.ObjectTemplateMetadata{
+								Labels: map[string]string{
+									"shared-label": "datacenter",
+								},
+							},
+							Racks: []scyllav1alpha1.RackSpec{
+								{
+									Name: "b",
+								},
+							},
+						},
+						Name: "us-west-1",
+						RemoteKubernetesCluster: &scyllav1alpha1.RemoteKubernetesClusterReference{
+							KubeconfigSecretName: "us-west-1-kubeconfig",
+							Namespace:            "remote",
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name     string
+		sc       *scyllav1alpha1.ScyllaDBCluster
+		dcIndex  int
+		seeds    []string
+		expected *scyllav1alpha1.ScyllaDBDatacenter
+	}{
+		{
+			name:    "local datacenter uses the datacenter template and is owned by the ScyllaDBCluster",
+			sc:      newScyllaDBCluster(),
+			dcIndex: 0,
+			seeds:   []string{"10.0.0.1"},
+			expected: &scyllav1alpha1.ScyllaDBDatacenter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-us-east-1",
+					Namespace: "scylla",
+					Labels: map[string]string{
+						"cluster-label": "cluster",
+						"shared-label":  "template",
+						"scylla-operator.scylladb.com/parent-scylladbcluster-name":            "basic",
+						"scylla-operator.scylladb.com/parent-scylladbcluster-namespace":       "scylla",
+						"scylla-operator.scylladb.com/parent-scylladbcluster-datacenter-name": "us-east-1",
+						"scylla-operator.scylladb.com/owner-uid":                              "the-uid",
+					},
+					Annotations: map[string]string{},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion:         "scylla.scylladb.com/v1alpha1",
+							Kind:               "ScyllaDBCluster",
+							Name:               "basic",
+							UID:                "the-uid",
+							Controller:         pointer.Ptr(true),
+							BlockOwnerDeletion: pointer.Ptr(true),
+						},
+					},
+				},
+				Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+					Metadata: &scyllav1alpha1.ObjectTemplateMetadata{
+						Labels: map[string]string{
+							"cluster-label": "cluster",
+							"shared-label":  "template",
+						},
+						Annotations: map[string]string{},
+					},
+					ClusterName:    "basic",
+					DatacenterName: pointer.Ptr("us-east-1"),
+					ScyllaDB: scyllav1alpha1.ScyllaDB{
+						Image:         "scylladb/scylla:latest",
+						ExternalSeeds: []string{"10.0.0.1"},
+					},
+					ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgent{
+						Image: pointer.Ptr("scylladb/scylla-manager-agent:latest"),
+					},
+					DNSDomains: []string{"template.example.com"},
+					RackTemplate: &scyllav1alpha1.RackTemplate{
+						Nodes: pointer.Ptr[int32](3),
+					},
+					Racks: []scyllav1alpha1.RackSpec{
+						{
+							Name: "a",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "remote datacenter overrides the datacenter template and isn't owned",
+			sc:      newScyllaDBCluster(),
+			dcIndex: 1,
+			seeds:   []string{"10.0.0.1", "10.1.0.1"},
+			expected: &scyllav1alpha1.ScyllaDBDatacenter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-us-west-1",
+					Namespace: "remote",
+					Labels: map[string]string{
+						"cluster-label": "cluster",
+						"shared-label":  "datacenter",
+						"scylla-operator.scylladb.com/parent-scylladbcluster-name":            "basic",
+						"scylla-operator.scylladb.com/parent-scylladbcluster-namespace":       "scylla",
+						"scylla-operator.scylladb.com/parent-scylladbcluster-datacenter-name": "us-west-1",
+						"scylla-operator.scylladb.com/owner-uid":                              "the-uid",
+					},
+					Annotations: map[string]string{},
+				},
+				Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+					Metadata: &scyllav1alpha1.ObjectTemplateMetadata{
+						Labels: map[string]string{
+							"cluster-label": "cluster",
+							"shared-label":  "datacenter",
+						},
+						Annotations: map[string]string{},
+					},
+					ClusterName:    "basic",
+					DatacenterName: pointer.Ptr("us-west-1"),
+					ScyllaDB: scyllav1alpha1.ScyllaDB{
+						Image:         "scylladb/scylla:latest",
+						ExternalSeeds: []string{"10.0.0.1", "10.1.0.1"},
+					},
+					ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgent{
+						Image: pointer.Ptr("scylladb/scylla-manager-agent:latest"),
+					},
+					DNSDomains: []string{"template.example.com"},
+					RackTemplate: &scyllav1alpha1.RackTemplate{
+						Nodes: pointer.Ptr[int32](3),
+					},
+					Racks: []scyllav1alpha1.RackSpec{
+						{
+							Name: "b",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "custom cluster name",
+			sc: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newScyllaDBCluster()
+				sc.Spec.ClusterName = pointer.Ptr("custom")
+				sc.Spec.Metadata = nil
+				sc.Spec.DatacenterTemplate.Metadata = nil
+				return sc
+			}(),
+			dcIndex: 0,
+			seeds:   nil,
+			expected: &scyllav1alpha1.ScyllaDBDatacenter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-us-east-1",
+					Namespace: "scylla",
+					Labels: map[string]string{
+						"scylla-operator.scylladb.com/parent-scylladbcluster-name":            "basic",
+						"scylla-operator.scylladb.com/parent-scylladbcluster-namespace":       "scylla",
+						"scylla-operator.scylladb.com/parent-scylladbcluster-datacenter-name": "us-east-1",
+						"scylla-operator.scylladb.com/owner-uid":                              "the-uid",
+					},
+					Annotations: map[string]string{},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion:         "scylla.scylladb.com/v1alpha1",
+							Kind:               "ScyllaDBCluster",
+							Name:               "basic",
+							UID:                "the-uid",
+							Controller:         pointer.Ptr(true),
+							BlockOwnerDeletion: pointer.Ptr(true),
+						},
+					},
+				},
+				Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+					Metadata: &scyllav1alpha1.ObjectTemplateMetadata{
+						Labels:      map[string]string{},
+						Annotations: map[string]string{},
+					},
+					ClusterName:    "custom",
+					DatacenterName: pointer.Ptr("us-east-1"),
+					ScyllaDB: scyllav1alpha1.ScyllaDB{
+						Image: "scylladb/scylla:latest",
+					},
+					ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgent{
+						Image: pointer.Ptr("scylladb/scylla-manager-agent:latest"),
+					},
+					DNSDomains: []string{"template.example.com"},
+					RackTemplate: &scyllav1alpha1.RackTemplate{
+						Nodes: pointer.Ptr[int32](3),
+					},
+					Racks: []scyllav1alpha1.RackSpec{
+						{
+							Name: "a",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := MakeScyllaDBDatacenter(tc.sc, &tc.sc.Spec.Datacenters[tc.dcIndex], tc.seeds)
+			if !apiequality.Semantic.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got ScyllaDBDatacenters differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func TestSetDatacentersStatus(t *testing.T) {
+	t.Parallel()
+
+	sc := &scyllav1alpha1.ScyllaDBCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "basic",
+			Namespace:  "scylla",
+			Generation: 2,
+		},
+	}
+
+	availableConditions := []metav1.Condition{
+		{
+			Type:   scyllav1alpha1.AvailableCondition,
+			Status: metav1.ConditionTrue,
+		},
+	}
+
+	tt := []struct {
+		name     string
+		status   *scyllav1alpha1.ScyllaDBClusterStatus
+		expected *scyllav1alpha1.ScyllaDBClusterStatus
+	}{
+		{
+			name: "all datacenters available",
+			status: &scyllav1alpha1.ScyllaDBClusterStatus{
+				Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+					{
+						Name:           "us-east-1",
+						Phase:          scyllav1alpha1.ScyllaDBClusterDatacenterPhaseProvisioned,
+						Nodes:          pointer.Ptr[int32](3),
+						ReadyNodes:     pointer.Ptr[int32](3),
+						AvailableNodes: pointer.Ptr[int32](3),
+						Conditions:     availableConditions,
+					},
+					{
+						Name:           "us-west-1",
+						Phase:          scyllav1alpha1.ScyllaDBClusterDatacenterPhaseProvisioned,
+						Nodes:          pointer.Ptr[int32](3),
+						ReadyNodes:     pointer.Ptr[int32](2),
+						AvailableNodes: pointer.Ptr[int32](2),
+						Conditions:     availableConditions,
+					},
+					{
+						Name:           "eu-west-1",
+						Phase:          scyllav1alpha1.ScyllaDBClusterDatacenterPhaseDecommissioning,
+						Nodes:          pointer.Ptr[int32](1),
+						ReadyNodes:     pointer.Ptr[int32](1),
+						AvailableNodes: pointer.Ptr[int32](1),
+					},
+				},
+			},
+			expected: &scyllav1alpha1.ScyllaDBClusterStatus{
+				Nodes:          pointer.Ptr[int32](7),
+				ReadyNodes:     pointer.Ptr[int32](6),
+				AvailableNodes: pointer.Ptr[int32](6),
+				Conditions: []metav1.Condition{
+					{
+						Type:               datacentersAvailableCondition,
+						Status:             metav1.ConditionTrue,
+						Reason:             "AsExpected",
+						ObservedGeneration: 2,
+					},
+				},
+			},
+		},
+		{
+			name: "pending datacenter isn't available",
+			status: &scyllav1alpha1.ScyllaDBClusterStatus{
+				Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+					{
+						Name:           "us-east-1",
+						Phase:          scyllav1alpha1.ScyllaDBClusterDatacenterPhaseProvisioned,
+						Nodes:          pointer.Ptr[int32](3),
+						ReadyNodes:     pointer.Ptr[int32](3),
+						AvailableNodes: pointer.Ptr[int32](3),
+						Conditions:     availableConditions,
+					},
+					{
+						Name:  "us-west-1",
+						Phase: scyllav1alpha1.ScyllaDBClusterDatacenterPhasePending,
+					},
+				},
+			},
+			expected: &scyllav1alpha1.ScyllaDBClusterStatus{
+				Nodes:          pointer.Ptr[int32](3),
+				ReadyNodes:     pointer.Ptr[int32](3),
+				AvailableNodes: pointer.Ptr[int32](3),
+				Conditions: []metav1.Condition{
+					{
+						Type:               datacentersAvailableCondition,
+						Status:             metav1.ConditionFalse,
+						Reason:             "DatacentersUnavailable",
+						Message:            `Datacenters ["us-west-1"] aren't available.`,
+						ObservedGeneration: 2,
+					},
+				},
+			},
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.status.DeepCopy()
+			setDatacentersStatus(sc, got)
+
+			// Datacenters are passed through.
+			tc.expected.Datacenters = tc.status.Datacenters
+			for i := range got.Conditions {
+				got.Conditions[i].LastTransitionTime = metav1.Time{}
+			}
+
+			if !apiequality.Semantic.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got status differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
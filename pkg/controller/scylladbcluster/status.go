@@ -0,0 +1,85 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbcluster
+
+import (
+	"context"
+	"fmt"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+func (scc *Controller) calculateStatus(sc *scyllav1alpha1.ScyllaDBCluster) *scyllav1alpha1.ScyllaDBClusterStatus {
+	status := sc.Status.DeepCopy()
+	status.ObservedGeneration = pointer.Ptr(sc.Generation)
+
+	return status
+}
+
+// setDatacentersStatus aggregates the node counts and availability of the datacenters.
+func setDatacentersStatus(sc *scyllav1alpha1.ScyllaDBCluster, status *scyllav1alpha1.ScyllaDBClusterStatus) {
+	var nodes, readyNodes, availableNodes int32
+	var unavailableDCs []string
+	for _, dcStatus := range status.Datacenters {
+		if dcStatus.Nodes != nil {
+			nodes += *dcStatus.Nodes
+		}
+		if dcStatus.ReadyNodes != nil {
+			readyNodes += *dcStatus.ReadyNodes
+		}
+		if dcStatus.AvailableNodes != nil {
+			availableNodes += *dcStatus.AvailableNodes
+		}
+
+		if dcStatus.Phase == scyllav1alpha1.ScyllaDBClusterDatacenterPhaseDecommissioning {
+			continue
+		}
+
+		if dcStatus.Phase != scyllav1alpha1.ScyllaDBClusterDatacenterPhaseProvisioned || !apimeta.IsStatusConditionTrue(dcStatus.Conditions, scyllav1alpha1.AvailableCondition) {
+			unavailableDCs = append(unavailableDCs, dcStatus.Name)
+		}
+	}
+
+	status.Nodes = pointer.Ptr(nodes)
+	status.ReadyNodes = pointer.Ptr(readyNodes)
+	status.AvailableNodes = pointer.Ptr(availableNodes)
+
+	availableCondition := metav1.Condition{
+		Type:               datacentersAvailableCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             internalapi.AsExpectedReason,
+		ObservedGeneration: sc.Generation,
+	}
+	if len(unavailableDCs) != 0 {
+		availableCondition.Status = metav1.ConditionFalse
+		availableCondition.Reason = "DatacentersUnavailable"
+		availableCondition.Message = fmt.Sprintf("Datacenters %q aren't available.", unavailableDCs)
+	}
+	apimeta.SetStatusCondition(&status.Conditions, availableCondition)
+}
+
+func (scc *Controller) updateStatus(ctx context.Context, currentSC *scyllav1alpha1.ScyllaDBCluster, status *scyllav1alpha1.ScyllaDBClusterStatus) error {
+	if apiequality.Semantic.DeepEqual(&currentSC.Status, status) {
+		return nil
+	}
+
+	sc := currentSC.DeepCopy()
+	sc.Status = *status
+
+	klog.V(2).InfoS("Updating status", "ScyllaDBCluster", klog.KObj(sc))
+
+	_, err := scc.scyllaClient.ScyllaV1alpha1().ScyllaDBClusters(sc.Namespace).UpdateStatus(ctx, sc, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	klog.V(2).InfoS("Status updated", "ScyllaDBCluster", klog.KObj(sc))
+
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbcluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+func (scc *Controller) sync(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		klog.ErrorS(err, "Failed to split meta namespace cache key", "cacheKey", key)
+		return err
+	}
+
+	startTime := time.Now()
+	klog.V(4).InfoS("Started syncing ScyllaDBCluster", "ScyllaDBCluster", klog.KRef(namespace, name), "startTime", startTime)
+	defer func() {
+		klog.V(4).InfoS("Finished syncing ScyllaDBCluster", "ScyllaDBCluster", klog.KRef(namespace, name), "duration", time.Since(startTime))
+	}()
+
+	sc, err := scc.scyllaDBClusterLister.ScyllaDBClusters(namespace).Get(name)
+	if errors.IsNotFound(err) {
+		klog.V(2).InfoS("ScyllaDBCluster has been deleted", "ScyllaDBCluster", klog.KRef(namespace, name))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	dcs, err := scc.getDatacenters(ctx, sc)
+	if err != nil {
+		return err
+	}
+
+	_, _, hasRemoteDCs := slices.Find(dcs, func(dc *datacenter) bool {
+		return dc.remote != nil
+	})
+	if hasRemoteDCs {
+		scc.queue.AddAfter(key, remoteDatacenterResyncInterval)
+	}
+
+	if sc.DeletionTimestamp != nil {
+		return scc.syncFinalizer(ctx, sc, dcs)
+	}
+
+	updated, err := scc.ensureFinalizer(ctx, sc, dcs)
+	if err != nil || updated {
+		// The update triggers another sync.
+		return err
+	}
+
+	status := scc.calculateStatus(sc)
+
+	var errs []error
+
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		scyllaDBDatacenterControllerProgressingCondition,
+		scyllaDBDatacenterControllerDegradedCondition,
+		sc.Generation,
+		func() ([]metav1.Condition, error) {
+			return scc.syncDatacenters(ctx, sc, status, dcs)
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync datacenters: %w", err))
+	}
+
+	setDatacentersStatus(sc, status)
+
+	decommissioning := slices.Contains(status.Datacenters, func(dcStatus scyllav1alpha1.ScyllaDBClusterDatacenterStatus) bool {
+		return dcStatus.Phase == scyllav1alpha1.ScyllaDBClusterDatacenterPhaseDecommissioning
+	})
+	if decommissioning {
+		scc.queue.AddAfter(key, datacenterDecommissionResyncInterval)
+	}
+
+	// Aggregate conditions.
+	err = controllerhelpers.SetAggregatedWorkloadConditions(&status.Conditions, sc.Generation)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't aggregate workload conditions: %w", err))
+	} else {
+		err = scc.updateStatus(ctx, sc, status)
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
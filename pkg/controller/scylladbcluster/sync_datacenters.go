@@ -0,0 +1,362 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbcluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/helpers"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+// datacenter is a datacenter that is either specified or still deployed.
+type datacenter struct {
+	name   string
+	remote *scyllav1alpha1.RemoteKubernetesClusterReference
+	client *datacenterClient
+	sdc    *scyllav1alpha1.ScyllaDBDatacenter
+	err    error
+}
+
+func isDatacenterRolledOut(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+	return helpers.IsStatusConditionPresentAndTrue(sdc.Status.Conditions, scyllav1alpha1.AvailableCondition, sdc.Generation) &&
+		helpers.IsStatusConditionPresentAndFalse(sdc.Status.Conditions, scyllav1alpha1.ProgressingCondition, sdc.Generation)
+}
+
+func makeDatacenterProgressingCondition(sc *scyllav1alpha1.ScyllaDBCluster, reason, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               scyllaDBDatacenterControllerProgressingCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: sc.Generation,
+	}
+}
+
+// getDatacenters returns the specified datacenters in order, followed by the removed datacenters that are still deployed.
+func (scc *Controller) getDatacenters(ctx context.Context, sc *scyllav1alpha1.ScyllaDBCluster) ([]*datacenter, error) {
+	var dcs []*datacenter
+	for _, dc := range sc.Spec.Datacenters {
+		dcs = append(dcs, &datacenter{
+			name:   dc.Name,
+			remote: dc.RemoteKubernetesCluster,
+		})
+	}
+
+	specifiedNames := sets.New(slices.ConvertSlice(dcs, func(dc *datacenter) string { return dc.name })...)
+	for _, dcStatus := range sc.Status.Datacenters {
+		if specifiedNames.Has(dcStatus.Name) {
+			continue
+		}
+		specifiedNames.Insert(dcStatus.Name)
+		dcs = append(dcs, &datacenter{
+			name:   dcStatus.Name,
+			remote: dcStatus.RemoteKubernetesCluster,
+		})
+	}
+
+	// Local ScyllaDBDatacenters are listed as well, so they are decommissioned even if the status was lost.
+	localSDCs, err := scc.scyllaDBDatacenterLister.ScyllaDBDatacenters(sc.Namespace).List(labels.SelectorFromSet(makeDatacenterSelectorLabels(sc)))
+	if err != nil {
+		return nil, fmt.Errorf("can't list ScyllaDBDatacenters: %w", err)
+	}
+	sort.Slice(localSDCs, func(i, j int) bool {
+		return localSDCs[i].Name < localSDCs[j].Name
+	})
+	for _, sdc := range localSDCs {
+		dcName, ok := sdc.Labels[naming.ParentClusterDatacenterNameLabel]
+		if !ok || specifiedNames.Has(dcName) {
+			continue
+		}
+		specifiedNames.Insert(dcName)
+		dcs = append(dcs, &datacenter{
+			name: dcName,
+		})
+	}
+
+	for _, dc := range dcs {
+		dc.client, dc.err = scc.getDatacenterClient(sc, dc.remote)
+		if dc.err != nil {
+			continue
+		}
+
+		dc.sdc, dc.err = scc.getScyllaDBDatacenter(ctx, sc, dc.client, naming.ScyllaDBDatacenterNameForScyllaDBCluster(sc, dc.name))
+	}
+
+	return dcs, nil
+}
+
+// getDatacenterSeeds returns the addresses of the first node of every rack of the ScyllaDBDatacenter.
+func getDatacenterSeeds(ctx context.Context, dc *datacenter) ([]string, error) {
+	var seeds []string
+	for _, rack := range dc.sdc.Spec.Racks {
+		svcName := naming.MemberServiceName(rack, dc.sdc, 0)
+
+		svc, err := dc.client.kubeClient.CoreV1().Services(dc.sdc.Namespace).Get(ctx, svcName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("can't get Service %q: %w", naming.ManualRef(dc.sdc.Namespace, svcName), err)
+		}
+
+		pod, err := dc.client.kubeClient.CoreV1().Pods(dc.sdc.Namespace).Get(ctx, naming.PodNameFromService(svc), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("can't get Pod %q: %w", naming.ManualRef(dc.sdc.Namespace, naming.PodNameFromService(svc)), err)
+		}
+
+		host, err := controllerhelpers.GetScyllaHost(dc.sdc, svc, pod)
+		if err != nil {
+			klog.V(4).InfoS("Node address isn't available yet", "Service", klog.KObj(svc), "Error", err)
+			continue
+		}
+
+		seeds = append(seeds, host)
+	}
+
+	return seeds, nil
+}
+
+func makeDatacenterStatus(dc *datacenter, phase scyllav1alpha1.ScyllaDBClusterDatacenterPhase, message string) scyllav1alpha1.ScyllaDBClusterDatacenterStatus {
+	dcStatus := scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+		Name:                    dc.name,
+		RemoteKubernetesCluster: dc.remote,
+		Phase:                   phase,
+		Message:                 message,
+	}
+
+	if dc.sdc != nil {
+		dcStatus.CurrentVersion = dc.sdc.Status.CurrentVersion
+		dcStatus.Nodes = dc.sdc.Status.Nodes
+		dcStatus.ReadyNodes = dc.sdc.Status.ReadyNodes
+		dcStatus.AvailableNodes = dc.sdc.Status.AvailableNodes
+		dcStatus.Conditions = dc.sdc.Status.Conditions
+	}
+
+	return dcStatus
+}
+
+func (scc *Controller) syncDatacenters(
+	ctx context.Context,
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	status *scyllav1alpha1.ScyllaDBClusterStatus,
+	dcs []*datacenter,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+	var errs []error
+
+	specifiedDCs := dcs[:len(sc.Spec.Datacenters)]
+	removedDCs := dcs[len(sc.Spec.Datacenters):]
+
+	var dcStatuses []scyllav1alpha1.ScyllaDBClusterDatacenterStatus
+
+	setDefaultDatacenterDecommissionConditions(sc, status)
+
+	// Removed datacenters are decommissioned one at a time, in the reverse order they were added in.
+	decommissioning := false
+	var removedDCStatuses []scyllav1alpha1.ScyllaDBClusterDatacenterStatus
+	for i := len(removedDCs) - 1; i >= 0; i-- {
+		dc := removedDCs[i]
+
+		if dc.err != nil {
+			errs = append(errs, dc.err)
+			removedDCStatuses = append(removedDCStatuses, makeDatacenterStatus(dc, scyllav1alpha1.ScyllaDBClusterDatacenterPhaseDecommissioning, dc.err.Error()))
+			decommissioning = true
+			continue
+		}
+
+		if decommissioning {
+			removedDCStatuses = append(removedDCStatuses, makeDatacenterStatus(dc, scyllav1alpha1.ScyllaDBClusterDatacenterPhaseDecommissioning, "Waiting for other datacenters to be decommissioned first."))
+			continue
+		}
+
+		done, decommissionProgressingConditions, err := scc.decommissionDatacenter(ctx, sc, status, dc, specifiedDCs)
+		progressingConditions = append(progressingConditions, decommissionProgressingConditions...)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if done {
+			klog.V(2).InfoS("Datacenter has been decommissioned", "ScyllaDBCluster", klog.KObj(sc), "Datacenter", dc.name)
+			continue
+		}
+
+		decommissioning = true
+		removedDCStatuses = append(removedDCStatuses, makeDatacenterStatus(dc, scyllav1alpha1.ScyllaDBClusterDatacenterPhaseDecommissioning, "Datacenter is being decommissioned."))
+	}
+
+	// New datacenters are added in order, one at a time, once all preceding datacenters are rolled out.
+	canCreate := !decommissioning
+	for i, dc := range specifiedDCs {
+		if dc.err != nil {
+			errs = append(errs, dc.err)
+			dcStatuses = append(dcStatuses, makeDatacenterStatus(dc, scyllav1alpha1.ScyllaDBClusterDatacenterPhasePending, dc.err.Error()))
+			canCreate = false
+			continue
+		}
+
+		var seeds []string
+		if dc.sdc != nil {
+			// Seeds are only used when nodes bootstrap, so they are kept stable to avoid needless rolling restarts.
+			seeds = dc.sdc.Spec.ScyllaDB.ExternalSeeds
+		} else {
+			if !canCreate {
+				dcStatuses = append(dcStatuses, makeDatacenterStatus(dc, scyllav1alpha1.ScyllaDBClusterDatacenterPhasePending, "Waiting for the preceding datacenters to be rolled out."))
+				continue
+			}
+
+			seeds = append(seeds, sc.Spec.ScyllaDB.ExternalSeeds...)
+			for _, otherDC := range specifiedDCs[:i] {
+				if otherDC.sdc == nil {
+					continue
+				}
+
+				otherSeeds, err := getDatacenterSeeds(ctx, otherDC)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("can't get seeds of datacenter %q: %w", otherDC.name, err))
+					continue
+				}
+				seeds = append(seeds, otherSeeds...)
+			}
+		}
+
+		required := MakeScyllaDBDatacenter(sc, &sc.Spec.Datacenters[i], seeds)
+		sdc, changed, err := scc.applyScyllaDBDatacenter(ctx, dc.client, required)
+		if changed {
+			controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, scyllaDBDatacenterControllerProgressingCondition, required, "apply", sc.Generation)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't apply ScyllaDBDatacenter %q: %w", naming.ObjRef(required), err))
+			dcStatuses = append(dcStatuses, makeDatacenterStatus(dc, scyllav1alpha1.ScyllaDBClusterDatacenterPhaseProvisioned, err.Error()))
+			canCreate = false
+			continue
+		}
+		dc.sdc = sdc
+
+		if !isDatacenterRolledOut(dc.sdc) {
+			canCreate = false
+		}
+
+		dcStatuses = append(dcStatuses, makeDatacenterStatus(dc, scyllav1alpha1.ScyllaDBClusterDatacenterPhaseProvisioned, ""))
+	}
+
+	for i := len(removedDCStatuses) - 1; i >= 0; i-- {
+		dcStatuses = append(dcStatuses, removedDCStatuses[i])
+	}
+	status.Datacenters = dcStatuses
+
+	pendingDCs := slices.Filter(dcStatuses, func(dcStatus scyllav1alpha1.ScyllaDBClusterDatacenterStatus) bool {
+		return dcStatus.Phase != scyllav1alpha1.ScyllaDBClusterDatacenterPhaseProvisioned
+	})
+	if len(pendingDCs) != 0 {
+		progressingConditions = append(progressingConditions, makeDatacenterProgressingCondition(
+			sc,
+			internalapi.ProgressingReason,
+			fmt.Sprintf("Waiting for datacenters %q to be added or decommissioned.", slices.ConvertSlice(pendingDCs, func(dcStatus scyllav1alpha1.ScyllaDBClusterDatacenterStatus) string {
+				return dcStatus.Name
+			})),
+		))
+	}
+
+	return progressingConditions, utilerrors.NewAggregate(errs)
+}
+
+// syncFinalizer removes the ScyllaDBDatacenters in remote Kubernetes clusters when the ScyllaDBCluster is being deleted.
+// Local ScyllaDBDatacenters are removed by the garbage collector.
+func (scc *Controller) syncFinalizer(ctx context.Context, sc *scyllav1alpha1.ScyllaDBCluster, dcs []*datacenter) error {
+	if !slices.ContainsItem(sc.Finalizers, naming.ScyllaDBClusterFinalizer) {
+		return nil
+	}
+
+	var errs []error
+	remaining := false
+	for _, dc := range dcs {
+		if dc.remote == nil {
+			continue
+		}
+
+		if dc.err != nil {
+			errs = append(errs, dc.err)
+			remaining = true
+			continue
+		}
+
+		if dc.sdc == nil {
+			continue
+		}
+
+		remaining = true
+		if dc.sdc.DeletionTimestamp != nil {
+			continue
+		}
+
+		err := dc.client.scyllaClient.ScyllaV1alpha1().ScyllaDBDatacenters(dc.sdc.Namespace).Delete(ctx, dc.sdc.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{
+				UID: &dc.sdc.UID,
+			},
+			PropagationPolicy: pointer.Ptr(metav1.DeletePropagationBackground),
+		})
+		resourceapply.ReportDeleteEvent(scc.eventRecorder, dc.sdc, err)
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("can't delete ScyllaDBDatacenter %q: %w", naming.ObjRef(dc.sdc), err))
+		}
+	}
+
+	if len(errs) != 0 || remaining {
+		return utilerrors.NewAggregate(errs)
+	}
+
+	scCopy := sc.DeepCopy()
+	scCopy.Finalizers = slices.FilterOut(scCopy.Finalizers, func(f string) bool {
+		return f == naming.ScyllaDBClusterFinalizer
+	})
+	_, err := scc.scyllaClient.ScyllaV1alpha1().ScyllaDBClusters(scCopy.Namespace).Update(ctx, scCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("can't remove finalizer from ScyllaDBCluster %q: %w", naming.ObjRef(sc), err)
+	}
+
+	return nil
+}
+
+// ensureFinalizer adds a finalizer to ScyllaDBClusters with datacenters in remote Kubernetes clusters.
+// It returns true if the ScyllaDBCluster was updated.
+func (scc *Controller) ensureFinalizer(ctx context.Context, sc *scyllav1alpha1.ScyllaDBCluster, dcs []*datacenter) (bool, error) {
+	if slices.ContainsItem(sc.Finalizers, naming.ScyllaDBClusterFinalizer) {
+		return false, nil
+	}
+
+	_, _, hasRemoteDCs := slices.Find(dcs, func(dc *datacenter) bool {
+		return dc.remote != nil
+	})
+	if !hasRemoteDCs {
+		return false, nil
+	}
+
+	scCopy := sc.DeepCopy()
+	scCopy.Finalizers = append(scCopy.Finalizers, naming.ScyllaDBClusterFinalizer)
+	_, err := scc.scyllaClient.ScyllaV1alpha1().ScyllaDBClusters(scCopy.Namespace).Update(ctx, scCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("can't add finalizer to ScyllaDBCluster %q: %w", naming.ObjRef(sc), err)
+	}
+
+	scc.eventRecorder.Event(sc, corev1.EventTypeNormal, "FinalizerAdded", "Added finalizer to remove ScyllaDBDatacenters in remote Kubernetes clusters on deletion")
+
+	return true, nil
+}
@@ -1,25 +1,29 @@
 package scylladbdatacenter
 
 const (
-	serviceAccountControllerProgressingCondition = "ServiceAccountControllerProgressing"
-	serviceAccountControllerDegradedCondition    = "ServiceAccountControllerDegraded"
-	roleBindingControllerProgressingCondition    = "RoleBindingControllerProgressing"
-	roleBindingControllerDegradedCondition       = "RoleBindingControllerDegraded"
-	agentTokenControllerProgressingCondition     = "AgentTokenControllerProgressing"
-	agentTokenControllerDegradedCondition        = "AgentTokenControllerDegraded"
-	certControllerProgressingCondition           = "CertControllerProgressing"
-	certControllerDegradedCondition              = "CertControllerDegraded"
-	statefulSetControllerAvailableCondition      = "StatefulSetControllerAvailable"
-	statefulSetControllerProgressingCondition    = "StatefulSetControllerProgressing"
-	statefulSetControllerDegradedCondition       = "StatefulSetControllerDegraded"
-	serviceControllerProgressingCondition        = "ServiceControllerProgressing"
-	serviceControllerDegradedCondition           = "ServiceControllerDegraded"
-	pdbControllerProgressingCondition            = "PDBControllerProgressing"
-	pdbControllerDegradedCondition               = "PDBControllerDegraded"
-	ingressControllerProgressingCondition        = "IngressControllerProgressing"
-	ingressControllerDegradedCondition           = "IngressControllerDegraded"
-	jobControllerProgressingCondition            = "JobControllerProgressing"
-	jobControllerDegradedCondition               = "JobControllerDegraded"
-	configControllerProgressingCondition         = "ConfigControllerProgressing"
-	configControllerDegradedCondition            = "ConfigControllerDegraded"
+	serviceAccountControllerProgressingCondition  = "ServiceAccountControllerProgressing"
+	serviceAccountControllerDegradedCondition     = "ServiceAccountControllerDegraded"
+	roleBindingControllerProgressingCondition     = "RoleBindingControllerProgressing"
+	roleBindingControllerDegradedCondition        = "RoleBindingControllerDegraded"
+	agentTokenControllerProgressingCondition      = "AgentTokenControllerProgressing"
+	agentTokenControllerDegradedCondition         = "AgentTokenControllerDegraded"
+	certControllerProgressingCondition            = "CertControllerProgressing"
+	certControllerDegradedCondition               = "CertControllerDegraded"
+	statefulSetControllerAvailableCondition       = "StatefulSetControllerAvailable"
+	statefulSetControllerProgressingCondition     = "StatefulSetControllerProgressing"
+	statefulSetControllerDegradedCondition        = "StatefulSetControllerDegraded"
+	serviceControllerProgressingCondition         = "ServiceControllerProgressing"
+	serviceControllerDegradedCondition            = "ServiceControllerDegraded"
+	pdbControllerProgressingCondition             = "PDBControllerProgressing"
+	pdbControllerDegradedCondition                = "PDBControllerDegraded"
+	ingressControllerProgressingCondition         = "IngressControllerProgressing"
+	ingressControllerDegradedCondition            = "IngressControllerDegraded"
+	jobControllerProgressingCondition             = "JobControllerProgressing"
+	jobControllerDegradedCondition                = "JobControllerDegraded"
+	configControllerProgressingCondition          = "ConfigControllerProgressing"
+	configControllerDegradedCondition             = "ConfigControllerDegraded"
+	scyllaDBVersionControllerProgressingCondition = "ScyllaDBVersionControllerProgressing"
+	scyllaDBVersionControllerDegradedCondition    = "ScyllaDBVersionControllerDegraded"
+	keyspaceControllerProgressingCondition        = "KeyspaceControllerProgressing"
+	keyspaceControllerDegradedCondition           = "KeyspaceControllerDegraded"
 )
@@ -0,0 +1,167 @@
+package scylladbdatacenter
+
+import (
+	"fmt"
+	"maps"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/scyllaconfig"
+	"github.com/scylladb/scylla-operator/pkg/util/hash"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const defaultScyllaDBCustomConfigMapName = "scylla-config"
+
+// getScyllaDBCustomConfigMapName returns the name of the user provided ConfigMap mounted into the rack.
+func getScyllaDBCustomConfigMapName(sdc *scyllav1alpha1.ScyllaDBDatacenter, rack scyllav1alpha1.RackSpec) string {
+	if sdc.Spec.RackTemplate != nil {
+		rack = applyRackTemplateOnRackSpec(sdc.Spec.RackTemplate, rack)
+	}
+
+	if rack.ScyllaDB != nil && rack.ScyllaDB.CustomConfigMapRef != nil {
+		return *rack.ScyllaDB.CustomConfigMapRef
+	}
+
+	return defaultScyllaDBCustomConfigMapName
+}
+
+// getCustomConfigInputs returns the part of the user provided ScyllaDB config that needs a restart to take effect.
+func getCustomConfigInputs(customConfigMap *corev1.ConfigMap) string {
+	if customConfigMap == nil {
+		return ""
+	}
+
+	customConfig, ok := customConfigMap.Data[naming.ScyllaConfigName]
+	if !ok {
+		return ""
+	}
+
+	filteredCustomConfig, err := scyllaconfig.WithoutLiveUpdatableKeys([]byte(customConfig))
+	if err != nil {
+		// The custom config is provided by users and may be invalid. Changes to it still have to be rolled out.
+		return customConfig
+	}
+
+	return string(filteredCustomConfig)
+}
+
+// getBaselineCustomConfigHash returns the hash of the custom config that is already running in the rack
+// without being accounted for in the inputs hash.
+// StatefulSets created by operator versions that didn't hash the custom config adopt the current one,
+// so the operator upgrade alone doesn't restart ScyllaDB.
+func getBaselineCustomConfigHash(existingSts *appsv1.StatefulSet, customConfigHash string) string {
+	if existingSts == nil {
+		return ""
+	}
+
+	baselineHash, ok := existingSts.Annotations[naming.CustomConfigBaselineHashAnnotation]
+	if !ok {
+		return customConfigHash
+	}
+
+	return baselineHash
+}
+
+// makeInputsHash computes a hash of the configuration that needs a restart to take effect.
+// Live updatable options are left out, because the sidecar applies them without restarting ScyllaDB.
+// The custom config is hashed only when it differs from the baseline recorded on the existing StatefulSet.
+// It returns the inputs hash and the baseline custom config hash to record on the StatefulSet.
+func makeInputsHash(managedConfigData map[string]string, customConfigMap *corev1.ConfigMap, existingSts *appsv1.StatefulSet) (string, string, error) {
+	managedInputs := maps.Clone(managedConfigData)
+	for k, v := range managedInputs {
+		filtered, err := scyllaconfig.WithoutLiveUpdatableKeys([]byte(v))
+		if err != nil {
+			return "", "", fmt.Errorf("can't filter managed config key %q: %w", k, err)
+		}
+		managedInputs[k] = string(filtered)
+	}
+
+	customConfigInputs := getCustomConfigInputs(customConfigMap)
+	customConfigHash := ""
+	if len(customConfigInputs) != 0 {
+		var err error
+		customConfigHash, err = hash.HashObjects(customConfigInputs)
+		if err != nil {
+			return "", "", fmt.Errorf("can't hash custom config: %w", err)
+		}
+	}
+
+	baselineCustomConfigHash := getBaselineCustomConfigHash(existingSts, customConfigHash)
+
+	var inputsHash string
+	var err error
+	if customConfigHash == baselineCustomConfigHash {
+		inputsHash, err = hash.HashObjects(managedInputs)
+	} else {
+		inputsHash, err = hash.HashObjects(managedInputs, customConfigInputs)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	return inputsHash, baselineCustomConfigHash, nil
+}
+
+func (sdcc *Controller) makeRackInputsHash(sdc *scyllav1alpha1.ScyllaDBDatacenter, rack scyllav1alpha1.RackSpec, managedConfigData map[string]string, existingSts *appsv1.StatefulSet) (string, string, error) {
+	customConfigMapName := getScyllaDBCustomConfigMapName(sdc, rack)
+	customConfigMap, err := sdcc.configMapLister.ConfigMaps(sdc.Namespace).Get(customConfigMapName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", "", fmt.Errorf("can't get ConfigMap %q: %w", naming.ManualRef(sdc.Namespace, customConfigMapName), err)
+		}
+
+		// The ConfigMap is optional.
+		customConfigMap = nil
+	}
+
+	inputsHash, baselineCustomConfigHash, err := makeInputsHash(managedConfigData, customConfigMap, existingSts)
+	if err != nil {
+		return "", "", err
+	}
+
+	// Agents have to be restarted to pick up changes to their managed config.
+	// The hash is taken from the rendered Secret, so it changes only after the config was successfully synced.
+	managedAgentConfigHash, rendered, err := sdcc.getManagedAgentConfigHash(sdc)
+	if err != nil {
+		return "", "", err
+	}
+	if !rendered {
+		return inputsHash, baselineCustomConfigHash, nil
+	}
+
+	inputsHash, err = hash.HashObjects(inputsHash, managedAgentConfigHash)
+	if err != nil {
+		return "", "", err
+	}
+
+	return inputsHash, baselineCustomConfigHash, nil
+}
+
+// getManagedAgentConfigHash returns the hash recorded on the rendered managed agent config Secret.
+// It reports false when no managed agent config is requested or the Secret wasn't rendered yet.
+func (sdcc *Controller) getManagedAgentConfigHash(sdc *scyllav1alpha1.ScyllaDBDatacenter) (string, bool, error) {
+	if getManagedAgentConfig(sdc) == nil {
+		return "", false, nil
+	}
+
+	managedAgentConfigSecretName := naming.ManagedAgentConfigSecretName(sdc)
+	managedAgentConfigSecret, err := sdcc.secretLister.Secrets(sdc.Namespace).Get(managedAgentConfigSecretName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", false, fmt.Errorf("can't get Secret %q: %w", naming.ManualRef(sdc.Namespace, managedAgentConfigSecretName), err)
+		}
+
+		// The Secret wasn't rendered yet. Errors are reported by the config sync.
+		return "", false, nil
+	}
+
+	managedAgentConfigHash, ok := managedAgentConfigSecret.Annotations[naming.ManagedAgentConfigHashAnnotation]
+	if !ok {
+		return "", false, nil
+	}
+
+	return managedAgentConfigHash, true, nil
+}
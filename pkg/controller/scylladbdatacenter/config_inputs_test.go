@@ -0,0 +1,159 @@
+package scylladbdatacenter
+
+import (
+	"testing"
+
+	"github.com/scylladb/scylla-operator/pkg/util/hash"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_makeInputsHash(t *testing.T) {
+	t.Parallel()
+
+	managedConfigData := map[string]string{
+		"scylladb-managed-config.yaml": "cluster_name: \"foo\"\n",
+	}
+
+	managedOnlyHash, err := hash.HashObjects(managedConfigData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nonLiveHash, err := hash.HashObjects(managedConfigData, "listen_interface: eth1\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	customConfigHash, err := hash.HashObjects("listen_interface: eth1\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := []struct {
+		name              string
+		managedConfigData map[string]string
+		customConfigMap   *corev1.ConfigMap
+		existingSts       *appsv1.StatefulSet
+		expectedHash      string
+		expectedBaseline  string
+	}{
+		{
+			name:              "hash of managed config is kept stable without a custom config",
+			managedConfigData: managedConfigData,
+			customConfigMap:   nil,
+			expectedHash:      managedOnlyHash,
+		},
+		{
+			name:              "live updatable options in managed config are ignored",
+			managedConfigData: map[string]string{"scylladb-managed-config.yaml": "cluster_name: \"foo\"\ncompaction_static_shares: 100\n"},
+			customConfigMap:   nil,
+			expectedHash: func() string {
+				h, err := hash.HashObjects(map[string]string{"scylladb-managed-config.yaml": "cluster_name: foo\n"})
+				if err != nil {
+					t.Fatal(err)
+				}
+				return h
+			}(),
+		},
+		{
+			name:              "custom config with live updatable options only doesn't change the hash",
+			managedConfigData: managedConfigData,
+			customConfigMap: &corev1.ConfigMap{
+				Data: map[string]string{
+					"scylla.yaml": "read_request_timeout_in_ms: 1000\n",
+				},
+			},
+			expectedHash: managedOnlyHash,
+		},
+		{
+			name:              "live updatable options are ignored next to options requiring a restart",
+			managedConfigData: managedConfigData,
+			customConfigMap: &corev1.ConfigMap{
+				Data: map[string]string{
+					"scylla.yaml": "listen_interface: eth1\nread_request_timeout_in_ms: 1000\n",
+				},
+			},
+			expectedHash:     nonLiveHash,
+			expectedBaseline: "",
+		},
+		{
+			name:              "unchanged custom config of a StatefulSet created before the custom config was hashed produces the baseline hash",
+			managedConfigData: managedConfigData,
+			customConfigMap: &corev1.ConfigMap{
+				Data: map[string]string{
+					"scylla.yaml": "listen_interface: eth1\n",
+				},
+			},
+			existingSts: &appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"scylla-operator.scylladb.com/inputs-hash": managedOnlyHash,
+							},
+						},
+					},
+				},
+			},
+			expectedHash:     managedOnlyHash,
+			expectedBaseline: customConfigHash,
+		},
+		{
+			name:              "custom config matching the recorded baseline produces the baseline hash",
+			managedConfigData: managedConfigData,
+			customConfigMap: &corev1.ConfigMap{
+				Data: map[string]string{
+					"scylla.yaml": "listen_interface: eth1\nread_request_timeout_in_ms: 1000\n",
+				},
+			},
+			existingSts: &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"scylla-operator.scylladb.com/custom-config-baseline-hash": customConfigHash,
+					},
+				},
+			},
+			expectedHash:     managedOnlyHash,
+			expectedBaseline: customConfigHash,
+		},
+		{
+			name:              "custom config changed from the recorded baseline is hashed",
+			managedConfigData: managedConfigData,
+			customConfigMap: &corev1.ConfigMap{
+				Data: map[string]string{
+					"scylla.yaml": "listen_interface: eth1\n",
+				},
+			},
+			existingSts: &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"scylla-operator.scylladb.com/custom-config-baseline-hash": "",
+					},
+				},
+			},
+			expectedHash:     nonLiveHash,
+			expectedBaseline: "",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotBaseline, err := makeInputsHash(tc.managedConfigData, tc.customConfigMap, tc.existingSts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tc.expectedHash {
+				t.Errorf("expected hash %q, got %q", tc.expectedHash, got)
+			}
+
+			if gotBaseline != tc.expectedBaseline {
+				t.Errorf("expected baseline custom config hash %q, got %q", tc.expectedBaseline, gotBaseline)
+			}
+		})
+	}
+}
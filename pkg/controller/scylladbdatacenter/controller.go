@@ -3,6 +3,7 @@ package scylladbdatacenter
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
 	"github.com/scylladb/scylla-operator/pkg/crypto"
 	"github.com/scylladb/scylla-operator/pkg/kubeinterfaces"
+	"github.com/scylladb/scylla-operator/pkg/naming"
 	"github.com/scylladb/scylla-operator/pkg/scheme"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
@@ -32,6 +34,7 @@ import (
 	networkingv1informers "k8s.io/client-go/informers/networking/v1"
 	policyv1informers "k8s.io/client-go/informers/policy/v1"
 	rbacv1informers "k8s.io/client-go/informers/rbac/v1"
+	storagev1informers "k8s.io/client-go/informers/storage/v1"
 	"k8s.io/client-go/kubernetes"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	appsv1listers "k8s.io/client-go/listers/apps/v1"
@@ -40,6 +43,7 @@ import (
 	networkingv1listers "k8s.io/client-go/listers/networking/v1"
 	policyv1listers "k8s.io/client-go/listers/policy/v1"
 	rbacv1listers "k8s.io/client-go/listers/rbac/v1"
+	storagev1listers "k8s.io/client-go/listers/storage/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
@@ -76,6 +80,8 @@ type Controller struct {
 	ingressLister            networkingv1listers.IngressLister
 	scyllaDBDatacenterLister scyllav1alpha1listers.ScyllaDBDatacenterLister
 	jobLister                batchv1listers.JobLister
+	pvcLister                corev1listers.PersistentVolumeClaimLister
+	storageClassLister       storagev1listers.StorageClassLister
 
 	cachesToSync []cache.InformerSynced
 
@@ -100,6 +106,8 @@ func NewController(
 	pdbInformer policyv1informers.PodDisruptionBudgetInformer,
 	ingressInformer networkingv1informers.IngressInformer,
 	jobInformer batchv1informers.JobInformer,
+	pvcInformer corev1informers.PersistentVolumeClaimInformer,
+	storageClassInformer storagev1informers.StorageClassInformer,
 	scyllaDBDatacenterInformer scyllav1alpha1informers.ScyllaDBDatacenterInformer,
 	operatorImage string,
 	cqlsIngressPort int,
@@ -127,6 +135,8 @@ func NewController(
 		ingressLister:            ingressInformer.Lister(),
 		scyllaDBDatacenterLister: scyllaDBDatacenterInformer.Lister(),
 		jobLister:                jobInformer.Lister(),
+		pvcLister:                pvcInformer.Lister(),
+		storageClassLister:       storageClassInformer.Lister(),
 
 		cachesToSync: []cache.InformerSynced{
 			podInformer.Informer().HasSynced,
@@ -140,6 +150,8 @@ func NewController(
 			ingressInformer.Informer().HasSynced,
 			scyllaDBDatacenterInformer.Informer().HasSynced,
 			jobInformer.Informer().HasSynced,
+			pvcInformer.Informer().HasSynced,
+			storageClassInformer.Informer().HasSynced,
 		},
 
 		eventRecorder: eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "scylladbdatacenter-controller"}),
@@ -186,6 +198,11 @@ func NewController(
 		DeleteFunc: sdcc.deleteConfigMap,
 	})
 
+	// We need PVC events to know when a volume expansion finishes.
+	pvcInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: sdcc.updatePVC,
+	})
+
 	// We need pods events to know if a pod is ready after replace operation.
 	podInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    sdcc.addPod,
@@ -373,6 +390,23 @@ func (sdcc *Controller) enqueueOwnerThroughStatefulSetOwner(depth int, obj kubei
 	sdcc.handlers.Enqueue(depth+1, sdc, op)
 }
 
+// enqueueScyllaDBDatacenterThroughClusterLabel enqueues the ScyllaDBDatacenter that the object is labeled with.
+// It's used for objects without an owner, like PVCs created from StatefulSet templates.
+func (sdcc *Controller) enqueueScyllaDBDatacenterThroughClusterLabel(depth int, obj kubeinterfaces.ObjectInterface, op controllerhelpers.HandlerOperationType) {
+	sdcName, ok := obj.GetLabels()[naming.ClusterNameLabel]
+	if !ok {
+		return
+	}
+
+	sdc, err := sdcc.scyllaDBDatacenterLister.ScyllaDBDatacenters(obj.GetNamespace()).Get(sdcName)
+	if err != nil {
+		return
+	}
+
+	klog.V(4).InfoS("Enqueuing ScyllaDBDatacenter of labeled object", "ScyllaDBDatacenter", klog.KObj(sdc))
+	sdcc.handlers.Enqueue(depth+1, sdc, op)
+}
+
 func (sdcc *Controller) addService(obj interface{}) {
 	sdcc.handlers.HandleAdd(
 		obj.(*corev1.Service),
@@ -399,7 +433,7 @@ func (sdcc *Controller) deleteService(obj interface{}) {
 func (sdcc *Controller) addSecret(obj interface{}) {
 	sdcc.handlers.HandleAdd(
 		obj.(*corev1.Secret),
-		sdcc.handlers.EnqueueOwner,
+		sdcc.enqueueSecretOwnerOrConsumers,
 	)
 }
 
@@ -407,7 +441,7 @@ func (sdcc *Controller) updateSecret(old, cur interface{}) {
 	sdcc.handlers.HandleUpdate(
 		old.(*corev1.Secret),
 		cur.(*corev1.Secret),
-		sdcc.handlers.EnqueueOwner,
+		sdcc.enqueueSecretOwnerOrConsumers,
 		sdcc.deleteSecret,
 	)
 }
@@ -415,14 +449,27 @@ func (sdcc *Controller) updateSecret(old, cur interface{}) {
 func (sdcc *Controller) deleteSecret(obj interface{}) {
 	sdcc.handlers.HandleDelete(
 		obj,
-		sdcc.handlers.EnqueueOwner,
+		sdcc.enqueueSecretOwnerOrConsumers,
 	)
 }
 
+func (sdcc *Controller) enqueueSecretOwnerOrConsumers(depth int, obj kubeinterfaces.ObjectInterface, op controllerhelpers.HandlerOperationType) {
+	sdcc.handlers.EnqueueOwner(depth+1, obj, op)
+
+	sdcc.handlers.EnqueueAllFunc(sdcc.handlers.EnqueueWithFilterFunc(func(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+		config := getManagedAgentConfig(sdc)
+		if config == nil {
+			return false
+		}
+
+		return slices.Contains(getManagedAgentConfigSecretNames(config), obj.GetName())
+	}))(depth+1, obj, op)
+}
+
 func (sdcc *Controller) addConfigMap(obj interface{}) {
 	sdcc.handlers.HandleAdd(
 		obj.(*corev1.ConfigMap),
-		sdcc.handlers.EnqueueOwner,
+		sdcc.enqueueConfigMapOwnerOrConsumers,
 	)
 }
 
@@ -430,7 +477,7 @@ func (sdcc *Controller) updateConfigMap(old, cur interface{}) {
 	sdcc.handlers.HandleUpdate(
 		old.(*corev1.ConfigMap),
 		cur.(*corev1.ConfigMap),
-		sdcc.handlers.EnqueueOwner,
+		sdcc.enqueueConfigMapOwnerOrConsumers,
 		sdcc.deleteConfigMap,
 	)
 }
@@ -438,10 +485,26 @@ func (sdcc *Controller) updateConfigMap(old, cur interface{}) {
 func (sdcc *Controller) deleteConfigMap(obj interface{}) {
 	sdcc.handlers.HandleDelete(
 		obj,
-		sdcc.handlers.EnqueueOwner,
+		sdcc.enqueueConfigMapOwnerOrConsumers,
 	)
 }
 
+// enqueueConfigMapOwnerOrConsumers enqueues the owner of the ConfigMap and ScyllaDBDatacenters using it as a custom config,
+// because changes to the custom config that need a restart are rolled out through the inputs hash.
+func (sdcc *Controller) enqueueConfigMapOwnerOrConsumers(depth int, obj kubeinterfaces.ObjectInterface, op controllerhelpers.HandlerOperationType) {
+	sdcc.handlers.EnqueueOwner(depth+1, obj, op)
+
+	sdcc.handlers.EnqueueAllFunc(sdcc.handlers.EnqueueWithFilterFunc(func(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+		for _, rack := range sdc.Spec.Racks {
+			if getScyllaDBCustomConfigMapName(sdc, rack) == obj.GetName() {
+				return true
+			}
+		}
+
+		return false
+	}))(depth+1, obj, op)
+}
+
 func (sdcc *Controller) addServiceAccount(obj interface{}) {
 	sdcc.handlers.HandleAdd(
 		obj.(*corev1.ServiceAccount),
@@ -511,6 +574,15 @@ func (sdcc *Controller) deletePod(obj interface{}) {
 	)
 }
 
+func (sdcc *Controller) updatePVC(old, cur interface{}) {
+	sdcc.handlers.HandleUpdate(
+		old.(*corev1.PersistentVolumeClaim),
+		cur.(*corev1.PersistentVolumeClaim),
+		sdcc.enqueueScyllaDBDatacenterThroughClusterLabel,
+		nil,
+	)
+}
+
 func (sdcc *Controller) addStatefulSet(obj interface{}) {
 	sdcc.handlers.HandleAdd(
 		obj.(*appsv1.StatefulSet),
@@ -0,0 +1,156 @@
+package scylladbdatacenter
+
+import (
+	"fmt"
+	"strings"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+// getDesiredInternodeEncryptionMode returns the internode encryption mode requested in the spec.
+func getDesiredInternodeEncryptionMode(sdc *scyllav1alpha1.ScyllaDBDatacenter) scyllav1alpha1.InternodeEncryptionMode {
+	if sdc.Spec.ScyllaDB.InternodeEncryptionOptions == nil {
+		return scyllav1alpha1.InternodeEncryptionModeNone
+	}
+
+	if len(sdc.Spec.ScyllaDB.InternodeEncryptionOptions.Mode) == 0 {
+		return scyllav1alpha1.InternodeEncryptionModeAll
+	}
+
+	return sdc.Spec.ScyllaDB.InternodeEncryptionOptions.Mode
+}
+
+// isInternodeEncryptionListening returns whether ScyllaDB nodes are rendered with internode certificates.
+// Rendering follows the persisted status, so every transition is observed before it's acted upon.
+func isInternodeEncryptionListening(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+	return sdc.Status.InternodeEncryption != nil && sdc.Status.InternodeEncryption.Listening
+}
+
+// getInternodeEncryptionMode returns the internode encryption mode ScyllaDB nodes are rendered with.
+func getInternodeEncryptionMode(sdc *scyllav1alpha1.ScyllaDBDatacenter) scyllav1alpha1.InternodeEncryptionMode {
+	if sdc.Status.InternodeEncryption == nil || len(sdc.Status.InternodeEncryption.Mode) == 0 {
+		return scyllav1alpha1.InternodeEncryptionModeNone
+	}
+
+	return sdc.Status.InternodeEncryption.Mode
+}
+
+// isInternodeEncryptionRequested returns whether internode certificates have to be managed for the datacenter.
+func isInternodeEncryptionRequested(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+	return getDesiredInternodeEncryptionMode(sdc) != scyllav1alpha1.InternodeEncryptionModeNone || isInternodeEncryptionListening(sdc)
+}
+
+// scyllaDBInternodeEncryptionValue converts the API mode into the value understood by ScyllaDB.
+func scyllaDBInternodeEncryptionValue(mode scyllav1alpha1.InternodeEncryptionMode) string {
+	if len(mode) == 0 {
+		return strings.ToLower(string(scyllav1alpha1.InternodeEncryptionModeNone))
+	}
+
+	return strings.ToLower(string(mode))
+}
+
+// nextInternodeEncryptionStatus computes the next step of the internode encryption rollout.
+// Enabling the encryption first makes all nodes accept encrypted connections while they still connect to their
+// peers in plaintext. Only when this configuration has rolled out everywhere, nodes start encrypting outgoing
+// connections. Disabling the encryption goes through the same phases in reverse order.
+// rolledOut reports whether the configuration rendered for the current status has been rolled out to all nodes.
+func nextInternodeEncryptionStatus(desiredMode scyllav1alpha1.InternodeEncryptionMode, current *scyllav1alpha1.InternodeEncryptionStatus, rolledOut bool) *scyllav1alpha1.InternodeEncryptionStatus {
+	currentListening := current != nil && current.Listening
+	currentMode := scyllav1alpha1.InternodeEncryptionModeNone
+	if current != nil && len(current.Mode) != 0 {
+		currentMode = current.Mode
+	}
+
+	if desiredMode == scyllav1alpha1.InternodeEncryptionModeNone {
+		if currentMode != scyllav1alpha1.InternodeEncryptionModeNone {
+			// Stop encrypting outgoing connections first, nodes still accept encrypted ones.
+			return &scyllav1alpha1.InternodeEncryptionStatus{
+				Listening: true,
+				Mode:      scyllav1alpha1.InternodeEncryptionModeNone,
+			}
+		}
+
+		if currentListening && !rolledOut {
+			return &scyllav1alpha1.InternodeEncryptionStatus{
+				Listening: true,
+				Mode:      scyllav1alpha1.InternodeEncryptionModeNone,
+			}
+		}
+
+		if current == nil {
+			return nil
+		}
+
+		return &scyllav1alpha1.InternodeEncryptionStatus{
+			Listening: false,
+			Mode:      scyllav1alpha1.InternodeEncryptionModeNone,
+		}
+	}
+
+	if !currentListening {
+		return &scyllav1alpha1.InternodeEncryptionStatus{
+			Listening: true,
+			Mode:      scyllav1alpha1.InternodeEncryptionModeNone,
+		}
+	}
+
+	if currentMode == scyllav1alpha1.InternodeEncryptionModeNone && !rolledOut {
+		return &scyllav1alpha1.InternodeEncryptionStatus{
+			Listening: true,
+			Mode:      scyllav1alpha1.InternodeEncryptionModeNone,
+		}
+	}
+
+	// All nodes accept encrypted connections, so they can switch between encryption modes directly.
+	return &scyllav1alpha1.InternodeEncryptionStatus{
+		Listening: true,
+		Mode:      desiredMode,
+	}
+}
+
+// isManagedConfigRolledOut returns whether all racks run with the managed config rendered for the current state.
+func (sdcc *Controller) isManagedConfigRolledOut(sdc *scyllav1alpha1.ScyllaDBDatacenter, statefulSets map[string]*appsv1.StatefulSet) (bool, error) {
+	cm, err := MakeManagedScyllaDBConfig(sdc)
+	if err != nil {
+		return false, fmt.Errorf("can't make managed scylladb config: %w", err)
+	}
+
+	for _, rack := range sdc.Spec.Racks {
+		sts, ok := statefulSets[naming.StatefulSetNameForRack(rack, sdc)]
+		if !ok {
+			return false, nil
+		}
+
+		inputsHash, _, err := sdcc.makeRackInputsHash(sdc, rack, cm.Data, sts)
+		if err != nil {
+			return false, fmt.Errorf("can't make inputs hash for rack %q: %w", rack.Name, err)
+		}
+
+		if sts.Spec.Template.Annotations[naming.InputsHashAnnotation] != inputsHash {
+			return false, nil
+		}
+
+		rolledOut, err := controllerhelpers.IsStatefulSetRolledOut(sts)
+		if err != nil {
+			return false, err
+		}
+
+		if !rolledOut {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (sdcc *Controller) calculateInternodeEncryptionStatus(sdc *scyllav1alpha1.ScyllaDBDatacenter, statefulSets map[string]*appsv1.StatefulSet) (*scyllav1alpha1.InternodeEncryptionStatus, error) {
+	rolledOut, err := sdcc.isManagedConfigRolledOut(sdc, statefulSets)
+	if err != nil {
+		return nil, err
+	}
+
+	return nextInternodeEncryptionStatus(getDesiredInternodeEncryptionMode(sdc), sdc.Status.InternodeEncryption, rolledOut), nil
+}
@@ -0,0 +1,127 @@
+package scylladbdatacenter
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+)
+
+func Test_nextInternodeEncryptionStatus(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name           string
+		desiredMode    scyllav1alpha1.InternodeEncryptionMode
+		current        *scyllav1alpha1.InternodeEncryptionStatus
+		rolledOut      bool
+		expectedStatus *scyllav1alpha1.InternodeEncryptionStatus
+	}{
+		{
+			name:           "encryption is neither requested nor configured",
+			desiredMode:    scyllav1alpha1.InternodeEncryptionModeNone,
+			current:        nil,
+			rolledOut:      true,
+			expectedStatus: nil,
+		},
+		{
+			name:        "enabling encryption starts with listening only",
+			desiredMode: scyllav1alpha1.InternodeEncryptionModeAll,
+			current:     nil,
+			rolledOut:   true,
+			expectedStatus: &scyllav1alpha1.InternodeEncryptionStatus{
+				Listening: true,
+				Mode:      scyllav1alpha1.InternodeEncryptionModeNone,
+			},
+		},
+		{
+			name:        "enabling encryption waits for listening to roll out",
+			desiredMode: scyllav1alpha1.InternodeEncryptionModeAll,
+			current: &scyllav1alpha1.InternodeEncryptionStatus{
+				Listening: true,
+				Mode:      scyllav1alpha1.InternodeEncryptionModeNone,
+			},
+			rolledOut: false,
+			expectedStatus: &scyllav1alpha1.InternodeEncryptionStatus{
+				Listening: true,
+				Mode:      scyllav1alpha1.InternodeEncryptionModeNone,
+			},
+		},
+		{
+			name:        "enabling encryption switches the mode after listening has rolled out",
+			desiredMode: scyllav1alpha1.InternodeEncryptionModeAll,
+			current: &scyllav1alpha1.InternodeEncryptionStatus{
+				Listening: true,
+				Mode:      scyllav1alpha1.InternodeEncryptionModeNone,
+			},
+			rolledOut: true,
+			expectedStatus: &scyllav1alpha1.InternodeEncryptionStatus{
+				Listening: true,
+				Mode:      scyllav1alpha1.InternodeEncryptionModeAll,
+			},
+		},
+		{
+			name:        "mode is changed directly when nodes are listening",
+			desiredMode: scyllav1alpha1.InternodeEncryptionModeDC,
+			current: &scyllav1alpha1.InternodeEncryptionStatus{
+				Listening: true,
+				Mode:      scyllav1alpha1.InternodeEncryptionModeAll,
+			},
+			rolledOut: false,
+			expectedStatus: &scyllav1alpha1.InternodeEncryptionStatus{
+				Listening: true,
+				Mode:      scyllav1alpha1.InternodeEncryptionModeDC,
+			},
+		},
+		{
+			name:        "disabling encryption stops encrypting outgoing connections first",
+			desiredMode: scyllav1alpha1.InternodeEncryptionModeNone,
+			current: &scyllav1alpha1.InternodeEncryptionStatus{
+				Listening: true,
+				Mode:      scyllav1alpha1.InternodeEncryptionModeAll,
+			},
+			rolledOut: true,
+			expectedStatus: &scyllav1alpha1.InternodeEncryptionStatus{
+				Listening: true,
+				Mode:      scyllav1alpha1.InternodeEncryptionModeNone,
+			},
+		},
+		{
+			name:        "disabling encryption keeps listening until the mode change has rolled out",
+			desiredMode: scyllav1alpha1.InternodeEncryptionModeNone,
+			current: &scyllav1alpha1.InternodeEncryptionStatus{
+				Listening: true,
+				Mode:      scyllav1alpha1.InternodeEncryptionModeNone,
+			},
+			rolledOut: false,
+			expectedStatus: &scyllav1alpha1.InternodeEncryptionStatus{
+				Listening: true,
+				Mode:      scyllav1alpha1.InternodeEncryptionModeNone,
+			},
+		},
+		{
+			name:        "disabling encryption stops listening after the mode change has rolled out",
+			desiredMode: scyllav1alpha1.InternodeEncryptionModeNone,
+			current: &scyllav1alpha1.InternodeEncryptionStatus{
+				Listening: true,
+				Mode:      scyllav1alpha1.InternodeEncryptionModeNone,
+			},
+			rolledOut: true,
+			expectedStatus: &scyllav1alpha1.InternodeEncryptionStatus{
+				Listening: false,
+				Mode:      scyllav1alpha1.InternodeEncryptionModeNone,
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := nextInternodeEncryptionStatus(tc.desiredMode, tc.current, tc.rolledOut)
+			if !cmp.Equal(got, tc.expectedStatus) {
+				t.Errorf("expected and got status differ:\n%s", cmp.Diff(tc.expectedStatus, got))
+			}
+		})
+	}
+}
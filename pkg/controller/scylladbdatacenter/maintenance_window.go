@@ -0,0 +1,42 @@
+package scylladbdatacenter
+
+import (
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/maintenancewindow"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// deferToMaintenanceWindow requeues the ScyllaDBDatacenter for when the next maintenance window opens
+// and returns a condition explaining which action is pending.
+func (sdcc *Controller) deferToMaintenanceWindow(
+	key string,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	conditionType string,
+	state maintenancewindow.State,
+	action string,
+) metav1.Condition {
+	if !state.NextOpen.IsZero() {
+		sdcc.queue.AddAfter(key, time.Until(state.NextOpen))
+	}
+
+	return metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             "WaitingForMaintenanceWindow",
+		Message:            state.PendingMessage(action),
+		ObservedGeneration: sdc.Generation,
+	}
+}
+
+// getMaintenanceWindowPartition returns the partition that keeps the StatefulSet controller from updating
+// any more Pods outside of maintenance windows.
+func getMaintenanceWindowPartition(sts *appsv1.StatefulSet) int32 {
+	if sts.Status.UpdateRevision == sts.Status.CurrentRevision {
+		return *sts.Spec.Replicas
+	}
+
+	return getFrozenPartition(sts)
+}
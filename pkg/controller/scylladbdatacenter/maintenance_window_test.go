@@ -0,0 +1,69 @@
+package scylladbdatacenter
+
+import (
+	"testing"
+
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+func TestGetMaintenanceWindowPartition(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		sts      *appsv1.StatefulSet
+		expected int32
+	}{
+		{
+			name: "rolled out StatefulSet holds back any new changes",
+			sts: &appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: pointer.Ptr(int32(3)),
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+						RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{
+							Partition: pointer.Ptr(int32(0)),
+						},
+					},
+				},
+				Status: appsv1.StatefulSetStatus{
+					UpdatedReplicas: 3,
+					CurrentRevision: "rev-1",
+					UpdateRevision:  "rev-1",
+				},
+			},
+			expected: 3,
+		},
+		{
+			name: "StatefulSet being rolled out stops before updating the next Pod",
+			sts: &appsv1.StatefulSet{
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: pointer.Ptr(int32(3)),
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+						RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{
+							Partition: pointer.Ptr(int32(0)),
+						},
+					},
+				},
+				Status: appsv1.StatefulSetStatus{
+					UpdatedReplicas: 2,
+					CurrentRevision: "rev-1",
+					UpdateRevision:  "rev-2",
+				},
+			},
+			expected: 1,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getMaintenanceWindowPartition(tc.sts)
+			if got != tc.expected {
+				t.Errorf("expected partition %d, got %d", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	"github.com/scylladb/scylla-operator/pkg/util/hash"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// managedAgentConfig is the ScyllaDB Manager Agent configuration rendered from the typed API.
+type managedAgentConfig struct {
+	S3     *managedAgentS3Config     `yaml:"s3,omitempty"`
+	GCS    *managedAgentGCSConfig    `yaml:"gcs,omitempty"`
+	Azure  *managedAgentAzureConfig  `yaml:"azure,omitempty"`
+	Rclone *managedAgentRcloneConfig `yaml:"rclone,omitempty"`
+}
+
+type managedAgentS3Config struct {
+	AccessKeyID     string `yaml:"access_key_id,omitempty"`
+	SecretAccessKey string `yaml:"secret_access_key,omitempty"`
+	Provider        string `yaml:"provider,omitempty"`
+	Region          string `yaml:"region,omitempty"`
+	Endpoint        string `yaml:"endpoint,omitempty"`
+}
+
+type managedAgentGCSConfig struct {
+	ServiceAccountFile string `yaml:"service_account_file,omitempty"`
+}
+
+type managedAgentAzureConfig struct {
+	Account string `yaml:"account"`
+	Key     string `yaml:"key,omitempty"`
+}
+
+type managedAgentRcloneConfig struct {
+	BandwidthLimit string `yaml:"bwlimit,omitempty"`
+	Transfers      int32  `yaml:"transfers,omitempty"`
+}
+
+func getManagedAgentConfig(sdc *scyllav1alpha1.ScyllaDBDatacenter) *scyllav1alpha1.ScyllaDBManagerAgentConfig {
+	if sdc.Spec.ScyllaDBManagerAgent == nil {
+		return nil
+	}
+
+	return sdc.Spec.ScyllaDBManagerAgent.Config
+}
+
+// getManagedAgentConfigSecretNames returns names of Secrets referenced by the typed agent configuration.
+func getManagedAgentConfigSecretNames(config *scyllav1alpha1.ScyllaDBManagerAgentConfig) []string {
+	var selectors []*corev1.SecretKeySelector
+	if config.S3 != nil {
+		selectors = append(selectors, config.S3.AccessKeyIDSecretKeyRef, config.S3.SecretAccessKeySecretKeyRef)
+	}
+	if config.GCS != nil {
+		selectors = append(selectors, config.GCS.ServiceAccountSecretKeyRef)
+	}
+	if config.Azure != nil {
+		selectors = append(selectors, config.Azure.KeySecretKeyRef)
+	}
+
+	var names []string
+	for _, selector := range selectors {
+		if selector != nil {
+			names = append(names, selector.Name)
+		}
+	}
+
+	return names
+}
+
+// makeManagedAgentConfigData renders the typed agent configuration into Secret data.
+// Values referenced from Secrets are resolved using getSecretKey.
+func makeManagedAgentConfigData(config *scyllav1alpha1.ScyllaDBManagerAgentConfig, getSecretKey func(*corev1.SecretKeySelector) ([]byte, error)) (map[string][]byte, error) {
+	data := map[string][]byte{}
+	agentConfig := &managedAgentConfig{}
+
+	getOptionalSecretKey := func(selector *corev1.SecretKeySelector) (string, error) {
+		if selector == nil {
+			return "", nil
+		}
+
+		v, err := getSecretKey(selector)
+		if err != nil {
+			return "", fmt.Errorf("can't get key %q of secret %q: %w", selector.Key, selector.Name, err)
+		}
+
+		return string(v), nil
+	}
+
+	if config.S3 != nil {
+		accessKeyID, err := getOptionalSecretKey(config.S3.AccessKeyIDSecretKeyRef)
+		if err != nil {
+			return nil, err
+		}
+
+		secretAccessKey, err := getOptionalSecretKey(config.S3.SecretAccessKeySecretKeyRef)
+		if err != nil {
+			return nil, err
+		}
+
+		agentConfig.S3 = &managedAgentS3Config{
+			AccessKeyID:     accessKeyID,
+			SecretAccessKey: secretAccessKey,
+			Provider:        config.S3.Provider,
+			Region:          config.S3.Region,
+			Endpoint:        config.S3.Endpoint,
+		}
+	}
+
+	if config.GCS != nil {
+		agentConfig.GCS = &managedAgentGCSConfig{}
+
+		serviceAccount, err := getOptionalSecretKey(config.GCS.ServiceAccountSecretKeyRef)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(serviceAccount) != 0 {
+			data[naming.ScyllaAgentGCSServiceAccount] = []byte(serviceAccount)
+			agentConfig.GCS.ServiceAccountFile = path.Join(naming.ScyllaAgentManagedConfigDir, naming.ScyllaAgentGCSServiceAccount)
+		}
+	}
+
+	if config.Azure != nil {
+		key, err := getOptionalSecretKey(config.Azure.KeySecretKeyRef)
+		if err != nil {
+			return nil, err
+		}
+
+		agentConfig.Azure = &managedAgentAzureConfig{
+			Account: config.Azure.Account,
+			Key:     key,
+		}
+	}
+
+	if config.RateLimits != nil {
+		agentConfig.Rclone = &managedAgentRcloneConfig{}
+
+		if config.RateLimits.BandwidthMiBPerSecond != nil {
+			agentConfig.Rclone.BandwidthLimit = fmt.Sprintf("%dM", *config.RateLimits.BandwidthMiBPerSecond)
+		}
+
+		if config.RateLimits.Transfers != nil {
+			agentConfig.Rclone.Transfers = *config.RateLimits.Transfers
+		}
+	}
+
+	agentConfigData, err := yaml.Marshal(agentConfig)
+	if err != nil {
+		return nil, fmt.Errorf("can't marshal agent config: %w", err)
+	}
+	data[naming.ScyllaAgentManagedConfigName] = agentConfigData
+
+	return data, nil
+}
+
+func (sdcc *Controller) getSecretKey(namespace string) func(*corev1.SecretKeySelector) ([]byte, error) {
+	return func(selector *corev1.SecretKeySelector) ([]byte, error) {
+		secret, err := sdcc.secretLister.Secrets(namespace).Get(selector.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		v, ok := secret.Data[selector.Key]
+		if !ok {
+			return nil, fmt.Errorf("secret %q is missing key %q", naming.ObjRef(secret), selector.Key)
+		}
+
+		return v, nil
+	}
+}
+
+// renderManagedAgentConfig renders the typed agent configuration of the ScyllaDBDatacenter.
+// It returns nil when the typed agent configuration isn't set.
+func (sdcc *Controller) renderManagedAgentConfig(sdc *scyllav1alpha1.ScyllaDBDatacenter) (map[string][]byte, error) {
+	config := getManagedAgentConfig(sdc)
+	if config == nil {
+		return nil, nil
+	}
+
+	return makeManagedAgentConfigData(config, sdcc.getSecretKey(sdc.Namespace))
+}
+
+// makeManagedAgentConfigHash hashes the typed agent configuration.
+// Secrets are hashed by reference, so rotating credentials doesn't restart the agents.
+func makeManagedAgentConfigHash(config *scyllav1alpha1.ScyllaDBManagerAgentConfig) (string, error) {
+	return hash.HashObjects(config)
+}
+
+func MakeManagedAgentConfigSecret(sdc *scyllav1alpha1.ScyllaDBDatacenter, data map[string][]byte, configHash string) *corev1.Secret {
+	labels := map[string]string{}
+	maps.Copy(labels, sdc.Labels)
+	maps.Copy(labels, naming.ClusterLabels(sdc))
+
+	// As ScyllaDBDatacenter may be managed object (when user is using scyllav1.ScyllaCluster API), managed
+	// hash from it shouldn't propagate into dependency objects to not trigger unnecessary double rollouts.
+	sdcAnnotations := maps.Clone(sdc.Annotations)
+	delete(sdcAnnotations, naming.ManagedHash)
+	if sdcAnnotations == nil {
+		sdcAnnotations = map[string]string{}
+	}
+	sdcAnnotations[naming.ManagedAgentConfigHashAnnotation] = configHash
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      naming.ManagedAgentConfigSecretName(sdc),
+			Namespace: sdc.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(sdc, scyllaDBDatacenterControllerGVK),
+			},
+			Labels:      labels,
+			Annotations: sdcAnnotations,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: data,
+	}
+}
+
+func (sdcc *Controller) syncManagedAgentConfig(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	secrets map[string]*corev1.Secret,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	data, err := sdcc.renderManagedAgentConfig(sdc)
+	if err != nil {
+		sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeWarning, "InvalidManagerAgentConfig", "Can't render manager agent config: %s", err.Error())
+		return progressingConditions, fmt.Errorf("can't make managed agent config: %w", err)
+	}
+
+	if data == nil {
+		existing, ok := secrets[naming.ManagedAgentConfigSecretName(sdc)]
+		if !ok || existing.DeletionTimestamp != nil {
+			return progressingConditions, nil
+		}
+
+		propagationPolicy := metav1.DeletePropagationBackground
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, configControllerProgressingCondition, existing, "delete", sdc.Generation)
+		err = sdcc.kubeClient.CoreV1().Secrets(existing.Namespace).Delete(ctx, existing.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{
+				UID: &existing.UID,
+			},
+			PropagationPolicy: &propagationPolicy,
+		})
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't delete secret %q: %w", naming.ObjRef(existing), err)
+		}
+
+		return progressingConditions, nil
+	}
+
+	configHash, err := makeManagedAgentConfigHash(getManagedAgentConfig(sdc))
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't hash managed agent config: %w", err)
+	}
+
+	secret := MakeManagedAgentConfigSecret(sdc, data, configHash)
+	_, changed, err := resourceapply.ApplySecret(ctx, sdcc.kubeClient.CoreV1(), sdcc.secretLister, sdcc.eventRecorder, secret, resourceapply.ApplyOptions{})
+	if changed {
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, configControllerProgressingCondition, secret, "apply", sdc.Generation)
+	}
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't apply secret %q: %w", naming.ObjRef(secret), err)
+	}
+
+	return progressingConditions, nil
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbdatacenter
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestMakeManagedAgentConfigData(t *testing.T) {
+	t.Parallel()
+
+	secrets := map[string]map[string][]byte{
+		"backup": {
+			"access-key-id":     []byte("AKIA"),
+			"secret-access-key": []byte("secret"),
+			"service-account":   []byte(`{"type": "service_account"}`),
+			"azure-key":         []byte("azure-secret"),
+		},
+	}
+	getSecretKey := func(selector *corev1.SecretKeySelector) ([]byte, error) {
+		secret, ok := secrets[selector.Name]
+		if !ok {
+			return nil, fmt.Errorf("secret %q not found", selector.Name)
+		}
+
+		v, ok := secret[selector.Key]
+		if !ok {
+			return nil, fmt.Errorf("key %q not found", selector.Key)
+		}
+
+		return v, nil
+	}
+	newSelector := func(name, key string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: name},
+			Key:                  key,
+		}
+	}
+
+	tt := []struct {
+		name          string
+		config        *scyllav1alpha1.ScyllaDBManagerAgentConfig
+		expectedData  map[string][]byte
+		expectedError error
+	}{
+		{
+			name:   "empty config",
+			config: &scyllav1alpha1.ScyllaDBManagerAgentConfig{},
+			expectedData: map[string][]byte{
+				"scylla-manager-agent-managed.yaml": []byte("{}\n"),
+			},
+		},
+		{
+			name: "all providers and rate limits",
+			config: &scyllav1alpha1.ScyllaDBManagerAgentConfig{
+				S3: &scyllav1alpha1.ScyllaDBManagerAgentS3Options{
+					Provider:                    "AWS",
+					Region:                      "us-east-1",
+					AccessKeyIDSecretKeyRef:     newSelector("backup", "access-key-id"),
+					SecretAccessKeySecretKeyRef: newSelector("backup", "secret-access-key"),
+				},
+				GCS: &scyllav1alpha1.ScyllaDBManagerAgentGCSOptions{
+					ServiceAccountSecretKeyRef: newSelector("backup", "service-account"),
+				},
+				Azure: &scyllav1alpha1.ScyllaDBManagerAgentAzureOptions{
+					Account:         "scylladb",
+					KeySecretKeyRef: newSelector("backup", "azure-key"),
+				},
+				RateLimits: &scyllav1alpha1.ScyllaDBManagerAgentRateLimits{
+					BandwidthMiBPerSecond: pointer.Ptr[int32](100),
+					Transfers:             pointer.Ptr[int32](4),
+				},
+			},
+			expectedData: map[string][]byte{
+				"scylla-manager-agent-managed.yaml": []byte(`s3:
+  access_key_id: AKIA
+  secret_access_key: secret
+  provider: AWS
+  region: us-east-1
+gcs:
+  service_account_file: /var/run/secrets/scylla-operator.scylladb.com/scylla-manager-agent/managed-config/gcs-service-account.json
+azure:
+  account: scylladb
+  key: azure-secret
+rclone:
+  bwlimit: 100M
+  transfers: 4
+`),
+				"gcs-service-account.json": []byte(`{"type": "service_account"}`),
+			},
+		},
+		{
+			name: "credentials provided by the environment",
+			config: &scyllav1alpha1.ScyllaDBManagerAgentConfig{
+				S3: &scyllav1alpha1.ScyllaDBManagerAgentS3Options{
+					Provider: "Minio",
+					Endpoint: "http://minio:9000",
+				},
+				GCS: &scyllav1alpha1.ScyllaDBManagerAgentGCSOptions{},
+			},
+			expectedData: map[string][]byte{
+				"scylla-manager-agent-managed.yaml": []byte(`s3:
+  provider: Minio
+  endpoint: http://minio:9000
+gcs: {}
+`),
+			},
+		},
+		{
+			name: "missing secret key",
+			config: &scyllav1alpha1.ScyllaDBManagerAgentConfig{
+				Azure: &scyllav1alpha1.ScyllaDBManagerAgentAzureOptions{
+					Account:         "scylladb",
+					KeySecretKeyRef: newSelector("backup", "missing"),
+				},
+			},
+			expectedData:  nil,
+			expectedError: fmt.Errorf(`can't get key "missing" of secret "backup": %w`, fmt.Errorf(`key "missing" not found`)),
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := makeManagedAgentConfigData(tc.config, getSecretKey)
+			if !reflect.DeepEqual(err, tc.expectedError) {
+				t.Fatalf("expected and got errors differ:\n%s", cmp.Diff(tc.expectedError, err))
+			}
+
+			if !reflect.DeepEqual(data, tc.expectedData) {
+				t.Errorf("expected and got data differ:\n%s", cmp.Diff(tc.expectedData, data))
+			}
+		})
+	}
+}
@@ -0,0 +1,512 @@
+package scylladbdatacenter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+	"github.com/scylladb/scylla-operator/pkg/scyllafeatures"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// removeNodeRequestTimeout bounds how long we wait on the removenode call, which blocks until the operation finishes.
+	// The operation itself keeps running in the topology coordinator and is tracked through the task manager.
+	removeNodeRequestTimeout = 5 * time.Second
+
+	// defaultUnavailableNodeRemovalDelay is used when the delay isn't set, e.g. on objects created before it was added.
+	defaultUnavailableNodeRemovalDelay = 10 * time.Minute
+
+	// removeNodeRecheckInterval is how often we recheck whether a refused removal became safe.
+	removeNodeRecheckInterval = time.Minute
+)
+
+// findNodeOperationTask returns the most relevant task of the type operating on the host ID.
+// Unfinished tasks take precedence over finished ones, and successful tasks over failed ones.
+func findNodeOperationTask(tasks []scyllaclient.TaskStats, taskType, hostID string) *scyllaclient.TaskStats {
+	statePriority := map[scyllaclient.TaskState]int{
+		scyllaclient.TaskStateCreated: 3,
+		scyllaclient.TaskStateRunning: 3,
+		scyllaclient.TaskStateDone:    2,
+		scyllaclient.TaskStateFailed:  1,
+	}
+
+	var found *scyllaclient.TaskStats
+	for i := range tasks {
+		t := &tasks[i]
+		if t.Type != taskType || t.Entity != hostID {
+			continue
+		}
+
+		if found == nil || statePriority[t.State] > statePriority[found.State] {
+			found = t
+		}
+	}
+
+	return found
+}
+
+// getReadyScyllaHosts returns hosts of ready nodes, except for the node of the excluded Service.
+func (sdcc *Controller) getReadyScyllaHosts(sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*corev1.Service, excludedServiceName string) []string {
+	var hosts []string
+	for _, svc := range services {
+		if svc.Name == excludedServiceName {
+			continue
+		}
+
+		if svc.Labels[naming.ScyllaServiceTypeLabel] != string(naming.ScyllaServiceTypeMember) {
+			continue
+		}
+
+		pod, err := sdcc.podLister.Pods(svc.Namespace).Get(naming.PodNameFromService(svc))
+		if err != nil {
+			continue
+		}
+
+		if !controllerhelpers.IsPodReady(pod) {
+			continue
+		}
+
+		host, err := controllerhelpers.GetScyllaHost(sdc, svc, pod)
+		if err != nil {
+			klog.V(4).InfoS("Can't get scylla host", "Service", klog.KObj(svc), "Error", err)
+			continue
+		}
+
+		hosts = append(hosts, host)
+	}
+
+	return hosts
+}
+
+// getRaftTopologyClient returns a client for a ready node, if the cluster manages topology through Raft
+// and the progress of topology operations can be tracked. Otherwise, it returns nil.
+// Callers are responsible for closing the returned client.
+func (sdcc *Controller) getRaftTopologyClient(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*corev1.Service, excludedServiceName string) (*scyllaclient.Client, string, error) {
+	supported, err := scyllafeatures.Supports(sdc, scyllafeatures.NodeOperationTasks)
+	if err != nil {
+		return nil, "", fmt.Errorf("can't determine whether node operation tasks are supported: %w", err)
+	}
+	if !supported {
+		return nil, "", nil
+	}
+
+	hosts := sdcc.getReadyScyllaHosts(sdc, services, excludedServiceName)
+	if len(hosts) == 0 {
+		return nil, "", nil
+	}
+
+	scyllaClient, err := sdcc.getScyllaClient(ctx, sdc, hosts)
+	if err != nil {
+		return nil, "", fmt.Errorf("can't get scylla client: %w", err)
+	}
+
+	host := hosts[0]
+	state, err := scyllaClient.RaftTopologyUpgradeState(ctx, host)
+	if err != nil {
+		scyllaClient.Close()
+		return nil, "", fmt.Errorf("can't get raft topology upgrade state: %w", err)
+	}
+
+	if state != scyllaclient.RaftTopologyUpgradeStateDone {
+		klog.V(4).InfoS("Topology isn't managed through Raft yet", "ScyllaDBDatacenter", klog.KObj(sdc), "State", state)
+		scyllaClient.Close()
+		return nil, "", nil
+	}
+
+	return scyllaClient, host, nil
+}
+
+// makeNodeOperationProgressingCondition reports the progress of a topology operation.
+func makeNodeOperationProgressingCondition(sdc *scyllav1alpha1.ScyllaDBDatacenter, svc *corev1.Service, status *scyllaclient.TaskStatus) metav1.Condition {
+	progress := ""
+	if status.ProgressTotal > 0 {
+		progress = fmt.Sprintf(" (%.0f%%)", 100*status.ProgressCompleted/status.ProgressTotal)
+	}
+
+	return metav1.Condition{
+		Type:               serviceControllerProgressingCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             "WaitingForRaftTopologyOperation",
+		Message:            fmt.Sprintf("Waiting for %s operation %q of node %q to finish, current state is %q%s.", status.Type, status.ID, naming.ObjRef(svc), status.State, progress),
+		ObservedGeneration: sdc.Generation,
+	}
+}
+
+// trackNodeOperation reports the state of the topology operation of the type on the host ID.
+// It returns whether the operation has finished successfully and errors out when it has failed.
+func trackNodeOperation(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, svc *corev1.Service, scyllaClient *scyllaclient.Client, host string, task *scyllaclient.TaskStats) (bool, []metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	status, err := scyllaClient.TaskStatus(ctx, host, task.TaskID)
+	if err != nil {
+		return false, progressingConditions, fmt.Errorf("can't get status of task %q: %w", task.TaskID, err)
+	}
+
+	switch status.State {
+	case scyllaclient.TaskStateDone:
+		return true, progressingConditions, nil
+
+	case scyllaclient.TaskStateFailed:
+		return false, progressingConditions, fmt.Errorf("%s operation %q of node %q failed: %s", status.Type, status.ID, naming.ObjRef(svc), status.Error)
+
+	default:
+		progressingConditions = append(progressingConditions, makeNodeOperationProgressingCondition(sdc, svc, status))
+		return false, progressingConditions, nil
+	}
+}
+
+// isScyllaDBRunning returns whether the ScyllaDB container of the Pod is running.
+func isScyllaDBRunning(pod *corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name == naming.ScyllaContainerName {
+			return cs.State.Running != nil
+		}
+	}
+
+	return false
+}
+
+// scyllaDBSystemKeyspaces are the keyspaces managed by ScyllaDB itself.
+var scyllaDBSystemKeyspaces = sets.New(
+	"system",
+	"system_auth",
+	"system_distributed",
+	"system_distributed_everywhere",
+	"system_replicated_keys",
+	"system_schema",
+	"system_traces",
+)
+
+// isSystemKeyspace returns whether the keyspace is managed by ScyllaDB itself.
+func isSystemKeyspace(keyspace string) bool {
+	return scyllaDBSystemKeyspaces.Has(keyspace)
+}
+
+// minReplicasInDatacenter returns the lowest number of replicas that the token ranges have in the datacenter.
+// Ranges that aren't replicated to the datacenter at all are ignored.
+func minReplicasInDatacenter(ranges []scyllaclient.TokenRange, datacenter string) int {
+	minReplicas := 0
+	for _, r := range ranges {
+		replicas := 0
+		for _, ed := range r.EndpointDetails {
+			if ed.Datacenter == datacenter {
+				replicas++
+			}
+		}
+
+		if replicas != 0 && (minReplicas == 0 || replicas < minReplicas) {
+			minReplicas = replicas
+		}
+	}
+
+	return minReplicas
+}
+
+// findSingleReplicaKeyspace returns a non-system keyspace that keeps only a single replica of its data
+// in the datacenter, or an empty string if there is none. Removing a node would lose such data.
+func (sdcc *Controller) findSingleReplicaKeyspace(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, scyllaClient *scyllaclient.Client, host string) (string, error) {
+	keyspaces, err := scyllaClient.Keyspaces(ctx)
+	if err != nil {
+		return "", fmt.Errorf("can't get keyspaces: %w", err)
+	}
+
+	supportsTablets, err := scyllafeatures.Supports(sdc, scyllafeatures.Tablets)
+	if err != nil {
+		return "", fmt.Errorf("can't determine whether tablets are supported: %w", err)
+	}
+
+	var tabletsKeyspaces []string
+	if supportsTablets {
+		tabletsKeyspaces, err = scyllaClient.KeyspacesWithReplication(ctx, host, scyllaclient.TabletsReplicationType)
+		if err != nil {
+			return "", fmt.Errorf("can't get keyspaces using tablets: %w", err)
+		}
+	}
+
+	datacenter := naming.GetScyllaDBDatacenterGossipDatacenterName(sdc)
+	for _, keyspace := range keyspaces {
+		if isSystemKeyspace(keyspace) {
+			continue
+		}
+
+		table := ""
+		if slices.ContainsItem(tabletsKeyspaces, keyspace) {
+			tables, err := scyllaClient.Tables(ctx, host, keyspace)
+			if err != nil {
+				return "", fmt.Errorf("can't get tables of keyspace %q: %w", keyspace, err)
+			}
+
+			if len(tables) == 0 {
+				// Keyspaces without tables have no data.
+				continue
+			}
+
+			// All tables of a keyspace share its replication.
+			table = tables[0]
+		}
+
+		ranges, err := scyllaClient.DescribeRing(ctx, host, keyspace, table)
+		if err != nil {
+			return "", fmt.Errorf("can't describe ring of keyspace %q: %w", keyspace, err)
+		}
+
+		if minReplicasInDatacenter(ranges, datacenter) == 1 {
+			return keyspace, nil
+		}
+	}
+
+	return "", nil
+}
+
+// getNodeDownSince returns the time since when the node of the Service has been reported as down.
+func getNodeDownSince(svc *corev1.Service) (*time.Time, error) {
+	downSinceString, ok := svc.Annotations[naming.NodeDownSinceAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	downSince, err := time.Parse(time.RFC3339, downSinceString)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse annotation %q of service %q: %w", naming.NodeDownSinceAnnotation, naming.ObjRef(svc), err)
+	}
+
+	return &downSince, nil
+}
+
+func getUnavailableNodeRemovalDelay(sdc *scyllav1alpha1.ScyllaDBDatacenter) time.Duration {
+	if sdc.Spec.UnavailableNodeRemovalDelay == nil {
+		return defaultUnavailableNodeRemovalDelay
+	}
+
+	return sdc.Spec.UnavailableNodeRemovalDelay.Duration
+}
+
+// setNodeDownSince records since when the node of the Service is reported as down, or clears it when downSince is nil.
+func (sdcc *Controller) setNodeDownSince(ctx context.Context, svc *corev1.Service, downSince *time.Time) error {
+	svcCopy := svc.DeepCopy()
+	if downSince == nil {
+		delete(svcCopy.Annotations, naming.NodeDownSinceAnnotation)
+	} else {
+		if svcCopy.Annotations == nil {
+			svcCopy.Annotations = map[string]string{}
+		}
+		svcCopy.Annotations[naming.NodeDownSinceAnnotation] = downSince.UTC().Format(time.RFC3339)
+	}
+
+	_, err := sdcc.kubeClient.CoreV1().Services(svcCopy.Namespace).Update(ctx, svcCopy, metav1.UpdateOptions{})
+	resourceapply.ReportUpdateEvent(sdcc.eventRecorder, svc, err)
+	return err
+}
+
+// canRemoveUnavailableNode returns whether the node can be safely removed without its participation.
+// The node has to be reported as down by the other nodes for the configured delay, so a node that is only
+// restarting isn't removed, and no keyspace may keep a single replica of its data in the datacenter.
+func (sdcc *Controller) canRemoveUnavailableNode(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, svc *corev1.Service, scyllaClient *scyllaclient.Client, host, hostID string) (bool, []metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	key, err := keyFunc(sdc)
+	if err != nil {
+		return false, progressingConditions, fmt.Errorf("can't get key of ScyllaDBDatacenter %q: %w", naming.ObjRef(sdc), err)
+	}
+
+	makeWaitingCondition := func(reason, message string) metav1.Condition {
+		return metav1.Condition{
+			Type:               serviceControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             reason,
+			Message:            message,
+			ObservedGeneration: sdc.Generation,
+		}
+	}
+
+	downSince, err := getNodeDownSince(svc)
+	if err != nil {
+		return false, progressingConditions, err
+	}
+
+	nodeStatuses, err := scyllaClient.Status(ctx, host)
+	if err != nil {
+		return false, progressingConditions, fmt.Errorf("can't get status of nodes: %w", err)
+	}
+
+	nodeStatus, _, ok := slices.Find(nodeStatuses, func(s scyllaclient.NodeStatusInfo) bool {
+		return s.HostID == hostID
+	})
+	if !ok || nodeStatus.Status != scyllaclient.NodeStatusDown {
+		if downSince != nil {
+			err = sdcc.setNodeDownSince(ctx, svc, nil)
+			if err != nil {
+				return false, progressingConditions, err
+			}
+		}
+
+		progressingConditions = append(progressingConditions, makeWaitingCondition("WaitingForNodeDown", fmt.Sprintf("Waiting for node %q to be reported as down before removing it.", naming.ObjRef(svc))))
+		sdcc.queue.AddAfter(key, removeNodeRecheckInterval)
+		return false, progressingConditions, nil
+	}
+
+	if downSince == nil {
+		now := time.Now()
+		downSince = &now
+		err = sdcc.setNodeDownSince(ctx, svc, downSince)
+		if err != nil {
+			return false, progressingConditions, err
+		}
+	}
+
+	delay := getUnavailableNodeRemovalDelay(sdc)
+	down := time.Since(*downSince)
+	if down < delay {
+		progressingConditions = append(progressingConditions, makeWaitingCondition("WaitingForNodeDown", fmt.Sprintf("Waiting for node %q to be down for %v before removing it.", naming.ObjRef(svc), delay)))
+		sdcc.queue.AddAfter(key, delay-down)
+		return false, progressingConditions, nil
+	}
+
+	keyspace, err := sdcc.findSingleReplicaKeyspace(ctx, sdc, scyllaClient, host)
+	if err != nil {
+		return false, progressingConditions, err
+	}
+	if len(keyspace) != 0 {
+		message := fmt.Sprintf("Refusing to remove node %q, because keyspace %q has a replication factor of 1 in this datacenter and its data would be lost.", naming.ObjRef(svc), keyspace)
+		sdcc.eventRecorder.Event(svc, corev1.EventTypeWarning, "RemoveNodeRefused", message)
+		progressingConditions = append(progressingConditions, makeWaitingCondition("RemoveNodeRefused", message))
+		sdcc.queue.AddAfter(key, removeNodeRecheckInterval)
+		return false, progressingConditions, nil
+	}
+
+	return true, progressingConditions, nil
+}
+
+// removeUnavailableNode removes a node that is being decommissioned but can't decommission itself, because
+// ScyllaDB isn't running. The node is removed through the Raft topology coordinator from one of the remaining nodes.
+// Running nodes are decommissioned by their sidecar.
+func (sdcc *Controller) removeUnavailableNode(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, svc *corev1.Service, services map[string]*corev1.Service) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	hostID, ok := svc.Annotations[naming.HostIDAnnotation]
+	if !ok {
+		return progressingConditions, nil
+	}
+
+	pod, err := sdcc.podLister.Pods(svc.Namespace).Get(naming.PodNameFromService(svc))
+	if err != nil && !apierrors.IsNotFound(err) {
+		return progressingConditions, fmt.Errorf("can't get pod of service %q: %w", naming.ObjRef(svc), err)
+	}
+	if err == nil && isScyllaDBRunning(pod) {
+		return progressingConditions, nil
+	}
+
+	scyllaClient, host, err := sdcc.getRaftTopologyClient(ctx, sdc, services, svc.Name)
+	if err != nil {
+		return progressingConditions, err
+	}
+	if scyllaClient == nil {
+		return progressingConditions, nil
+	}
+	defer scyllaClient.Close()
+
+	tasks, err := scyllaClient.NodeOperationTasks(ctx, host)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't list node operation tasks: %w", err)
+	}
+
+	task := findNodeOperationTask(tasks, scyllaclient.RemoveNodeTaskType, hostID)
+	if task == nil || task.State == scyllaclient.TaskStateFailed {
+		canRemove, pcs, err := sdcc.canRemoveUnavailableNode(ctx, sdc, svc, scyllaClient, host, hostID)
+		progressingConditions = append(progressingConditions, pcs...)
+		if err != nil || !canRemove {
+			return progressingConditions, err
+		}
+
+		if task != nil {
+			// The request is submitted again, the failure may have been transient.
+			sdcc.eventRecorder.Eventf(svc, corev1.EventTypeWarning, "RemoveNodeFailed", "Removing node with host ID %q failed in task %q, retrying.", hostID, task.TaskID)
+		}
+
+		klog.V(2).InfoS("Removing unavailable node through Raft topology", "ScyllaDBDatacenter", klog.KObj(sdc), "Service", klog.KObj(svc), "HostID", hostID)
+		sdcc.eventRecorder.Eventf(svc, corev1.EventTypeNormal, "RemovingNode", "Removing unavailable node with host ID %q.", hostID)
+
+		removeCtx, removeCtxCancel := context.WithTimeout(ctx, removeNodeRequestTimeout)
+		defer removeCtxCancel()
+		err = scyllaClient.RemoveNode(removeCtx, host, hostID)
+		if err != nil && !errors.Is(removeCtx.Err(), context.DeadlineExceeded) {
+			return progressingConditions, err
+		}
+
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               serviceControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForRaftTopologyOperation",
+			Message:            fmt.Sprintf("Waiting for removal of node %q to start.", naming.ObjRef(svc)),
+			ObservedGeneration: sdc.Generation,
+		})
+		return progressingConditions, nil
+	}
+
+	done, pcs, err := trackNodeOperation(ctx, sdc, svc, scyllaClient, host, task)
+	progressingConditions = append(progressingConditions, pcs...)
+	if err != nil || !done {
+		return progressingConditions, err
+	}
+
+	sdcc.eventRecorder.Eventf(svc, corev1.EventTypeNormal, "RemovedNode", "Node with host ID %q has been removed.", hostID)
+	svcCopy := svc.DeepCopy()
+	svcCopy.Labels[naming.DecommissionedLabel] = naming.LabelValueTrue
+	controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, serviceControllerProgressingCondition, svcCopy, "update", sdc.Generation)
+	_, err = sdcc.kubeClient.CoreV1().Services(svcCopy.Namespace).Update(ctx, svcCopy, metav1.UpdateOptions{})
+	resourceapply.ReportUpdateEvent(sdcc.eventRecorder, svc, err)
+	if err != nil {
+		return progressingConditions, err
+	}
+
+	return progressingConditions, nil
+}
+
+// trackReplace reports the state of the replace operation tracked by the Raft topology coordinator.
+// It returns false when the replace can't be tracked, so callers fall back to observing the Pod.
+func (sdcc *Controller) trackReplace(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, svc *corev1.Service, services map[string]*corev1.Service, replacedHostID string) (bool, []metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	scyllaClient, host, err := sdcc.getRaftTopologyClient(ctx, sdc, services, svc.Name)
+	if err != nil {
+		return false, progressingConditions, err
+	}
+	if scyllaClient == nil {
+		return false, progressingConditions, nil
+	}
+	defer scyllaClient.Close()
+
+	tasks, err := scyllaClient.NodeOperationTasks(ctx, host)
+	if err != nil {
+		return false, progressingConditions, fmt.Errorf("can't list node operation tasks: %w", err)
+	}
+
+	task := findNodeOperationTask(tasks, scyllaclient.ReplaceTaskType, replacedHostID)
+	if task == nil {
+		return false, progressingConditions, nil
+	}
+
+	_, pcs, err := trackNodeOperation(ctx, sdc, svc, scyllaClient, host, task)
+	progressingConditions = append(progressingConditions, pcs...)
+	if err != nil {
+		return true, progressingConditions, err
+	}
+
+	return len(progressingConditions) != 0, progressingConditions, nil
+}
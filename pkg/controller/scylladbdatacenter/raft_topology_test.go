@@ -0,0 +1,254 @@
+package scylladbdatacenter
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFindNodeOperationTask(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		tasks    []scyllaclient.TaskStats
+		taskType string
+		hostID   string
+		expected *scyllaclient.TaskStats
+	}{
+		{
+			name:     "no tasks",
+			tasks:    nil,
+			taskType: scyllaclient.RemoveNodeTaskType,
+			hostID:   "host-1",
+			expected: nil,
+		},
+		{
+			name: "ignores tasks of other types and hosts",
+			tasks: []scyllaclient.TaskStats{
+				{TaskID: "1", Type: scyllaclient.ReplaceTaskType, State: scyllaclient.TaskStateRunning, Entity: "host-1"},
+				{TaskID: "2", Type: scyllaclient.RemoveNodeTaskType, State: scyllaclient.TaskStateRunning, Entity: "host-2"},
+			},
+			taskType: scyllaclient.RemoveNodeTaskType,
+			hostID:   "host-1",
+			expected: nil,
+		},
+		{
+			name: "prefers running task over finished ones",
+			tasks: []scyllaclient.TaskStats{
+				{TaskID: "1", Type: scyllaclient.RemoveNodeTaskType, State: scyllaclient.TaskStateFailed, Entity: "host-1"},
+				{TaskID: "2", Type: scyllaclient.RemoveNodeTaskType, State: scyllaclient.TaskStateDone, Entity: "host-1"},
+				{TaskID: "3", Type: scyllaclient.RemoveNodeTaskType, State: scyllaclient.TaskStateRunning, Entity: "host-1"},
+			},
+			taskType: scyllaclient.RemoveNodeTaskType,
+			hostID:   "host-1",
+			expected: &scyllaclient.TaskStats{TaskID: "3", Type: scyllaclient.RemoveNodeTaskType, State: scyllaclient.TaskStateRunning, Entity: "host-1"},
+		},
+		{
+			name: "prefers done task over failed one",
+			tasks: []scyllaclient.TaskStats{
+				{TaskID: "1", Type: scyllaclient.ReplaceTaskType, State: scyllaclient.TaskStateFailed, Entity: "host-1"},
+				{TaskID: "2", Type: scyllaclient.ReplaceTaskType, State: scyllaclient.TaskStateDone, Entity: "host-1"},
+			},
+			taskType: scyllaclient.ReplaceTaskType,
+			hostID:   "host-1",
+			expected: &scyllaclient.TaskStats{TaskID: "2", Type: scyllaclient.ReplaceTaskType, State: scyllaclient.TaskStateDone, Entity: "host-1"},
+		},
+		{
+			name: "returns failed task when there is no other",
+			tasks: []scyllaclient.TaskStats{
+				{TaskID: "1", Type: scyllaclient.ReplaceTaskType, State: scyllaclient.TaskStateFailed, Entity: "host-1"},
+			},
+			taskType: scyllaclient.ReplaceTaskType,
+			hostID:   "host-1",
+			expected: &scyllaclient.TaskStats{TaskID: "1", Type: scyllaclient.ReplaceTaskType, State: scyllaclient.TaskStateFailed, Entity: "host-1"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := findNodeOperationTask(tc.tasks, tc.taskType, tc.hostID)
+			if !cmp.Equal(got, tc.expected) {
+				t.Errorf("expected and got task differ: %s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func TestIsSystemKeyspace(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		keyspace string
+		expected bool
+	}{
+		{
+			name:     "system keyspace",
+			keyspace: "system",
+			expected: true,
+		},
+		{
+			name:     "system_auth keyspace",
+			keyspace: "system_auth",
+			expected: true,
+		},
+		{
+			name:     "user keyspace",
+			keyspace: "app_data",
+			expected: false,
+		},
+		{
+			name:     "user keyspace prefixed with system",
+			keyspace: "systems_data",
+			expected: false,
+		},
+		{
+			name:     "user keyspace prefixed with system and underscore",
+			keyspace: "system_metrics_app",
+			expected: false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := isSystemKeyspace(tc.keyspace)
+			if got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestMinReplicasInDatacenter(t *testing.T) {
+	t.Parallel()
+
+	newTokenRange := func(datacenters ...string) scyllaclient.TokenRange {
+		tr := scyllaclient.TokenRange{}
+		for i, dc := range datacenters {
+			tr.EndpointDetails = append(tr.EndpointDetails, scyllaclient.EndpointDetails{
+				Host:       fmt.Sprintf("10.0.0.%d", i),
+				Datacenter: dc,
+			})
+		}
+		return tr
+	}
+
+	tt := []struct {
+		name       string
+		ranges     []scyllaclient.TokenRange
+		datacenter string
+		expected   int
+	}{
+		{
+			name:       "no ranges",
+			ranges:     nil,
+			datacenter: "dc1",
+			expected:   0,
+		},
+		{
+			name: "ranges not replicated to the datacenter are ignored",
+			ranges: []scyllaclient.TokenRange{
+				newTokenRange("dc2", "dc2"),
+				newTokenRange("dc2", "dc2"),
+			},
+			datacenter: "dc1",
+			expected:   0,
+		},
+		{
+			name: "replicas in other datacenters aren't counted",
+			ranges: []scyllaclient.TokenRange{
+				newTokenRange("dc1", "dc2", "dc2"),
+				newTokenRange("dc2", "dc1", "dc2"),
+			},
+			datacenter: "dc1",
+			expected:   1,
+		},
+		{
+			name: "lowest number of replicas is returned",
+			ranges: []scyllaclient.TokenRange{
+				newTokenRange("dc1", "dc1", "dc1"),
+				newTokenRange("dc1", "dc1"),
+			},
+			datacenter: "dc1",
+			expected:   2,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := minReplicasInDatacenter(tc.ranges, tc.datacenter)
+			if got != tc.expected {
+				t.Errorf("expected %d replicas, got %d", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetNodeDownSince(t *testing.T) {
+	t.Parallel()
+
+	downSince := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name          string
+		annotations   map[string]string
+		expected      *time.Time
+		expectedError bool
+	}{
+		{
+			name:        "node isn't known to be down",
+			annotations: nil,
+			expected:    nil,
+		},
+		{
+			name: "node is down since the recorded time",
+			annotations: map[string]string{
+				"scylla-operator.scylladb.com/node-down-since": "2024-01-01T12:00:00Z",
+			},
+			expected: &downSince,
+		},
+		{
+			name: "invalid time is an error",
+			annotations: map[string]string{
+				"scylla-operator.scylladb.com/node-down-since": "yesterday",
+			},
+			expected:      nil,
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "member",
+					Namespace:   "scylla",
+					Annotations: tc.annotations,
+				},
+			}
+
+			got, err := getNodeDownSince(svc)
+			if (err != nil) != tc.expectedError {
+				t.Fatalf("expected error %t, got %v", tc.expectedError, err)
+			}
+
+			if !cmp.Equal(got, tc.expected) {
+				t.Errorf("expected and got times differ: %s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
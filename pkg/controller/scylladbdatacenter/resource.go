@@ -17,6 +17,7 @@ import (
 	"github.com/scylladb/scylla-operator/pkg/helpers"
 	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
 	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	okubecrypto "github.com/scylladb/scylla-operator/pkg/kubecrypto"
 	"github.com/scylladb/scylla-operator/pkg/naming"
 	"github.com/scylladb/scylla-operator/pkg/pointer"
 	appsv1 "k8s.io/api/apps/v1"
@@ -35,10 +36,14 @@ import (
 const (
 	scyllaAgentConfigVolumeName              = "scylla-agent-config-volume"
 	scyllaAgentAuthTokenVolumeName           = "scylla-agent-auth-token-volume"
+	scyllaAgentManagedConfigVolumeName       = "scylla-agent-managed-config"
 	scylladbServingCertsVolumeName           = "scylladb-serving-certs"
 	scylladbClientCAVolumeName               = "scylladb-client-ca"
 	scylladbUserAdminVolumeName              = "scylladb-user-admin"
 	scylladbAlternatorServingCertsVolumeName = "scylladb-alternator-serving-certs"
+	scylladbInternodeCertsVolumeName         = "scylladb-internode-certs"
+	scylladbInternodeCAVolumeName            = "scylladb-internode-ca"
+	scylladbServingCAVolumeName              = "scylladb-serving-ca"
 )
 
 const (
@@ -281,7 +286,7 @@ func getServicePorts(sdc *scyllav1alpha1.ScyllaDBDatacenter) ([]corev1.ServicePo
 
 // StatefulSetForRack make a StatefulSet for the rack.
 // existingSts may be nil if it doesn't exist yet.
-func StatefulSetForRack(rack scyllav1alpha1.RackSpec, sdc *scyllav1alpha1.ScyllaDBDatacenter, existingSts *appsv1.StatefulSet, sidecarImage string, rackOrdinal int, inputsHash string) (*appsv1.StatefulSet, error) {
+func StatefulSetForRack(rack scyllav1alpha1.RackSpec, sdc *scyllav1alpha1.ScyllaDBDatacenter, existingSts *appsv1.StatefulSet, sidecarImage string, rackOrdinal int, inputsHash string, managedAgentConfigRendered bool) (*appsv1.StatefulSet, error) {
 	selectorLabels, err := naming.RackSelectorLabels(rack, sdc)
 	if err != nil {
 		return nil, fmt.Errorf("can't get selector labels: %w", err)
@@ -292,10 +297,17 @@ func StatefulSetForRack(rack scyllav1alpha1.RackSpec, sdc *scyllav1alpha1.Scylla
 		return nil, fmt.Errorf("can't get version of image %q: %w", sdc.Spec.ScyllaDB.Image, err)
 	}
 
+	topologyLabelSelector := getRackTopologyLabelSelector(sdc.Spec.RackTemplate, rack)
+
 	if sdc.Spec.RackTemplate != nil {
 		rack = applyRackTemplateOnRackSpec(sdc.Spec.RackTemplate, rack)
 	}
 
+	if sdc.Spec.AutomaticRacks != nil {
+		// Racks managed by automaticRacks have to stay in the zone they select, regardless of the placement.
+		rack.Placement = makePlacementWithTopologyLabelSelector(rack.Placement, topologyLabelSelector)
+	}
+
 	requiredLabels := map[string]string{}
 	requiredLabels[naming.RackOrdinalLabel] = strconv.Itoa(rackOrdinal)
 	requiredLabels[naming.ScyllaVersionLabel] = scyllaDBVersion
@@ -482,7 +494,7 @@ func StatefulSetForRack(rack scyllav1alpha1.RackSpec, sdc *scyllav1alpha1.Scylla
 												if rack.ScyllaDB != nil && rack.ScyllaDB.CustomConfigMapRef != nil {
 													return *rack.ScyllaDB.CustomConfigMapRef
 												}
-												return "scylla-config"
+												return defaultScyllaDBCustomConfigMapName
 											}(),
 										},
 										Optional: &opt,
@@ -533,6 +545,18 @@ func StatefulSetForRack(rack scyllav1alpha1.RackSpec, sdc *scyllav1alpha1.Scylla
 							},
 						}
 
+						// The Secret is mounted only once it was rendered, otherwise the Pods would be stuck waiting for it.
+						if sdc.Spec.ScyllaDBManagerAgent != nil && managedAgentConfigRendered {
+							volumes = append(volumes, corev1.Volume{
+								Name: scyllaAgentManagedConfigVolumeName,
+								VolumeSource: corev1.VolumeSource{
+									Secret: &corev1.SecretVolumeSource{
+										SecretName: naming.ManagedAgentConfigSecretName(sdc),
+									},
+								},
+							})
+						}
+
 						if utilfeature.DefaultMutableFeatureGate.Enabled(features.AutomaticTLSCertificates) {
 							volumes = append(volumes, []corev1.Volume{
 								{
@@ -573,6 +597,43 @@ func StatefulSetForRack(rack scyllav1alpha1.RackSpec, sdc *scyllav1alpha1.Scylla
 							})
 						}
 
+						if isCQLReadinessCheckTLSEnabled(sdc) {
+							volumes = append(volumes, corev1.Volume{
+								Name: scylladbServingCAVolumeName,
+								VolumeSource: corev1.VolumeSource{
+									ConfigMap: &corev1.ConfigMapVolumeSource{
+										LocalObjectReference: corev1.LocalObjectReference{
+											Name: naming.GetScyllaClusterLocalServingCAName(sdc.Name),
+										},
+										Optional: pointer.Ptr(false),
+									},
+								},
+							})
+						}
+
+						if isInternodeEncryptionListening(sdc) {
+							volumes = append(volumes, []corev1.Volume{
+								{
+									// Every node has a dedicated certificate, so it's projected by the sidecar.
+									Name: scylladbInternodeCertsVolumeName,
+									VolumeSource: corev1.VolumeSource{
+										EmptyDir: &corev1.EmptyDirVolumeSource{},
+									},
+								},
+								{
+									Name: scylladbInternodeCAVolumeName,
+									VolumeSource: corev1.VolumeSource{
+										ConfigMap: &corev1.ConfigMapVolumeSource{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: naming.GetScyllaClusterLocalInternodeCAName(sdc.Name),
+											},
+											Optional: pointer.Ptr(false),
+										},
+									},
+								},
+							}...)
+						}
+
 						return volumes
 					}(),
 					Tolerations: placement.Tolerations,
@@ -671,7 +732,11 @@ exec /mnt/shared/scylla-operator sidecar \
 												optionalArgs = append(optionalArgs, fmt.Sprintf("--external-seeds=%s", strings.Join(sdc.Spec.ScyllaDB.ExternalSeeds, ",")))
 											}
 
-											return strings.Join(optionalArgs, ` \`)
+											if isInternodeEncryptionListening(sdc) {
+												optionalArgs = append(optionalArgs, fmt.Sprintf("--internode-certificates-dir=%s", naming.ScyllaDBInternodeCertsDir))
+											}
+
+											return strings.Join(optionalArgs, " \\\n")
 										}() +
 										func() string {
 											if len(positionalArgs) > 0 {
@@ -756,7 +821,7 @@ exec /mnt/shared/scylla-operator sidecar \
 										},
 										{
 											Name:      scylladbUserAdminVolumeName,
-											MountPath: "/var/run/secrets/scylla-operator.scylladb.com/scylladb/user-admin",
+											MountPath: naming.ScyllaDBUserAdminDir,
 											ReadOnly:  true,
 										},
 									}...)
@@ -770,6 +835,20 @@ exec /mnt/shared/scylla-operator sidecar \
 									})
 								}
 
+								if isInternodeEncryptionListening(sdc) {
+									mounts = append(mounts, []corev1.VolumeMount{
+										{
+											Name:      scylladbInternodeCertsVolumeName,
+											MountPath: naming.ScyllaDBInternodeCertsDir,
+										},
+										{
+											Name:      scylladbInternodeCAVolumeName,
+											MountPath: naming.ScyllaDBInternodeCADir,
+											ReadOnly:  true,
+										},
+									}...)
+								}
+
 								return mounts
 							}(),
 							// Add CAP_SYS_NICE as instructed by scylla logs
@@ -854,24 +933,75 @@ wait
 							Name:            "scylladb-api-status-probe",
 							Image:           sidecarImage,
 							ImagePullPolicy: corev1.PullIfNotPresent,
-							Command: []string{
-								"/usr/bin/scylla-operator",
-								"serve-probes",
-								"scylladb-api-status",
-								fmt.Sprintf("--port=%d", naming.ScyllaDBAPIStatusProbePort),
-								"--service-name=$(SERVICE_NAME)",
-								"--loglevel=2",
-							},
-							Env: []corev1.EnvVar{
-								{
-									Name: "SERVICE_NAME",
-									ValueFrom: &corev1.EnvVarSource{
-										FieldRef: &corev1.ObjectFieldSelector{
-											FieldPath: "metadata.name",
+							Command: func() []string {
+								cmd := []string{
+									"/usr/bin/scylla-operator",
+									"serve-probes",
+									"scylladb-api-status",
+									fmt.Sprintf("--port=%d", naming.ScyllaDBAPIStatusProbePort),
+									"--service-name=$(SERVICE_NAME)",
+									"--loglevel=2",
+								}
+
+								if isCQLReadinessCheckEnabled(sdc) {
+									cmd = append(cmd, "--cql-readiness-check=true")
+
+									if isCQLReadinessCheckTLSEnabled(sdc) {
+										cmd = append(cmd,
+											fmt.Sprintf("--cql-address=localhost:%d", naming.ScyllaCQLSSLPort),
+											fmt.Sprintf("--cql-tls-ca-file=%s/%s", naming.ScyllaDBServingCADir, okubecrypto.CABundleKey),
+											"--cql-tls-server-name=$(SERVICE_NAME).$(POD_NAMESPACE).svc",
+											fmt.Sprintf("--cql-tls-cert-file=%s/%s", naming.ScyllaDBUserAdminDir, corev1.TLSCertKey),
+											fmt.Sprintf("--cql-tls-key-file=%s/%s", naming.ScyllaDBUserAdminDir, corev1.TLSPrivateKeyKey),
+										)
+									}
+								}
+
+								return cmd
+							}(),
+							Env: func() []corev1.EnvVar {
+								env := []corev1.EnvVar{
+									{
+										Name: "SERVICE_NAME",
+										ValueFrom: &corev1.EnvVarSource{
+											FieldRef: &corev1.ObjectFieldSelector{
+												FieldPath: "metadata.name",
+											},
 										},
 									},
-								},
-							},
+								}
+
+								if isCQLReadinessCheckTLSEnabled(sdc) {
+									env = append(env, corev1.EnvVar{
+										Name: "POD_NAMESPACE",
+										ValueFrom: &corev1.EnvVarSource{
+											FieldRef: &corev1.ObjectFieldSelector{
+												FieldPath: "metadata.namespace",
+											},
+										},
+									})
+								}
+
+								return env
+							}(),
+							VolumeMounts: func() []corev1.VolumeMount {
+								if !isCQLReadinessCheckTLSEnabled(sdc) {
+									return nil
+								}
+
+								return []corev1.VolumeMount{
+									{
+										Name:      scylladbServingCAVolumeName,
+										MountPath: naming.ScyllaDBServingCADir,
+										ReadOnly:  true,
+									},
+									{
+										Name:      scylladbUserAdminVolumeName,
+										MountPath: naming.ScyllaDBUserAdminDir,
+										ReadOnly:  true,
+									},
+								}
+							}(),
 							ReadinessProbe: &corev1.Probe{
 								TimeoutSeconds:   int32(30),
 								FailureThreshold: int32(1),
@@ -1032,7 +1162,7 @@ wait
 		}
 	}
 
-	agentContainer, err := getScyllaDBManagerAgentContainer(rack, sdc)
+	agentContainer, err := getScyllaDBManagerAgentContainer(rack, sdc, managedAgentConfigRendered)
 	if err != nil {
 		return nil, fmt.Errorf("can't create scylladb manager agent container: %w", err)
 	}
@@ -1158,7 +1288,7 @@ func sysctlInitContainer(sdc *scyllav1alpha1.ScyllaDBDatacenter, image string) (
 	}, nil
 }
 
-func getScyllaDBManagerAgentContainer(r scyllav1alpha1.RackSpec, sdc *scyllav1alpha1.ScyllaDBDatacenter) (*corev1.Container, error) {
+func getScyllaDBManagerAgentContainer(r scyllav1alpha1.RackSpec, sdc *scyllav1alpha1.ScyllaDBDatacenter, managedAgentConfigRendered bool) (*corev1.Container, error) {
 	if sdc.Spec.ScyllaDBManagerAgent == nil {
 		return nil, nil
 	}
@@ -1167,6 +1297,12 @@ func getScyllaDBManagerAgentContainer(r scyllav1alpha1.RackSpec, sdc *scyllav1al
 		return nil, fmt.Errorf("ScyllaDBDatacneter %q is missing scylla manager agent image", naming.ObjRef(sdc))
 	}
 
+	// The managed config comes before the custom config, so options from the custom config take precedence.
+	var managedAgentConfigArgs string
+	if managedAgentConfigRendered {
+		managedAgentConfigArgs = `-c ` + fmt.Sprintf("%q ", path.Join(naming.ScyllaAgentManagedConfigDir, naming.ScyllaAgentManagedConfigName)) + "\\\n"
+	}
+
 	cnt := &corev1.Container{
 		Name:            "scylla-manager-agent",
 		Image:           *sdc.Spec.ScyllaDBManagerAgent.Image,
@@ -1188,7 +1324,7 @@ printf '{"L":"INFO","T":"%s","M":"Ignited. Starting ScyllaDB Manager Agent"}\n'
 
 scylla-manager-agent \
 -c ` + fmt.Sprintf("%q ", naming.ScyllaAgentConfigDefaultFile) + `\
--c ` + fmt.Sprintf("%q ", path.Join(naming.ScyllaAgentConfigDirName, naming.ScyllaAgentConfigFileName)) + `\
+` + managedAgentConfigArgs + `-c ` + fmt.Sprintf("%q ", path.Join(naming.ScyllaAgentConfigDirName, naming.ScyllaAgentConfigFileName)) + `\
 -c ` + fmt.Sprintf("%q ", path.Join(naming.ScyllaAgentConfigDirName, naming.ScyllaAgentAuthTokenFileName)) + `
 `),
 		},
@@ -1236,6 +1372,14 @@ scylla-manager-agent \
 		}(),
 	}
 
+	if managedAgentConfigRendered {
+		cnt.VolumeMounts = append(cnt.VolumeMounts, corev1.VolumeMount{
+			Name:      scyllaAgentManagedConfigVolumeName,
+			MountPath: naming.ScyllaAgentManagedConfigDir,
+			ReadOnly:  true,
+		})
+	}
+
 	if r.ScyllaDBManagerAgent != nil {
 		for _, vm := range r.ScyllaDBManagerAgent.VolumeMounts {
 			cnt.VolumeMounts = append(cnt.VolumeMounts, *vm.DeepCopy())
@@ -1730,6 +1874,8 @@ func MakeManagedScyllaDBConfig(sdc *scyllav1alpha1.ScyllaDBDatacenter) (*corev1.
 			"AlternatorInsecureDisableAuthorization": getBoolAnnotation(naming.TransformScyllaClusterToScyllaDBDatacenterInsecureDisableAuthorizationAnnotation),
 			"AlternatorInsecureEnableHTTP":           getBoolAnnotation(naming.TransformScyllaClusterToScyllaDBDatacenterInsecureEnableHTTPAnnotation),
 			"AlternatorPort":                         alternatorPort,
+			"InternodeEncryptionListening":           isInternodeEncryptionListening(sdc),
+			"InternodeEncryptionMode":                scyllaDBInternodeEncryptionValue(getInternodeEncryptionMode(sdc)),
 			"Spec":                                   sdc.Spec,
 		},
 	)
@@ -1767,6 +1913,64 @@ func MakeManagedScyllaDBConfig(sdc *scyllav1alpha1.ScyllaDBDatacenter) (*corev1.
 	return cm, nil
 }
 
+// getRackTopologyLabelSelector returns the topology label selector of the rack merged with the one of the rack template.
+func getRackTopologyLabelSelector(rackTemplate *scyllav1alpha1.RackTemplate, rack scyllav1alpha1.RackSpec) map[string]string {
+	topologyLabelSelector := make(map[string]string)
+	if rackTemplate != nil {
+		maps.Copy(topologyLabelSelector, rackTemplate.TopologyLabelSelector)
+	}
+	maps.Copy(topologyLabelSelector, rack.TopologyLabelSelector)
+
+	return topologyLabelSelector
+}
+
+// makePlacementWithTopologyLabelSelector returns a copy of the placement which additionally requires Nodes to match
+// the topology label selector.
+func makePlacementWithTopologyLabelSelector(placement *scyllav1alpha1.Placement, topologyLabelSelector map[string]string) *scyllav1alpha1.Placement {
+	if len(topologyLabelSelector) == 0 {
+		return placement
+	}
+
+	var p *scyllav1alpha1.Placement
+	if placement != nil {
+		p = placement.DeepCopy()
+	} else {
+		p = &scyllav1alpha1.Placement{}
+	}
+
+	if p.NodeAffinity == nil {
+		p.NodeAffinity = &corev1.NodeAffinity{}
+	}
+
+	if p.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		p.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
+	}
+
+	nodeSelector := p.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(nodeSelector.NodeSelectorTerms) == 0 {
+		nodeSelector.NodeSelectorTerms = []corev1.NodeSelectorTerm{{}}
+	}
+
+	keys := make([]string, 0, len(topologyLabelSelector))
+	for k := range topologyLabelSelector {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	// Node selector terms are ORed, so every term has to require the topology.
+	for i := range nodeSelector.NodeSelectorTerms {
+		for _, k := range keys {
+			nodeSelector.NodeSelectorTerms[i].MatchExpressions = append(nodeSelector.NodeSelectorTerms[i].MatchExpressions, corev1.NodeSelectorRequirement{
+				Key:      k,
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{topologyLabelSelector[k]},
+			})
+		}
+	}
+
+	return p
+}
+
 func applyRackTemplateOnRackSpec(rackTemplate *scyllav1alpha1.RackTemplate, rack scyllav1alpha1.RackSpec) scyllav1alpha1.RackSpec {
 	if rackTemplate == nil {
 		return rack
@@ -1979,6 +2183,18 @@ func MakeUpgradeContextConfigMap(sdc *scyllav1alpha1.ScyllaDBDatacenter, uc *int
 		},
 		Data: map[string]string{
 			naming.UpgradeContextConfigMapKey: string(data),
+			naming.UpgradePlanConfigMapKey:    uc.Render(),
 		},
 	}, nil
 }
+
+// isCQLReadinessCheckEnabled returns whether nodes are required to serve CQL queries to be ready.
+func isCQLReadinessCheckEnabled(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+	return sdc.Annotations[naming.CQLReadinessCheckAnnotation] == naming.LabelValueTrue
+}
+
+// isCQLReadinessCheckTLSEnabled returns whether the CQL readiness check goes through the TLS listener,
+// authenticating with the admin client certificate.
+func isCQLReadinessCheckTLSEnabled(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+	return isCQLReadinessCheckEnabled(sdc) && utilfeature.DefaultMutableFeatureGate.Enabled(features.AutomaticTLSCertificates)
+}
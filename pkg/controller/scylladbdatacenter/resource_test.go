@@ -2,6 +2,7 @@ package scylladbdatacenter
 
 import (
 	"fmt"
+	"path"
 	"reflect"
 	"strings"
 	"testing"
@@ -1537,7 +1538,7 @@ scylla-manager-agent \
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := StatefulSetForRack(tc.rack, tc.scyllaDBDatacenter, tc.existingStatefulSet, "scylladb/scylla-operator:latest", 0, "")
+			got, err := StatefulSetForRack(tc.rack, tc.scyllaDBDatacenter, tc.existingStatefulSet, "scylladb/scylla-operator:latest", 0, "", false)
 
 			if !reflect.DeepEqual(err, tc.expectedError) {
 				t.Fatalf("expected and actual errors differ: %s",
@@ -2840,6 +2841,76 @@ client_encryption_options:
   keyfile: "/var/run/secrets/scylla-operator.scylladb.com/scylladb/serving-certs/tls.key"
   require_client_auth: true
   truststore: "/var/run/secrets/scylla-operator.scylladb.com/scylladb/client-ca/tls.crt"
+`, "\n"),
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "internode encryption config present when it's listening",
+			sdc: &scyllav1alpha1.ScyllaDBDatacenter{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "foo-ns",
+					Name:      "foo",
+					UID:       "uid-42",
+					Labels: map[string]string{
+						"user-label": "user-label-value",
+					},
+				},
+				Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+					ClusterName: "foo-cluster",
+					ScyllaDB: scyllav1alpha1.ScyllaDB{
+						InternodeEncryptionOptions: &scyllav1alpha1.InternodeEncryptionOptions{
+							Mode: scyllav1alpha1.InternodeEncryptionModeAll,
+						},
+					},
+				},
+				Status: scyllav1alpha1.ScyllaDBDatacenterStatus{
+					InternodeEncryption: &scyllav1alpha1.InternodeEncryptionStatus{
+						Listening: true,
+						Mode:      scyllav1alpha1.InternodeEncryptionModeAll,
+					},
+				},
+			},
+			enableTLSFeatureGate: false,
+			expectedCM: &corev1.ConfigMap{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "ConfigMap",
+					APIVersion: "v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "foo-ns",
+					Name:      "foo-managed-config",
+					Labels: map[string]string{
+						"app":                          "scylla",
+						"app.kubernetes.io/managed-by": "scylla-operator",
+						"app.kubernetes.io/name":       "scylla",
+						"scylla/cluster":               "foo",
+						"user-label":                   "user-label-value",
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion:         "scylla.scylladb.com/v1alpha1",
+							Kind:               "ScyllaDBDatacenter",
+							Name:               "foo",
+							UID:                "uid-42",
+							Controller:         pointer.Ptr(true),
+							BlockOwnerDeletion: pointer.Ptr(true),
+						},
+					},
+				},
+				Data: map[string]string{
+					"scylladb-managed-config.yaml": strings.TrimPrefix(`
+cluster_name: "foo-cluster"
+rpc_address: "0.0.0.0"
+endpoint_snitch: "GossipingPropertyFileSnitch"
+internode_compression: "all"
+server_encryption_options:
+  internode_encryption: "all"
+  certificate: "/var/run/secrets/scylla-operator.scylladb.com/scylladb/internode-certs/tls.crt"
+  keyfile: "/var/run/secrets/scylla-operator.scylladb.com/scylladb/internode-certs/tls.key"
+  truststore: "/var/run/configmaps/scylla-operator.scylladb.com/scylladb/internode-ca/ca-bundle.crt"
+  require_client_auth: true
 `, "\n"),
 				},
 			},
@@ -3487,3 +3558,388 @@ alternator_encryption_options:
 		})
 	}
 }
+
+func TestMakePlacementWithTopologyLabelSelector(t *testing.T) {
+	t.Parallel()
+
+	tolerations := []corev1.Toleration{
+		{
+			Key:      "role",
+			Operator: corev1.TolerationOpEqual,
+			Value:    "scylla",
+			Effect:   corev1.TaintEffectNoSchedule,
+		},
+	}
+
+	tt := []struct {
+		name                  string
+		placement             *scyllav1alpha1.Placement
+		topologyLabelSelector map[string]string
+		expected              *scyllav1alpha1.Placement
+	}{
+		{
+			name:                  "placement is kept without topology label selector",
+			placement:             &scyllav1alpha1.Placement{Tolerations: tolerations},
+			topologyLabelSelector: nil,
+			expected:              &scyllav1alpha1.Placement{Tolerations: tolerations},
+		},
+		{
+			name:      "topology label selector is converted into node affinity",
+			placement: nil,
+			topologyLabelSelector: map[string]string{
+				"topology.kubernetes.io/zone":   "us-east-1a",
+				"topology.kubernetes.io/region": "us-east-1",
+			},
+			expected: &scyllav1alpha1.Placement{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{
+								MatchExpressions: []corev1.NodeSelectorRequirement{
+									{
+										Key:      "topology.kubernetes.io/region",
+										Operator: corev1.NodeSelectorOpIn,
+										Values:   []string{"us-east-1"},
+									},
+									{
+										Key:      "topology.kubernetes.io/zone",
+										Operator: corev1.NodeSelectorOpIn,
+										Values:   []string{"us-east-1a"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "topology label selector is required by every node selector term",
+			placement: &scyllav1alpha1.Placement{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{
+								MatchExpressions: []corev1.NodeSelectorRequirement{
+									{
+										Key:      "pool",
+										Operator: corev1.NodeSelectorOpIn,
+										Values:   []string{"scylla"},
+									},
+								},
+							},
+							{
+								MatchFields: []corev1.NodeSelectorRequirement{
+									{
+										Key:      "metadata.name",
+										Operator: corev1.NodeSelectorOpIn,
+										Values:   []string{"node-1"},
+									},
+								},
+							},
+						},
+					},
+				},
+				Tolerations: tolerations,
+			},
+			topologyLabelSelector: map[string]string{
+				"topology.kubernetes.io/zone": "us-east-1a",
+			},
+			expected: &scyllav1alpha1.Placement{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{
+								MatchExpressions: []corev1.NodeSelectorRequirement{
+									{
+										Key:      "pool",
+										Operator: corev1.NodeSelectorOpIn,
+										Values:   []string{"scylla"},
+									},
+									{
+										Key:      "topology.kubernetes.io/zone",
+										Operator: corev1.NodeSelectorOpIn,
+										Values:   []string{"us-east-1a"},
+									},
+								},
+							},
+							{
+								MatchExpressions: []corev1.NodeSelectorRequirement{
+									{
+										Key:      "topology.kubernetes.io/zone",
+										Operator: corev1.NodeSelectorOpIn,
+										Values:   []string{"us-east-1a"},
+									},
+								},
+								MatchFields: []corev1.NodeSelectorRequirement{
+									{
+										Key:      "metadata.name",
+										Operator: corev1.NodeSelectorOpIn,
+										Values:   []string{"node-1"},
+									},
+								},
+							},
+						},
+					},
+				},
+				Tolerations: tolerations,
+			},
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var placementCopy *scyllav1alpha1.Placement
+			if tc.placement != nil {
+				placementCopy = tc.placement.DeepCopy()
+			}
+
+			got := makePlacementWithTopologyLabelSelector(tc.placement, tc.topologyLabelSelector)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got placements differ: %s", cmp.Diff(tc.expected, got))
+			}
+
+			if !reflect.DeepEqual(tc.placement, placementCopy) {
+				t.Errorf("placement has been mutated: %s", cmp.Diff(placementCopy, tc.placement))
+			}
+		})
+	}
+}
+
+func TestStatefulSetForRackTopologyLabelSelector(t *testing.T) {
+	t.Parallel()
+
+	zoneSelector := map[string]string{
+		"topology.kubernetes.io/zone": "us-east-1a",
+	}
+
+	placement := &scyllav1alpha1.Placement{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{
+						MatchExpressions: []corev1.NodeSelectorRequirement{
+							{
+								Key:      "role",
+								Operator: corev1.NodeSelectorOpIn,
+								Values:   []string{"scylla"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	newScyllaDBDatacenter := func(rackTemplate *scyllav1alpha1.RackTemplate, rack scyllav1alpha1.RackSpec, automaticRacks *scyllav1alpha1.AutomaticRacks) *scyllav1alpha1.ScyllaDBDatacenter {
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "basic",
+				UID:  "the-uid",
+			},
+			Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+				ClusterName:    "basic",
+				DatacenterName: pointer.Ptr("dc"),
+				ScyllaDB: scyllav1alpha1.ScyllaDB{
+					Image: "scylladb/scylla:latest",
+				},
+				ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgent{
+					Image: pointer.Ptr("scylladb/scylla-manager-agent:latest"),
+				},
+				RackTemplate:   rackTemplate,
+				Racks:          []scyllav1alpha1.RackSpec{rack},
+				AutomaticRacks: automaticRacks,
+			},
+		}
+	}
+
+	newRack := func(placement *scyllav1alpha1.Placement, topologyLabelSelector map[string]string) scyllav1alpha1.RackSpec {
+		return scyllav1alpha1.RackSpec{
+			Name: "rack",
+			RackTemplate: scyllav1alpha1.RackTemplate{
+				ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+					Storage: &scyllav1alpha1.StorageOptions{
+						Capacity: "1Gi",
+					},
+				},
+				Placement:             placement,
+				TopologyLabelSelector: topologyLabelSelector,
+			},
+		}
+	}
+
+	tt := []struct {
+		name                 string
+		sdc                  *scyllav1alpha1.ScyllaDBDatacenter
+		expectedNodeAffinity *corev1.NodeAffinity
+	}{
+		{
+			name:                 "topology label selector is ignored on racks without a rack template",
+			sdc:                  newScyllaDBDatacenter(nil, newRack(nil, zoneSelector), nil),
+			expectedNodeAffinity: nil,
+		},
+		{
+			name:                 "topology label selector doesn't override the placement of a rack",
+			sdc:                  newScyllaDBDatacenter(&scyllav1alpha1.RackTemplate{}, newRack(placement, zoneSelector), nil),
+			expectedNodeAffinity: placement.NodeAffinity,
+		},
+		{
+			name: "topology label selector is merged into the placement of racks managed by automatic racks",
+			sdc:  newScyllaDBDatacenter(&scyllav1alpha1.RackTemplate{Placement: placement}, newRack(nil, zoneSelector), &scyllav1alpha1.AutomaticRacks{Nodes: 3}),
+			expectedNodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{
+						{
+							MatchExpressions: []corev1.NodeSelectorRequirement{
+								{
+									Key:      "role",
+									Operator: corev1.NodeSelectorOpIn,
+									Values:   []string{"scylla"},
+								},
+								{
+									Key:      "topology.kubernetes.io/zone",
+									Operator: corev1.NodeSelectorOpIn,
+									Values:   []string{"us-east-1a"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sts, err := StatefulSetForRack(tc.sdc.Spec.Racks[0], tc.sdc, nil, "scylladb/scylla-operator:latest", 0, "", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var gotNodeAffinity *corev1.NodeAffinity
+			if sts.Spec.Template.Spec.Affinity != nil {
+				gotNodeAffinity = sts.Spec.Template.Spec.Affinity.NodeAffinity
+			}
+
+			if !apiequality.Semantic.DeepEqual(gotNodeAffinity, tc.expectedNodeAffinity) {
+				t.Errorf("expected and got node affinities differ: %s", cmp.Diff(tc.expectedNodeAffinity, gotNodeAffinity))
+			}
+		})
+	}
+}
+
+func TestStatefulSetForRackManagedAgentConfig(t *testing.T) {
+	t.Parallel()
+
+	newScyllaDBDatacenter := func(config *scyllav1alpha1.ScyllaDBManagerAgentConfig) *scyllav1alpha1.ScyllaDBDatacenter {
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "basic",
+				UID:  "the-uid",
+			},
+			Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+				ClusterName:    "basic",
+				DatacenterName: pointer.Ptr("dc"),
+				ScyllaDB: scyllav1alpha1.ScyllaDB{
+					Image: "scylladb/scylla:latest",
+				},
+				ScyllaDBManagerAgent: &scyllav1alpha1.ScyllaDBManagerAgent{
+					Image:  pointer.Ptr("scylladb/scylla-manager-agent:latest"),
+					Config: config,
+				},
+				Racks: []scyllav1alpha1.RackSpec{
+					{
+						Name: "rack",
+						RackTemplate: scyllav1alpha1.RackTemplate{
+							ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+								Storage: &scyllav1alpha1.StorageOptions{
+									Capacity: "1Gi",
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name                       string
+		sdc                        *scyllav1alpha1.ScyllaDBDatacenter
+		managedAgentConfigRendered bool
+		expectManagedConfig        bool
+	}{
+		{
+			name:                       "managed config isn't mounted when it isn't requested",
+			sdc:                        newScyllaDBDatacenter(nil),
+			managedAgentConfigRendered: false,
+			expectManagedConfig:        false,
+		},
+		{
+			name:                       "managed config isn't mounted until the Secret is rendered",
+			sdc:                        newScyllaDBDatacenter(&scyllav1alpha1.ScyllaDBManagerAgentConfig{}),
+			managedAgentConfigRendered: false,
+			expectManagedConfig:        false,
+		},
+		{
+			name:                       "managed config is mounted once the Secret is rendered",
+			sdc:                        newScyllaDBDatacenter(&scyllav1alpha1.ScyllaDBManagerAgentConfig{}),
+			managedAgentConfigRendered: true,
+			expectManagedConfig:        true,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sts, err := StatefulSetForRack(tc.sdc.Spec.Racks[0], tc.sdc, nil, "scylladb/scylla-operator:latest", 0, "", tc.managedAgentConfigRendered)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			hasVolume := false
+			for _, v := range sts.Spec.Template.Spec.Volumes {
+				if v.Name == scyllaAgentManagedConfigVolumeName {
+					hasVolume = true
+				}
+			}
+			if hasVolume != tc.expectManagedConfig {
+				t.Errorf("expected managed config volume to be present: %t, got %t", tc.expectManagedConfig, hasVolume)
+			}
+
+			var agentContainer *corev1.Container
+			for i := range sts.Spec.Template.Spec.Containers {
+				if sts.Spec.Template.Spec.Containers[i].Name == "scylla-manager-agent" {
+					agentContainer = &sts.Spec.Template.Spec.Containers[i]
+				}
+			}
+			if agentContainer == nil {
+				t.Fatalf("expected scylla-manager-agent container")
+			}
+
+			hasMount := false
+			for _, vm := range agentContainer.VolumeMounts {
+				if vm.Name == scyllaAgentManagedConfigVolumeName {
+					hasMount = true
+				}
+			}
+			if hasMount != tc.expectManagedConfig {
+				t.Errorf("expected managed config volume mount to be present: %t, got %t", tc.expectManagedConfig, hasMount)
+			}
+
+			managedConfigPath := path.Join(naming.ScyllaAgentManagedConfigDir, naming.ScyllaAgentManagedConfigName)
+			hasFlag := strings.Contains(strings.Join(agentContainer.Command, " "), managedConfigPath)
+			if hasFlag != tc.expectManagedConfig {
+				t.Errorf("expected managed config flag to be present: %t, got %t", tc.expectManagedConfig, hasFlag)
+			}
+		})
+	}
+}
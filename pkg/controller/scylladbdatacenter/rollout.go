@@ -0,0 +1,276 @@
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	prometheusappclient "github.com/prometheus/client_golang/api"
+	prometheusappv1api "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	canaryPrometheusQueryTimeout = 30 * time.Second
+	canaryChecksRetryInterval    = time.Minute
+	defaultCanarySoakDuration    = 10 * time.Minute
+)
+
+func isCanaryRolloutStrategy(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+	return sdc.Spec.RolloutStrategy != nil && sdc.Spec.RolloutStrategy.Type == scyllav1alpha1.CanaryRolloutStrategyType
+}
+
+// makeRolloutStatus returns the rollout status for the current generation of the ScyllaDBDatacenter,
+// taking the pause and abort annotations into account.
+func makeRolloutStatus(sdc *scyllav1alpha1.ScyllaDBDatacenter, existing *scyllav1alpha1.RolloutStatus) *scyllav1alpha1.RolloutStatus {
+	if sdc.Spec.RolloutStrategy == nil {
+		return nil
+	}
+
+	resumedPhase := scyllav1alpha1.RolloutPhaseProgressing
+	if isCanaryRolloutStrategy(sdc) {
+		resumedPhase = scyllav1alpha1.RolloutPhaseCanary
+	}
+
+	var rollout *scyllav1alpha1.RolloutStatus
+	if existing != nil && existing.Generation == sdc.Generation {
+		rollout = existing.DeepCopy()
+		if rollout.CanaryPassed {
+			resumedPhase = scyllav1alpha1.RolloutPhaseProgressing
+		}
+	} else {
+		rollout = &scyllav1alpha1.RolloutStatus{
+			Generation: sdc.Generation,
+			Phase:      resumedPhase,
+		}
+	}
+
+	switch {
+	case rollout.Phase == scyllav1alpha1.RolloutPhaseComplete:
+
+	case rollout.Phase == scyllav1alpha1.RolloutPhaseAborted:
+
+	case sdc.Annotations[naming.AbortRolloutAnnotation] == naming.LabelValueTrue:
+		rollout.Phase = scyllav1alpha1.RolloutPhaseAborted
+		rollout.Message = "Rollout was aborted. No more nodes are updated until the spec changes."
+
+	case sdc.Annotations[naming.PauseRolloutAnnotation] == naming.LabelValueTrue:
+		rollout.Phase = scyllav1alpha1.RolloutPhasePaused
+		rollout.Message = "Rollout is paused."
+
+	case rollout.Phase == scyllav1alpha1.RolloutPhasePaused:
+		rollout.Phase = resumedPhase
+		rollout.Message = "Rollout was resumed."
+	}
+
+	return rollout
+}
+
+// getFrozenPartition returns the partition that keeps the StatefulSet controller from updating any more Pods.
+func getFrozenPartition(sts *appsv1.StatefulSet) int32 {
+	var partition int32
+	if sts.Spec.UpdateStrategy.RollingUpdate != nil && sts.Spec.UpdateStrategy.RollingUpdate.Partition != nil {
+		partition = *sts.Spec.UpdateStrategy.RollingUpdate.Partition
+	}
+
+	if sts.Status.UpdateRevision == sts.Status.CurrentRevision {
+		return partition
+	}
+
+	return max(partition, *sts.Spec.Replicas-sts.Status.UpdatedReplicas)
+}
+
+// getRolloutPartition returns the partition of a StatefulSet being rolled out in the given phase.
+func getRolloutPartition(phase scyllav1alpha1.RolloutPhase, sts *appsv1.StatefulSet) int32 {
+	if phase == scyllav1alpha1.RolloutPhaseCanary && *sts.Spec.Replicas > 0 {
+		return *sts.Spec.Replicas - 1
+	}
+
+	return 0
+}
+
+func makeRolloutProgressingCondition(sdc *scyllav1alpha1.ScyllaDBDatacenter, reason, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               statefulSetControllerProgressingCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: sdc.Generation,
+	}
+}
+
+// freezeRollout keeps the StatefulSets from updating any more Pods while the rollout is paused or aborted.
+func (sdcc *Controller) freezeRollout(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	rollout *scyllav1alpha1.RolloutStatus,
+	requiredStatefulSets []*appsv1.StatefulSet,
+	statefulSets map[string]*appsv1.StatefulSet,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	for _, required := range requiredStatefulSets {
+		existing, ok := statefulSets[required.Name]
+		if !ok {
+			return progressingConditions, fmt.Errorf("internal error: can't lookup stateful set %s/%s", required.Namespace, required.Name)
+		}
+
+		if existing.Spec.UpdateStrategy.RollingUpdate == nil {
+			continue
+		}
+
+		partition := getFrozenPartition(existing)
+		if existing.Spec.UpdateStrategy.RollingUpdate.Partition != nil && *existing.Spec.UpdateStrategy.RollingUpdate.Partition == partition {
+			continue
+		}
+
+		stsCopy := existing.DeepCopy()
+		stsCopy.Spec.UpdateStrategy.RollingUpdate.Partition = pointer.Ptr(partition)
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, stsCopy, "update", sdc.Generation)
+		_, err := sdcc.kubeClient.AppsV1().StatefulSets(stsCopy.Namespace).Update(ctx, stsCopy, metav1.UpdateOptions{})
+		resourceapply.ReportUpdateEvent(sdcc.eventRecorder, existing, err)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't freeze rollout of StatefulSet %q: %w", naming.ObjRef(existing), err)
+		}
+	}
+
+	progressingConditions = append(progressingConditions, makeRolloutProgressingCondition(sdc, fmt.Sprintf("Rollout%s", rollout.Phase), rollout.Message))
+
+	return progressingConditions, nil
+}
+
+// verifyCanary waits for the canary node of a rolled out StatefulSet to soak and pass the Prometheus queries.
+func (sdcc *Controller) verifyCanary(
+	ctx context.Context,
+	key string,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	rollout *scyllav1alpha1.RolloutStatus,
+	sts *appsv1.StatefulSet,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	podName := fmt.Sprintf("%s-%d", sts.Name, *sts.Spec.Replicas-1)
+	pod, err := sdcc.podLister.Pods(sts.Namespace).Get(podName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return progressingConditions, fmt.Errorf("can't get canary pod %q: %w", naming.ManualRef(sts.Namespace, podName), err)
+	}
+
+	if pod == nil || !controllerhelpers.IsPodReady(pod) {
+		rollout.CanaryNode = podName
+		rollout.CanarySoakStartTime = nil
+		rollout.Message = fmt.Sprintf("Waiting for canary node %q to become ready.", podName)
+		progressingConditions = append(progressingConditions, makeRolloutProgressingCondition(sdc, "WaitingForCanary", rollout.Message))
+		return progressingConditions, nil
+	}
+
+	// The soak restarts whenever the canary node becomes ready again.
+	soakStartTime := metav1.Now()
+	readyCondition := controllerhelpers.GetPodCondition(pod.Status.Conditions, corev1.PodReady)
+	if readyCondition != nil && !readyCondition.LastTransitionTime.IsZero() {
+		soakStartTime = readyCondition.LastTransitionTime
+	}
+	if rollout.CanaryNode == podName && rollout.CanarySoakStartTime != nil && rollout.CanarySoakStartTime.After(soakStartTime.Time) {
+		soakStartTime = *rollout.CanarySoakStartTime
+	}
+	rollout.CanaryNode = podName
+	rollout.CanarySoakStartTime = &soakStartTime
+
+	soakDuration := defaultCanarySoakDuration
+	var prometheusOptions *scyllav1alpha1.CanaryPrometheusOptions
+	if sdc.Spec.RolloutStrategy.Canary != nil {
+		soakDuration = sdc.Spec.RolloutStrategy.Canary.SoakDuration.Duration
+		prometheusOptions = sdc.Spec.RolloutStrategy.Canary.Prometheus
+	}
+
+	soaked := time.Since(soakStartTime.Time)
+	if soaked < soakDuration {
+		rollout.Message = fmt.Sprintf("Canary node %q is soaking for %v.", podName, soakDuration)
+		progressingConditions = append(progressingConditions, makeRolloutProgressingCondition(sdc, "WaitingForCanary", rollout.Message))
+		sdcc.queue.AddAfter(key, soakDuration-soaked)
+		return progressingConditions, nil
+	}
+
+	if prometheusOptions != nil {
+		failedQueries, err := runCanaryPrometheusQueries(ctx, prometheusOptions)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't run canary Prometheus queries: %w", err)
+		}
+
+		if len(failedQueries) != 0 {
+			rollout.Message = fmt.Sprintf("Canary node %q failed Prometheus queries: %s.", podName, strings.Join(failedQueries, ", "))
+			progressingConditions = append(progressingConditions, makeRolloutProgressingCondition(sdc, "CanaryChecksFailed", rollout.Message))
+			sdcc.queue.AddAfter(key, canaryChecksRetryInterval)
+			return progressingConditions, nil
+		}
+	}
+
+	klog.V(2).InfoS("Canary node passed", "ScyllaDBDatacenter", klog.KObj(sdc), "Pod", klog.KObj(pod))
+	sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "CanaryPassed", "Canary node %q passed, continuing the rollout", podName)
+	rollout.CanaryPassed = true
+	rollout.Phase = scyllav1alpha1.RolloutPhaseProgressing
+	rollout.Message = fmt.Sprintf("Canary node %q passed.", podName)
+
+	return progressingConditions, nil
+}
+
+// evaluateCanaryQueryResult returns whether an instant query result passes, i.e. it contains at least one sample
+// and none of the samples is zero.
+func evaluateCanaryQueryResult(value model.Value) bool {
+	switch v := value.(type) {
+	case model.Vector:
+		if len(v) == 0 {
+			return false
+		}
+		for _, s := range v {
+			if s.Value == 0 {
+				return false
+			}
+		}
+		return true
+
+	case *model.Scalar:
+		return v.Value != 0
+
+	default:
+		return false
+	}
+}
+
+// runCanaryPrometheusQueries runs the canary queries and returns the ones that didn't pass.
+func runCanaryPrometheusQueries(ctx context.Context, options *scyllav1alpha1.CanaryPrometheusOptions) ([]string, error) {
+	client, err := prometheusappclient.NewClient(prometheusappclient.Config{
+		Address: options.URL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can't create Prometheus client: %w", err)
+	}
+
+	api := prometheusappv1api.NewAPI(client)
+
+	var failedQueries []string
+	for _, query := range options.Queries {
+		queryCtx, queryCtxCancel := context.WithTimeout(ctx, canaryPrometheusQueryTimeout)
+		value, _, err := api.Query(queryCtx, query, time.Now())
+		queryCtxCancel()
+		if err != nil {
+			return nil, fmt.Errorf("can't run query %q: %w", query, err)
+		}
+
+		if !evaluateCanaryQueryResult(value) {
+			failedQueries = append(failedQueries, fmt.Sprintf("%q", query))
+		}
+	}
+
+	return failedQueries, nil
+}
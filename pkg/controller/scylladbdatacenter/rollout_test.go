@@ -0,0 +1,299 @@
+package scylladbdatacenter
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/common/model"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	appsv1 "k8s.io/api/apps/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMakeRolloutStatus(t *testing.T) {
+	t.Parallel()
+
+	newSDC := func(strategyType scyllav1alpha1.RolloutStrategyType, annotations map[string]string) *scyllav1alpha1.ScyllaDBDatacenter {
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "basic",
+				Namespace:   "default",
+				Generation:  2,
+				Annotations: annotations,
+			},
+			Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+				RolloutStrategy: &scyllav1alpha1.RolloutStrategy{
+					Type: strategyType,
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name     string
+		sdc      *scyllav1alpha1.ScyllaDBDatacenter
+		existing *scyllav1alpha1.RolloutStatus
+		expected *scyllav1alpha1.RolloutStatus
+	}{
+		{
+			name: "no status without rollout strategy",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newSDC(scyllav1alpha1.CanaryRolloutStrategyType, nil)
+				sdc.Spec.RolloutStrategy = nil
+				return sdc
+			}(),
+			existing: &scyllav1alpha1.RolloutStatus{
+				Generation: 1,
+				Phase:      scyllav1alpha1.RolloutPhaseComplete,
+			},
+			expected: nil,
+		},
+		{
+			name: "new generation starts with the canary",
+			sdc:  newSDC(scyllav1alpha1.CanaryRolloutStrategyType, nil),
+			existing: &scyllav1alpha1.RolloutStatus{
+				Generation:   1,
+				Phase:        scyllav1alpha1.RolloutPhaseComplete,
+				CanaryNode:   "basic-a-2",
+				CanaryPassed: true,
+			},
+			expected: &scyllav1alpha1.RolloutStatus{
+				Generation: 2,
+				Phase:      scyllav1alpha1.RolloutPhaseCanary,
+			},
+		},
+		{
+			name:     "new generation of rolling update starts progressing",
+			sdc:      newSDC(scyllav1alpha1.RollingUpdateRolloutStrategyType, nil),
+			existing: nil,
+			expected: &scyllav1alpha1.RolloutStatus{
+				Generation: 2,
+				Phase:      scyllav1alpha1.RolloutPhaseProgressing,
+			},
+		},
+		{
+			name: "pause annotation pauses the rollout",
+			sdc:  newSDC(scyllav1alpha1.CanaryRolloutStrategyType, map[string]string{naming.PauseRolloutAnnotation: naming.LabelValueTrue}),
+			existing: &scyllav1alpha1.RolloutStatus{
+				Generation: 2,
+				Phase:      scyllav1alpha1.RolloutPhaseCanary,
+				CanaryNode: "basic-a-2",
+			},
+			expected: &scyllav1alpha1.RolloutStatus{
+				Generation: 2,
+				Phase:      scyllav1alpha1.RolloutPhasePaused,
+				Message:    "Rollout is paused.",
+				CanaryNode: "basic-a-2",
+			},
+		},
+		{
+			name: "resumed rollout continues after passed canary",
+			sdc:  newSDC(scyllav1alpha1.CanaryRolloutStrategyType, nil),
+			existing: &scyllav1alpha1.RolloutStatus{
+				Generation:   2,
+				Phase:        scyllav1alpha1.RolloutPhasePaused,
+				CanaryNode:   "basic-a-2",
+				CanaryPassed: true,
+			},
+			expected: &scyllav1alpha1.RolloutStatus{
+				Generation:   2,
+				Phase:        scyllav1alpha1.RolloutPhaseProgressing,
+				Message:      "Rollout was resumed.",
+				CanaryNode:   "basic-a-2",
+				CanaryPassed: true,
+			},
+		},
+		{
+			name: "abort annotation takes precedence over pause annotation",
+			sdc: newSDC(scyllav1alpha1.CanaryRolloutStrategyType, map[string]string{
+				naming.PauseRolloutAnnotation: naming.LabelValueTrue,
+				naming.AbortRolloutAnnotation: naming.LabelValueTrue,
+			}),
+			existing: &scyllav1alpha1.RolloutStatus{
+				Generation: 2,
+				Phase:      scyllav1alpha1.RolloutPhaseCanary,
+			},
+			expected: &scyllav1alpha1.RolloutStatus{
+				Generation: 2,
+				Phase:      scyllav1alpha1.RolloutPhaseAborted,
+				Message:    "Rollout was aborted. No more nodes are updated until the spec changes.",
+			},
+		},
+		{
+			name: "aborted rollout stays aborted after the annotation is removed",
+			sdc:  newSDC(scyllav1alpha1.CanaryRolloutStrategyType, nil),
+			existing: &scyllav1alpha1.RolloutStatus{
+				Generation: 2,
+				Phase:      scyllav1alpha1.RolloutPhaseAborted,
+				Message:    "Rollout was aborted. No more nodes are updated until the spec changes.",
+			},
+			expected: &scyllav1alpha1.RolloutStatus{
+				Generation: 2,
+				Phase:      scyllav1alpha1.RolloutPhaseAborted,
+				Message:    "Rollout was aborted. No more nodes are updated until the spec changes.",
+			},
+		},
+		{
+			name: "complete rollout isn't paused",
+			sdc:  newSDC(scyllav1alpha1.CanaryRolloutStrategyType, map[string]string{naming.PauseRolloutAnnotation: naming.LabelValueTrue}),
+			existing: &scyllav1alpha1.RolloutStatus{
+				Generation: 2,
+				Phase:      scyllav1alpha1.RolloutPhaseComplete,
+			},
+			expected: &scyllav1alpha1.RolloutStatus{
+				Generation: 2,
+				Phase:      scyllav1alpha1.RolloutPhaseComplete,
+			},
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := makeRolloutStatus(tc.sdc, tc.existing)
+			if !apiequality.Semantic.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got rollout status differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func TestGetFrozenPartition(t *testing.T) {
+	t.Parallel()
+
+	newStatefulSet := func(replicas, partition, updatedReplicas int32, currentRevision, updateRevision string) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: pointer.Ptr(replicas),
+				UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+					Type: appsv1.RollingUpdateStatefulSetStrategyType,
+					RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{
+						Partition: pointer.Ptr(partition),
+					},
+				},
+			},
+			Status: appsv1.StatefulSetStatus{
+				UpdatedReplicas: updatedReplicas,
+				CurrentRevision: currentRevision,
+				UpdateRevision:  updateRevision,
+			},
+		}
+	}
+
+	tt := []struct {
+		name     string
+		sts      *appsv1.StatefulSet
+		expected int32
+	}{
+		{
+			name:     "rolled out StatefulSet keeps its partition",
+			sts:      newStatefulSet(3, 0, 3, "rev-1", "rev-1"),
+			expected: 0,
+		},
+		{
+			name:     "StatefulSet being rolled out is frozen at the updated replicas",
+			sts:      newStatefulSet(3, 0, 1, "rev-1", "rev-2"),
+			expected: 2,
+		},
+		{
+			name:     "higher partition is kept",
+			sts:      newStatefulSet(3, 3, 1, "rev-1", "rev-2"),
+			expected: 3,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getFrozenPartition(tc.sts)
+			if got != tc.expected {
+				t.Errorf("expected partition %d, got %d", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestEvaluateCanaryQueryResult(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		value    model.Value
+		expected bool
+	}{
+		{
+			name:     "empty vector fails",
+			value:    model.Vector{},
+			expected: false,
+		},
+		{
+			name: "vector with a zero sample fails",
+			value: model.Vector{
+				&model.Sample{Value: 1},
+				&model.Sample{Value: 0},
+			},
+			expected: false,
+		},
+		{
+			name: "vector with non-zero samples passes",
+			value: model.Vector{
+				&model.Sample{Value: 1},
+				&model.Sample{Value: 0.5},
+			},
+			expected: true,
+		},
+		{
+			name:     "non-zero scalar passes",
+			value:    &model.Scalar{Value: 1},
+			expected: true,
+		},
+		{
+			name: "documented query with the bool modifier passes when the comparison holds",
+			// 'sum(rate(scylla_storage_proxy_coordinator_write_errors_local_node[5m])) == bool 0' without errors.
+			value: model.Vector{
+				&model.Sample{Value: 1},
+			},
+			expected: true,
+		},
+		{
+			name: "documented query with the bool modifier fails when the comparison doesn't hold",
+			// 'sum(rate(scylla_storage_proxy_coordinator_write_errors_local_node[5m])) == bool 0' with errors.
+			value: model.Vector{
+				&model.Sample{Value: 0},
+			},
+			expected: false,
+		},
+		{
+			name: "filtering comparison fails even when the comparison holds",
+			// 'sum(rate(scylla_storage_proxy_coordinator_write_errors_local_node[5m])) == 0' without errors.
+			value: model.Vector{
+				&model.Sample{Value: 0},
+			},
+			expected: false,
+		},
+		{
+			name:     "matrix fails",
+			value:    model.Matrix{},
+			expected: false,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := evaluateCanaryQueryResult(tc.value)
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
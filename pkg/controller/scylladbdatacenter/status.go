@@ -134,5 +134,12 @@ func (sdcc *Controller) calculateStatus(sdc *scyllav1alpha1.ScyllaDBDatacenter,
 
 	updateAggregatedStatusFields(status)
 
+	internodeEncryptionStatus, err := sdcc.calculateInternodeEncryptionStatus(sdc, statefulSetMap)
+	if err != nil {
+		klog.ErrorS(err, "Can't calculate internode encryption status", "ScyllaDBDatacenter", klog.KObj(sdc))
+	} else {
+		status.InternodeEncryption = internodeEncryptionStatus
+	}
+
 	return status
 }
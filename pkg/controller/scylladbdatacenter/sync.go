@@ -257,7 +257,7 @@ func (sdcc *Controller) sync(ctx context.Context, key string) error {
 		configControllerDegradedCondition,
 		sdc.Generation,
 		func() ([]metav1.Condition, error) {
-			return sdcc.syncConfigs(ctx, sdc)
+			return sdcc.syncConfigs(ctx, sdc, secretMap)
 		},
 	)
 	if err != nil {
@@ -295,6 +295,19 @@ func (sdcc *Controller) sync(ctx context.Context, key string) error {
 		errs = append(errs, fmt.Errorf("can't sync services: %w", err))
 	}
 
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		scyllaDBVersionControllerProgressingCondition,
+		scyllaDBVersionControllerDegradedCondition,
+		sdc.Generation,
+		func() ([]metav1.Condition, error) {
+			return sdcc.syncDetectedVersion(ctx, sdc, status, statefulSetMap, serviceMap)
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync detected version: %w", err))
+	}
+
 	err = controllerhelpers.RunSync(
 		&status.Conditions,
 		pdbControllerProgressingCondition,
@@ -327,13 +340,26 @@ func (sdcc *Controller) sync(ctx context.Context, key string) error {
 		jobControllerDegradedCondition,
 		sdc.Generation,
 		func() ([]metav1.Condition, error) {
-			return sdcc.syncJobs(ctx, sdc, serviceMap, jobMap)
+			return sdcc.syncJobs(ctx, key, sdc, serviceMap, jobMap)
 		},
 	)
 	if err != nil {
 		errs = append(errs, fmt.Errorf("can't sync jobs: %w", err))
 	}
 
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		keyspaceControllerProgressingCondition,
+		keyspaceControllerDegradedCondition,
+		sdc.Generation,
+		func() ([]metav1.Condition, error) {
+			return sdcc.syncKeyspaces(ctx, key, sdc, status, serviceMap)
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync keyspaces: %w", err))
+	}
+
 	// Aggregate conditions.
 	err = controllerhelpers.SetAggregatedWorkloadConditions(&status.Conditions, sdc.Generation)
 	if err != nil {
@@ -467,5 +467,106 @@ func (sdcc *Controller) syncCerts(
 		))
 	}
 
+	// Setup internode certificates.
+	// Every node gets a dedicated certificate which the sidecar projects into the ScyllaDB container.
+	if isInternodeEncryptionRequested(sdc) {
+		var internodeCertConfigs []*okubecrypto.CertificateConfig
+		var internodeProgressingMessages []string
+		for _, svc := range serviceMap {
+			if svc.Labels[naming.ScyllaServiceTypeLabel] != string(naming.ScyllaServiceTypeMember) {
+				continue
+			}
+
+			var nodeIPAddresses []net.IP
+			if svc.Spec.ClusterIP != corev1.ClusterIPNone && len(svc.Spec.ClusterIP) != 0 {
+				parsedIP, err := helpers.ParseIP(svc.Spec.ClusterIP)
+				if err != nil {
+					return progressingConditions, fmt.Errorf("can't parse Service %q ClusterIP %q: %w", naming.ObjRef(svc), svc.Spec.ClusterIP, err)
+				}
+
+				nodeIPAddresses = append(nodeIPAddresses, parsedIP)
+			}
+
+			pod, err := sdcc.podLister.Pods(sdc.Namespace).Get(svc.Name)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return progressingConditions, fmt.Errorf("can't get Pod %q: %w", naming.ManualRef(sdc.Namespace, svc.Name), err)
+			}
+			if err == nil && len(pod.Status.PodIP) != 0 {
+				parsedIP := net.ParseIP(pod.Status.PodIP)
+				if parsedIP == nil {
+					return progressingConditions, fmt.Errorf("can't parse Pod %q IP %q", naming.ObjRef(pod), pod.Status.PodIP)
+				}
+
+				nodeIPAddresses = append(nodeIPAddresses, parsedIP)
+			}
+
+			if len(nodeIPAddresses) == 0 {
+				internodeProgressingMessages = append(internodeProgressingMessages, fmt.Sprintf("waiting for Service %q or its Pod to have an IP address", naming.ObjRef(svc)))
+			}
+
+			sort.SliceStable(nodeIPAddresses, func(i, j int) bool {
+				return nodeIPAddresses[i].String() < nodeIPAddresses[j].String()
+			})
+
+			internodeCertConfigs = append(internodeCertConfigs, &okubecrypto.CertificateConfig{
+				MetaConfig: okubecrypto.MetaConfig{
+					Name:   naming.GetScyllaDBNodeInternodeCertName(svc.Name),
+					Labels: clusterLabels,
+				},
+				Validity: 30 * 24 * time.Hour,
+				Refresh:  20 * 24 * time.Hour,
+				CertCreator: (&ocrypto.ServingCertCreatorConfig{
+					Subject: pkix.Name{
+						CommonName: "",
+					},
+					IPAddresses: nodeIPAddresses,
+					DNSNames: []string{
+						fmt.Sprintf("%s.%s.svc", svc.Name, svc.Namespace),
+					},
+				}).ToCreator(),
+			})
+		}
+
+		// Make sure certificate configs are always sorted and can be reconciled in a declarative way.
+		sort.SliceStable(internodeCertConfigs, func(i, j int) bool {
+			return internodeCertConfigs[i].Name < internodeCertConfigs[j].Name
+		})
+
+		if len(internodeProgressingMessages) != 0 {
+			sort.Strings(internodeProgressingMessages)
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               certControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             internalapi.ProgressingReason,
+				Message:            strings.Join(internodeProgressingMessages, "\n"),
+				ObservedGeneration: sdc.Generation,
+			})
+		}
+
+		errs = append(errs, cm.ManageCertificates(
+			ctx,
+			time.Now,
+			&sdc.ObjectMeta,
+			scyllaDBDatacenterControllerGVK,
+			&okubecrypto.CAConfig{
+				MetaConfig: okubecrypto.MetaConfig{
+					Name:   naming.GetScyllaClusterLocalInternodeCAName(sdc.Name),
+					Labels: clusterLabels,
+				},
+				Validity: 10 * 365 * 24 * time.Hour,
+				Refresh:  8 * 365 * 24 * time.Hour,
+			},
+			&okubecrypto.CABundleConfig{
+				MetaConfig: okubecrypto.MetaConfig{
+					Name:   naming.GetScyllaClusterLocalInternodeCAName(sdc.Name),
+					Labels: clusterLabels,
+				},
+			},
+			internodeCertConfigs,
+			secrets,
+			configMaps,
+		))
+	}
+
 	return progressingConditions, errors.NewAggregate(errs)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
 	"github.com/scylladb/scylla-operator/pkg/naming"
 	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/errors"
 )
@@ -15,6 +16,7 @@ import (
 func (sdcc *Controller) syncConfigs(
 	ctx context.Context,
 	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	secrets map[string]*corev1.Secret,
 ) ([]metav1.Condition, error) {
 	var errs []error
 	var progressingConditions []metav1.Condition
@@ -32,5 +34,11 @@ func (sdcc *Controller) syncConfigs(
 		return progressingConditions, fmt.Errorf("can't apply configmap %q: %w", naming.ObjRef(cm), err)
 	}
 
+	pcs, err := sdcc.syncManagedAgentConfig(ctx, sdc, secrets)
+	progressingConditions = append(progressingConditions, pcs...)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync managed agent config: %w", err))
+	}
+
 	return progressingConditions, errors.NewAggregate(errs)
 }
@@ -6,11 +6,13 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	scyllav1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1"
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
 	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/maintenancewindow"
 	"github.com/scylladb/scylla-operator/pkg/naming"
 	"github.com/scylladb/scylla-operator/pkg/resourceapply"
 	batchv1 "k8s.io/api/batch/v1"
@@ -22,6 +24,7 @@ import (
 
 func (sdcc *Controller) syncJobs(
 	ctx context.Context,
+	key string,
 	sdc *scyllav1alpha1.ScyllaDBDatacenter,
 	services map[string]*corev1.Service,
 	jobs map[string]*batchv1.Job,
@@ -71,7 +74,20 @@ func (sdcc *Controller) syncJobs(
 		return progressingConditions, nil
 	}
 
+	maintenanceWindowState, err := maintenancewindow.GetState(sdc.Spec.MaintenanceWindows, time.Now())
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't get maintenance window state: %w", err)
+	}
+
+	var pendingJobs []string
 	for _, job := range requiredJobs {
+		// Cleanups that have already started aren't interrupted.
+		_, exists := jobs[job.Name]
+		if !exists && !maintenanceWindowState.Open {
+			pendingJobs = append(pendingJobs, naming.ObjRef(job))
+			continue
+		}
+
 		fresh, changed, err := resourceapply.ApplyJob(ctx, sdcc.kubeClient.BatchV1(), sdcc.jobLister, sdcc.eventRecorder, job, resourceapply.ApplyOptions{})
 		if changed {
 			controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, jobControllerProgressingCondition, job, "apply", sdc.Generation)
@@ -96,5 +112,15 @@ func (sdcc *Controller) syncJobs(
 		return progressingConditions, err
 	}
 
+	if len(pendingJobs) != 0 {
+		progressingConditions = append(progressingConditions, sdcc.deferToMaintenanceWindow(
+			key,
+			sdc,
+			jobControllerProgressingCondition,
+			maintenanceWindowState,
+			fmt.Sprintf("Cleanup Job(s) %s", strings.Join(pendingJobs, ", ")),
+		))
+	}
+
 	return progressingConditions, nil
 }
@@ -0,0 +1,121 @@
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	scyllav1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+	"github.com/scylladb/scylla-operator/pkg/scyllafeatures"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// keyspacesResyncInterval is how often keyspaces are read from the nodes.
+	keyspacesResyncInterval = 5 * time.Minute
+)
+
+// makeKeyspaceStatuses returns statuses of non-system keyspaces sorted by name.
+func makeKeyspaceStatuses(keyspaces []string, tabletsKeyspaces []string) []scyllav1alpha1.KeyspaceStatus {
+	tabletsKeyspacesSet := sets.New(tabletsKeyspaces...)
+
+	statuses := make([]scyllav1alpha1.KeyspaceStatus, 0, len(keyspaces))
+	for _, keyspace := range keyspaces {
+		if isSystemKeyspace(keyspace) {
+			continue
+		}
+
+		replication := scyllav1alpha1.KeyspaceReplicationTypeVnodes
+		if tabletsKeyspacesSet.Has(keyspace) {
+			replication = scyllav1alpha1.KeyspaceReplicationTypeTablets
+		}
+
+		statuses = append(statuses, scyllav1alpha1.KeyspaceStatus{
+			Name:        keyspace,
+			Replication: replication,
+		})
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+
+	return statuses
+}
+
+// getKeyspacesResyncAfter returns how long to wait until keyspaces are due to be resynced.
+func getKeyspacesResyncAfter(status *scyllav1alpha1.ScyllaDBDatacenterStatus, now time.Time) time.Duration {
+	if status.KeyspacesLastSyncTime == nil {
+		return 0
+	}
+
+	return max(status.KeyspacesLastSyncTime.Add(keyspacesResyncInterval).Sub(now), 0)
+}
+
+// syncKeyspaces reflects the replication of keyspaces in the status.
+// Keyspaces are read from the nodes at most once per keyspacesResyncInterval.
+func (sdcc *Controller) syncKeyspaces(
+	ctx context.Context,
+	key string,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
+	services map[string]*corev1.Service,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if !apimeta.IsStatusConditionTrue(sdc.Status.Conditions, scyllav1.AvailableCondition) {
+		// Keep the last known state until the nodes are available.
+		return progressingConditions, nil
+	}
+
+	now := time.Now()
+	resyncAfter := getKeyspacesResyncAfter(status, now)
+	if resyncAfter > 0 {
+		sdcc.queue.AddAfter(key, resyncAfter)
+		return progressingConditions, nil
+	}
+
+	hosts, err := controllerhelpers.GetRequiredScyllaHosts(sdc, services, sdcc.podLister)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't get scylla hosts: %w", err)
+	}
+
+	scyllaClient, err := sdcc.getScyllaClient(ctx, sdc, hosts)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't get scylla client: %w", err)
+	}
+	defer scyllaClient.Close()
+
+	keyspaces, err := scyllaClient.Keyspaces(ctx)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't get keyspaces: %w", err)
+	}
+
+	supportsTablets, err := scyllafeatures.Supports(sdc, scyllafeatures.Tablets)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't determine whether tablets are supported: %w", err)
+	}
+
+	var tabletsKeyspaces []string
+	if supportsTablets {
+		tabletsKeyspaces, err = scyllaClient.KeyspacesWithReplication(ctx, "", scyllaclient.TabletsReplicationType)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't get keyspaces using tablets: %w", err)
+		}
+	}
+
+	klog.V(4).InfoS("Discovered keyspaces", "ScyllaDBDatacenter", klog.KObj(sdc), "Keyspaces", keyspaces, "TabletsKeyspaces", tabletsKeyspaces)
+	status.Keyspaces = makeKeyspaceStatuses(keyspaces, tabletsKeyspaces)
+	status.KeyspacesLastSyncTime = &metav1.Time{Time: now}
+	sdcc.queue.AddAfter(key, keyspacesResyncInterval)
+
+	return progressingConditions, nil
+}
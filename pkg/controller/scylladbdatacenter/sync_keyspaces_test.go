@@ -0,0 +1,112 @@
+package scylladbdatacenter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_makeKeyspaceStatuses(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name             string
+		keyspaces        []string
+		tabletsKeyspaces []string
+		expected         []scyllav1alpha1.KeyspaceStatus
+	}{
+		{
+			name:             "no keyspaces",
+			keyspaces:        nil,
+			tabletsKeyspaces: nil,
+			expected:         []scyllav1alpha1.KeyspaceStatus{},
+		},
+		{
+			name:             "keyspaces are sorted and marked by replication",
+			keyspaces:        []string{"foo", "bar"},
+			tabletsKeyspaces: []string{"foo"},
+			expected: []scyllav1alpha1.KeyspaceStatus{
+				{
+					Name:        "bar",
+					Replication: scyllav1alpha1.KeyspaceReplicationTypeVnodes,
+				},
+				{
+					Name:        "foo",
+					Replication: scyllav1alpha1.KeyspaceReplicationTypeTablets,
+				},
+			},
+		},
+		{
+			name:             "system keyspaces are left out, user keyspaces prefixed with system are kept",
+			keyspaces:        []string{"system", "system_schema", "system_distributed", "foo", "systems_data"},
+			tabletsKeyspaces: nil,
+			expected: []scyllav1alpha1.KeyspaceStatus{
+				{
+					Name:        "foo",
+					Replication: scyllav1alpha1.KeyspaceReplicationTypeVnodes,
+				},
+				{
+					Name:        "systems_data",
+					Replication: scyllav1alpha1.KeyspaceReplicationTypeVnodes,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := makeKeyspaceStatuses(tc.keyspaces, tc.tabletsKeyspaces)
+			if !cmp.Equal(got, tc.expected) {
+				t.Errorf("expected and got keyspace statuses differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func Test_getKeyspacesResyncAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name     string
+		status   *scyllav1alpha1.ScyllaDBDatacenterStatus
+		expected time.Duration
+	}{
+		{
+			name:     "keyspaces were never synced",
+			status:   &scyllav1alpha1.ScyllaDBDatacenterStatus{},
+			expected: 0,
+		},
+		{
+			name: "keyspaces were synced recently",
+			status: &scyllav1alpha1.ScyllaDBDatacenterStatus{
+				KeyspacesLastSyncTime: &metav1.Time{Time: now.Add(-time.Minute)},
+			},
+			expected: 4 * time.Minute,
+		},
+		{
+			name: "resync interval elapsed",
+			status: &scyllav1alpha1.ScyllaDBDatacenterStatus{
+				KeyspacesLastSyncTime: &metav1.Time{Time: now.Add(-time.Hour)},
+			},
+			expected: 0,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getKeyspacesResyncAfter(tc.status, now)
+			if got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -229,6 +229,26 @@ func (sdcc *Controller) syncServices(
 		}
 	}
 
+	// Remove members that are being decommissioned but can't decommission themselves.
+	for _, svc := range services {
+		if svc.Labels[naming.DecommissionedLabel] != naming.LabelValueFalse {
+			continue
+		}
+
+		pcs, err := sdcc.removeUnavailableNode(ctx, sdc, svc, services)
+		progressingConditions = append(progressingConditions, pcs...)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't remove unavailable node %q: %w", naming.ObjRef(svc), err)
+		}
+	}
+
+	// Move members to the storage class of their rack.
+	pcs, err := sdcc.syncStorageMigrations(ctx, sdc, status, services, statefulSets)
+	progressingConditions = append(progressingConditions, pcs...)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't sync storage migrations: %w", err)
+	}
+
 	// Replace members.
 	for _, svc := range services {
 		_, ok := svc.Labels[naming.ReplaceLabel]
@@ -247,7 +267,7 @@ func (sdcc *Controller) syncServices(
 		}
 
 		klog.V(4).InfoS("Replacing node using HostID", "ScyllaDBDatacenter", klog.KObj(sdc), "Service", klog.KObj(svc))
-		pcs, err := sdcc.replaceNodeUsingHostID(ctx, sdc, svc)
+		pcs, err := sdcc.replaceNodeUsingHostID(ctx, sdc, svc, services)
 		if err != nil {
 			return progressingConditions, fmt.Errorf("can't replace node using Host ID: %w", err)
 		}
@@ -258,7 +278,7 @@ func (sdcc *Controller) syncServices(
 	return progressingConditions, nil
 }
 
-func (sdcc *Controller) replaceNodeUsingHostID(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, svc *corev1.Service) ([]metav1.Condition, error) {
+func (sdcc *Controller) replaceNodeUsingHostID(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, svc *corev1.Service, services map[string]*corev1.Service) ([]metav1.Condition, error) {
 	var progressingConditions []metav1.Condition
 
 	nodeHostID, ok := svc.Annotations[naming.HostIDAnnotation]
@@ -281,7 +301,7 @@ func (sdcc *Controller) replaceNodeUsingHostID(ctx context.Context, sdc *scyllav
 		}
 		progressingConditions = append(progressingConditions, pcs...)
 	} else {
-		pcs, err := sdcc.finishOngoingReplaceNodeUsingHostID(ctx, sdc, svc, nodeHostID, replacingNodeHostID)
+		pcs, err := sdcc.finishOngoingReplaceNodeUsingHostID(ctx, sdc, svc, services, nodeHostID, replacingNodeHostID)
 		if err != nil {
 			return progressingConditions, fmt.Errorf("can't finish replace using HostID: %w", err)
 		}
@@ -329,7 +349,7 @@ func (sdcc *Controller) initializeReplaceNodeUsingHostID(ctx context.Context, sd
 	return progressingConditions, nil
 }
 
-func (sdcc *Controller) finishOngoingReplaceNodeUsingHostID(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, svc *corev1.Service, nodeHostID, replacingNodeHostID string) ([]metav1.Condition, error) {
+func (sdcc *Controller) finishOngoingReplaceNodeUsingHostID(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, svc *corev1.Service, services map[string]*corev1.Service, nodeHostID, replacingNodeHostID string) ([]metav1.Condition, error) {
 	klog.V(4).InfoS("Node is being replaced, awaiting node to become ready", "ScyllaDBDatacenter", klog.KObj(sdc), "Service", klog.KObj(svc))
 
 	var progressingConditions []metav1.Condition
@@ -361,6 +381,17 @@ func (sdcc *Controller) finishOngoingReplaceNodeUsingHostID(ctx context.Context,
 			"NodeHostID", nodeHostID,
 			"ReplacingNodeHostID", replacingNodeHostID,
 		)
+
+		// With Raft topology, the replace is driven by the topology coordinator, which reports its progress.
+		tracked, pcs, err := sdcc.trackReplace(ctx, sdc, svc, services, replacingNodeHostID)
+		progressingConditions = append(progressingConditions, pcs...)
+		if err != nil {
+			return progressingConditions, err
+		}
+		if tracked {
+			return progressingConditions, nil
+		}
+
 		progressingConditions = append(progressingConditions, metav1.Condition{
 			Type:               serviceControllerProgressingCondition,
 			Status:             metav1.ConditionTrue,
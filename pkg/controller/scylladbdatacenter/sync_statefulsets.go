@@ -13,11 +13,11 @@ import (
 	"github.com/scylladb/scylla-operator/pkg/helpers"
 	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
 	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/maintenancewindow"
 	"github.com/scylladb/scylla-operator/pkg/naming"
 	"github.com/scylladb/scylla-operator/pkg/pointer"
 	"github.com/scylladb/scylla-operator/pkg/resourceapply"
 	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
-	"github.com/scylladb/scylla-operator/pkg/util/hash"
 	"github.com/scylladb/scylla-operator/pkg/util/parallel"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
@@ -38,15 +38,30 @@ func snapshotTag(prefix string, t time.Time) string {
 	return fmt.Sprintf("so_%s_%sUTC", prefix, t.UTC().Format(time.RFC3339))
 }
 
-func (sdcc *Controller) makeRacks(sdc *scyllav1alpha1.ScyllaDBDatacenter, statefulSets map[string]*appsv1.StatefulSet, inputsHash string) ([]*appsv1.StatefulSet, error) {
+func (sdcc *Controller) makeRacks(sdc *scyllav1alpha1.ScyllaDBDatacenter, statefulSets map[string]*appsv1.StatefulSet, managedConfigData map[string]string) ([]*appsv1.StatefulSet, error) {
+	_, managedAgentConfigRendered, err := sdcc.getManagedAgentConfigHash(sdc)
+	if err != nil {
+		return nil, err
+	}
+
 	sets := make([]*appsv1.StatefulSet, 0, len(sdc.Spec.Racks))
 	for i, rack := range sdc.Spec.Racks {
 		oldSts := statefulSets[naming.StatefulSetNameForRack(rack, sdc)]
-		sts, err := StatefulSetForRack(rack, sdc, oldSts, sdcc.operatorImage, i, inputsHash)
+		inputsHash, baselineCustomConfigHash, err := sdcc.makeRackInputsHash(sdc, rack, managedConfigData, oldSts)
+		if err != nil {
+			return nil, fmt.Errorf("can't make inputs hash for rack %q: %w", rack.Name, err)
+		}
+
+		sts, err := StatefulSetForRack(rack, sdc, oldSts, sdcc.operatorImage, i, inputsHash, managedAgentConfigRendered)
 		if err != nil {
 			return nil, err
 		}
 
+		if sts.Annotations == nil {
+			sts.Annotations = map[string]string{}
+		}
+		sts.Annotations[naming.CustomConfigBaselineHashAnnotation] = baselineCustomConfigHash
+
 		sets = append(sets, sts)
 	}
 	return sets, nil
@@ -393,14 +408,17 @@ func (sdcc *Controller) pruneStatefulSets(
 }
 
 // createMissingStatefulSets creates missing StatefulSets.
+// Creating a StatefulSet scales out the datacenter, so it waits for a maintenance window.
 // It return true if done and an error.
 func (sdcc *Controller) createMissingStatefulSets(
 	ctx context.Context,
+	key string,
 	sdc *scyllav1alpha1.ScyllaDBDatacenter,
 	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
 	requiredStatefulSets []*appsv1.StatefulSet,
 	statefulSets map[string]*appsv1.StatefulSet,
 	services map[string]*corev1.Service,
+	maintenanceWindowState maintenancewindow.State,
 ) ([]metav1.Condition, error) {
 	var errs []error
 	var progressingConditions []metav1.Condition
@@ -409,6 +427,17 @@ func (sdcc *Controller) createMissingStatefulSets(
 		// Check the adopted set.
 		sts, found := statefulSets[req.Name]
 		if !found {
+			if !maintenanceWindowState.Open {
+				progressingConditions = append(progressingConditions, sdcc.deferToMaintenanceWindow(
+					key,
+					sdc,
+					statefulSetControllerProgressingCondition,
+					maintenanceWindowState,
+					fmt.Sprintf("Creating StatefulSet %q", naming.ObjRef(req)),
+				))
+				return progressingConditions, utilerrors.NewAggregate(errs)
+			}
+
 			klog.V(2).InfoS("Creating missing StatefulSet", "StatefulSet", klog.KObj(req))
 			var changed bool
 			var err error
@@ -496,12 +525,7 @@ func (sdcc *Controller) syncStatefulSets(
 		return progressingConditions, nil
 	}
 
-	inputsHash, err := hash.HashObjects(managedScyllaDBConfigCM.Data)
-	if err != nil {
-		return progressingConditions, fmt.Errorf("can't hash inputs: %w", err)
-	}
-
-	requiredStatefulSets, err := sdcc.makeRacks(sdc, statefulSets, inputsHash)
+	requiredStatefulSets, err := sdcc.makeRacks(sdc, statefulSets, managedScyllaDBConfigCM.Data)
 	if err != nil {
 		sdcc.eventRecorder.Eventf(
 			sdc,
@@ -512,6 +536,43 @@ func (sdcc *Controller) syncStatefulSets(
 		return progressingConditions, err
 	}
 
+	storageProgressingConditions, err := sdcc.syncStorageExpansion(ctx, sdc, requiredStatefulSets, statefulSets)
+	progressingConditions = append(progressingConditions, storageProgressingConditions...)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't expand storage: %w", err)
+	}
+
+	var ongoingUpgradeContext *internalapi.DatacenterUpgradeContext
+	if cm, ok := configMaps[naming.UpgradeContextConfigMapName(sdc)]; ok {
+		ongoingUpgradeContext, err = sdcc.decodeUpgradeContext(cm)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't decode upgrade context for ScyllaDBDatacenter %q: %w", naming.ObjRef(sdc), err)
+		}
+
+		if ongoingUpgradeContext.State == internalapi.RollbackUpgradePhase && ongoingUpgradeContext.Rollback != nil {
+			status.UpgradeRollback = makeUpgradeRollbackStatus(ongoingUpgradeContext, status.UpgradeRollback, services)
+		}
+	}
+
+	// A rolled back upgrade can be retried by changing the image.
+	if status.UpgradeRollback != nil &&
+		status.UpgradeRollback.Phase == scyllav1alpha1.UpgradeRollbackPhaseCompleted &&
+		status.UpgradeRollback.ToImage != sdc.Spec.ScyllaDB.Image {
+		status.UpgradeRollback = nil
+	}
+
+	err = overrideImageForUpgradeRollback(sdc, status, requiredStatefulSets)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't keep image of rolled back upgrade: %w", err)
+	}
+
+	status.Rollout = makeRolloutStatus(sdc, status.Rollout)
+
+	maintenanceWindowState, err := maintenancewindow.GetState(sdc.Spec.MaintenanceWindows, time.Now())
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't get maintenance window state: %w", err)
+	}
+
 	// Delete any excessive StatefulSets.
 	// Delete has to be the first action to avoid getting stuck on quota.
 	pruneProgressingConditions, err := sdcc.pruneStatefulSets(ctx, sdc, status, requiredStatefulSets, statefulSets)
@@ -522,7 +583,7 @@ func (sdcc *Controller) syncStatefulSets(
 
 	// Before any update, make sure all StatefulSets are present.
 	// Create any that are missing.
-	createProgressingConditions, err := sdcc.createMissingStatefulSets(ctx, sdc, status, requiredStatefulSets, statefulSets, services)
+	createProgressingConditions, err := sdcc.createMissingStatefulSets(ctx, key, sdc, status, requiredStatefulSets, statefulSets, services, maintenanceWindowState)
 	progressingConditions = append(progressingConditions, createProgressingConditions...)
 	if err != nil {
 		return progressingConditions, fmt.Errorf("can't create StatefulSet(s): %w", err)
@@ -603,6 +664,20 @@ func (sdcc *Controller) syncStatefulSets(
 			continue
 		}
 
+		// Decommissions that have already started aren't interrupted.
+		lastSvc, lastSvcFound := rackServices[fmt.Sprintf("%s-%d", sts.Name, *sts.Spec.Replicas-1)]
+		decommissionStarted := scale.Spec.Replicas < *sts.Spec.Replicas && lastSvcFound && len(lastSvc.Labels[naming.DecommissionedLabel]) != 0
+		if !maintenanceWindowState.Open && !decommissionStarted {
+			progressingConditions = append(progressingConditions, sdcc.deferToMaintenanceWindow(
+				key,
+				sdc,
+				statefulSetControllerProgressingCondition,
+				maintenanceWindowState,
+				fmt.Sprintf("Scaling StatefulSet %q from %d to %d replicas", naming.ObjRef(sts), *sts.Spec.Replicas, scale.Spec.Replicas),
+			))
+			return progressingConditions, nil
+		}
+
 		if scale.Spec.Replicas < *sts.Spec.Replicas {
 			// Make sure we always scale down by 1 member.
 			scale.Spec.Replicas = *sts.Spec.Replicas - 1
@@ -647,6 +722,46 @@ func (sdcc *Controller) syncStatefulSets(
 		return progressingConditions, err
 	}
 
+	// Failed upgrades never finish rolling out, so they have to be rolled back before waiting for the rollout.
+	if ongoingUpgradeContext != nil {
+		switch ongoingUpgradeContext.State {
+		case internalapi.RollbackUpgradePhase:
+			if ongoingUpgradeContext.Rollback == nil {
+				return progressingConditions, fmt.Errorf("upgrade context of ScyllaDBDatacenter %q is missing rollback details", naming.ObjRef(sdc))
+			}
+
+			pcs, err := sdcc.rollBackUpgrade(ctx, sdc, status, ongoingUpgradeContext, requiredStatefulSets, statefulSets, services)
+			progressingConditions = append(progressingConditions, pcs...)
+			if err != nil {
+				return progressingConditions, fmt.Errorf("can't roll back upgrade: %w", err)
+			}
+
+			return progressingConditions, nil
+
+		case internalapi.PreHooksUpgradePhase, internalapi.RolloutInitUpgradePhase, internalapi.RolloutRunUpgradePhase:
+			started, pcs, err := sdcc.startUpgradeRollbackIfNeeded(ctx, key, sdc, statefulSets, ongoingUpgradeContext)
+			progressingConditions = append(progressingConditions, pcs...)
+			if err != nil {
+				return progressingConditions, err
+			}
+			if started {
+				return progressingConditions, nil
+			}
+		}
+	}
+
+	// Paused and aborted rollouts have to be frozen before waiting for the rollout, the nodes being updated may never become ready.
+	if ongoingUpgradeContext == nil && status.Rollout != nil &&
+		(status.Rollout.Phase == scyllav1alpha1.RolloutPhasePaused || status.Rollout.Phase == scyllav1alpha1.RolloutPhaseAborted) {
+		pcs, err := sdcc.freezeRollout(ctx, sdc, status.Rollout, requiredStatefulSets, statefulSets)
+		progressingConditions = append(progressingConditions, pcs...)
+		if err != nil {
+			return progressingConditions, err
+		}
+
+		return progressingConditions, nil
+	}
+
 	// TODO: This blocks unstucking by an update.
 	//  	 Also blocks lowering resources when the cluster is running low.
 	// Wait for all racks to be up and ready.
@@ -712,6 +827,29 @@ func (sdcc *Controller) syncStatefulSets(
 		klog.V(4).InfoS("Upgrade is in progress", "Phase", currentUpgradeContext.State)
 		switch currentUpgradeContext.State {
 		case internalapi.PreHooksUpgradePhase:
+			checks, err := sdcc.runPreUpgradeChecks(ctx, sdc, services)
+			if err != nil {
+				return progressingConditions, fmt.Errorf("can't run pre-upgrade checks: %w", err)
+			}
+
+			failedChecksMessage := failedUpgradeChecksMessage(checks)
+			if len(failedChecksMessage) != 0 {
+				klog.V(2).InfoS("Upgrade is blocked by failed pre-upgrade checks", "ScyllaDBDatacenter", klog.KObj(sdc), "FailedChecks", failedChecksMessage)
+				progressingConditions = append(progressingConditions, metav1.Condition{
+					Type:               statefulSetControllerProgressingCondition,
+					Status:             metav1.ConditionTrue,
+					Reason:             "UpgradeBlocked",
+					Message:            fmt.Sprintf("Upgrade from %q to %q is blocked by failed pre-upgrade checks: %s.", currentUpgradeContext.FromVersion, currentUpgradeContext.ToVersion, failedChecksMessage),
+					ObservedGeneration: sdc.Generation,
+				})
+				sdcc.queue.AddAfter(key, 5*time.Second)
+				return progressingConditions, nil
+			}
+
+			if currentUpgradeContext.Plan != nil {
+				mergeUpgradeChecks(currentUpgradeContext.Plan, checks)
+			}
+
 			// TODO: Move the pre-upgrade hook into a Job.
 			done, err := sdcc.beforeUpgrade(ctx, sdc, services, currentUpgradeContext)
 			if err != nil {
@@ -961,6 +1099,7 @@ func (sdcc *Controller) syncStatefulSets(
 	}
 
 	// Begin the update.
+	var pendingRollouts []string
 	anyStsChanged := false
 	defer func() {
 		if anyStsChanged {
@@ -987,6 +1126,26 @@ func (sdcc *Controller) syncStatefulSets(
 
 				if requiredVersion.Major != existingVersion.Major ||
 					requiredVersion.Minor != existingVersion.Minor {
+					upgradePathWarning, err := validateUpgradePath(existingVersionString, requiredVersionString)
+					if err != nil {
+						sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeWarning, "UpgradeBlocked", "Upgrade from %q to %q is blocked: %v", existingVersionString, requiredVersionString, err)
+						return progressingConditions, fmt.Errorf("can't upgrade from %q to %q: %w", existingVersionString, requiredVersionString, err)
+					}
+					if len(upgradePathWarning) != 0 {
+						sdcc.eventRecorder.Event(sdc, corev1.EventTypeWarning, "UnknownUpgradePath", upgradePathWarning)
+					}
+
+					if !maintenanceWindowState.Open {
+						progressingConditions = append(progressingConditions, sdcc.deferToMaintenanceWindow(
+							key,
+							sdc,
+							statefulSetControllerProgressingCondition,
+							maintenanceWindowState,
+							fmt.Sprintf("Upgrade from %q to %q", existingVersionString, requiredVersionString),
+						))
+						return progressingConditions, nil
+					}
+
 					// We need to run hooks for version upgrades.
 					sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "UpgradeStarted", "Version changed from %q to %q", existingVersionString, requiredVersionString)
 
@@ -1005,8 +1164,11 @@ func (sdcc *Controller) syncStatefulSets(
 						State:             internalapi.PreHooksUpgradePhase,
 						FromVersion:       existingVersionString,
 						ToVersion:         requiredVersionString,
+						FromImage:         getScyllaDBImage(&existing.Spec.Template.Spec),
+						ToImage:           getScyllaDBImage(&required.Spec.Template.Spec),
 						SystemSnapshotTag: snapshotTag("system", now),
 						DataSnapshotTag:   snapshotTag("data", now),
+						Plan:              makeUpgradePlan(requiredStatefulSets, statefulSets),
 					})
 					if err != nil {
 						return progressingConditions, fmt.Errorf("can't make upgrade context ConfigMap: %w", err)
@@ -1025,6 +1187,16 @@ func (sdcc *Controller) syncStatefulSets(
 			}
 		}
 
+		// Rollout strategies drive the update through the partition.
+		if upgradeContextConfigMap == nil && status.Rollout != nil {
+			required.Spec.UpdateStrategy.RollingUpdate.Partition = pointer.Ptr(getRolloutPartition(status.Rollout.Phase, required))
+		}
+
+		// Outside of maintenance windows, changes are applied but not rolled out to any more Pods.
+		if upgradeContextConfigMap == nil && !maintenanceWindowState.Open && existingFound {
+			required.Spec.UpdateStrategy.RollingUpdate.Partition = pointer.Ptr(getMaintenanceWindowPartition(existing))
+		}
+
 		updatedSts, changed, err := resourceapply.ApplyStatefulSet(ctx, sdcc.kubeClient.AppsV1(), sdcc.statefulSetLister, sdcc.eventRecorder, required, resourceapply.ApplyOptions{})
 		if err != nil {
 			return progressingConditions, fmt.Errorf("can't apply statefulset update: %w", err)
@@ -1070,6 +1242,46 @@ func (sdcc *Controller) syncStatefulSets(
 			})
 			return progressingConditions, nil
 		}
+
+		if upgradeContextConfigMap == nil && !maintenanceWindowState.Open {
+			if updatedSts.Status.UpdateRevision != updatedSts.Status.CurrentRevision {
+				pendingRollouts = append(pendingRollouts, naming.ObjRef(updatedSts))
+			}
+
+			continue
+		}
+
+		// Only the first rack with pending changes has a canary node.
+		if upgradeContextConfigMap == nil &&
+			status.Rollout != nil &&
+			status.Rollout.Phase == scyllav1alpha1.RolloutPhaseCanary &&
+			*updatedSts.Spec.Replicas > 0 &&
+			updatedSts.Status.UpdateRevision != updatedSts.Status.CurrentRevision {
+			pcs, err := sdcc.verifyCanary(ctx, key, sdc, status.Rollout, updatedSts)
+			progressingConditions = append(progressingConditions, pcs...)
+			if err != nil {
+				return progressingConditions, err
+			}
+
+			// Partitions of all racks have to be updated once the canary passes.
+			return progressingConditions, nil
+		}
+	}
+
+	if len(pendingRollouts) != 0 {
+		progressingConditions = append(progressingConditions, sdcc.deferToMaintenanceWindow(
+			key,
+			sdc,
+			statefulSetControllerProgressingCondition,
+			maintenanceWindowState,
+			fmt.Sprintf("Rollout of StatefulSet(s) %s", strings.Join(pendingRollouts, ", ")),
+		))
+		return progressingConditions, nil
+	}
+
+	if upgradeContextConfigMap == nil && status.Rollout != nil && status.Rollout.Phase != scyllav1alpha1.RolloutPhaseComplete {
+		status.Rollout.Phase = scyllav1alpha1.RolloutPhaseComplete
+		status.Rollout.Message = "Changes were rolled out to all nodes."
 	}
 
 	return progressingConditions, nil
@@ -0,0 +1,254 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+func getDataPVCTemplate(sts *appsv1.StatefulSet) (*corev1.PersistentVolumeClaim, bool) {
+	_, i, ok := slices.Find(sts.Spec.VolumeClaimTemplates, func(pvc corev1.PersistentVolumeClaim) bool {
+		return pvc.Name == naming.PVCTemplateName
+	})
+	if !ok {
+		return nil, false
+	}
+
+	return &sts.Spec.VolumeClaimTemplates[i], true
+}
+
+// isPVCExpanded returns whether the volume and the filesystem of the PVC have been expanded to the capacity.
+func isPVCExpanded(pvc *corev1.PersistentVolumeClaim, capacity resource.Quantity) bool {
+	statusCapacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]
+	if !ok || statusCapacity.Cmp(capacity) < 0 {
+		return false
+	}
+
+	for _, c := range pvc.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		if c.Type == corev1.PersistentVolumeClaimResizing || c.Type == corev1.PersistentVolumeClaimFileSystemResizePending {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasPVCsBelowCapacity returns whether any existing member PVC of the StatefulSet requests less than the capacity.
+func (sdcc *Controller) hasPVCsBelowCapacity(sts *appsv1.StatefulSet, capacity resource.Quantity) (bool, error) {
+	var replicas int32
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+
+	for ordinal := range replicas {
+		pvcName := naming.PVCNameForPod(fmt.Sprintf("%s-%d", sts.Name, ordinal))
+		pvc, err := sdcc.pvcLister.PersistentVolumeClaims(sts.Namespace).Get(pvcName)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return false, fmt.Errorf("can't get PVC %q: %w", naming.ManualRef(sts.Namespace, pvcName), err)
+		}
+
+		request := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		if request.Cmp(capacity) < 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// syncStorageExpansion expands member PVCs of racks which storage capacity was increased.
+// Volume claim templates of StatefulSets are immutable, so until all PVCs of a rack are expanded,
+// the required StatefulSet keeps the existing templates. Once they are, the StatefulSet is recreated
+// with orphan deletion to match the new capacity, without disrupting its Pods.
+// PVCs created from the previous templates in the meantime, e.g. by scaling out, are expanded
+// after the StatefulSet was recreated.
+func (sdcc *Controller) syncStorageExpansion(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	requiredStatefulSets []*appsv1.StatefulSet,
+	statefulSets map[string]*appsv1.StatefulSet,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	for _, required := range requiredStatefulSets {
+		existing, ok := statefulSets[required.Name]
+		if !ok {
+			continue
+		}
+
+		requiredTemplate, ok := getDataPVCTemplate(required)
+		if !ok {
+			continue
+		}
+		existingTemplate, ok := getDataPVCTemplate(existing)
+		if !ok {
+			continue
+		}
+
+		// Nodes moved to another storage class get new volumes of the required capacity.
+		if !equality.Semantic.DeepEqual(requiredTemplate.Spec.StorageClassName, existingTemplate.Spec.StorageClassName) {
+			continue
+		}
+
+		requiredCapacity := requiredTemplate.Spec.Resources.Requests[corev1.ResourceStorage]
+		existingCapacity := existingTemplate.Spec.Resources.Requests[corev1.ResourceStorage]
+		switch requiredCapacity.Cmp(existingCapacity) {
+		case 0:
+			belowCapacity, err := sdcc.hasPVCsBelowCapacity(existing, requiredCapacity)
+			if err != nil {
+				return progressingConditions, err
+			}
+			if !belowCapacity {
+				continue
+			}
+
+			_, rackProgressingConditions, err := sdcc.expandStatefulSetPVCs(ctx, sdc, existing, requiredCapacity)
+			progressingConditions = append(progressingConditions, rackProgressingConditions...)
+			if err != nil {
+				return progressingConditions, err
+			}
+			continue
+		case -1:
+			// Volumes can't be shrunk, validation doesn't allow it either.
+			required.Spec.VolumeClaimTemplates = existing.Spec.VolumeClaimTemplates
+			continue
+		}
+
+		expanded, rackProgressingConditions, err := sdcc.expandStatefulSetPVCs(ctx, sdc, existing, requiredCapacity)
+		progressingConditions = append(progressingConditions, rackProgressingConditions...)
+		if err != nil {
+			return progressingConditions, err
+		}
+
+		if !expanded {
+			required.Spec.VolumeClaimTemplates = existing.Spec.VolumeClaimTemplates
+			continue
+		}
+
+		klog.V(2).InfoS("PVCs of StatefulSet have been expanded", "ScyllaDBDatacenter", klog.KObj(sdc), "StatefulSet", klog.KObj(existing), "Capacity", requiredCapacity.String())
+	}
+
+	return progressingConditions, nil
+}
+
+// expandStatefulSetPVCs requests the capacity for all member PVCs of the StatefulSet and returns whether all of them
+// have been expanded.
+func (sdcc *Controller) expandStatefulSetPVCs(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	sts *appsv1.StatefulSet,
+	capacity resource.Quantity,
+) (bool, []metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	var replicas int32
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+
+	expanded := true
+	for ordinal := range replicas {
+		pvcName := naming.PVCNameForPod(fmt.Sprintf("%s-%d", sts.Name, ordinal))
+		pvc, err := sdcc.pvcLister.PersistentVolumeClaims(sts.Namespace).Get(pvcName)
+		if apierrors.IsNotFound(err) {
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               statefulSetControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForPVC",
+				Message:            fmt.Sprintf("Waiting for PVC %q to be created before expanding it.", naming.ManualRef(sts.Namespace, pvcName)),
+				ObservedGeneration: sdc.Generation,
+			})
+			expanded = false
+			continue
+		}
+		if err != nil {
+			return false, progressingConditions, fmt.Errorf("can't get PVC %q: %w", naming.ManualRef(sts.Namespace, pvcName), err)
+		}
+
+		if pvc.Spec.StorageClassName == nil {
+			return false, progressingConditions, fmt.Errorf("PVC %q has no storage class", naming.ObjRef(pvc))
+		}
+
+		storageClass, err := sdcc.storageClassLister.Get(*pvc.Spec.StorageClassName)
+		if err != nil {
+			return false, progressingConditions, fmt.Errorf("can't get StorageClass %q of PVC %q: %w", *pvc.Spec.StorageClassName, naming.ObjRef(pvc), err)
+		}
+
+		if storageClass.AllowVolumeExpansion == nil || !*storageClass.AllowVolumeExpansion {
+			sdcc.eventRecorder.Eventf(
+				sdc,
+				corev1.EventTypeWarning,
+				"VolumeExpansionNotAllowed",
+				"StorageClass %q of PVC %q doesn't allow volume expansion",
+				storageClass.Name,
+				naming.ObjRef(pvc),
+			)
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               statefulSetControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "VolumeExpansionNotAllowed",
+				Message:            fmt.Sprintf("Can't expand PVC %q because StorageClass %q doesn't allow volume expansion.", naming.ObjRef(pvc), storageClass.Name),
+				ObservedGeneration: sdc.Generation,
+			})
+			return false, progressingConditions, nil
+		}
+
+		request := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		if request.Cmp(capacity) < 0 {
+			pvcCopy := pvc.DeepCopy()
+			if pvcCopy.Spec.Resources.Requests == nil {
+				pvcCopy.Spec.Resources.Requests = corev1.ResourceList{}
+			}
+			pvcCopy.Spec.Resources.Requests[corev1.ResourceStorage] = capacity
+
+			_, err = sdcc.kubeClient.CoreV1().PersistentVolumeClaims(pvcCopy.Namespace).Update(ctx, pvcCopy, metav1.UpdateOptions{})
+			if err != nil {
+				return false, progressingConditions, fmt.Errorf("can't expand PVC %q: %w", naming.ObjRef(pvc), err)
+			}
+
+			sdcc.eventRecorder.Eventf(
+				sdc,
+				corev1.EventTypeNormal,
+				"VolumeExpansionRequested",
+				"Requested expansion of PVC %q from %s to %s",
+				naming.ObjRef(pvc),
+				request.String(),
+				capacity.String(),
+			)
+			expanded = false
+			continue
+		}
+
+		if !isPVCExpanded(pvc, capacity) {
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               statefulSetControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForVolumeExpansion",
+				Message:            fmt.Sprintf("Waiting for PVC %q to be expanded to %s.", naming.ObjRef(pvc), capacity.String()),
+				ObservedGeneration: sdc.Generation,
+			})
+			expanded = false
+		}
+	}
+
+	return expanded, progressingConditions, nil
+}
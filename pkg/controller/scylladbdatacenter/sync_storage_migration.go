@@ -0,0 +1,248 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+func getRackStorageClassName(sdc *scyllav1alpha1.ScyllaDBDatacenter, rack scyllav1alpha1.RackSpec) *string {
+	rack = applyRackTemplateOnRackSpec(sdc.Spec.RackTemplate, rack)
+	if rack.ScyllaDB == nil || rack.ScyllaDB.Storage == nil {
+		return nil
+	}
+
+	return rack.ScyllaDB.Storage.StorageClassName
+}
+
+// resolveStorageMigrationPhase returns the phase of a storage migration that isn't complete,
+// taking the pause and abort annotations into account.
+// The abort annotation names the storage class of the migration, so a leftover annotation doesn't abort later migrations.
+func resolveStorageMigrationPhase(sdc *scyllav1alpha1.ScyllaDBDatacenter, phase scyllav1alpha1.StorageMigrationPhase, storageClassName string) (scyllav1alpha1.StorageMigrationPhase, string) {
+	switch {
+	case phase == scyllav1alpha1.StorageMigrationPhaseAborted:
+		return phase, "Storage migration was aborted. No more nodes are replaced until the storage class changes."
+
+	case sdc.Annotations[naming.AbortStorageMigrationAnnotation] == storageClassName:
+		return scyllav1alpha1.StorageMigrationPhaseAborted, "Storage migration was aborted. No more nodes are replaced until the storage class changes."
+
+	case sdc.Annotations[naming.PauseStorageMigrationAnnotation] == naming.LabelValueTrue:
+		return scyllav1alpha1.StorageMigrationPhasePaused, "Storage migration is paused."
+
+	default:
+		return scyllav1alpha1.StorageMigrationPhaseProgressing, ""
+	}
+}
+
+func makeStorageMigrationProgressingCondition(sdc *scyllav1alpha1.ScyllaDBDatacenter, reason, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               serviceControllerProgressingCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: sdc.Generation,
+	}
+}
+
+// syncStorageMigrations moves nodes of racks which storage class was changed onto volumes of the new storage class.
+// Nodes are replaced one at a time, across the whole datacenter, using the host ID based replace procedure.
+// Replaced nodes get a new PVC from the volume claim template of the recreated StatefulSet.
+func (sdcc *Controller) syncStorageMigrations(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
+	services map[string]*corev1.Service,
+	statefulSets map[string]*appsv1.StatefulSet,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	replacing := false
+	for _, svc := range services {
+		if _, ok := svc.Labels[naming.ReplaceLabel]; ok {
+			replacing = true
+			break
+		}
+	}
+
+	var storageMigrations []scyllav1alpha1.StorageMigrationStatus
+	for _, rack := range sdc.Spec.Racks {
+		storageClassName := getRackStorageClassName(sdc, rack)
+		if storageClassName == nil {
+			continue
+		}
+
+		sts, ok := statefulSets[naming.StatefulSetNameForRack(rack, sdc)]
+		if !ok {
+			continue
+		}
+
+		var nodes int32
+		if sts.Spec.Replicas != nil {
+			nodes = *sts.Spec.Replicas
+		}
+
+		var migratedNodes int32
+		var pendingNodes []string
+		for ordinal := range nodes {
+			svcName := naming.MemberServiceName(rack, sdc, int(ordinal))
+			pvcName := naming.PVCNameForService(svcName)
+			pvc, err := sdcc.pvcLister.PersistentVolumeClaims(sdc.Namespace).Get(pvcName)
+			if apierrors.IsNotFound(err) {
+				// The PVC is being recreated.
+				continue
+			}
+			if err != nil {
+				return progressingConditions, fmt.Errorf("can't get PVC %q: %w", naming.ManualRef(sdc.Namespace, pvcName), err)
+			}
+
+			if pvc.DeletionTimestamp != nil {
+				continue
+			}
+
+			if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName == *storageClassName {
+				migratedNodes++
+				continue
+			}
+
+			pendingNodes = append(pendingNodes, svcName)
+		}
+
+		storageMigration, _, ok := slices.Find(status.StorageMigrations, func(sm scyllav1alpha1.StorageMigrationStatus) bool {
+			return sm.Rack == rack.Name
+		})
+		if !ok || storageMigration.StorageClassName != *storageClassName {
+			if len(pendingNodes) == 0 {
+				continue
+			}
+
+			klog.V(2).InfoS("Starting storage migration", "ScyllaDBDatacenter", klog.KObj(sdc), "Rack", rack.Name, "StorageClass", *storageClassName)
+			storageMigration = scyllav1alpha1.StorageMigrationStatus{
+				Rack:             rack.Name,
+				StorageClassName: *storageClassName,
+				Phase:            scyllav1alpha1.StorageMigrationPhaseProgressing,
+				StartTime:        metav1.Now(),
+			}
+		}
+		storageMigration.Nodes = nodes
+		storageMigration.MigratedNodes = migratedNodes
+
+		if migratedNodes == nodes {
+			if storageMigration.Phase != scyllav1alpha1.StorageMigrationPhaseComplete {
+				storageMigration.Phase = scyllav1alpha1.StorageMigrationPhaseComplete
+				storageMigration.Message = fmt.Sprintf("All nodes use volumes of StorageClass %q.", *storageClassName)
+				storageMigration.CurrentNode = ""
+				storageMigration.CompletionTime = pointer.Ptr(metav1.Now())
+				sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "StorageMigrationCompleted", "Nodes of rack %q were migrated to StorageClass %q", rack.Name, *storageClassName)
+			}
+			storageMigrations = append(storageMigrations, storageMigration)
+			continue
+		}
+
+		storageMigration.Phase, storageMigration.Message = resolveStorageMigrationPhase(sdc, storageMigration.Phase, storageMigration.StorageClassName)
+		storageMigration.CompletionTime = nil
+		if storageMigration.Phase != scyllav1alpha1.StorageMigrationPhaseProgressing {
+			storageMigrations = append(storageMigrations, storageMigration)
+			continue
+		}
+
+		if len(storageMigration.CurrentNode) != 0 {
+			svc, ok := services[storageMigration.CurrentNode]
+			if ok {
+				if _, ok := svc.Labels[naming.ReplaceLabel]; ok {
+					storageMigration.Message = fmt.Sprintf("Waiting for node %q to be replaced.", storageMigration.CurrentNode)
+					progressingConditions = append(progressingConditions, makeStorageMigrationProgressingCondition(sdc, "WaitingForStorageMigrationReplacement", storageMigration.Message))
+					storageMigrations = append(storageMigrations, storageMigration)
+					continue
+				}
+			}
+			storageMigration.CurrentNode = ""
+		}
+
+		pcs, err := sdcc.startStorageMigrationReplacement(ctx, sdc, &storageMigration, sts, services, pendingNodes, replacing)
+		progressingConditions = append(progressingConditions, pcs...)
+		if err != nil {
+			return progressingConditions, err
+		}
+		if len(storageMigration.CurrentNode) != 0 {
+			replacing = true
+		}
+
+		storageMigrations = append(storageMigrations, storageMigration)
+	}
+
+	status.StorageMigrations = storageMigrations
+
+	return progressingConditions, nil
+}
+
+// startStorageMigrationReplacement marks the next pending node of the rack for replacement,
+// once no other node is being replaced and all nodes of the rack are ready.
+func (sdcc *Controller) startStorageMigrationReplacement(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	storageMigration *scyllav1alpha1.StorageMigrationStatus,
+	sts *appsv1.StatefulSet,
+	services map[string]*corev1.Service,
+	pendingNodes []string,
+	replacing bool,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if replacing {
+		storageMigration.Message = "Waiting for other nodes to be replaced."
+		progressingConditions = append(progressingConditions, makeStorageMigrationProgressingCondition(sdc, "WaitingForOtherReplacements", storageMigration.Message))
+		return progressingConditions, nil
+	}
+
+	pvcTemplate, ok := getDataPVCTemplate(sts)
+	if !ok || pvcTemplate.Spec.StorageClassName == nil || *pvcTemplate.Spec.StorageClassName != storageMigration.StorageClassName {
+		storageMigration.Message = fmt.Sprintf("Waiting for StatefulSet %q to use StorageClass %q.", naming.ObjRef(sts), storageMigration.StorageClassName)
+		progressingConditions = append(progressingConditions, makeStorageMigrationProgressingCondition(sdc, "WaitingForStatefulSetUpdate", storageMigration.Message))
+		return progressingConditions, nil
+	}
+
+	if sts.Status.ObservedGeneration < sts.Generation || sts.Status.ReadyReplicas != storageMigration.Nodes {
+		storageMigration.Message = fmt.Sprintf("Waiting for all nodes of rack %q to be ready.", storageMigration.Rack)
+		progressingConditions = append(progressingConditions, makeStorageMigrationProgressingCondition(sdc, "WaitingForNodesToBeReady", storageMigration.Message))
+		return progressingConditions, nil
+	}
+
+	svcName := pendingNodes[0]
+	if _, ok := services[svcName]; !ok {
+		storageMigration.Message = fmt.Sprintf("Waiting for Service %q to be created.", naming.ManualRef(sdc.Namespace, svcName))
+		progressingConditions = append(progressingConditions, makeStorageMigrationProgressingCondition(sdc, "WaitingForService", storageMigration.Message))
+		return progressingConditions, nil
+	}
+
+	_, err := sdcc.kubeClient.CoreV1().Services(sdc.Namespace).Patch(
+		ctx,
+		svcName,
+		types.MergePatchType,
+		[]byte(fmt.Sprintf(`{"metadata": {"labels": {%q: ""} } }`, naming.ReplaceLabel)),
+		metav1.PatchOptions{},
+	)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't mark Service %q for replacement: %w", naming.ManualRef(sdc.Namespace, svcName), err)
+	}
+
+	klog.V(2).InfoS("Marked service for replacement to migrate storage", "ScyllaDBDatacenter", klog.KObj(sdc), "Service", klog.KRef(sdc.Namespace, svcName), "StorageClass", storageMigration.StorageClassName)
+	sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "StorageMigrationReplacingNode", "Replacing node %q to move it to StorageClass %q", svcName, storageMigration.StorageClassName)
+
+	storageMigration.CurrentNode = svcName
+	storageMigration.Message = fmt.Sprintf("Waiting for node %q to be replaced.", svcName)
+	progressingConditions = append(progressingConditions, makeStorageMigrationProgressingCondition(sdc, "WaitingForStorageMigrationReplacement", storageMigration.Message))
+
+	return progressingConditions, nil
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbdatacenter
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetRackStorageClassName(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name         string
+		rackTemplate *scyllav1alpha1.RackTemplate
+		rack         scyllav1alpha1.RackSpec
+		expected     *string
+	}{
+		{
+			name:     "no storage",
+			rack:     scyllav1alpha1.RackSpec{Name: "a"},
+			expected: nil,
+		},
+		{
+			name: "storage class from rack template",
+			rackTemplate: &scyllav1alpha1.RackTemplate{
+				ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+					Storage: &scyllav1alpha1.StorageOptions{
+						Capacity:         "10Gi",
+						StorageClassName: pointer.Ptr("standard"),
+					},
+				},
+			},
+			rack:     scyllav1alpha1.RackSpec{Name: "a"},
+			expected: pointer.Ptr("standard"),
+		},
+		{
+			name: "storage class of rack takes precedence over rack template",
+			rackTemplate: &scyllav1alpha1.RackTemplate{
+				ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+					Storage: &scyllav1alpha1.StorageOptions{
+						Capacity:         "10Gi",
+						StorageClassName: pointer.Ptr("standard"),
+					},
+				},
+			},
+			rack: scyllav1alpha1.RackSpec{
+				Name: "a",
+				RackTemplate: scyllav1alpha1.RackTemplate{
+					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+						Storage: &scyllav1alpha1.StorageOptions{
+							Capacity:         "10Gi",
+							StorageClassName: pointer.Ptr("local-nvme"),
+						},
+					},
+				},
+			},
+			expected: pointer.Ptr("local-nvme"),
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+				Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+					RackTemplate: tc.rackTemplate,
+					Racks:        []scyllav1alpha1.RackSpec{tc.rack},
+				},
+			}
+
+			got := getRackStorageClassName(sdc, tc.rack)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got storage class names differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func TestResolveStorageMigrationPhase(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name          string
+		annotations   map[string]string
+		phase         scyllav1alpha1.StorageMigrationPhase
+		expectedPhase scyllav1alpha1.StorageMigrationPhase
+	}{
+		{
+			name:          "progressing migration keeps progressing",
+			phase:         scyllav1alpha1.StorageMigrationPhaseProgressing,
+			expectedPhase: scyllav1alpha1.StorageMigrationPhaseProgressing,
+		},
+		{
+			name: "pause annotation pauses the migration",
+			annotations: map[string]string{
+				naming.PauseStorageMigrationAnnotation: naming.LabelValueTrue,
+			},
+			phase:         scyllav1alpha1.StorageMigrationPhaseProgressing,
+			expectedPhase: scyllav1alpha1.StorageMigrationPhasePaused,
+		},
+		{
+			name:          "removing pause annotation resumes the migration",
+			phase:         scyllav1alpha1.StorageMigrationPhasePaused,
+			expectedPhase: scyllav1alpha1.StorageMigrationPhaseProgressing,
+		},
+		{
+			name: "abort annotation takes precedence over pause annotation",
+			annotations: map[string]string{
+				naming.PauseStorageMigrationAnnotation: naming.LabelValueTrue,
+				naming.AbortStorageMigrationAnnotation: "local-nvme",
+			},
+			phase:         scyllav1alpha1.StorageMigrationPhasePaused,
+			expectedPhase: scyllav1alpha1.StorageMigrationPhaseAborted,
+		},
+		{
+			name: "abort annotation of another storage class doesn't abort the migration",
+			annotations: map[string]string{
+				naming.AbortStorageMigrationAnnotation: "network-ssd",
+			},
+			phase:         scyllav1alpha1.StorageMigrationPhaseProgressing,
+			expectedPhase: scyllav1alpha1.StorageMigrationPhaseProgressing,
+		},
+		{
+			name: "abort annotation without a storage class doesn't abort the migration",
+			annotations: map[string]string{
+				naming.AbortStorageMigrationAnnotation: naming.LabelValueTrue,
+			},
+			phase:         scyllav1alpha1.StorageMigrationPhaseProgressing,
+			expectedPhase: scyllav1alpha1.StorageMigrationPhaseProgressing,
+		},
+		{
+			name:          "aborted migration stays aborted when annotation is removed",
+			phase:         scyllav1alpha1.StorageMigrationPhaseAborted,
+			expectedPhase: scyllav1alpha1.StorageMigrationPhaseAborted,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tc.annotations,
+				},
+			}
+
+			got, _ := resolveStorageMigrationPhase(sdc, tc.phase, "local-nvme")
+			if got != tc.expectedPhase {
+				t.Errorf("expected phase %q, got %q", tc.expectedPhase, got)
+			}
+		})
+	}
+}
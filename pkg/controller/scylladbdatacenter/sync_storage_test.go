@@ -0,0 +1,198 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbdatacenter
+
+import (
+	"testing"
+
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestIsPVCExpanded(t *testing.T) {
+	t.Parallel()
+
+	newPVC := func(capacity string, conditions ...corev1.PersistentVolumeClaimCondition) *corev1.PersistentVolumeClaim {
+		pvc := &corev1.PersistentVolumeClaim{
+			Status: corev1.PersistentVolumeClaimStatus{
+				Conditions: conditions,
+			},
+		}
+		if len(capacity) != 0 {
+			pvc.Status.Capacity = corev1.ResourceList{
+				corev1.ResourceStorage: resource.MustParse(capacity),
+			}
+		}
+		return pvc
+	}
+
+	tt := []struct {
+		name     string
+		pvc      *corev1.PersistentVolumeClaim
+		capacity resource.Quantity
+		expected bool
+	}{
+		{
+			name:     "capacity isn't reported",
+			pvc:      newPVC(""),
+			capacity: resource.MustParse("20Gi"),
+			expected: false,
+		},
+		{
+			name:     "capacity is lower than requested",
+			pvc:      newPVC("10Gi"),
+			capacity: resource.MustParse("20Gi"),
+			expected: false,
+		},
+		{
+			name: "volume is being resized",
+			pvc: newPVC("20Gi", corev1.PersistentVolumeClaimCondition{
+				Type:   corev1.PersistentVolumeClaimResizing,
+				Status: corev1.ConditionTrue,
+			}),
+			capacity: resource.MustParse("20Gi"),
+			expected: false,
+		},
+		{
+			name: "filesystem resize is pending",
+			pvc: newPVC("20Gi", corev1.PersistentVolumeClaimCondition{
+				Type:   corev1.PersistentVolumeClaimFileSystemResizePending,
+				Status: corev1.ConditionTrue,
+			}),
+			capacity: resource.MustParse("20Gi"),
+			expected: false,
+		},
+		{
+			name: "resize condition is false",
+			pvc: newPVC("20Gi", corev1.PersistentVolumeClaimCondition{
+				Type:   corev1.PersistentVolumeClaimResizing,
+				Status: corev1.ConditionFalse,
+			}),
+			capacity: resource.MustParse("20Gi"),
+			expected: true,
+		},
+		{
+			name:     "capacity is higher than requested",
+			pvc:      newPVC("21Gi"),
+			capacity: resource.MustParse("20Gi"),
+			expected: true,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := isPVCExpanded(tc.pvc, tc.capacity)
+			if got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestHasPVCsBelowCapacity(t *testing.T) {
+	t.Parallel()
+
+	newPVC := func(name, request string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "scylla",
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: resource.MustParse(request),
+					},
+				},
+			},
+		}
+	}
+
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic-dc-rack",
+			Namespace: "scylla",
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: pointer.Ptr[int32](3),
+		},
+	}
+
+	tt := []struct {
+		name     string
+		pvcs     []*corev1.PersistentVolumeClaim
+		expected bool
+	}{
+		{
+			name: "all PVCs request the capacity",
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("data-basic-dc-rack-0", "2Gi"),
+				newPVC("data-basic-dc-rack-1", "2Gi"),
+				newPVC("data-basic-dc-rack-2", "2Gi"),
+			},
+			expected: false,
+		},
+		{
+			name: "PVC created by a scale out during the expansion requests less than the capacity",
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("data-basic-dc-rack-0", "2Gi"),
+				newPVC("data-basic-dc-rack-1", "2Gi"),
+				newPVC("data-basic-dc-rack-2", "1Gi"),
+			},
+			expected: true,
+		},
+		{
+			name: "missing PVCs are ignored",
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("data-basic-dc-rack-0", "2Gi"),
+			},
+			expected: false,
+		},
+		{
+			name: "PVCs of ordinals above the replicas are ignored",
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("data-basic-dc-rack-0", "2Gi"),
+				newPVC("data-basic-dc-rack-1", "2Gi"),
+				newPVC("data-basic-dc-rack-2", "2Gi"),
+				newPVC("data-basic-dc-rack-3", "1Gi"),
+			},
+			expected: false,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			pvcCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			for _, pvc := range tc.pvcs {
+				err := pvcCache.Add(pvc)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			sdcc := &Controller{
+				pvcLister: corev1listers.NewPersistentVolumeClaimLister(pvcCache),
+			}
+
+			got, err := sdcc.hasPVCsBelowCapacity(sts, resource.MustParse("2Gi"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/scyllafeatures"
+	"github.com/scylladb/scylla-operator/pkg/util/parallel"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// isRolledOutToImage returns whether all racks run the ScyllaDB image from the spec.
+func isRolledOutToImage(sdc *scyllav1alpha1.ScyllaDBDatacenter, statefulSets map[string]*appsv1.StatefulSet) (bool, error) {
+	for _, rack := range sdc.Spec.Racks {
+		sts, ok := statefulSets[naming.StatefulSetNameForRack(rack, sdc)]
+		if !ok {
+			return false, nil
+		}
+
+		containerIdx := -1
+		for i := range sts.Spec.Template.Spec.Containers {
+			if sts.Spec.Template.Spec.Containers[i].Name == naming.ScyllaContainerName {
+				containerIdx = i
+				break
+			}
+		}
+		if containerIdx < 0 {
+			return false, fmt.Errorf("can't find container %q in StatefulSet %q", naming.ScyllaContainerName, naming.ObjRef(sts))
+		}
+
+		if sts.Spec.Template.Spec.Containers[containerIdx].Image != sdc.Spec.ScyllaDB.Image {
+			return false, nil
+		}
+
+		rolledOut, err := controllerhelpers.IsStatefulSetRolledOut(sts)
+		if err != nil {
+			return false, err
+		}
+
+		if !rolledOut {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// lowestVersion returns the lowest of the ScyllaDB versions.
+func lowestVersion(versions []string) (string, error) {
+	lowest := ""
+	for _, v := range versions {
+		parsed, err := scyllafeatures.ParseVersion(v)
+		if err != nil {
+			return "", err
+		}
+
+		if len(lowest) != 0 {
+			parsedLowest, err := scyllafeatures.ParseVersion(lowest)
+			if err != nil {
+				return "", err
+			}
+
+			if parsed.GTE(parsedLowest) {
+				continue
+			}
+		}
+
+		lowest = v
+	}
+
+	return lowest, nil
+}
+
+// syncDetectedVersion detects the ScyllaDB version from the running nodes and caches it in the status.
+// The version is detected again only after the nodes have been rolled out to a different image.
+func (sdcc *Controller) syncDetectedVersion(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
+	statefulSets map[string]*appsv1.StatefulSet,
+	services map[string]*corev1.Service,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if status.DetectedVersion != nil && status.DetectedVersion.Image == sdc.Spec.ScyllaDB.Image {
+		return progressingConditions, nil
+	}
+
+	if status.Nodes == nil || *status.Nodes == 0 {
+		return progressingConditions, nil
+	}
+
+	rolledOut, err := isRolledOutToImage(sdc, statefulSets)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't determine whether the image has been rolled out: %w", err)
+	}
+
+	if !rolledOut {
+		// Keep the previously detected version until all nodes run the same image.
+		klog.V(4).InfoS("Waiting for the image to roll out before detecting ScyllaDB version", "ScyllaDBDatacenter", klog.KObj(sdc))
+		return progressingConditions, nil
+	}
+
+	hosts, err := controllerhelpers.GetRequiredScyllaHosts(sdc, services, sdcc.podLister)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't get scylla hosts: %w", err)
+	}
+
+	scyllaClient, err := sdcc.getScyllaClient(ctx, sdc, hosts)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't get scylla client: %w", err)
+	}
+	defer scyllaClient.Close()
+
+	var versionsLock sync.Mutex
+	versions := make([]string, 0, len(hosts))
+	err = parallel.ForEach(len(hosts), func(i int) error {
+		version, err := scyllaClient.ScyllaVersion(ctx, hosts[i])
+		if err != nil {
+			return fmt.Errorf("can't get ScyllaDB version of host %q: %w", hosts[i], err)
+		}
+
+		versionsLock.Lock()
+		defer versionsLock.Unlock()
+		versions = append(versions, version)
+
+		return nil
+	})
+	if err != nil {
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               scyllaDBVersionControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForScyllaDBVersion",
+			Message:            fmt.Sprintf("Waiting for ScyllaDB version to be reported by all nodes: %v", err),
+			ObservedGeneration: sdc.Generation,
+		})
+		return progressingConditions, nil
+	}
+
+	version, err := lowestVersion(versions)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't determine ScyllaDB version: %w", err)
+	}
+
+	klog.V(2).InfoS("Detected ScyllaDB version", "ScyllaDBDatacenter", klog.KObj(sdc), "Version", version)
+	status.DetectedVersion = &scyllav1alpha1.DetectedScyllaDBVersion{
+		Version: version,
+		Image:   sdc.Spec.ScyllaDB.Image,
+	}
+
+	return progressingConditions, nil
+}
@@ -0,0 +1,236 @@
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/scyllafeatures"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+var (
+	// openSourceReleases lists ScyllaDB open source releases in the order they have to be upgraded through.
+	openSourceReleases = []string{"5.0", "5.1", "5.2", "5.4", "6.0", "6.1", "6.2"}
+
+	// enterpriseReleases lists ScyllaDB Enterprise releases in the order they have to be upgraded through.
+	enterpriseReleases = []string{"2022.1", "2022.2", "2023.1", "2024.1", "2024.2", "2025.1"}
+
+	// enterpriseOpenSourceBase maps ScyllaDB Enterprise releases to the open source release
+	// they can be upgraded to from.
+	enterpriseOpenSourceBase = map[string]string{
+		"2022.1": "5.0",
+		"2022.2": "5.1",
+		"2023.1": "5.2",
+		"2024.1": "5.4",
+		"2024.2": "6.0",
+		"2025.1": "6.2",
+	}
+)
+
+func releaseOf(v semver.Version) string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// validateUpgradePath returns an error when upgrading directly between the versions isn't supported.
+// Upgrades from or to releases unknown to the operator can't be validated and are allowed with a warning.
+func validateUpgradePath(fromVersion, toVersion string) (string, error) {
+	from, err := scyllafeatures.ParseVersion(fromVersion)
+	if err != nil {
+		return "", err
+	}
+
+	to, err := scyllafeatures.ParseVersion(toVersion)
+	if err != nil {
+		return "", err
+	}
+
+	if releaseOf(from) == releaseOf(to) {
+		return "", nil
+	}
+
+	unknownReleaseWarning := fmt.Sprintf("upgrade path from %q to %q can't be validated because the release isn't known to the operator, make sure it's supported by ScyllaDB", fromVersion, toVersion)
+
+	switch {
+	case scyllafeatures.IsEnterprise(from) && scyllafeatures.IsOpenSource(to):
+		return "", fmt.Errorf("upgrading from ScyllaDB Enterprise %q to ScyllaDB open source %q isn't supported", fromVersion, toVersion)
+
+	case scyllafeatures.IsOpenSource(from) && scyllafeatures.IsEnterprise(to):
+		base, ok := enterpriseOpenSourceBase[releaseOf(to)]
+		if !ok || !slices.Contains(openSourceReleases, releaseOf(from)) {
+			return unknownReleaseWarning, nil
+		}
+		if base != releaseOf(from) {
+			return "", fmt.Errorf("ScyllaDB Enterprise %q can only be upgraded to from ScyllaDB open source %s, not %q", toVersion, base, fromVersion)
+		}
+
+		return "", nil
+
+	case to.LT(from):
+		return "", fmt.Errorf("downgrading from %q to %q isn't supported", fromVersion, toVersion)
+
+	default:
+		releases := openSourceReleases
+		if scyllafeatures.IsEnterprise(from) {
+			releases = enterpriseReleases
+		}
+
+		fromIdx := slices.Index(releases, releaseOf(from))
+		toIdx := slices.Index(releases, releaseOf(to))
+		if fromIdx < 0 || toIdx < 0 {
+			return unknownReleaseWarning, nil
+		}
+
+		if toIdx != fromIdx+1 {
+			return "", fmt.Errorf("upgrading from %q to %q skips a release, upgrade through every release in between one at a time", fromVersion, toVersion)
+		}
+
+		return "", nil
+	}
+}
+
+// makeUpgradePlan describes the order in which the nodes are upgraded.
+// It mirrors the rollout which goes through racks one at a time, upgrading nodes from the highest ordinal.
+func makeUpgradePlan(requiredStatefulSets []*appsv1.StatefulSet, statefulSets map[string]*appsv1.StatefulSet) *internalapi.UpgradePlan {
+	plan := &internalapi.UpgradePlan{
+		Checks: []internalapi.UpgradeCheck{
+			{
+				Name:   internalapi.UpgradePathUpgradeCheck,
+				Passed: true,
+			},
+		},
+		Steps: make([]internalapi.UpgradeRackStep, 0, len(requiredStatefulSets)),
+	}
+
+	for _, required := range requiredStatefulSets {
+		existing, ok := statefulSets[required.Name]
+		if !ok || existing.Spec.Replicas == nil {
+			continue
+		}
+
+		step := internalapi.UpgradeRackStep{
+			Rack:  required.Labels[naming.RackNameLabel],
+			Nodes: make([]string, 0, *existing.Spec.Replicas),
+		}
+		for i := *existing.Spec.Replicas - 1; i >= 0; i-- {
+			step.Nodes = append(step.Nodes, fmt.Sprintf("%s-%d", existing.Name, i))
+		}
+
+		plan.Steps = append(plan.Steps, step)
+	}
+
+	return plan
+}
+
+// runPreUpgradeChecks checks that the cluster is in a state safe for an upgrade.
+func (sdcc *Controller) runPreUpgradeChecks(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*corev1.Service) ([]internalapi.UpgradeCheck, error) {
+	hosts, err := controllerhelpers.GetRequiredScyllaHosts(sdc, services, sdcc.podLister)
+	if err != nil {
+		return nil, err
+	}
+
+	scyllaClient, err := sdcc.getScyllaClient(ctx, sdc, hosts)
+	if err != nil {
+		return nil, err
+	}
+	defer scyllaClient.Close()
+
+	var checks []internalapi.UpgradeCheck
+
+	klog.V(4).InfoS("Checking schema agreement", "ScyllaDBDatacenter", klog.KObj(sdc))
+	hasSchemaAgreement, err := scyllaClient.HasSchemaAgreement(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("can't check schema agreement: %w", err)
+	}
+	schemaCheck := internalapi.UpgradeCheck{
+		Name:   internalapi.SchemaAgreementUpgradeCheck,
+		Passed: hasSchemaAgreement,
+	}
+	if !hasSchemaAgreement {
+		schemaCheck.Message = "nodes don't agree on the schema version"
+	}
+	checks = append(checks, schemaCheck)
+
+	klog.V(4).InfoS("Checking status of nodes", "ScyllaDBDatacenter", klog.KObj(sdc))
+	nodeStatuses, err := scyllaClient.Status(ctx, hosts[0])
+	if err != nil {
+		return nil, fmt.Errorf("can't get status of nodes: %w", err)
+	}
+	var notUNHosts []string
+	for _, s := range nodeStatuses {
+		if !s.IsUN() {
+			notUNHosts = append(notUNHosts, s.Addr)
+		}
+	}
+	nodesCheck := internalapi.UpgradeCheck{
+		Name:   internalapi.NodesUpUpgradeCheck,
+		Passed: len(notUNHosts) == 0,
+	}
+	if len(notUNHosts) != 0 {
+		sort.Strings(notUNHosts)
+		nodesCheck.Message = fmt.Sprintf("nodes %s aren't up and normal", strings.Join(notUNHosts, ", "))
+	}
+	checks = append(checks, nodesCheck)
+
+	klog.V(4).InfoS("Checking running repairs", "ScyllaDBDatacenter", klog.KObj(sdc))
+	var repairingHosts []string
+	for _, host := range hosts {
+		repairs, err := scyllaClient.ActiveRepairs(ctx, host)
+		if err != nil {
+			return nil, fmt.Errorf("can't get active repairs of host %q: %w", host, err)
+		}
+		if len(repairs) != 0 {
+			repairingHosts = append(repairingHosts, host)
+		}
+	}
+	repairsCheck := internalapi.UpgradeCheck{
+		Name:   internalapi.NoRunningRepairsUpgradeCheck,
+		Passed: len(repairingHosts) == 0,
+	}
+	if len(repairingHosts) != 0 {
+		sort.Strings(repairingHosts)
+		repairsCheck.Message = fmt.Sprintf("repairs are running on nodes %s", strings.Join(repairingHosts, ", "))
+	}
+	checks = append(checks, repairsCheck)
+
+	return checks, nil
+}
+
+// mergeUpgradeChecks replaces results of the checks in the plan with the new ones.
+func mergeUpgradeChecks(plan *internalapi.UpgradePlan, checks []internalapi.UpgradeCheck) {
+	for _, c := range checks {
+		replaced := false
+		for i := range plan.Checks {
+			if plan.Checks[i].Name == c.Name {
+				plan.Checks[i] = c
+				replaced = true
+				break
+			}
+		}
+
+		if !replaced {
+			plan.Checks = append(plan.Checks, c)
+		}
+	}
+}
+
+// failedUpgradeChecksMessage describes the failed checks. It returns an empty string if all checks passed.
+func failedUpgradeChecksMessage(checks []internalapi.UpgradeCheck) string {
+	var failed []string
+	for _, c := range checks {
+		if !c.Passed {
+			failed = append(failed, fmt.Sprintf("%s: %s", c.Name, c.Message))
+		}
+	}
+
+	return strings.Join(failed, "; ")
+}
@@ -0,0 +1,195 @@
+package scylladbdatacenter
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateUpgradePath(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name            string
+		from            string
+		to              string
+		expectedWarning string
+		expectedErr     error
+	}{
+		{
+			name:        "patch upgrade",
+			from:        "6.0.1",
+			to:          "6.0.3",
+			expectedErr: nil,
+		},
+		{
+			name:        "next open source minor release",
+			from:        "6.0.1",
+			to:          "6.1.0",
+			expectedErr: nil,
+		},
+		{
+			name:        "next open source release skipping an unreleased minor",
+			from:        "5.2.9",
+			to:          "5.4.0",
+			expectedErr: nil,
+		},
+		{
+			name:        "next open source major release",
+			from:        "5.4.6",
+			to:          "6.0.0",
+			expectedErr: nil,
+		},
+		{
+			name:        "open source release skipping a release",
+			from:        "6.0.1",
+			to:          "6.2.0",
+			expectedErr: errors.New(`upgrading from "6.0.1" to "6.2.0" skips a release, upgrade through every release in between one at a time`),
+		},
+		{
+			name:            "unknown next open source minor release",
+			from:            "6.2.1",
+			to:              "6.3.0",
+			expectedWarning: `upgrade path from "6.2.1" to "6.3.0" can't be validated because the release isn't known to the operator, make sure it's supported by ScyllaDB`,
+			expectedErr:     nil,
+		},
+		{
+			name:            "unknown open source release skipping an unknown release",
+			from:            "6.2.1",
+			to:              "6.4.0",
+			expectedWarning: `upgrade path from "6.2.1" to "6.4.0" can't be validated because the release isn't known to the operator, make sure it's supported by ScyllaDB`,
+			expectedErr:     nil,
+		},
+		{
+			name:            "unknown source open source release",
+			from:            "4.6.3",
+			to:              "5.0.0",
+			expectedWarning: `upgrade path from "4.6.3" to "5.0.0" can't be validated because the release isn't known to the operator, make sure it's supported by ScyllaDB`,
+			expectedErr:     nil,
+		},
+		{
+			name:        "next enterprise release in a new year",
+			from:        "2023.1.8",
+			to:          "2024.1.0",
+			expectedErr: nil,
+		},
+		{
+			name:        "enterprise release skipping a release",
+			from:        "2024.1.0",
+			to:          "2025.1.0",
+			expectedErr: errors.New(`upgrading from "2024.1.0" to "2025.1.0" skips a release, upgrade through every release in between one at a time`),
+		},
+		{
+			name:        "open source to the matching enterprise release",
+			from:        "6.0.1",
+			to:          "2024.2.0",
+			expectedErr: nil,
+		},
+		{
+			name:            "open source to an unknown enterprise release",
+			from:            "6.2.1",
+			to:              "2026.1.0",
+			expectedWarning: `upgrade path from "6.2.1" to "2026.1.0" can't be validated because the release isn't known to the operator, make sure it's supported by ScyllaDB`,
+			expectedErr:     nil,
+		},
+		{
+			name:            "unknown enterprise release",
+			from:            "2025.1.2",
+			to:              "2026.1.0",
+			expectedWarning: `upgrade path from "2025.1.2" to "2026.1.0" can't be validated because the release isn't known to the operator, make sure it's supported by ScyllaDB`,
+			expectedErr:     nil,
+		},
+		{
+			name:        "open source to a non-matching enterprise release",
+			from:        "5.4.1",
+			to:          "2024.2.0",
+			expectedErr: errors.New(`ScyllaDB Enterprise "2024.2.0" can only be upgraded to from ScyllaDB open source 6.0, not "5.4.1"`),
+		},
+		{
+			name:        "enterprise to open source",
+			from:        "2024.1.0",
+			to:          "6.0.0",
+			expectedErr: errors.New(`upgrading from ScyllaDB Enterprise "2024.1.0" to ScyllaDB open source "6.0.0" isn't supported`),
+		},
+		{
+			name:        "downgrade",
+			from:        "6.1.0",
+			to:          "6.0.0",
+			expectedErr: errors.New(`downgrading from "6.1.0" to "6.0.0" isn't supported`),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			warning, err := validateUpgradePath(tc.from, tc.to)
+			if warning != tc.expectedWarning {
+				t.Errorf("expected warning %q, got %q", tc.expectedWarning, warning)
+			}
+			if !reflect.DeepEqual(err, tc.expectedErr) {
+				t.Errorf("expected and got errors differ: %s", cmp.Diff(tc.expectedErr, err, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
+
+func TestMakeUpgradePlan(t *testing.T) {
+	t.Parallel()
+
+	makeStatefulSet := func(name, rack string, replicas int32) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					naming.RackNameLabel: rack,
+				},
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: pointer.Ptr(replicas),
+			},
+		}
+	}
+
+	requiredStatefulSets := []*appsv1.StatefulSet{
+		makeStatefulSet("basic-dc-a", "a", 3),
+		makeStatefulSet("basic-dc-b", "b", 3),
+		makeStatefulSet("basic-dc-c", "c", 1),
+	}
+	statefulSets := map[string]*appsv1.StatefulSet{
+		"basic-dc-a": makeStatefulSet("basic-dc-a", "a", 2),
+		"basic-dc-b": makeStatefulSet("basic-dc-b", "b", 1),
+	}
+
+	expected := &internalapi.UpgradePlan{
+		Checks: []internalapi.UpgradeCheck{
+			{
+				Name:   internalapi.UpgradePathUpgradeCheck,
+				Passed: true,
+			},
+		},
+		Steps: []internalapi.UpgradeRackStep{
+			{
+				Rack:  "a",
+				Nodes: []string{"basic-dc-a-1", "basic-dc-a-0"},
+			},
+			{
+				Rack:  "b",
+				Nodes: []string{"basic-dc-b-0"},
+			},
+		},
+	}
+
+	got := makeUpgradePlan(requiredStatefulSets, statefulSets)
+	if !cmp.Equal(got, expected) {
+		t.Errorf("expected and got plans differ: %s", cmp.Diff(expected, got))
+	}
+}
@@ -0,0 +1,429 @@
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	"github.com/scylladb/scylla-operator/pkg/scyllafeatures"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	upgradeRollbackRequestedReason    = "RollbackRequested"
+	upgradeNodesFailedReadinessReason = "NodesFailedReadiness"
+
+	defaultUpgradeRollbackFailedNodesThreshold = 1
+	defaultUpgradeRollbackNodeReadinessTimeout = 15 * time.Minute
+)
+
+// getUpgradeRollbackFailedNodesThreshold returns the failed nodes threshold, falling back to the default
+// when it isn't set.
+func getUpgradeRollbackFailedNodesThreshold(options *scyllav1alpha1.UpgradeRollbackOptions) int32 {
+	if options.FailedNodesThreshold < 1 {
+		return defaultUpgradeRollbackFailedNodesThreshold
+	}
+
+	return options.FailedNodesThreshold
+}
+
+// getUpgradeRollbackNodeReadinessTimeout returns the node readiness timeout, falling back to the default
+// when it isn't set. A zero timeout would count every upgraded node that isn't ready yet as failed.
+func getUpgradeRollbackNodeReadinessTimeout(options *scyllav1alpha1.UpgradeRollbackOptions) time.Duration {
+	if options.NodeReadinessTimeout.Duration <= 0 {
+		return defaultUpgradeRollbackNodeReadinessTimeout
+	}
+
+	return options.NodeReadinessTimeout.Duration
+}
+
+// isUpgradeRollbackRequested returns whether the rollback annotation requests rolling back the ongoing upgrade.
+// The annotation names the version or image of the upgrade, so a leftover annotation doesn't roll back later upgrades.
+func isUpgradeRollbackRequested(sdc *scyllav1alpha1.ScyllaDBDatacenter, uc *internalapi.DatacenterUpgradeContext) bool {
+	value, ok := sdc.Annotations[naming.RollbackUpgradeAnnotation]
+	if !ok || len(value) == 0 {
+		return false
+	}
+
+	return value == uc.ToVersion || (len(uc.ToImage) != 0 && value == uc.ToImage)
+}
+
+func getScyllaDBImage(podSpec *corev1.PodSpec) string {
+	for _, c := range podSpec.Containers {
+		if c.Name == naming.ScyllaContainerName {
+			return c.Image
+		}
+	}
+
+	return ""
+}
+
+// getUpgradedNodeNotReadyDuration returns how long the node running the upgraded image hasn't been ready.
+// It returns false if the node doesn't run the upgraded image or is ready.
+func getUpgradedNodeNotReadyDuration(pod *corev1.Pod, toImage string, now time.Time) (time.Duration, bool) {
+	if pod.DeletionTimestamp != nil || getScyllaDBImage(&pod.Spec) != toImage {
+		return 0, false
+	}
+
+	if controllerhelpers.IsPodReady(pod) {
+		return 0, false
+	}
+
+	notReadySince := pod.CreationTimestamp.Time
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady && c.LastTransitionTime.After(notReadySince) {
+			notReadySince = c.LastTransitionTime.Time
+		}
+	}
+
+	return now.Sub(notReadySince), true
+}
+
+// overrideImageForUpgradeRollback keeps the nodes on the image a rolled back upgrade started from,
+// for as long as the spec points to the image of the rolled back upgrade.
+func overrideImageForUpgradeRollback(sdc *scyllav1alpha1.ScyllaDBDatacenter, status *scyllav1alpha1.ScyllaDBDatacenterStatus, requiredStatefulSets []*appsv1.StatefulSet) error {
+	rollback := status.UpgradeRollback
+	if rollback == nil || rollback.ToImage != sdc.Spec.ScyllaDB.Image {
+		return nil
+	}
+
+	version, err := naming.ImageToVersion(rollback.FromImage)
+	if err != nil {
+		return fmt.Errorf("can't get version of image %q: %w", rollback.FromImage, err)
+	}
+
+	for _, sts := range requiredStatefulSets {
+		sts.Labels[naming.ScyllaVersionLabel] = version
+		sts.Spec.Template.Labels[naming.ScyllaVersionLabel] = version
+
+		for i := range sts.Spec.Template.Spec.Containers {
+			if sts.Spec.Template.Spec.Containers[i].Name == naming.ScyllaContainerName {
+				sts.Spec.Template.Spec.Containers[i].Image = rollback.FromImage
+			}
+		}
+	}
+
+	return nil
+}
+
+// makeUpgradeRollbackStatus reflects a running rollback in the status. Nodes with restored system keyspaces
+// are accumulated, as their annotations are removed once the rollback completes.
+func makeUpgradeRollbackStatus(uc *internalapi.DatacenterUpgradeContext, existing *scyllav1alpha1.UpgradeRollbackStatus, services map[string]*corev1.Service) *scyllav1alpha1.UpgradeRollbackStatus {
+	restoredNodes := map[string]struct{}{}
+	if existing != nil && existing.ToImage == uc.ToImage && existing.StartTime.Time.Equal(uc.Rollback.StartTime) {
+		for _, n := range existing.RestoredSystemKeyspacesNodes {
+			restoredNodes[n] = struct{}{}
+		}
+	}
+
+	for _, svc := range services {
+		if len(uc.SystemSnapshotTag) != 0 && svc.Annotations[naming.RestoredSystemSnapshotAnnotation] == uc.SystemSnapshotTag {
+			restoredNodes[svc.Name] = struct{}{}
+		}
+	}
+
+	var restoredNodesList []string
+	for n := range restoredNodes {
+		restoredNodesList = append(restoredNodesList, n)
+	}
+	sort.Strings(restoredNodesList)
+
+	return &scyllav1alpha1.UpgradeRollbackStatus{
+		Phase:                        scyllav1alpha1.UpgradeRollbackPhaseRunning,
+		Reason:                       uc.Rollback.Reason,
+		Message:                      uc.Rollback.Message,
+		FromImage:                    uc.FromImage,
+		ToImage:                      uc.ToImage,
+		FailedNodes:                  uc.Rollback.FailedNodes,
+		RestoredSystemKeyspacesNodes: restoredNodesList,
+		StartTime:                    metav1.NewTime(uc.Rollback.StartTime),
+	}
+}
+
+// isSystemSnapshotRestoreSafe returns whether system keyspaces can be restored from the pre-upgrade snapshot.
+// When the cluster keeps its state in Raft, restoring the node local Raft tables would make the node diverge
+// from the rest of the group, so only the image is reverted.
+func isSystemSnapshotRestoreSafe(uc *internalapi.DatacenterUpgradeContext) (bool, error) {
+	if len(uc.SystemSnapshotTag) == 0 {
+		return false, nil
+	}
+
+	usesRaft, err := scyllafeatures.VersionSupports(uc.FromVersion, scyllafeatures.ConsistentSchema)
+	if err != nil {
+		return false, err
+	}
+
+	return !usesRaft, nil
+}
+
+// getFailedUpgradedNodes returns names of the upgraded nodes that haven't become ready in time.
+// It also returns how long to wait before the nodes that aren't ready yet need to be checked again.
+func (sdcc *Controller) getFailedUpgradedNodes(sdc *scyllav1alpha1.ScyllaDBDatacenter, statefulSets map[string]*appsv1.StatefulSet, uc *internalapi.DatacenterUpgradeContext, now time.Time) ([]string, time.Duration, error) {
+	timeout := getUpgradeRollbackNodeReadinessTimeout(sdc.Spec.UpgradeRollback)
+
+	var failedNodes []string
+	var recheckAfter time.Duration
+	for _, sts := range statefulSets {
+		for i := int32(0); i < *sts.Spec.Replicas; i++ {
+			podName := fmt.Sprintf("%s-%d", sts.Name, i)
+			pod, err := sdcc.podLister.Pods(sts.Namespace).Get(podName)
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, 0, fmt.Errorf("can't get pod %q: %w", naming.ManualRef(sts.Namespace, podName), err)
+			}
+
+			notReadyDuration, ok := getUpgradedNodeNotReadyDuration(pod, uc.ToImage, now)
+			if !ok {
+				continue
+			}
+
+			if notReadyDuration >= timeout {
+				failedNodes = append(failedNodes, pod.Name)
+				continue
+			}
+
+			if recheckAfter == 0 || timeout-notReadyDuration < recheckAfter {
+				recheckAfter = timeout - notReadyDuration
+			}
+		}
+	}
+
+	sort.Strings(failedNodes)
+
+	return failedNodes, recheckAfter, nil
+}
+
+// startUpgradeRollbackIfNeeded switches an ongoing upgrade into the rollback phase when it's requested by the user
+// or when enough upgraded nodes fail to become ready. It returns true if the rollback has been started.
+func (sdcc *Controller) startUpgradeRollbackIfNeeded(
+	ctx context.Context,
+	key string,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	statefulSets map[string]*appsv1.StatefulSet,
+	uc *internalapi.DatacenterUpgradeContext,
+) (bool, []metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	now := time.Now()
+	rollback := &internalapi.UpgradeRollback{
+		StartTime: now.UTC().Truncate(time.Second),
+	}
+
+	switch {
+	case isUpgradeRollbackRequested(sdc, uc):
+		rollback.Reason = upgradeRollbackRequestedReason
+		rollback.Message = fmt.Sprintf("Rollback was requested with the %q annotation.", naming.RollbackUpgradeAnnotation)
+
+	case sdc.Spec.UpgradeRollback != nil && len(uc.ToImage) != 0:
+		failedNodes, recheckAfter, err := sdcc.getFailedUpgradedNodes(sdc, statefulSets, uc, now)
+		if err != nil {
+			return false, progressingConditions, err
+		}
+
+		if int32(len(failedNodes)) < getUpgradeRollbackFailedNodesThreshold(sdc.Spec.UpgradeRollback) {
+			if recheckAfter > 0 {
+				sdcc.queue.AddAfter(key, recheckAfter)
+			}
+			return false, progressingConditions, nil
+		}
+
+		rollback.Reason = upgradeNodesFailedReadinessReason
+		rollback.Message = fmt.Sprintf("%d upgraded node(s) didn't become ready within %v.", len(failedNodes), getUpgradeRollbackNodeReadinessTimeout(sdc.Spec.UpgradeRollback))
+		rollback.FailedNodes = failedNodes
+
+	default:
+		return false, progressingConditions, nil
+	}
+
+	if len(uc.FromImage) == 0 || len(uc.ToImage) == 0 {
+		return false, progressingConditions, fmt.Errorf("can't roll back upgrade from %q to %q: the upgrade was started by an operator version that didn't record its images", uc.FromVersion, uc.ToVersion)
+	}
+
+	restoreSafe, err := isSystemSnapshotRestoreSafe(uc)
+	if err != nil {
+		return false, progressingConditions, fmt.Errorf("can't determine whether system keyspaces can be restored: %w", err)
+	}
+	rollback.RestoreSystemSnapshot = restoreSafe
+
+	klog.InfoS("Rolling back upgrade", "ScyllaDBDatacenter", klog.KObj(sdc), "FromImage", uc.FromImage, "ToImage", uc.ToImage, "Reason", rollback.Reason)
+	sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeWarning, "UpgradeRollbackStarted", "Rolling back upgrade from %q to %q: %s", uc.FromImage, uc.ToImage, rollback.Message)
+
+	ucCopy := *uc
+	ucCopy.State = internalapi.RollbackUpgradePhase
+	ucCopy.Rollback = rollback
+	cm, err := MakeUpgradeContextConfigMap(sdc, &ucCopy)
+	if err != nil {
+		return true, progressingConditions, fmt.Errorf("can't make upgrade context ConfigMap: %w", err)
+	}
+
+	cm, changed, err := resourceapply.ApplyConfigMap(ctx, sdcc.kubeClient.CoreV1(), sdcc.configMapLister, sdcc.eventRecorder, cm, resourceapply.ApplyOptions{})
+	if changed {
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, cm, "apply", sdc.Generation)
+	}
+	if err != nil {
+		return true, progressingConditions, fmt.Errorf("can't apply upgrade context ConfigMap: %w", err)
+	}
+
+	return true, progressingConditions, nil
+}
+
+func makeUpgradeRollbackProgressingCondition(sdc *scyllav1alpha1.ScyllaDBDatacenter, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               statefulSetControllerProgressingCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             "RollingBackUpgrade",
+		Message:            message,
+		ObservedGeneration: sdc.Generation,
+	}
+}
+
+// rollBackUpgrade reverts nodes to the image the upgrade started from, one node at a time.
+// Nodes are reverted by deleting their Pods, because a StatefulSet rollout gets stuck on Pods that aren't ready.
+func (sdcc *Controller) rollBackUpgrade(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
+	uc *internalapi.DatacenterUpgradeContext,
+	requiredStatefulSets []*appsv1.StatefulSet,
+	statefulSets map[string]*appsv1.StatefulSet,
+	services map[string]*corev1.Service,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	for _, required := range requiredStatefulSets {
+		existing, ok := statefulSets[required.Name]
+		if !ok {
+			return progressingConditions, fmt.Errorf("internal error: can't lookup stateful set %s/%s", required.Namespace, required.Name)
+		}
+
+		// Keep the StatefulSet controller from updating Pods on its own.
+		required = required.DeepCopy()
+		required.ResourceVersion = existing.ResourceVersion
+		required.Spec.Replicas = pointer.Ptr(*existing.Spec.Replicas)
+		required.Spec.UpdateStrategy.RollingUpdate.Partition = pointer.Ptr(*existing.Spec.Replicas)
+		_, changed, err := resourceapply.ApplyStatefulSet(ctx, sdcc.kubeClient.AppsV1(), sdcc.statefulSetLister, sdcc.eventRecorder, required, resourceapply.ApplyOptions{})
+		if changed {
+			controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, required, "apply", sdc.Generation)
+		}
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't apply statefulset to roll back: %w", err)
+		}
+		if changed {
+			return progressingConditions, nil
+		}
+
+		for i := *existing.Spec.Replicas - 1; i >= 0; i-- {
+			podName := fmt.Sprintf("%s-%d", existing.Name, i)
+			pod, err := sdcc.podLister.Pods(existing.Namespace).Get(podName)
+			if err != nil {
+				if !apierrors.IsNotFound(err) {
+					return progressingConditions, fmt.Errorf("can't get pod %q: %w", naming.ManualRef(existing.Namespace, podName), err)
+				}
+
+				progressingConditions = append(progressingConditions, makeUpgradeRollbackProgressingCondition(sdc, fmt.Sprintf("Waiting for Pod %q to be recreated.", naming.ManualRef(existing.Namespace, podName))))
+				return progressingConditions, nil
+			}
+
+			if getScyllaDBImage(&pod.Spec) == uc.FromImage {
+				if !controllerhelpers.IsPodReady(pod) {
+					progressingConditions = append(progressingConditions, makeUpgradeRollbackProgressingCondition(sdc, fmt.Sprintf("Waiting for rolled back Pod %q to become ready.", naming.ObjRef(pod))))
+					return progressingConditions, nil
+				}
+
+				continue
+			}
+
+			if pod.DeletionTimestamp != nil {
+				progressingConditions = append(progressingConditions, makeUpgradeRollbackProgressingCondition(sdc, fmt.Sprintf("Waiting for Pod %q to be deleted.", naming.ObjRef(pod))))
+				return progressingConditions, nil
+			}
+
+			svc, ok := services[podName]
+			if ok && uc.Rollback.RestoreSystemSnapshot && svc.Annotations[naming.RestoreSystemSnapshotAnnotation] != uc.SystemSnapshotTag {
+				svcCopy := svc.DeepCopy()
+				if svcCopy.Annotations == nil {
+					svcCopy.Annotations = map[string]string{}
+				}
+				svcCopy.Annotations[naming.RestoreSystemSnapshotAnnotation] = uc.SystemSnapshotTag
+				controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, svcCopy, "update", sdc.Generation)
+				_, err = sdcc.kubeClient.CoreV1().Services(svcCopy.Namespace).Update(ctx, svcCopy, metav1.UpdateOptions{})
+				resourceapply.ReportUpdateEvent(sdcc.eventRecorder, svc, err)
+				if err != nil {
+					return progressingConditions, err
+				}
+
+				return progressingConditions, nil
+			}
+
+			klog.V(2).InfoS("Rolling back node", "ScyllaDBDatacenter", klog.KObj(sdc), "Pod", klog.KObj(pod), "Image", uc.FromImage)
+			controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, pod, "delete", sdc.Generation)
+			err = sdcc.kubeClient.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{
+				Preconditions: &metav1.Preconditions{
+					UID: &pod.UID,
+				},
+			})
+			resourceapply.ReportDeleteEvent(sdcc.eventRecorder, pod, err)
+			if err != nil {
+				return progressingConditions, fmt.Errorf("can't delete pod %q: %w", naming.ObjRef(pod), err)
+			}
+
+			return progressingConditions, nil
+		}
+	}
+
+	// All nodes have been reverted. Clean up the restore requests, so they don't apply on the next restart.
+	for _, svc := range services {
+		_, hasRestore := svc.Annotations[naming.RestoreSystemSnapshotAnnotation]
+		_, hasRestored := svc.Annotations[naming.RestoredSystemSnapshotAnnotation]
+		if !hasRestore && !hasRestored {
+			continue
+		}
+
+		svcCopy := svc.DeepCopy()
+		delete(svcCopy.Annotations, naming.RestoreSystemSnapshotAnnotation)
+		delete(svcCopy.Annotations, naming.RestoredSystemSnapshotAnnotation)
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, svcCopy, "update", sdc.Generation)
+		_, err := sdcc.kubeClient.CoreV1().Services(svcCopy.Namespace).Update(ctx, svcCopy, metav1.UpdateOptions{})
+		resourceapply.ReportUpdateEvent(sdcc.eventRecorder, svc, err)
+		if err != nil {
+			return progressingConditions, err
+		}
+	}
+
+	status.UpgradeRollback.Phase = scyllav1alpha1.UpgradeRollbackPhaseCompleted
+	status.UpgradeRollback.CompletionTime = pointer.Ptr(metav1.Now())
+	status.UpgradeRollback.Message = fmt.Sprintf("%s Nodes were reverted to %q. Snapshots %q and %q were kept on the nodes.", uc.Rollback.Message, uc.FromImage, uc.SystemSnapshotTag, uc.DataSnapshotTag)
+	sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "UpgradeRolledBack", "Upgrade from %q to %q has been rolled back.", uc.FromImage, uc.ToImage)
+
+	cmName := naming.UpgradeContextConfigMapName(sdc)
+	cm, err := sdcc.configMapLister.ConfigMaps(sdc.Namespace).Get(cmName)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't get upgrade context ConfigMap %q: %w", naming.ManualRef(sdc.Namespace, cmName), err)
+	}
+
+	controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, cm, "delete", sdc.Generation)
+	err = sdcc.kubeClient.CoreV1().ConfigMaps(sdc.Namespace).Delete(ctx, cmName, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{
+			UID: &cm.UID,
+		},
+		PropagationPolicy: pointer.Ptr(metav1.DeletePropagationBackground),
+	})
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't delete upgrade context ConfigMap %q: %w", naming.ManualRef(sdc.Namespace, cmName), err)
+	}
+
+	return progressingConditions, nil
+}
@@ -0,0 +1,305 @@
+package scylladbdatacenter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetUpgradedNodeNotReadyDuration(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	makePod := func(image string, ready corev1.ConditionStatus, created, transitioned time.Time) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "basic-dc-a-0",
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:  naming.ScyllaContainerName,
+						Image: image,
+					},
+				},
+			},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{
+					{
+						Type:               corev1.PodReady,
+						Status:             ready,
+						LastTransitionTime: metav1.NewTime(transitioned),
+					},
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name             string
+		pod              *corev1.Pod
+		expectedDuration time.Duration
+		expectedOK       bool
+	}{
+		{
+			name:             "node running the previous image",
+			pod:              makePod("scylladb/scylla:6.0.0", corev1.ConditionFalse, now.Add(-time.Hour), now.Add(-time.Hour)),
+			expectedDuration: 0,
+			expectedOK:       false,
+		},
+		{
+			name:             "ready upgraded node",
+			pod:              makePod("scylladb/scylla:6.1.0", corev1.ConditionTrue, now.Add(-time.Hour), now.Add(-time.Hour)),
+			expectedDuration: 0,
+			expectedOK:       false,
+		},
+		{
+			name:             "upgraded node that has never been ready",
+			pod:              makePod("scylladb/scylla:6.1.0", corev1.ConditionFalse, now.Add(-10*time.Minute), now.Add(-10*time.Minute)),
+			expectedDuration: 10 * time.Minute,
+			expectedOK:       true,
+		},
+		{
+			name:             "upgraded node that stopped being ready",
+			pod:              makePod("scylladb/scylla:6.1.0", corev1.ConditionFalse, now.Add(-time.Hour), now.Add(-5*time.Minute)),
+			expectedDuration: 5 * time.Minute,
+			expectedOK:       true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotDuration, gotOK := getUpgradedNodeNotReadyDuration(tc.pod, "scylladb/scylla:6.1.0", now)
+			if gotDuration != tc.expectedDuration {
+				t.Errorf("expected duration %v, got %v", tc.expectedDuration, gotDuration)
+			}
+			if gotOK != tc.expectedOK {
+				t.Errorf("expected ok %v, got %v", tc.expectedOK, gotOK)
+			}
+		})
+	}
+}
+
+func TestOverrideImageForUpgradeRollback(t *testing.T) {
+	t.Parallel()
+
+	makeStatefulSet := func(image, version string) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "basic-dc-a",
+				Labels: map[string]string{
+					naming.ScyllaVersionLabel: version,
+				},
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{
+							naming.ScyllaVersionLabel: version,
+						},
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name:  naming.ScyllaContainerName,
+								Image: image,
+							},
+							{
+								Name:  "scylla-manager-agent",
+								Image: "scylladb/scylla-manager-agent:3.4.0",
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name     string
+		image    string
+		rollback *scyllav1alpha1.UpgradeRollbackStatus
+		expected *appsv1.StatefulSet
+	}{
+		{
+			name:     "no rollback",
+			image:    "scylladb/scylla:6.1.0",
+			rollback: nil,
+			expected: makeStatefulSet("scylladb/scylla:6.1.0", "6.1.0"),
+		},
+		{
+			name:  "spec matches the rolled back image",
+			image: "scylladb/scylla:6.1.0",
+			rollback: &scyllav1alpha1.UpgradeRollbackStatus{
+				Phase:     scyllav1alpha1.UpgradeRollbackPhaseCompleted,
+				FromImage: "scylladb/scylla:6.0.2",
+				ToImage:   "scylladb/scylla:6.1.0",
+			},
+			expected: makeStatefulSet("scylladb/scylla:6.0.2", "6.0.2"),
+		},
+		{
+			name:  "spec changed since the rollback",
+			image: "scylladb/scylla:6.1.1",
+			rollback: &scyllav1alpha1.UpgradeRollbackStatus{
+				Phase:     scyllav1alpha1.UpgradeRollbackPhaseCompleted,
+				FromImage: "scylladb/scylla:6.0.2",
+				ToImage:   "scylladb/scylla:6.1.0",
+			},
+			expected: makeStatefulSet("scylladb/scylla:6.1.1", "6.1.1"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+				Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+					ScyllaDB: scyllav1alpha1.ScyllaDB{
+						Image: tc.image,
+					},
+				},
+			}
+			status := &scyllav1alpha1.ScyllaDBDatacenterStatus{
+				UpgradeRollback: tc.rollback,
+			}
+
+			version, err := naming.ImageToVersion(tc.image)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sts := makeStatefulSet(tc.image, version)
+
+			err = overrideImageForUpgradeRollback(sdc, status, []*appsv1.StatefulSet{sts})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(sts, tc.expected) {
+				t.Errorf("expected and got StatefulSets differ: %s", cmp.Diff(tc.expected, sts))
+			}
+		})
+	}
+}
+
+func TestIsUpgradeRollbackRequested(t *testing.T) {
+	t.Parallel()
+
+	uc := &internalapi.DatacenterUpgradeContext{
+		FromVersion: "6.0.2",
+		ToVersion:   "6.1.0",
+		FromImage:   "scylladb/scylla:6.0.2",
+		ToImage:     "scylladb/scylla:6.1.0",
+	}
+
+	tt := []struct {
+		name        string
+		annotations map[string]string
+		expected    bool
+	}{
+		{
+			name:        "no annotation",
+			annotations: nil,
+			expected:    false,
+		},
+		{
+			name: "annotation names the version of the upgrade",
+			annotations: map[string]string{
+				naming.RollbackUpgradeAnnotation: "6.1.0",
+			},
+			expected: true,
+		},
+		{
+			name: "annotation names the image of the upgrade",
+			annotations: map[string]string{
+				naming.RollbackUpgradeAnnotation: "scylladb/scylla:6.1.0",
+			},
+			expected: true,
+		},
+		{
+			name: "annotation left over from an earlier upgrade",
+			annotations: map[string]string{
+				naming.RollbackUpgradeAnnotation: "6.0.2",
+			},
+			expected: false,
+		},
+		{
+			name: "annotation without a version",
+			annotations: map[string]string{
+				naming.RollbackUpgradeAnnotation: "true",
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: tc.annotations,
+				},
+			}
+
+			got := isUpgradeRollbackRequested(sdc, uc)
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetUpgradeRollbackOptions(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name                         string
+		options                      *scyllav1alpha1.UpgradeRollbackOptions
+		expectedFailedNodesThreshold int32
+		expectedNodeReadinessTimeout time.Duration
+	}{
+		{
+			name:                         "unset options fall back to defaults",
+			options:                      &scyllav1alpha1.UpgradeRollbackOptions{},
+			expectedFailedNodesThreshold: 1,
+			expectedNodeReadinessTimeout: 15 * time.Minute,
+		},
+		{
+			name: "set options are used",
+			options: &scyllav1alpha1.UpgradeRollbackOptions{
+				FailedNodesThreshold: 2,
+				NodeReadinessTimeout: metav1.Duration{Duration: 5 * time.Minute},
+			},
+			expectedFailedNodesThreshold: 2,
+			expectedNodeReadinessTimeout: 5 * time.Minute,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotFailedNodesThreshold := getUpgradeRollbackFailedNodesThreshold(tc.options)
+			if gotFailedNodesThreshold != tc.expectedFailedNodesThreshold {
+				t.Errorf("expected failed nodes threshold %d, got %d", tc.expectedFailedNodesThreshold, gotFailedNodesThreshold)
+			}
+
+			gotNodeReadinessTimeout := getUpgradeRollbackNodeReadinessTimeout(tc.options)
+			if gotNodeReadinessTimeout != tc.expectedNodeReadinessTimeout {
+				t.Errorf("expected node readiness timeout %v, got %v", tc.expectedNodeReadinessTimeout, gotNodeReadinessTimeout)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbdatacenterautoscaler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scyllaclient "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned"
+	scyllav1alpha1informers "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/scylla/v1alpha1"
+	scyllav1alpha1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/kubeinterfaces"
+	"github.com/scylladb/scylla-operator/pkg/scheme"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+)
+
+const (
+	ControllerName = "ScyllaDBDatacenterAutoscalerController"
+
+	// metricsResyncInterval is how often metrics are evaluated.
+	metricsResyncInterval = time.Minute
+)
+
+var (
+	keyFunc                                   = cache.DeletionHandlingMetaNamespaceKeyFunc
+	scyllaDBDatacenterAutoscalerControllerGVK = scyllav1alpha1.GroupVersion.WithKind("ScyllaDBDatacenterAutoscaler")
+)
+
+// Controller recommends and applies the number of nodes of racks of ScyllaDBDatacenters based on their utilization.
+type Controller struct {
+	kubeClient   kubernetes.Interface
+	scyllaClient scyllaclient.Interface
+
+	secretLister                       corev1listers.SecretLister
+	serviceLister                      corev1listers.ServiceLister
+	podLister                          corev1listers.PodLister
+	pvcLister                          corev1listers.PersistentVolumeClaimLister
+	scyllaDBDatacenterLister           scyllav1alpha1listers.ScyllaDBDatacenterLister
+	scyllaDBDatacenterAutoscalerLister scyllav1alpha1listers.ScyllaDBDatacenterAutoscalerLister
+
+	cachesToSync []cache.InformerSynced
+
+	eventRecorder record.EventRecorder
+
+	queue    workqueue.RateLimitingInterface
+	handlers *controllerhelpers.Handlers[*scyllav1alpha1.ScyllaDBDatacenterAutoscaler]
+}
+
+func NewController(
+	kubeClient kubernetes.Interface,
+	scyllaClient scyllaclient.Interface,
+	secretInformer corev1informers.SecretInformer,
+	serviceInformer corev1informers.ServiceInformer,
+	podInformer corev1informers.PodInformer,
+	pvcInformer corev1informers.PersistentVolumeClaimInformer,
+	scyllaDBDatacenterInformer scyllav1alpha1informers.ScyllaDBDatacenterInformer,
+	scyllaDBDatacenterAutoscalerInformer scyllav1alpha1informers.ScyllaDBDatacenterAutoscalerInformer,
+) (*Controller, error) {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartStructuredLogging(0)
+	eventBroadcaster.StartRecordingToSink(&corev1client.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+
+	sdcac := &Controller{
+		kubeClient:   kubeClient,
+		scyllaClient: scyllaClient,
+
+		secretLister:                       secretInformer.Lister(),
+		serviceLister:                      serviceInformer.Lister(),
+		podLister:                          podInformer.Lister(),
+		pvcLister:                          pvcInformer.Lister(),
+		scyllaDBDatacenterLister:           scyllaDBDatacenterInformer.Lister(),
+		scyllaDBDatacenterAutoscalerLister: scyllaDBDatacenterAutoscalerInformer.Lister(),
+
+		cachesToSync: []cache.InformerSynced{
+			secretInformer.Informer().HasSynced,
+			serviceInformer.Informer().HasSynced,
+			podInformer.Informer().HasSynced,
+			pvcInformer.Informer().HasSynced,
+			scyllaDBDatacenterInformer.Informer().HasSynced,
+			scyllaDBDatacenterAutoscalerInformer.Informer().HasSynced,
+		},
+
+		eventRecorder: eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "scylladbdatacenterautoscaler-controller"}),
+
+		queue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "scylladbdatacenterautoscaler"),
+	}
+
+	var err error
+	sdcac.handlers, err = controllerhelpers.NewHandlers[*scyllav1alpha1.ScyllaDBDatacenterAutoscaler](
+		sdcac.queue,
+		keyFunc,
+		scheme.Scheme,
+		scyllaDBDatacenterAutoscalerControllerGVK,
+		kubeinterfaces.NamespacedGetList[*scyllav1alpha1.ScyllaDBDatacenterAutoscaler]{
+			GetFunc: func(namespace, name string) (*scyllav1alpha1.ScyllaDBDatacenterAutoscaler, error) {
+				return sdcac.scyllaDBDatacenterAutoscalerLister.ScyllaDBDatacenterAutoscalers(namespace).Get(name)
+			},
+			ListFunc: func(namespace string, selector labels.Selector) (ret []*scyllav1alpha1.ScyllaDBDatacenterAutoscaler, err error) {
+				return sdcac.scyllaDBDatacenterAutoscalerLister.ScyllaDBDatacenterAutoscalers(namespace).List(selector)
+			},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("can't create handlers: %w", err)
+	}
+
+	scyllaDBDatacenterAutoscalerInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    sdcac.addScyllaDBDatacenterAutoscaler,
+		UpdateFunc: sdcac.updateScyllaDBDatacenterAutoscaler,
+		DeleteFunc: sdcac.deleteScyllaDBDatacenterAutoscaler,
+	})
+
+	scyllaDBDatacenterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    sdcac.addScyllaDBDatacenter,
+		UpdateFunc: sdcac.updateScyllaDBDatacenter,
+		DeleteFunc: sdcac.deleteScyllaDBDatacenter,
+	})
+
+	return sdcac, nil
+}
+
+func (sdcac *Controller) processNextItem(ctx context.Context) bool {
+	key, quit := sdcac.queue.Get()
+	if quit {
+		return false
+	}
+	defer sdcac.queue.Done(key)
+
+	err := sdcac.sync(ctx, key.(string))
+	// TODO: Do smarter filtering then just Reduce to handle cases like 2 conflict errors.
+	err = utilerrors.Reduce(err)
+	switch {
+	case err == nil:
+		sdcac.queue.Forget(key)
+		return true
+
+	case apierrors.IsConflict(err):
+		klog.V(2).InfoS("Hit conflict, will retry in a bit", "Key", key, "Error", err)
+
+	default:
+		utilruntime.HandleError(fmt.Errorf("syncing key '%v' failed: %v", key, err))
+	}
+
+	sdcac.queue.AddRateLimited(key)
+
+	return true
+}
+
+func (sdcac *Controller) runWorker(ctx context.Context) {
+	for sdcac.processNextItem(ctx) {
+	}
+}
+
+func (sdcac *Controller) Run(ctx context.Context, workers int) {
+	defer utilruntime.HandleCrash()
+
+	klog.InfoS("Starting controller", "controller", ControllerName)
+
+	var wg sync.WaitGroup
+	defer func() {
+		klog.InfoS("Shutting down controller", "controller", ControllerName)
+		sdcac.queue.ShutDown()
+		wg.Wait()
+		klog.InfoS("Shut down controller", "controller", ControllerName)
+	}()
+
+	if !cache.WaitForNamedCacheSync(ControllerName, ctx.Done(), sdcac.cachesToSync...) {
+		return
+	}
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wait.UntilWithContext(ctx, sdcac.runWorker, time.Second)
+		}()
+	}
+
+	<-ctx.Done()
+}
+
+// enqueueScyllaDBDatacenterAutoscalersReferencingScyllaDBDatacenter enqueues ScyllaDBDatacenterAutoscalers scaling the ScyllaDBDatacenter.
+func (sdcac *Controller) enqueueScyllaDBDatacenterAutoscalersReferencingScyllaDBDatacenter(depth int, obj kubeinterfaces.ObjectInterface, op controllerhelpers.HandlerOperationType) {
+	sdc := obj.(*scyllav1alpha1.ScyllaDBDatacenter)
+
+	sdcas, err := sdcac.scyllaDBDatacenterAutoscalerLister.ScyllaDBDatacenterAutoscalers(sdc.Namespace).List(labels.Everything())
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("can't list ScyllaDBDatacenterAutoscalers: %w", err))
+		return
+	}
+
+	for _, sdca := range sdcas {
+		if sdca.Spec.ScyllaDBDatacenterRef.Name == sdc.Name {
+			sdcac.handlers.Enqueue(depth+1, sdca, op)
+		}
+	}
+}
+
+func (sdcac *Controller) addScyllaDBDatacenterAutoscaler(obj interface{}) {
+	sdcac.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.ScyllaDBDatacenterAutoscaler),
+		sdcac.handlers.Enqueue,
+	)
+}
+
+func (sdcac *Controller) updateScyllaDBDatacenterAutoscaler(old, cur interface{}) {
+	sdcac.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.ScyllaDBDatacenterAutoscaler),
+		cur.(*scyllav1alpha1.ScyllaDBDatacenterAutoscaler),
+		sdcac.handlers.Enqueue,
+		sdcac.deleteScyllaDBDatacenterAutoscaler,
+	)
+}
+
+func (sdcac *Controller) deleteScyllaDBDatacenterAutoscaler(obj interface{}) {
+	sdcac.handlers.HandleDelete(
+		obj,
+		sdcac.handlers.Enqueue,
+	)
+}
+
+func (sdcac *Controller) addScyllaDBDatacenter(obj interface{}) {
+	sdcac.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.ScyllaDBDatacenter),
+		sdcac.enqueueScyllaDBDatacenterAutoscalersReferencingScyllaDBDatacenter,
+	)
+}
+
+func (sdcac *Controller) updateScyllaDBDatacenter(old, cur interface{}) {
+	sdcac.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.ScyllaDBDatacenter),
+		cur.(*scyllav1alpha1.ScyllaDBDatacenter),
+		sdcac.enqueueScyllaDBDatacenterAutoscalersReferencingScyllaDBDatacenter,
+		sdcac.deleteScyllaDBDatacenter,
+	)
+}
+
+func (sdcac *Controller) deleteScyllaDBDatacenter(obj interface{}) {
+	sdcac.handlers.HandleDelete(
+		obj,
+		sdcac.enqueueScyllaDBDatacenterAutoscalersReferencingScyllaDBDatacenter,
+	)
+}
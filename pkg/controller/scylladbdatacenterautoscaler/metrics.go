@@ -0,0 +1,261 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbdatacenterautoscaler
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	prometheusappclient "github.com/prometheus/client_golang/api"
+	prometheusappv1api "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/helpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+)
+
+const (
+	prometheusQueryTimeout = 30 * time.Second
+
+	namespacePlaceholder = "$namespace"
+
+	// defaultDiskUtilizationQuery returns the utilization of ScyllaDB data volumes, keyed by the Pod using them.
+	defaultDiskUtilizationQuery = `max by (pod) (label_replace(100 * kubelet_volume_stats_used_bytes{namespace="$namespace"} / kubelet_volume_stats_capacity_bytes{namespace="$namespace"}, "pod", "$1", "persistentvolumeclaim", "` + naming.PVCTemplateName + `-(.+)"))`
+
+	// defaultLoadQuery returns the average reactor utilization of ScyllaDB nodes, keyed by Pod.
+	defaultLoadQuery = `avg by (pod) (scylla_reactor_utilization{namespace="$namespace"})`
+)
+
+// podMetrics hold a metric value, in percent, keyed by Pod name.
+type podMetrics map[string]float64
+
+// aggregateRackMetrics maps metrics of Pods to racks. Disk utilization of a rack is the one of its most utilized node,
+// load is averaged across its nodes.
+func aggregateRackMetrics(sdc *scyllav1alpha1.ScyllaDBDatacenter, diskUtilization, load podMetrics) map[string]rackMetrics {
+	res := make(map[string]rackMetrics, len(sdc.Spec.Racks))
+
+	for _, rack := range sdc.Spec.Racks {
+		podNameRegexp := regexp.MustCompile(fmt.Sprintf(`^%s-[0-9]+$`, regexp.QuoteMeta(naming.StatefulSetNameForRack(rack, sdc))))
+
+		var rm rackMetrics
+		for podName, v := range diskUtilization {
+			if !podNameRegexp.MatchString(podName) {
+				continue
+			}
+
+			if rm.diskUtilization == nil || v > *rm.diskUtilization {
+				rm.diskUtilization = pointer.Ptr(v)
+			}
+		}
+
+		var loadSum float64
+		var loadCount int
+		for podName, v := range load {
+			if !podNameRegexp.MatchString(podName) {
+				continue
+			}
+
+			loadSum += v
+			loadCount++
+		}
+		if loadCount > 0 {
+			rm.load = pointer.Ptr(loadSum / float64(loadCount))
+		}
+
+		res[rack.Name] = rm
+	}
+
+	return res
+}
+
+func (sdcac *Controller) getRackMetrics(ctx context.Context, sdca *scyllav1alpha1.ScyllaDBDatacenterAutoscaler, sdc *scyllav1alpha1.ScyllaDBDatacenter) (map[string]rackMetrics, error) {
+	switch sdca.Spec.MetricsSource.Type {
+	case scyllav1alpha1.AutoscalerMetricsSourceTypePrometheus:
+		diskUtilization, load, err := getPrometheusMetrics(ctx, sdca.Spec.MetricsSource.Prometheus, sdc.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		return aggregateRackMetrics(sdc, diskUtilization, load), nil
+
+	case scyllav1alpha1.AutoscalerMetricsSourceTypeScyllaDBAPI:
+		diskUtilization, err := sdcac.getScyllaDBAPIDiskUtilization(ctx, sdc)
+		if err != nil {
+			return nil, err
+		}
+		return aggregateRackMetrics(sdc, diskUtilization, nil), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported metrics source type %q", sdca.Spec.MetricsSource.Type)
+	}
+}
+
+func getPrometheusMetrics(ctx context.Context, options *scyllav1alpha1.AutoscalerPrometheusOptions, namespace string) (podMetrics, podMetrics, error) {
+	if options == nil {
+		return nil, nil, fmt.Errorf("prometheus options are missing")
+	}
+
+	client, err := prometheusappclient.NewClient(prometheusappclient.Config{
+		Address: options.URL,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't create Prometheus client: %w", err)
+	}
+
+	api := prometheusappv1api.NewAPI(client)
+
+	diskUtilizationQuery := options.DiskUtilizationQuery
+	if len(diskUtilizationQuery) == 0 {
+		diskUtilizationQuery = defaultDiskUtilizationQuery
+	}
+	diskUtilization, err := queryPodMetrics(ctx, api, strings.ReplaceAll(diskUtilizationQuery, namespacePlaceholder, namespace))
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't get disk utilization: %w", err)
+	}
+
+	loadQuery := options.LoadQuery
+	if len(loadQuery) == 0 {
+		loadQuery = defaultLoadQuery
+	}
+	load, err := queryPodMetrics(ctx, api, strings.ReplaceAll(loadQuery, namespacePlaceholder, namespace))
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't get load: %w", err)
+	}
+
+	return diskUtilization, load, nil
+}
+
+func queryPodMetrics(ctx context.Context, api prometheusappv1api.API, query string) (podMetrics, error) {
+	queryCtx, queryCtxCancel := context.WithTimeout(ctx, prometheusQueryTimeout)
+	defer queryCtxCancel()
+
+	value, _, err := api.Query(queryCtx, query, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("can't run query %q: %w", query, err)
+	}
+
+	return podMetricsFromValue(value)
+}
+
+func podMetricsFromValue(value model.Value) (podMetrics, error) {
+	vector, ok := value.(model.Vector)
+	if !ok {
+		return nil, fmt.Errorf("expected a vector result, got %q", value.Type())
+	}
+
+	res := make(podMetrics, len(vector))
+	for _, sample := range vector {
+		podName, ok := sample.Metric["pod"]
+		if !ok {
+			return nil, fmt.Errorf("sample %q is missing the pod label", sample.Metric)
+		}
+		res[string(podName)] = float64(sample.Value)
+	}
+
+	return res, nil
+}
+
+// getScyllaDBAPIDiskUtilization computes the disk utilization of nodes as the size of data reported by ScyllaDB
+// relative to the capacity of their data volumes.
+func (sdcac *Controller) getScyllaDBAPIDiskUtilization(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter) (podMetrics, error) {
+	type node struct {
+		host     string
+		capacity int64
+	}
+
+	nodes := map[string]node{}
+	var hosts []string
+	for _, rack := range sdc.Spec.Racks {
+		for i := range getRackNodes(sdc, &rack) {
+			svcName := naming.MemberServiceName(rack, sdc, int(i))
+
+			pod, err := sdcac.podLister.Pods(sdc.Namespace).Get(svcName)
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("can't get pod %q: %w", naming.ManualRef(sdc.Namespace, svcName), err)
+			}
+
+			svc, err := sdcac.serviceLister.Services(sdc.Namespace).Get(svcName)
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("can't get service %q: %w", naming.ManualRef(sdc.Namespace, svcName), err)
+			}
+
+			pvcName := naming.PVCNameForService(svcName)
+			pvc, err := sdcac.pvcLister.PersistentVolumeClaims(sdc.Namespace).Get(pvcName)
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("can't get PVC %q: %w", naming.ManualRef(sdc.Namespace, pvcName), err)
+			}
+
+			capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]
+			if !ok || capacity.IsZero() {
+				continue
+			}
+
+			host, err := controllerhelpers.GetScyllaHost(sdc, svc, pod)
+			if err != nil {
+				klog.V(4).InfoS("Can't get host of a ScyllaDB node", "Pod", klog.KObj(pod), "Error", err)
+				continue
+			}
+
+			nodes[pod.Name] = node{host: host, capacity: capacity.Value()}
+			hosts = append(hosts, host)
+		}
+	}
+
+	if len(hosts) == 0 {
+		return podMetrics{}, nil
+	}
+
+	token, err := sdcac.getScyllaManagerAgentToken(sdc)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := controllerhelpers.NewScyllaClientFromToken(hosts, token)
+	if err != nil {
+		return nil, fmt.Errorf("can't create scylla client: %w", err)
+	}
+	defer client.Close()
+
+	res := make(podMetrics, len(nodes))
+	for podName, n := range nodes {
+		load, err := client.StorageLoad(ctx, n.host)
+		if err != nil {
+			return nil, fmt.Errorf("can't get storage load of node %q: %w", podName, err)
+		}
+
+		res[podName] = 100 * load / float64(n.capacity)
+	}
+
+	return res, nil
+}
+
+func (sdcac *Controller) getScyllaManagerAgentToken(sdc *scyllav1alpha1.ScyllaDBDatacenter) (string, error) {
+	secretName := naming.AgentAuthTokenSecretName(sdc)
+	secret, err := sdcac.secretLister.Secrets(sdc.Namespace).Get(secretName)
+	if err != nil {
+		return "", fmt.Errorf("can't get manager agent auth secret %q: %w", naming.ManualRef(sdc.Namespace, secretName), err)
+	}
+
+	token, err := helpers.GetAgentAuthTokenFromSecret(secret)
+	if err != nil {
+		return "", fmt.Errorf("can't get agent token from secret %q: %w", naming.ObjRef(secret), err)
+	}
+
+	return token, nil
+}
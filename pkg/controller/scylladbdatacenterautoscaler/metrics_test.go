@@ -0,0 +1,91 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbdatacenterautoscaler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/common/model"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+)
+
+func TestAggregateRackMetrics(t *testing.T) {
+	t.Parallel()
+
+	sdc := newScyllaDBDatacenterWithRacks(2, 2)
+	sdc.Spec.DatacenterName = pointer.Ptr("dc1")
+
+	diskUtilization := podMetrics{
+		"basic-dc1-a-0":  40,
+		"basic-dc1-a-1":  60,
+		"basic-dc1-b-0":  20,
+		"basic-dc1-ab-0": 99,
+		"other-dc1-a-0":  99,
+	}
+	load := podMetrics{
+		"basic-dc1-a-0": 30,
+		"basic-dc1-a-1": 50,
+	}
+
+	expected := map[string]rackMetrics{
+		"a": {diskUtilization: pointer.Ptr(60.0), load: pointer.Ptr(40.0)},
+		"b": {diskUtilization: pointer.Ptr(20.0)},
+	}
+
+	got := aggregateRackMetrics(sdc, diskUtilization, load)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected and got rack metrics differ:\n%s", cmp.Diff(expected, got, cmp.AllowUnexported(rackMetrics{})))
+	}
+}
+
+func TestPodMetricsFromValue(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name          string
+		value         model.Value
+		expected      podMetrics
+		expectedError bool
+	}{
+		{
+			name: "vector",
+			value: model.Vector{
+				{Metric: model.Metric{"pod": "basic-dc1-a-0"}, Value: 42},
+				{Metric: model.Metric{"pod": "basic-dc1-a-1"}, Value: 7.5},
+			},
+			expected: podMetrics{
+				"basic-dc1-a-0": 42,
+				"basic-dc1-a-1": 7.5,
+			},
+		},
+		{
+			name: "sample without pod label",
+			value: model.Vector{
+				{Metric: model.Metric{"instance": "10.0.0.1"}, Value: 42},
+			},
+			expectedError: true,
+		},
+		{
+			name:          "scalar",
+			value:         &model.Scalar{Value: 1},
+			expectedError: true,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := podMetricsFromValue(tc.value)
+			if (err != nil) != tc.expectedError {
+				t.Fatalf("expected error %v, got %v", tc.expectedError, err)
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got pod metrics differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
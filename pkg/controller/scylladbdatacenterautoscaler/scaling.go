@@ -0,0 +1,210 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbdatacenterautoscaler
+
+import (
+	"math"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultScaleOutCooldown = 10 * time.Minute
+	defaultScaleInCooldown  = 30 * time.Minute
+)
+
+const (
+	belowMinNodesReason     = "BelowMinNodes"
+	aboveMaxNodesReason     = "AboveMaxNodes"
+	thresholdExceededReason = "ThresholdExceeded"
+	belowThresholdsReason   = "BelowThresholds"
+)
+
+// rackMetrics hold the utilization of a rack, in percent. Missing metrics are nil.
+type rackMetrics struct {
+	// diskUtilization is the disk utilization of the most utilized node in the rack.
+	diskUtilization *float64
+	// load is the average load of nodes in the rack.
+	load *float64
+}
+
+// scalingDecision describes a change of the number of nodes of a single rack.
+type scalingDecision struct {
+	rackName string
+	from     int32
+	to       int32
+	reason   string
+}
+
+func getRackNodes(sdc *scyllav1alpha1.ScyllaDBDatacenter, rack *scyllav1alpha1.RackSpec) int32 {
+	if rack.Nodes != nil {
+		return *rack.Nodes
+	}
+
+	if sdc.Spec.RackTemplate != nil && sdc.Spec.RackTemplate.Nodes != nil {
+		return *sdc.Spec.RackTemplate.Nodes
+	}
+
+	return 0
+}
+
+func getCooldown(d *metav1.Duration, defaultCooldown time.Duration) time.Duration {
+	if d == nil {
+		return defaultCooldown
+	}
+
+	return d.Duration
+}
+
+// exceedsAnyThreshold returns whether any of the configured thresholds is exceeded.
+func exceedsAnyThreshold(thresholds *scyllav1alpha1.AutoscalerThresholds, metrics rackMetrics) bool {
+	if thresholds.DiskUtilizationPercent != nil && metrics.diskUtilization != nil && *metrics.diskUtilization > float64(*thresholds.DiskUtilizationPercent) {
+		return true
+	}
+
+	if thresholds.LoadPercent != nil && metrics.load != nil && *metrics.load > float64(*thresholds.LoadPercent) {
+		return true
+	}
+
+	return false
+}
+
+// belowAllThresholds returns whether all configured thresholds are met. Missing metrics never meet a threshold,
+// so that capacity isn't removed blindly.
+func belowAllThresholds(thresholds *scyllav1alpha1.AutoscalerThresholds, metrics rackMetrics) bool {
+	if thresholds.DiskUtilizationPercent == nil && thresholds.LoadPercent == nil {
+		return false
+	}
+
+	if thresholds.DiskUtilizationPercent != nil && (metrics.diskUtilization == nil || *metrics.diskUtilization >= float64(*thresholds.DiskUtilizationPercent)) {
+		return false
+	}
+
+	if thresholds.LoadPercent != nil && (metrics.load == nil || *metrics.load >= float64(*thresholds.LoadPercent)) {
+		return false
+	}
+
+	return true
+}
+
+// recommendNodes returns the recommended number of nodes of a rack and the reason for a change.
+// The recommendation never differs from the current number of nodes by more than one.
+func recommendNodes(spec *scyllav1alpha1.ScyllaDBDatacenterAutoscalerSpec, current int32, metrics rackMetrics) (int32, string) {
+	switch {
+	case current < spec.MinNodes:
+		return current + 1, belowMinNodesReason
+
+	case current > spec.MaxNodes:
+		return current - 1, aboveMaxNodesReason
+
+	case exceedsAnyThreshold(&spec.ScaleOut, metrics):
+		if current < spec.MaxNodes {
+			return current + 1, thresholdExceededReason
+		}
+
+	case belowAllThresholds(&spec.ScaleIn, metrics):
+		if current > spec.MinNodes {
+			return current - 1, belowThresholdsReason
+		}
+	}
+
+	return current, ""
+}
+
+func roundPercent(v *float64) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	return pointer.Ptr(int32(math.Round(*v)))
+}
+
+// planScaling computes the status of every rack and picks at most one rack to scale.
+// Scale-outs take precedence over scale-ins. Racks within their cooldown are skipped, unless they are out of bounds.
+func planScaling(
+	sdca *scyllav1alpha1.ScyllaDBDatacenterAutoscaler,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	metrics map[string]rackMetrics,
+	now time.Time,
+) ([]scyllav1alpha1.AutoscalerRackStatus, *scalingDecision) {
+	rackStatuses := make([]scyllav1alpha1.AutoscalerRackStatus, 0, len(sdc.Spec.Racks))
+	var scaleOut, scaleIn *scalingDecision
+
+	for i := range sdc.Spec.Racks {
+		rack := &sdc.Spec.Racks[i]
+		current := getRackNodes(sdc, rack)
+		rackMetrics := metrics[rack.Name]
+		recommended, reason := recommendNodes(&sdca.Spec, current, rackMetrics)
+
+		rackStatus := scyllav1alpha1.AutoscalerRackStatus{
+			Name:                   rack.Name,
+			CurrentNodes:           current,
+			RecommendedNodes:       recommended,
+			DiskUtilizationPercent: roundPercent(rackMetrics.diskUtilization),
+			LoadPercent:            roundPercent(rackMetrics.load),
+		}
+		previousRackStatus, _, ok := slices.Find(sdca.Status.Racks, func(rs scyllav1alpha1.AutoscalerRackStatus) bool {
+			return rs.Name == rack.Name
+		})
+		if ok {
+			rackStatus.LastScaleTime = previousRackStatus.LastScaleTime
+		}
+		rackStatuses = append(rackStatuses, rackStatus)
+
+		if recommended == current {
+			continue
+		}
+
+		outOfBounds := reason == belowMinNodesReason || reason == aboveMaxNodesReason
+		if recommended > current {
+			cooldown := getCooldown(sdca.Spec.ScaleOutCooldown, defaultScaleOutCooldown)
+			if scaleOut == nil && (outOfBounds || !inCooldown(rackStatus.LastScaleTime, cooldown, now)) {
+				scaleOut = &scalingDecision{rackName: rack.Name, from: current, to: recommended, reason: reason}
+			}
+		} else {
+			cooldown := getCooldown(sdca.Spec.ScaleInCooldown, defaultScaleInCooldown)
+			if scaleIn == nil && (outOfBounds || !inCooldown(rackStatus.LastScaleTime, cooldown, now)) {
+				scaleIn = &scalingDecision{rackName: rack.Name, from: current, to: recommended, reason: reason}
+			}
+		}
+	}
+
+	if scaleOut != nil {
+		return rackStatuses, scaleOut
+	}
+
+	return rackStatuses, scaleIn
+}
+
+func inCooldown(lastScaleTime *metav1.Time, cooldown time.Duration, now time.Time) bool {
+	return lastScaleTime != nil && now.Before(lastScaleTime.Add(cooldown))
+}
+
+// isDatacenterSettled returns whether all racks of the ScyllaDBDatacenter have all their nodes ready,
+// according to a fresh status. Only a settled ScyllaDBDatacenter is scaled, so nodes are added or removed one at a time.
+func isDatacenterSettled(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+	if sdc.Status.ObservedGeneration == nil || *sdc.Status.ObservedGeneration < sdc.Generation {
+		return false
+	}
+
+	for i := range sdc.Spec.Racks {
+		rack := &sdc.Spec.Racks[i]
+		rackStatus, _, ok := slices.Find(sdc.Status.Racks, func(rs scyllav1alpha1.RackStatus) bool {
+			return rs.Name == rack.Name
+		})
+		if !ok || rackStatus.Stale == nil || *rackStatus.Stale {
+			return false
+		}
+
+		nodes := getRackNodes(sdc, rack)
+		if rackStatus.Nodes == nil || *rackStatus.Nodes != nodes || rackStatus.ReadyNodes == nil || *rackStatus.ReadyNodes != nodes {
+			return false
+		}
+	}
+
+	return true
+}
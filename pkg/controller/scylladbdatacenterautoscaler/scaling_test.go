@@ -0,0 +1,243 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbdatacenterautoscaler
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newScyllaDBDatacenterWithRacks(rackNodes ...int32) *scyllav1alpha1.ScyllaDBDatacenter {
+	sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "basic",
+			Namespace:  "scylla",
+			Generation: 2,
+		},
+		Status: scyllav1alpha1.ScyllaDBDatacenterStatus{
+			ObservedGeneration: pointer.Ptr[int64](2),
+		},
+	}
+
+	for i, nodes := range rackNodes {
+		name := string(rune('a' + i))
+		sdc.Spec.Racks = append(sdc.Spec.Racks, scyllav1alpha1.RackSpec{
+			Name: name,
+			RackTemplate: scyllav1alpha1.RackTemplate{
+				Nodes: pointer.Ptr(nodes),
+			},
+		})
+		sdc.Status.Racks = append(sdc.Status.Racks, scyllav1alpha1.RackStatus{
+			Name:       name,
+			Stale:      pointer.Ptr(false),
+			Nodes:      pointer.Ptr(nodes),
+			ReadyNodes: pointer.Ptr(nodes),
+		})
+	}
+
+	return sdc
+}
+
+func newScyllaDBDatacenterAutoscaler() *scyllav1alpha1.ScyllaDBDatacenterAutoscaler {
+	return &scyllav1alpha1.ScyllaDBDatacenterAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic",
+			Namespace: "scylla",
+		},
+		Spec: scyllav1alpha1.ScyllaDBDatacenterAutoscalerSpec{
+			ScyllaDBDatacenterRef: scyllav1alpha1.ScyllaDBDatacenterReference{
+				Name: "basic",
+			},
+			Mode:     scyllav1alpha1.AutoscalerModeApply,
+			MinNodes: 3,
+			MaxNodes: 5,
+			ScaleOut: scyllav1alpha1.AutoscalerThresholds{
+				DiskUtilizationPercent: pointer.Ptr[int32](70),
+				LoadPercent:            pointer.Ptr[int32](80),
+			},
+			ScaleIn: scyllav1alpha1.AutoscalerThresholds{
+				DiskUtilizationPercent: pointer.Ptr[int32](30),
+				LoadPercent:            pointer.Ptr[int32](20),
+			},
+			ScaleOutCooldown: &metav1.Duration{Duration: 10 * time.Minute},
+			ScaleInCooldown:  &metav1.Duration{Duration: 30 * time.Minute},
+		},
+	}
+}
+
+func TestPlanScaling(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	recently := metav1.NewTime(now.Add(-5 * time.Minute))
+
+	tt := []struct {
+		name                 string
+		sdca                 *scyllav1alpha1.ScyllaDBDatacenterAutoscaler
+		sdc                  *scyllav1alpha1.ScyllaDBDatacenter
+		metrics              map[string]rackMetrics
+		expectedRackStatuses []scyllav1alpha1.AutoscalerRackStatus
+		expectedDecision     *scalingDecision
+	}{
+		{
+			name: "metrics within thresholds keep racks as they are",
+			sdca: newScyllaDBDatacenterAutoscaler(),
+			sdc:  newScyllaDBDatacenterWithRacks(3),
+			metrics: map[string]rackMetrics{
+				"a": {diskUtilization: pointer.Ptr(50.4), load: pointer.Ptr(50.0)},
+			},
+			expectedRackStatuses: []scyllav1alpha1.AutoscalerRackStatus{
+				{Name: "a", CurrentNodes: 3, RecommendedNodes: 3, DiskUtilizationPercent: pointer.Ptr[int32](50), LoadPercent: pointer.Ptr[int32](50)},
+			},
+			expectedDecision: nil,
+		},
+		{
+			name: "exceeded threshold scales out by one node and takes precedence over scale-in",
+			sdca: newScyllaDBDatacenterAutoscaler(),
+			sdc:  newScyllaDBDatacenterWithRacks(4, 3),
+			metrics: map[string]rackMetrics{
+				"a": {diskUtilization: pointer.Ptr(10.0), load: pointer.Ptr(10.0)},
+				"b": {diskUtilization: pointer.Ptr(75.0), load: pointer.Ptr(10.0)},
+			},
+			expectedRackStatuses: []scyllav1alpha1.AutoscalerRackStatus{
+				{Name: "a", CurrentNodes: 4, RecommendedNodes: 3, DiskUtilizationPercent: pointer.Ptr[int32](10), LoadPercent: pointer.Ptr[int32](10)},
+				{Name: "b", CurrentNodes: 3, RecommendedNodes: 4, DiskUtilizationPercent: pointer.Ptr[int32](75), LoadPercent: pointer.Ptr[int32](10)},
+			},
+			expectedDecision: &scalingDecision{rackName: "b", from: 3, to: 4, reason: thresholdExceededReason},
+		},
+		{
+			name: "rack at max nodes isn't scaled out",
+			sdca: newScyllaDBDatacenterAutoscaler(),
+			sdc:  newScyllaDBDatacenterWithRacks(5),
+			metrics: map[string]rackMetrics{
+				"a": {load: pointer.Ptr(95.0)},
+			},
+			expectedRackStatuses: []scyllav1alpha1.AutoscalerRackStatus{
+				{Name: "a", CurrentNodes: 5, RecommendedNodes: 5, LoadPercent: pointer.Ptr[int32](95)},
+			},
+			expectedDecision: nil,
+		},
+		{
+			name: "missing metrics don't scale in",
+			sdca: newScyllaDBDatacenterAutoscaler(),
+			sdc:  newScyllaDBDatacenterWithRacks(4),
+			metrics: map[string]rackMetrics{
+				"a": {diskUtilization: pointer.Ptr(10.0)},
+			},
+			expectedRackStatuses: []scyllav1alpha1.AutoscalerRackStatus{
+				{Name: "a", CurrentNodes: 4, RecommendedNodes: 4, DiskUtilizationPercent: pointer.Ptr[int32](10)},
+			},
+			expectedDecision: nil,
+		},
+		{
+			name: "scale-in within cooldown is only recommended",
+			sdca: func() *scyllav1alpha1.ScyllaDBDatacenterAutoscaler {
+				sdca := newScyllaDBDatacenterAutoscaler()
+				sdca.Status.Racks = []scyllav1alpha1.AutoscalerRackStatus{
+					{Name: "a", CurrentNodes: 4, RecommendedNodes: 4, LastScaleTime: &recently},
+				}
+				return sdca
+			}(),
+			sdc: newScyllaDBDatacenterWithRacks(4),
+			metrics: map[string]rackMetrics{
+				"a": {diskUtilization: pointer.Ptr(10.0), load: pointer.Ptr(10.0)},
+			},
+			expectedRackStatuses: []scyllav1alpha1.AutoscalerRackStatus{
+				{Name: "a", CurrentNodes: 4, RecommendedNodes: 3, DiskUtilizationPercent: pointer.Ptr[int32](10), LoadPercent: pointer.Ptr[int32](10), LastScaleTime: &recently},
+			},
+			expectedDecision: nil,
+		},
+		{
+			name: "rack below min nodes is scaled out regardless of cooldown",
+			sdca: func() *scyllav1alpha1.ScyllaDBDatacenterAutoscaler {
+				sdca := newScyllaDBDatacenterAutoscaler()
+				sdca.Status.Racks = []scyllav1alpha1.AutoscalerRackStatus{
+					{Name: "a", CurrentNodes: 1, RecommendedNodes: 2, LastScaleTime: &recently},
+				}
+				return sdca
+			}(),
+			sdc:     newScyllaDBDatacenterWithRacks(2),
+			metrics: map[string]rackMetrics{},
+			expectedRackStatuses: []scyllav1alpha1.AutoscalerRackStatus{
+				{Name: "a", CurrentNodes: 2, RecommendedNodes: 3, LastScaleTime: &recently},
+			},
+			expectedDecision: &scalingDecision{rackName: "a", from: 2, to: 3, reason: belowMinNodesReason},
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rackStatuses, decision := planScaling(tc.sdca, tc.sdc, tc.metrics, now)
+			if !reflect.DeepEqual(rackStatuses, tc.expectedRackStatuses) {
+				t.Errorf("expected and got rack statuses differ:\n%s", cmp.Diff(tc.expectedRackStatuses, rackStatuses))
+			}
+			if !reflect.DeepEqual(decision, tc.expectedDecision) {
+				t.Errorf("expected and got decisions differ:\n%s", cmp.Diff(tc.expectedDecision, decision, cmp.AllowUnexported(scalingDecision{})))
+			}
+		})
+	}
+}
+
+func TestIsDatacenterSettled(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		sdc      *scyllav1alpha1.ScyllaDBDatacenter
+		expected bool
+	}{
+		{
+			name:     "all nodes ready",
+			sdc:      newScyllaDBDatacenterWithRacks(3, 3),
+			expected: true,
+		},
+		{
+			name: "outdated status",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newScyllaDBDatacenterWithRacks(3, 3)
+				sdc.Generation = 3
+				return sdc
+			}(),
+			expected: false,
+		},
+		{
+			name: "node being added",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newScyllaDBDatacenterWithRacks(3, 3)
+				sdc.Spec.Racks[1].Nodes = pointer.Ptr[int32](4)
+				return sdc
+			}(),
+			expected: false,
+		},
+		{
+			name: "node not ready",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newScyllaDBDatacenterWithRacks(3, 3)
+				sdc.Status.Racks[0].ReadyNodes = pointer.Ptr[int32](2)
+				return sdc
+			}(),
+			expected: false,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := isDatacenterSettled(tc.sdc)
+			if got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbdatacenterautoscaler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+const (
+	scalingControllerProgressingCondition = "ScalingControllerProgressing"
+	scalingControllerDegradedCondition    = "ScalingControllerDegraded"
+	// scalingApplyDegradedCondition reports that recommendations can't be applied to the ScyllaDBDatacenter.
+	scalingApplyDegradedCondition = "ScalingApplyDegraded"
+)
+
+func makeProgressingCondition(sdca *scyllav1alpha1.ScyllaDBDatacenterAutoscaler, reason, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               scalingControllerProgressingCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: sdca.Generation,
+	}
+}
+
+func setScalingApplyDegradedCondition(sdca *scyllav1alpha1.ScyllaDBDatacenterAutoscaler, status *scyllav1alpha1.ScyllaDBDatacenterAutoscalerStatus, conditionStatus metav1.ConditionStatus, reason, message string) {
+	apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               scalingApplyDegradedCondition,
+		Status:             conditionStatus,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: sdca.Generation,
+	})
+}
+
+func (sdcac *Controller) sync(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		klog.ErrorS(err, "Failed to split meta namespace cache key", "cacheKey", key)
+		return err
+	}
+
+	startTime := time.Now()
+	klog.V(4).InfoS("Started syncing ScyllaDBDatacenterAutoscaler", "ScyllaDBDatacenterAutoscaler", klog.KRef(namespace, name), "startTime", startTime)
+	defer func() {
+		klog.V(4).InfoS("Finished syncing ScyllaDBDatacenterAutoscaler", "ScyllaDBDatacenterAutoscaler", klog.KRef(namespace, name), "duration", time.Since(startTime))
+	}()
+
+	sdca, err := sdcac.scyllaDBDatacenterAutoscalerLister.ScyllaDBDatacenterAutoscalers(namespace).Get(name)
+	if errors.IsNotFound(err) {
+		klog.V(2).InfoS("ScyllaDBDatacenterAutoscaler has been deleted", "ScyllaDBDatacenterAutoscaler", klog.KRef(namespace, name))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if sdca.DeletionTimestamp != nil {
+		return nil
+	}
+
+	sdcac.queue.AddAfter(key, metricsResyncInterval)
+
+	status := sdca.Status.DeepCopy()
+	status.ObservedGeneration = pointer.Ptr(sdca.Generation)
+
+	var errs []error
+
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		scalingControllerProgressingCondition,
+		scalingControllerDegradedCondition,
+		sdca.Generation,
+		func() ([]metav1.Condition, error) {
+			var progressingConditions []metav1.Condition
+			progressingConditions, sdca, err = sdcac.syncScaling(ctx, sdca, status)
+			return progressingConditions, err
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync scaling: %w", err))
+	}
+
+	// Aggregate conditions.
+	err = controllerhelpers.SetAggregatedWorkloadConditions(&status.Conditions, sdca.Generation)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't aggregate workload conditions: %w", err))
+	} else {
+		_, err = sdcac.updateStatus(ctx, sdca, status)
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// syncScaling evaluates metrics of the referenced ScyllaDBDatacenter and scales at most one of its racks by one node.
+// It returns the latest ScyllaDBDatacenterAutoscaler, which changes when the status is persisted before scaling.
+func (sdcac *Controller) syncScaling(
+	ctx context.Context,
+	sdca *scyllav1alpha1.ScyllaDBDatacenterAutoscaler,
+	status *scyllav1alpha1.ScyllaDBDatacenterAutoscalerStatus,
+) ([]metav1.Condition, *scyllav1alpha1.ScyllaDBDatacenterAutoscaler, error) {
+	var progressingConditions []metav1.Condition
+
+	// Refusing to apply recommendations overrides the default.
+	setScalingApplyDegradedCondition(sdca, status, metav1.ConditionFalse, internalapi.AsExpectedReason, "")
+
+	sdc, err := sdcac.scyllaDBDatacenterLister.ScyllaDBDatacenters(sdca.Namespace).Get(sdca.Spec.ScyllaDBDatacenterRef.Name)
+	if errors.IsNotFound(err) {
+		progressingConditions = append(progressingConditions, makeProgressingCondition(
+			sdca,
+			"WaitingForScyllaDBDatacenter",
+			fmt.Sprintf("Waiting for ScyllaDBDatacenter %q to exist.", naming.ManualRef(sdca.Namespace, sdca.Spec.ScyllaDBDatacenterRef.Name)),
+		))
+		return progressingConditions, sdca, nil
+	}
+	if err != nil {
+		return progressingConditions, sdca, fmt.Errorf("can't get ScyllaDBDatacenter %q: %w", naming.ManualRef(sdca.Namespace, sdca.Spec.ScyllaDBDatacenterRef.Name), err)
+	}
+
+	if sdc.DeletionTimestamp != nil {
+		return progressingConditions, sdca, nil
+	}
+
+	metrics, err := sdcac.getRackMetrics(ctx, sdca, sdc)
+	if err != nil {
+		return progressingConditions, sdca, fmt.Errorf("can't get metrics of ScyllaDBDatacenter %q: %w", naming.ObjRef(sdc), err)
+	}
+
+	now := time.Now()
+	rackStatuses, decision := planScaling(sdca, sdc, metrics, now)
+	previousRackStatuses := status.Racks
+	status.Racks = rackStatuses
+
+	for _, rs := range rackStatuses {
+		if rs.RecommendedNodes == rs.CurrentNodes || isRecommendationUnchanged(previousRackStatuses, rs) {
+			continue
+		}
+
+		sdcac.eventRecorder.Eventf(sdca, corev1.EventTypeNormal, "ScalingRecommended", "Recommended scaling rack %q from %d to %d nodes", rs.Name, rs.CurrentNodes, rs.RecommendedNodes)
+	}
+
+	if decision == nil || sdca.Spec.Mode != scyllav1alpha1.AutoscalerModeApply {
+		return progressingConditions, sdca, nil
+	}
+
+	if sdc.Spec.AutomaticRacks != nil {
+		// Racks are owned by the automatic racks controller, which would revert the change.
+		message := "Racks managed through automaticRacks can't be scaled, only recommendations are reported."
+		setScalingApplyDegradedCondition(sdca, status, metav1.ConditionTrue, "AutomaticRacksUnsupported", message)
+		sdcac.eventRecorder.Event(sdca, corev1.EventTypeWarning, "AutomaticRacksUnsupported", message)
+		return progressingConditions, sdca, nil
+	}
+
+	if controllerRef := metav1.GetControllerOf(sdc); controllerRef != nil {
+		// The controller owning the ScyllaDBDatacenter would revert the change.
+		message := fmt.Sprintf("ScyllaDBDatacenter %q is controlled by %s %q and can't be scaled, only recommendations are reported.", naming.ObjRef(sdc), controllerRef.Kind, controllerRef.Name)
+		setScalingApplyDegradedCondition(sdca, status, metav1.ConditionTrue, "ControlledScyllaDBDatacenterUnsupported", message)
+		sdcac.eventRecorder.Event(sdca, corev1.EventTypeWarning, "ControlledScyllaDBDatacenterUnsupported", message)
+		return progressingConditions, sdca, nil
+	}
+
+	if !isDatacenterSettled(sdc) {
+		progressingConditions = append(progressingConditions, makeProgressingCondition(
+			sdca,
+			"WaitingForScyllaDBDatacenter",
+			fmt.Sprintf("Waiting for all nodes of ScyllaDBDatacenter %q to be ready before scaling rack %q.", naming.ObjRef(sdc), decision.rackName),
+		))
+		return progressingConditions, sdca, nil
+	}
+
+	// The scale time is persisted before scaling, so the cooldown holds even if the status update after scaling fails.
+	for i := range status.Racks {
+		if status.Racks[i].Name == decision.rackName {
+			status.Racks[i].LastScaleTime = pointer.Ptr(metav1.NewTime(now))
+		}
+	}
+	sdca, err = sdcac.updateStatus(ctx, sdca, status)
+	if err != nil {
+		return progressingConditions, sdca, fmt.Errorf("can't record scaling of rack %q: %w", decision.rackName, err)
+	}
+
+	sdcCopy := sdc.DeepCopy()
+	for i := range sdcCopy.Spec.Racks {
+		if sdcCopy.Spec.Racks[i].Name == decision.rackName {
+			sdcCopy.Spec.Racks[i].Nodes = pointer.Ptr(decision.to)
+		}
+	}
+	_, err = sdcac.scyllaClient.ScyllaV1alpha1().ScyllaDBDatacenters(sdcCopy.Namespace).Update(ctx, sdcCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return progressingConditions, sdca, fmt.Errorf("can't scale rack %q of ScyllaDBDatacenter %q: %w", decision.rackName, naming.ObjRef(sdc), err)
+	}
+
+	for i := range status.Racks {
+		if status.Racks[i].Name == decision.rackName {
+			status.Racks[i].CurrentNodes = decision.to
+		}
+	}
+
+	reason := "ScaledOut"
+	if decision.to < decision.from {
+		// Removing a node from the rack goes through the regular decommission of the ScyllaDBDatacenter controller.
+		reason = "ScaledIn"
+	}
+	klog.V(2).InfoS("Scaled rack", "ScyllaDBDatacenter", klog.KObj(sdc), "Rack", decision.rackName, "From", decision.from, "To", decision.to, "Reason", decision.reason)
+	sdcac.eventRecorder.Eventf(sdca, corev1.EventTypeNormal, reason, "Scaled rack %q from %d to %d nodes: %s", decision.rackName, decision.from, decision.to, decision.reason)
+
+	progressingConditions = append(progressingConditions, makeProgressingCondition(
+		sdca,
+		reason,
+		fmt.Sprintf("Scaling rack %q of ScyllaDBDatacenter %q from %d to %d nodes.", decision.rackName, naming.ObjRef(sdc), decision.from, decision.to),
+	))
+
+	return progressingConditions, sdca, nil
+}
+
+func isRecommendationUnchanged(previous []scyllav1alpha1.AutoscalerRackStatus, rs scyllav1alpha1.AutoscalerRackStatus) bool {
+	for _, p := range previous {
+		if p.Name == rs.Name {
+			return p.CurrentNodes == rs.CurrentNodes && p.RecommendedNodes == rs.RecommendedNodes
+		}
+	}
+
+	return false
+}
+
+// updateStatus persists the status and returns the updated ScyllaDBDatacenterAutoscaler.
+func (sdcac *Controller) updateStatus(ctx context.Context, currentSDCA *scyllav1alpha1.ScyllaDBDatacenterAutoscaler, status *scyllav1alpha1.ScyllaDBDatacenterAutoscalerStatus) (*scyllav1alpha1.ScyllaDBDatacenterAutoscaler, error) {
+	if apiequality.Semantic.DeepEqual(&currentSDCA.Status, status) {
+		return currentSDCA, nil
+	}
+
+	sdca := currentSDCA.DeepCopy()
+	sdca.Status = *status
+
+	klog.V(2).InfoS("Updating status", "ScyllaDBDatacenterAutoscaler", klog.KObj(sdca))
+
+	updatedSDCA, err := sdcac.scyllaClient.ScyllaV1alpha1().ScyllaDBDatacenterAutoscalers(sdca.Namespace).UpdateStatus(ctx, sdca, metav1.UpdateOptions{})
+	if err != nil {
+		return currentSDCA, err
+	}
+
+	klog.V(2).InfoS("Status updated", "ScyllaDBDatacenterAutoscaler", klog.KObj(sdca))
+
+	return updatedSDCA, nil
+}
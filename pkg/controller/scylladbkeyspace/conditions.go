@@ -0,0 +1,11 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbkeyspace
+
+const (
+	keyspaceControllerProgressingCondition = "KeyspaceControllerProgressing"
+	keyspaceControllerDegradedCondition    = "KeyspaceControllerDegraded"
+	keyspaceAvailableCondition             = "KeyspaceAvailable"
+	repairControllerProgressingCondition   = "RepairControllerProgressing"
+	repairControllerDegradedCondition      = "RepairControllerDegraded"
+)
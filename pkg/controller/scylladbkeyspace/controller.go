@@ -0,0 +1,261 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbkeyspace
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scyllaclient "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned"
+	scyllav1alpha1informers "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/scylla/v1alpha1"
+	scyllav1alpha1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/kubeinterfaces"
+	"github.com/scylladb/scylla-operator/pkg/scheme"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+)
+
+const (
+	ControllerName = "ScyllaDBKeyspaceController"
+
+	// keyspaceResyncInterval is how often keyspaces are compared with the cluster.
+	keyspaceResyncInterval = 5 * time.Minute
+
+	// repairResyncInterval is how often ScyllaDBKeyspaces with a repair in progress are resynced.
+	repairResyncInterval = 30 * time.Second
+)
+
+var (
+	keyFunc                       = cache.DeletionHandlingMetaNamespaceKeyFunc
+	scyllaDBKeyspaceControllerGVK = scyllav1alpha1.GroupVersion.WithKind("ScyllaDBKeyspace")
+)
+
+type Controller struct {
+	kubeClient   kubernetes.Interface
+	scyllaClient scyllaclient.Interface
+
+	secretLister             corev1listers.SecretLister
+	serviceLister            corev1listers.ServiceLister
+	podLister                corev1listers.PodLister
+	scyllaDBDatacenterLister scyllav1alpha1listers.ScyllaDBDatacenterLister
+	scyllaDBKeyspaceLister   scyllav1alpha1listers.ScyllaDBKeyspaceLister
+
+	cachesToSync []cache.InformerSynced
+
+	eventRecorder record.EventRecorder
+
+	queue    workqueue.RateLimitingInterface
+	handlers *controllerhelpers.Handlers[*scyllav1alpha1.ScyllaDBKeyspace]
+}
+
+func NewController(
+	kubeClient kubernetes.Interface,
+	scyllaClient scyllaclient.Interface,
+	secretInformer corev1informers.SecretInformer,
+	serviceInformer corev1informers.ServiceInformer,
+	podInformer corev1informers.PodInformer,
+	scyllaDBDatacenterInformer scyllav1alpha1informers.ScyllaDBDatacenterInformer,
+	scyllaDBKeyspaceInformer scyllav1alpha1informers.ScyllaDBKeyspaceInformer,
+) (*Controller, error) {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartStructuredLogging(0)
+	eventBroadcaster.StartRecordingToSink(&corev1client.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+
+	skc := &Controller{
+		kubeClient:   kubeClient,
+		scyllaClient: scyllaClient,
+
+		secretLister:             secretInformer.Lister(),
+		serviceLister:            serviceInformer.Lister(),
+		podLister:                podInformer.Lister(),
+		scyllaDBDatacenterLister: scyllaDBDatacenterInformer.Lister(),
+		scyllaDBKeyspaceLister:   scyllaDBKeyspaceInformer.Lister(),
+
+		cachesToSync: []cache.InformerSynced{
+			secretInformer.Informer().HasSynced,
+			serviceInformer.Informer().HasSynced,
+			podInformer.Informer().HasSynced,
+			scyllaDBDatacenterInformer.Informer().HasSynced,
+			scyllaDBKeyspaceInformer.Informer().HasSynced,
+		},
+
+		eventRecorder: eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "scylladbkeyspace-controller"}),
+
+		queue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "scylladbkeyspace"),
+	}
+
+	var err error
+	skc.handlers, err = controllerhelpers.NewHandlers[*scyllav1alpha1.ScyllaDBKeyspace](
+		skc.queue,
+		keyFunc,
+		scheme.Scheme,
+		scyllaDBKeyspaceControllerGVK,
+		kubeinterfaces.NamespacedGetList[*scyllav1alpha1.ScyllaDBKeyspace]{
+			GetFunc: func(namespace, name string) (*scyllav1alpha1.ScyllaDBKeyspace, error) {
+				return skc.scyllaDBKeyspaceLister.ScyllaDBKeyspaces(namespace).Get(name)
+			},
+			ListFunc: func(namespace string, selector labels.Selector) (ret []*scyllav1alpha1.ScyllaDBKeyspace, err error) {
+				return skc.scyllaDBKeyspaceLister.ScyllaDBKeyspaces(namespace).List(selector)
+			},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("can't create handlers: %w", err)
+	}
+
+	scyllaDBKeyspaceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    skc.addScyllaDBKeyspace,
+		UpdateFunc: skc.updateScyllaDBKeyspace,
+		DeleteFunc: skc.deleteScyllaDBKeyspace,
+	})
+
+	scyllaDBDatacenterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    skc.addScyllaDBDatacenter,
+		UpdateFunc: skc.updateScyllaDBDatacenter,
+		DeleteFunc: skc.deleteScyllaDBDatacenter,
+	})
+
+	return skc, nil
+}
+
+func (skc *Controller) processNextItem(ctx context.Context) bool {
+	key, quit := skc.queue.Get()
+	if quit {
+		return false
+	}
+	defer skc.queue.Done(key)
+
+	err := skc.sync(ctx, key.(string))
+	// TODO: Do smarter filtering then just Reduce to handle cases like 2 conflict errors.
+	err = utilerrors.Reduce(err)
+	switch {
+	case err == nil:
+		skc.queue.Forget(key)
+		return true
+
+	case apierrors.IsConflict(err):
+		klog.V(2).InfoS("Hit conflict, will retry in a bit", "Key", key, "Error", err)
+
+	case apierrors.IsAlreadyExists(err):
+		klog.V(2).InfoS("Hit already exists, will retry in a bit", "Key", key, "Error", err)
+
+	default:
+		utilruntime.HandleError(fmt.Errorf("syncing key '%v' failed: %v", key, err))
+	}
+
+	skc.queue.AddRateLimited(key)
+
+	return true
+}
+
+func (skc *Controller) runWorker(ctx context.Context) {
+	for skc.processNextItem(ctx) {
+	}
+}
+
+func (skc *Controller) Run(ctx context.Context, workers int) {
+	defer utilruntime.HandleCrash()
+
+	klog.InfoS("Starting controller", "controller", ControllerName)
+
+	var wg sync.WaitGroup
+	defer func() {
+		klog.InfoS("Shutting down controller", "controller", ControllerName)
+		skc.queue.ShutDown()
+		wg.Wait()
+		klog.InfoS("Shut down controller", "controller", ControllerName)
+	}()
+
+	if !cache.WaitForNamedCacheSync(ControllerName, ctx.Done(), skc.cachesToSync...) {
+		return
+	}
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wait.UntilWithContext(ctx, skc.runWorker, time.Second)
+		}()
+	}
+
+	<-ctx.Done()
+}
+
+// enqueueScyllaDBKeyspacesReferencingScyllaDBDatacenter enqueues ScyllaDBKeyspaces managed through the ScyllaDBDatacenter.
+func (skc *Controller) enqueueScyllaDBKeyspacesReferencingScyllaDBDatacenter(depth int, obj kubeinterfaces.ObjectInterface, op controllerhelpers.HandlerOperationType) {
+	sdc := obj.(*scyllav1alpha1.ScyllaDBDatacenter)
+
+	sks, err := skc.scyllaDBKeyspaceLister.ScyllaDBKeyspaces(sdc.Namespace).List(labels.Everything())
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("can't list ScyllaDBKeyspaces: %w", err))
+		return
+	}
+
+	for _, sk := range sks {
+		if sk.Spec.ScyllaDBDatacenterRef.Name == sdc.Name {
+			skc.handlers.Enqueue(depth+1, sk, op)
+		}
+	}
+}
+
+func (skc *Controller) addScyllaDBKeyspace(obj interface{}) {
+	skc.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.ScyllaDBKeyspace),
+		skc.handlers.Enqueue,
+	)
+}
+
+func (skc *Controller) updateScyllaDBKeyspace(old, cur interface{}) {
+	skc.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.ScyllaDBKeyspace),
+		cur.(*scyllav1alpha1.ScyllaDBKeyspace),
+		skc.handlers.Enqueue,
+		skc.deleteScyllaDBKeyspace,
+	)
+}
+
+func (skc *Controller) deleteScyllaDBKeyspace(obj interface{}) {
+	skc.handlers.HandleDelete(
+		obj,
+		skc.handlers.Enqueue,
+	)
+}
+
+func (skc *Controller) addScyllaDBDatacenter(obj interface{}) {
+	skc.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.ScyllaDBDatacenter),
+		skc.enqueueScyllaDBKeyspacesReferencingScyllaDBDatacenter,
+	)
+}
+
+func (skc *Controller) updateScyllaDBDatacenter(old, cur interface{}) {
+	skc.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.ScyllaDBDatacenter),
+		cur.(*scyllav1alpha1.ScyllaDBDatacenter),
+		skc.enqueueScyllaDBKeyspacesReferencingScyllaDBDatacenter,
+		skc.deleteScyllaDBDatacenter,
+	)
+}
+
+func (skc *Controller) deleteScyllaDBDatacenter(obj interface{}) {
+	skc.handlers.HandleDelete(
+		obj,
+		skc.enqueueScyllaDBKeyspacesReferencingScyllaDBDatacenter,
+	)
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbkeyspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gocql/gocql"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/util/cql"
+)
+
+const (
+	replicationClassKey          = "class"
+	replicationFactorKey         = "replication_factor"
+	networkTopologyStrategyClass = "NetworkTopologyStrategy"
+
+	selectKeyspaceQuery        = "SELECT replication, durable_writes FROM system_schema.keyspaces WHERE keyspace_name = ?"
+	selectKeyspaceTabletsQuery = "SELECT initial_tablets FROM system_schema.scylla_keyspaces WHERE keyspace_name = ?"
+)
+
+// keyspace describes the properties of a keyspace the controller manages.
+type keyspace struct {
+	// replicationClass is the replication strategy of the keyspace.
+	replicationClass string
+
+	// replication holds the replication factors of datacenters with replicas.
+	replication map[string]int32
+
+	durableWrites bool
+
+	// tabletsEnabled and initialTablets are nil when they aren't specified.
+	tabletsEnabled *bool
+	initialTablets *int32
+}
+
+func getKeyspaceName(sk *scyllav1alpha1.ScyllaDBKeyspace) string {
+	if len(sk.Spec.KeyspaceName) != 0 {
+		return sk.Spec.KeyspaceName
+	}
+
+	return sk.Name
+}
+
+func isNetworkTopologyStrategy(class string) bool {
+	return class == networkTopologyStrategyClass || strings.HasSuffix(class, "."+networkTopologyStrategyClass)
+}
+
+// makeRequiredKeyspace returns the keyspace as specified by the ScyllaDBKeyspace.
+func makeRequiredKeyspace(sk *scyllav1alpha1.ScyllaDBKeyspace) *keyspace {
+	ks := &keyspace{
+		replicationClass: networkTopologyStrategyClass,
+		replication:      make(map[string]int32, len(sk.Spec.Replication.Datacenters)),
+		durableWrites:    true,
+	}
+
+	for _, dc := range sk.Spec.Replication.Datacenters {
+		if dc.ReplicationFactor > 0 {
+			ks.replication[dc.Name] = dc.ReplicationFactor
+		}
+	}
+
+	if sk.Spec.DurableWrites != nil {
+		ks.durableWrites = *sk.Spec.DurableWrites
+	}
+
+	if sk.Spec.Tablets != nil {
+		ks.tabletsEnabled = sk.Spec.Tablets.Enabled
+		ks.initialTablets = sk.Spec.Tablets.InitialTablets
+	}
+
+	return ks
+}
+
+func makeReplicationOptions(ks *keyspace) string {
+	dcs := make([]string, 0, len(ks.replication))
+	for dc := range ks.replication {
+		dcs = append(dcs, dc)
+	}
+	sort.Strings(dcs)
+
+	options := make([]string, 0, len(dcs)+1)
+	options = append(options, fmt.Sprintf("%s: %s", cql.QuoteString(replicationClassKey), cql.QuoteString(ks.replicationClass)))
+	for _, dc := range dcs {
+		options = append(options, fmt.Sprintf("%s: %s", cql.QuoteString(dc), cql.QuoteString(strconv.Itoa(int(ks.replication[dc])))))
+	}
+
+	return "{" + strings.Join(options, ", ") + "}"
+}
+
+func makeCreateKeyspaceStatement(name string, ks *keyspace) string {
+	stmt := fmt.Sprintf("CREATE KEYSPACE IF NOT EXISTS %s WITH replication = %s AND durable_writes = %t", cql.QuoteIdentifier(name), makeReplicationOptions(ks), ks.durableWrites)
+
+	var tabletsOptions []string
+	if ks.tabletsEnabled != nil {
+		tabletsOptions = append(tabletsOptions, fmt.Sprintf("%s: %t", cql.QuoteString("enabled"), *ks.tabletsEnabled))
+	}
+	if ks.initialTablets != nil {
+		tabletsOptions = append(tabletsOptions, fmt.Sprintf("%s: %d", cql.QuoteString("initial"), *ks.initialTablets))
+	}
+	if len(tabletsOptions) != 0 {
+		stmt += fmt.Sprintf(" AND tablets = {%s}", strings.Join(tabletsOptions, ", "))
+	}
+
+	return stmt
+}
+
+func makeAlterKeyspaceStatement(name string, ks *keyspace) string {
+	return fmt.Sprintf("ALTER KEYSPACE %s WITH replication = %s AND durable_writes = %t", cql.QuoteIdentifier(name), makeReplicationOptions(ks), ks.durableWrites)
+}
+
+// keyspaceDifferences describes how the keyspace in the cluster differs from the required one.
+type keyspaceDifferences struct {
+	// alterable differences are reconciled by altering the keyspace.
+	alterable []string
+
+	// immutable differences can't be reconciled, as ScyllaDB doesn't allow changing them.
+	immutable []string
+}
+
+func (d *keyspaceDifferences) isEmpty() bool {
+	return len(d.alterable) == 0 && len(d.immutable) == 0
+}
+
+func getKeyspaceDifferences(required, existing *keyspace) *keyspaceDifferences {
+	diff := &keyspaceDifferences{}
+
+	if !isNetworkTopologyStrategy(existing.replicationClass) {
+		diff.alterable = append(diff.alterable, fmt.Sprintf("replication class is %q instead of %q", existing.replicationClass, required.replicationClass))
+	}
+
+	dcs := make([]string, 0, len(required.replication)+len(existing.replication))
+	for dc := range required.replication {
+		dcs = append(dcs, dc)
+	}
+	for dc := range existing.replication {
+		if _, ok := required.replication[dc]; !ok {
+			dcs = append(dcs, dc)
+		}
+	}
+	sort.Strings(dcs)
+
+	for _, dc := range dcs {
+		if required.replication[dc] != existing.replication[dc] {
+			diff.alterable = append(diff.alterable, fmt.Sprintf("replication factor of datacenter %q is %d instead of %d", dc, existing.replication[dc], required.replication[dc]))
+		}
+	}
+
+	if required.durableWrites != existing.durableWrites {
+		diff.alterable = append(diff.alterable, fmt.Sprintf("durable writes are %t instead of %t", existing.durableWrites, required.durableWrites))
+	}
+
+	if required.tabletsEnabled != nil && existing.tabletsEnabled != nil && *required.tabletsEnabled != *existing.tabletsEnabled {
+		diff.immutable = append(diff.immutable, fmt.Sprintf("tablets are enabled: %t instead of %t", *existing.tabletsEnabled, *required.tabletsEnabled))
+	}
+
+	if required.initialTablets != nil && existing.initialTablets != nil && *required.initialTablets != *existing.initialTablets {
+		diff.immutable = append(diff.immutable, fmt.Sprintf("initial tablets are %d instead of %d", *existing.initialTablets, *required.initialTablets))
+	}
+
+	return diff
+}
+
+// getIncreasedReplicationDatacenters returns the datacenters whose replication factor grew.
+func getIncreasedReplicationDatacenters(previous []scyllav1alpha1.DatacenterReplicationFactor, current *keyspace) []string {
+	previousRFs := make(map[string]int32, len(previous))
+	for _, dc := range previous {
+		previousRFs[dc.Name] = dc.ReplicationFactor
+	}
+
+	var dcs []string
+	for dc, rf := range current.replication {
+		if rf > previousRFs[dc] {
+			dcs = append(dcs, dc)
+		}
+	}
+	sort.Strings(dcs)
+
+	return dcs
+}
+
+func makeReplicationStatus(ks *keyspace) []scyllav1alpha1.DatacenterReplicationFactor {
+	dcs := make([]string, 0, len(ks.replication))
+	for dc := range ks.replication {
+		dcs = append(dcs, dc)
+	}
+	sort.Strings(dcs)
+
+	replication := make([]scyllav1alpha1.DatacenterReplicationFactor, 0, len(dcs))
+	for _, dc := range dcs {
+		replication = append(replication, scyllav1alpha1.DatacenterReplicationFactor{
+			Name:              dc,
+			ReplicationFactor: ks.replication[dc],
+		})
+	}
+
+	return replication
+}
+
+func parseReplication(replication map[string]string) (string, map[string]int32, error) {
+	class := replication[replicationClassKey]
+	rfs := make(map[string]int32, len(replication))
+	if !isNetworkTopologyStrategy(class) {
+		return class, rfs, nil
+	}
+
+	for k, v := range replication {
+		if k == replicationClassKey || k == replicationFactorKey {
+			continue
+		}
+
+		rf, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return "", nil, fmt.Errorf("can't parse replication factor %q of datacenter %q: %w", v, k, err)
+		}
+
+		if rf > 0 {
+			rfs[k] = int32(rf)
+		}
+	}
+
+	return class, rfs, nil
+}
+
+// getKeyspace reads the keyspace from the cluster. It returns nil if the keyspace doesn't exist.
+func getKeyspace(ctx context.Context, session *gocql.Session, name string) (*keyspace, error) {
+	var replication map[string]string
+	var durableWrites bool
+	err := session.Query(selectKeyspaceQuery, name).WithContext(ctx).Scan(&replication, &durableWrites)
+	if errors.Is(err, gocql.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("can't get keyspace %q: %w", name, err)
+	}
+
+	class, rfs, err := parseReplication(replication)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse replication of keyspace %q: %w", name, err)
+	}
+
+	ks := &keyspace{
+		replicationClass: class,
+		replication:      rfs,
+		durableWrites:    durableWrites,
+		tabletsEnabled:   pointer.Ptr(false),
+	}
+
+	var initialTablets *int
+	err = session.Query(selectKeyspaceTabletsQuery, name).WithContext(ctx).Scan(&initialTablets)
+	var reqErr gocql.RequestError
+	switch {
+	case errors.Is(err, gocql.ErrNotFound):
+	case errors.As(err, &reqErr) && reqErr.Code() == gocql.ErrCodeInvalid:
+		// ScyllaDB versions without tablets don't have the table.
+	case err != nil:
+		return nil, fmt.Errorf("can't get tablets options of keyspace %q: %w", name, err)
+	case initialTablets != nil:
+		ks.tabletsEnabled = pointer.Ptr(true)
+		ks.initialTablets = pointer.Ptr(int32(*initialTablets))
+	}
+
+	return ks, nil
+}
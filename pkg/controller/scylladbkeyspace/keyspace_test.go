@@ -0,0 +1,227 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbkeyspace
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+)
+
+func TestMakeCreateKeyspaceStatement(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name              string
+		keyspaceName      string
+		keyspace          *keyspace
+		expectedStatement string
+	}{
+		{
+			name:         "datacenters are sorted and tablets options are omitted when not specified",
+			keyspaceName: "app_data",
+			keyspace: &keyspace{
+				replicationClass: networkTopologyStrategyClass,
+				replication:      map[string]int32{"us-west-1": 2, "us-east-1": 3},
+				durableWrites:    true,
+			},
+			expectedStatement: `CREATE KEYSPACE IF NOT EXISTS "app_data" WITH replication = {'class': 'NetworkTopologyStrategy', 'us-east-1': '3', 'us-west-1': '2'} AND durable_writes = true`,
+		},
+		{
+			name:         "tablets options are rendered when specified",
+			keyspaceName: "App",
+			keyspace: &keyspace{
+				replicationClass: networkTopologyStrategyClass,
+				replication:      map[string]int32{"us-east-1": 3},
+				durableWrites:    false,
+				tabletsEnabled:   pointer.Ptr(true),
+				initialTablets:   pointer.Ptr[int32](8),
+			},
+			expectedStatement: `CREATE KEYSPACE IF NOT EXISTS "App" WITH replication = {'class': 'NetworkTopologyStrategy', 'us-east-1': '3'} AND durable_writes = false AND tablets = {'enabled': true, 'initial': 8}`,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := makeCreateKeyspaceStatement(tc.keyspaceName, tc.keyspace)
+			if got != tc.expectedStatement {
+				t.Errorf("expected and got statements differ: %s", cmp.Diff(tc.expectedStatement, got))
+			}
+		})
+	}
+}
+
+func TestGetKeyspaceDifferences(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name                string
+		required            *keyspace
+		existing            *keyspace
+		expectedDifferences *keyspaceDifferences
+	}{
+		{
+			name: "matching keyspaces have no differences",
+			required: &keyspace{
+				replicationClass: networkTopologyStrategyClass,
+				replication:      map[string]int32{"us-east-1": 3},
+				durableWrites:    true,
+			},
+			existing: &keyspace{
+				replicationClass: "org.apache.cassandra.locator.NetworkTopologyStrategy",
+				replication:      map[string]int32{"us-east-1": 3},
+				durableWrites:    true,
+				tabletsEnabled:   pointer.Ptr(true),
+			},
+			expectedDifferences: &keyspaceDifferences{},
+		},
+		{
+			name: "replication, durable writes and tablets differ",
+			required: &keyspace{
+				replicationClass: networkTopologyStrategyClass,
+				replication:      map[string]int32{"us-east-1": 3},
+				durableWrites:    true,
+				tabletsEnabled:   pointer.Ptr(false),
+			},
+			existing: &keyspace{
+				replicationClass: "org.apache.cassandra.locator.SimpleStrategy",
+				replication:      map[string]int32{"us-west-1": 2},
+				durableWrites:    false,
+				tabletsEnabled:   pointer.Ptr(true),
+			},
+			expectedDifferences: &keyspaceDifferences{
+				alterable: []string{
+					`replication class is "org.apache.cassandra.locator.SimpleStrategy" instead of "NetworkTopologyStrategy"`,
+					`replication factor of datacenter "us-east-1" is 0 instead of 3`,
+					`replication factor of datacenter "us-west-1" is 2 instead of 0`,
+					`durable writes are false instead of true`,
+				},
+				immutable: []string{
+					`tablets are enabled: true instead of false`,
+				},
+			},
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getKeyspaceDifferences(tc.required, tc.existing)
+			if !reflect.DeepEqual(got, tc.expectedDifferences) {
+				t.Errorf("expected and got differences differ: %s", cmp.Diff(tc.expectedDifferences, got, cmp.AllowUnexported(keyspaceDifferences{})))
+			}
+		})
+	}
+}
+
+func TestGetIncreasedReplicationDatacenters(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name        string
+		previous    []scyllav1alpha1.DatacenterReplicationFactor
+		current     *keyspace
+		expectedDCs []string
+	}{
+		{
+			name: "unchanged and decreased replication factors are ignored",
+			previous: []scyllav1alpha1.DatacenterReplicationFactor{
+				{Name: "us-east-1", ReplicationFactor: 3},
+				{Name: "us-west-1", ReplicationFactor: 3},
+			},
+			current: &keyspace{
+				replication: map[string]int32{"us-east-1": 3, "us-west-1": 2},
+			},
+			expectedDCs: nil,
+		},
+		{
+			name: "increased replication factors and new datacenters are returned",
+			previous: []scyllav1alpha1.DatacenterReplicationFactor{
+				{Name: "us-east-1", ReplicationFactor: 1},
+			},
+			current: &keyspace{
+				replication: map[string]int32{"us-east-1": 3, "us-west-1": 2, "eu-west-1": 3},
+			},
+			expectedDCs: []string{"eu-west-1", "us-east-1", "us-west-1"},
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getIncreasedReplicationDatacenters(tc.previous, tc.current)
+			if !reflect.DeepEqual(got, tc.expectedDCs) {
+				t.Errorf("expected and got datacenters differ: %s", cmp.Diff(tc.expectedDCs, got))
+			}
+		})
+	}
+}
+
+func TestParseReplication(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name          string
+		replication   map[string]string
+		expectedClass string
+		expectedRFs   map[string]int32
+		expectedError string
+	}{
+		{
+			name:          "datacenters without replicas are dropped",
+			replication:   map[string]string{"class": "org.apache.cassandra.locator.NetworkTopologyStrategy", "us-east-1": "3", "us-west-1": "0"},
+			expectedClass: "org.apache.cassandra.locator.NetworkTopologyStrategy",
+			expectedRFs:   map[string]int32{"us-east-1": 3},
+			expectedError: "",
+		},
+		{
+			name:          "other strategies have no datacenters",
+			replication:   map[string]string{"class": "org.apache.cassandra.locator.SimpleStrategy", "replication_factor": "3"},
+			expectedClass: "org.apache.cassandra.locator.SimpleStrategy",
+			expectedRFs:   map[string]int32{},
+			expectedError: "",
+		},
+		{
+			name:          "invalid replication factor",
+			replication:   map[string]string{"class": "NetworkTopologyStrategy", "us-east-1": "three"},
+			expectedClass: "",
+			expectedRFs:   nil,
+			expectedError: `can't parse replication factor "three" of datacenter "us-east-1": strconv.ParseInt: parsing "three": invalid syntax`,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			class, rfs, err := parseReplication(tc.replication)
+
+			var errStr string
+			if err != nil {
+				errStr = err.Error()
+			}
+			if errStr != tc.expectedError {
+				t.Errorf("expected and got errors differ: %s", cmp.Diff(tc.expectedError, errStr))
+			}
+
+			if class != tc.expectedClass {
+				t.Errorf("expected class %q, got %q", tc.expectedClass, class)
+			}
+
+			if !reflect.DeepEqual(rfs, tc.expectedRFs) {
+				t.Errorf("expected and got replication factors differ: %s", cmp.Diff(tc.expectedRFs, rfs))
+			}
+		})
+	}
+}
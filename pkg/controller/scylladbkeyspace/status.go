@@ -0,0 +1,41 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbkeyspace
+
+import (
+	"context"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+func (skc *Controller) calculateStatus(sk *scyllav1alpha1.ScyllaDBKeyspace) *scyllav1alpha1.ScyllaDBKeyspaceStatus {
+	status := sk.Status.DeepCopy()
+	status.ObservedGeneration = pointer.Ptr(sk.Generation)
+	status.KeyspaceName = getKeyspaceName(sk)
+
+	return status
+}
+
+func (skc *Controller) updateStatus(ctx context.Context, currentSK *scyllav1alpha1.ScyllaDBKeyspace, status *scyllav1alpha1.ScyllaDBKeyspaceStatus) error {
+	if apiequality.Semantic.DeepEqual(&currentSK.Status, status) {
+		return nil
+	}
+
+	sk := currentSK.DeepCopy()
+	sk.Status = *status
+
+	klog.V(2).InfoS("Updating status", "ScyllaDBKeyspace", klog.KObj(sk))
+
+	_, err := skc.scyllaClient.ScyllaV1alpha1().ScyllaDBKeyspaces(sk.Namespace).UpdateStatus(ctx, sk, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	klog.V(2).InfoS("Status updated", "ScyllaDBKeyspace", klog.KObj(sk))
+
+	return nil
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbkeyspace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+func getDeletionPolicy(sk *scyllav1alpha1.ScyllaDBKeyspace) scyllav1alpha1.KeyspaceDeletionPolicy {
+	if len(sk.Spec.DeletionPolicy) != 0 {
+		return sk.Spec.DeletionPolicy
+	}
+
+	return scyllav1alpha1.KeyspaceDeletionPolicyRetain
+}
+
+func (skc *Controller) sync(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		klog.ErrorS(err, "Failed to split meta namespace cache key", "cacheKey", key)
+		return err
+	}
+
+	startTime := time.Now()
+	klog.V(4).InfoS("Started syncing ScyllaDBKeyspace", "ScyllaDBKeyspace", klog.KRef(namespace, name), "startTime", startTime)
+	defer func() {
+		klog.V(4).InfoS("Finished syncing ScyllaDBKeyspace", "ScyllaDBKeyspace", klog.KRef(namespace, name), "duration", time.Since(startTime))
+	}()
+
+	sk, err := skc.scyllaDBKeyspaceLister.ScyllaDBKeyspaces(namespace).Get(name)
+	if errors.IsNotFound(err) {
+		klog.V(2).InfoS("ScyllaDBKeyspace has been deleted", "ScyllaDBKeyspace", klog.KRef(namespace, name))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	sdc, err := skc.scyllaDBDatacenterLister.ScyllaDBDatacenters(sk.Namespace).Get(sk.Spec.ScyllaDBDatacenterRef.Name)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("can't get ScyllaDBDatacenter %q: %w", naming.ManualRef(sk.Namespace, sk.Spec.ScyllaDBDatacenterRef.Name), err)
+	}
+	if errors.IsNotFound(err) {
+		sdc = nil
+	}
+
+	if sk.DeletionTimestamp != nil {
+		return skc.syncFinalizer(ctx, sk, sdc)
+	}
+
+	updated, err := skc.syncFinalizerPresence(ctx, sk)
+	if err != nil || updated {
+		// The update triggers another sync.
+		return err
+	}
+
+	skc.queue.AddAfter(key, keyspaceResyncInterval)
+
+	status := skc.calculateStatus(sk)
+
+	var errs []error
+
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		keyspaceControllerProgressingCondition,
+		keyspaceControllerDegradedCondition,
+		sk.Generation,
+		func() ([]metav1.Condition, error) {
+			return skc.syncKeyspace(ctx, sk, sdc, status)
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync keyspace: %w", err))
+	}
+
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		repairControllerProgressingCondition,
+		repairControllerDegradedCondition,
+		sk.Generation,
+		func() ([]metav1.Condition, error) {
+			return skc.syncRepair(ctx, sk, sdc, status)
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync repair: %w", err))
+	}
+
+	if status.Repair != nil {
+		skc.queue.AddAfter(key, repairResyncInterval)
+	}
+
+	// Aggregate conditions.
+	err = controllerhelpers.SetAggregatedWorkloadConditions(&status.Conditions, sk.Generation)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't aggregate workload conditions: %w", err))
+	} else {
+		err = skc.updateStatus(ctx, sk, status)
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// syncFinalizer drops the keyspace of a deleted ScyllaDBKeyspace with the Delete policy and releases the object.
+func (skc *Controller) syncFinalizer(ctx context.Context, sk *scyllav1alpha1.ScyllaDBKeyspace, sdc *scyllav1alpha1.ScyllaDBDatacenter) error {
+	if !slices.ContainsItem(sk.Finalizers, naming.ScyllaDBKeyspaceFinalizer) {
+		return nil
+	}
+
+	if getDeletionPolicy(sk) == scyllav1alpha1.KeyspaceDeletionPolicyDelete {
+		if sdc == nil || sdc.DeletionTimestamp != nil {
+			// The keyspace goes away together with the cluster.
+			klog.V(2).InfoS("ScyllaDBDatacenter is gone, not dropping the keyspace", "ScyllaDBKeyspace", klog.KObj(sk))
+		} else {
+			err := skc.dropKeyspace(ctx, sk, sdc)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return skc.removeFinalizer(ctx, sk)
+}
+
+// syncFinalizerPresence makes sure that only ScyllaDBKeyspaces with the Delete policy carry the finalizer.
+// It returns true if the ScyllaDBKeyspace was updated.
+func (skc *Controller) syncFinalizerPresence(ctx context.Context, sk *scyllav1alpha1.ScyllaDBKeyspace) (bool, error) {
+	hasFinalizer := slices.ContainsItem(sk.Finalizers, naming.ScyllaDBKeyspaceFinalizer)
+	needsFinalizer := getDeletionPolicy(sk) == scyllav1alpha1.KeyspaceDeletionPolicyDelete
+
+	switch {
+	case needsFinalizer && !hasFinalizer:
+		skCopy := sk.DeepCopy()
+		skCopy.Finalizers = append(skCopy.Finalizers, naming.ScyllaDBKeyspaceFinalizer)
+		_, err := skc.scyllaClient.ScyllaV1alpha1().ScyllaDBKeyspaces(skCopy.Namespace).Update(ctx, skCopy, metav1.UpdateOptions{})
+		if err != nil {
+			return false, fmt.Errorf("can't add finalizer to ScyllaDBKeyspace %q: %w", naming.ObjRef(sk), err)
+		}
+		return true, nil
+
+	case !needsFinalizer && hasFinalizer:
+		return true, skc.removeFinalizer(ctx, sk)
+
+	default:
+		return false, nil
+	}
+}
+
+func (skc *Controller) removeFinalizer(ctx context.Context, sk *scyllav1alpha1.ScyllaDBKeyspace) error {
+	skCopy := sk.DeepCopy()
+	skCopy.Finalizers = slices.FilterOut(skCopy.Finalizers, func(f string) bool {
+		return f == naming.ScyllaDBKeyspaceFinalizer
+	})
+	_, err := skc.scyllaClient.ScyllaV1alpha1().ScyllaDBKeyspaces(skCopy.Namespace).Update(ctx, skCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("can't remove finalizer from ScyllaDBKeyspace %q: %w", naming.ObjRef(sk), err)
+	}
+
+	return nil
+}
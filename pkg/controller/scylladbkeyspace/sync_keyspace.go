@@ -0,0 +1,187 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbkeyspace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gocql/gocql"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/util/cql"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+func makeProgressingCondition(sk *scyllav1alpha1.ScyllaDBKeyspace, conditionType, reason, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: sk.Generation,
+	}
+}
+
+func setKeyspaceCondition(sk *scyllav1alpha1.ScyllaDBKeyspace, status *scyllav1alpha1.ScyllaDBKeyspaceStatus, conditionType string, conditionStatus metav1.ConditionStatus, reason, message string) {
+	apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             conditionStatus,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: sk.Generation,
+	})
+}
+
+// newSession opens an admin CQL session to the nodes of the ScyllaDBDatacenter.
+func (skc *Controller) newSession(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter) ([]string, *gocql.Session, error) {
+	hosts, err := controllerhelpers.GetScyllaDBDatacenterHosts(sdc, skc.serviceLister, skc.podLister)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't get hosts of ScyllaDBDatacenter %q: %w", naming.ObjRef(sdc), err)
+	}
+
+	if len(hosts) == 0 {
+		return nil, nil, fmt.Errorf("ScyllaDBDatacenter %q has no hosts", naming.ObjRef(sdc))
+	}
+
+	session, err := controllerhelpers.NewScyllaDBDatacenterCQLSession(ctx, skc.kubeClient, sdc, hosts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hosts, session, nil
+}
+
+func (skc *Controller) dropKeyspace(ctx context.Context, sk *scyllav1alpha1.ScyllaDBKeyspace, sdc *scyllav1alpha1.ScyllaDBDatacenter) error {
+	_, session, err := skc.newSession(ctx, sdc)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	name := getKeyspaceName(sk)
+	err = session.Query(fmt.Sprintf("DROP KEYSPACE IF EXISTS %s", cql.QuoteIdentifier(name))).WithContext(ctx).Exec()
+	if err != nil {
+		return fmt.Errorf("can't drop keyspace %q: %w", name, err)
+	}
+
+	klog.V(2).InfoS("Dropped keyspace", "ScyllaDBKeyspace", klog.KObj(sk), "Keyspace", name)
+	skc.eventRecorder.Eventf(sk, corev1.EventTypeNormal, "KeyspaceDropped", "Dropped keyspace %q", name)
+
+	return nil
+}
+
+// syncKeyspace creates the keyspace or alters it to match the spec and reports drift of the keyspace
+// from the last reconciled state.
+func (skc *Controller) syncKeyspace(
+	ctx context.Context,
+	sk *scyllav1alpha1.ScyllaDBKeyspace,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBKeyspaceStatus,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if sdc == nil {
+		setKeyspaceCondition(sk, status, keyspaceAvailableCondition, metav1.ConditionFalse, "WaitingForScyllaDBDatacenter", "")
+		progressingConditions = append(progressingConditions, makeProgressingCondition(
+			sk,
+			keyspaceControllerProgressingCondition,
+			"WaitingForScyllaDBDatacenter",
+			fmt.Sprintf("Waiting for ScyllaDBDatacenter %q to exist.", naming.ManualRef(sk.Namespace, sk.Spec.ScyllaDBDatacenterRef.Name)),
+		))
+		return progressingConditions, nil
+	}
+
+	hosts, session, err := skc.newSession(ctx, sdc)
+	if err != nil {
+		return progressingConditions, err
+	}
+	defer session.Close()
+
+	name := getKeyspaceName(sk)
+	required := makeRequiredKeyspace(sk)
+
+	existing, err := getKeyspace(ctx, session, name)
+	if err != nil {
+		return progressingConditions, err
+	}
+
+	driftReason, driftMessage := internalapi.AsExpectedReason, ""
+	driftStatus := metav1.ConditionFalse
+
+	if existing == nil {
+		err = session.Query(makeCreateKeyspaceStatement(name, required)).WithContext(ctx).Exec()
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't create keyspace %q: %w", name, err)
+		}
+
+		klog.V(2).InfoS("Created keyspace", "ScyllaDBKeyspace", klog.KObj(sk), "Keyspace", name)
+		skc.eventRecorder.Eventf(sk, corev1.EventTypeNormal, "KeyspaceCreated", "Created keyspace %q", name)
+	} else {
+		diff := getKeyspaceDifferences(required, existing)
+
+		// The spec didn't change since the last reconciliation, so the keyspace was changed outside the operator.
+		drifted := !diff.isEmpty() && sk.Status.ObservedGeneration != nil && *sk.Status.ObservedGeneration == sk.Generation
+		if drifted {
+			differences := strings.Join(append(append([]string{}, diff.alterable...), diff.immutable...), ", ")
+			skc.eventRecorder.Eventf(sk, corev1.EventTypeWarning, "DriftDetected", "Keyspace %q drifted from the spec: %s", name, differences)
+		}
+
+		if len(diff.alterable) != 0 {
+			err = session.Query(makeAlterKeyspaceStatement(name, required)).WithContext(ctx).Exec()
+			if err != nil {
+				return progressingConditions, fmt.Errorf("can't alter keyspace %q: %w", name, err)
+			}
+
+			klog.V(2).InfoS("Altered keyspace", "ScyllaDBKeyspace", klog.KObj(sk), "Keyspace", name, "Differences", diff.alterable)
+			skc.eventRecorder.Eventf(sk, corev1.EventTypeNormal, "KeyspaceAltered", "Altered keyspace %q: %s", name, strings.Join(diff.alterable, ", "))
+
+			if drifted {
+				driftReason = "DriftCorrected"
+				driftMessage = fmt.Sprintf("Keyspace was changed outside of the operator and was reverted: %s.", strings.Join(diff.alterable, ", "))
+			}
+		}
+
+		if len(diff.immutable) != 0 {
+			driftStatus = metav1.ConditionTrue
+			driftReason = "ImmutableFieldsDrifted"
+			driftMessage = fmt.Sprintf("Keyspace differs from the spec in properties that can't be changed: %s.", strings.Join(diff.immutable, ", "))
+		}
+	}
+
+	setKeyspaceCondition(sk, status, scyllav1alpha1.ScyllaDBKeyspaceDriftedCondition, driftStatus, driftReason, driftMessage)
+
+	current, err := getKeyspace(ctx, session, name)
+	if err != nil {
+		return progressingConditions, err
+	}
+	if current == nil {
+		return progressingConditions, fmt.Errorf("keyspace %q doesn't exist after it was reconciled", name)
+	}
+
+	setKeyspaceCondition(sk, status, keyspaceAvailableCondition, metav1.ConditionTrue, internalapi.AsExpectedReason, "")
+
+	increasedDCs := getIncreasedReplicationDatacenters(status.Replication, current)
+	tablets := current.tabletsEnabled != nil && *current.tabletsEnabled
+	// Keyspaces using tablets are rebuilt by ScyllaDB on their own. Newly created keyspaces have no data to repair.
+	if len(status.Replication) != 0 && len(increasedDCs) != 0 && !tablets && status.Repair == nil {
+		klog.V(2).InfoS("Replication factor increased, scheduling repair", "ScyllaDBKeyspace", klog.KObj(sk), "Keyspace", name, "Datacenters", increasedDCs)
+		skc.eventRecorder.Eventf(sk, corev1.EventTypeNormal, "RepairScheduled", "Replication factor of keyspace %q increased in datacenters %q, scheduling a repair", name, increasedDCs)
+		status.Repair = &scyllav1alpha1.KeyspaceRepairStatus{
+			PendingHosts: hosts,
+		}
+	}
+
+	status.Replication = makeReplicationStatus(current)
+	status.TabletsEnabled = current.tabletsEnabled
+	status.DurableWrites = pointer.Ptr(current.durableWrites)
+
+	return progressingConditions, nil
+}
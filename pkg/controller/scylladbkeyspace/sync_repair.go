@@ -0,0 +1,128 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbkeyspace
+
+import (
+	"context"
+	"fmt"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/helpers"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+func (skc *Controller) getScyllaClient(sdc *scyllav1alpha1.ScyllaDBDatacenter, hosts []string) (*scyllaclient.Client, error) {
+	secretName := naming.AgentAuthTokenSecretName(sdc)
+	secret, err := skc.secretLister.Secrets(sdc.Namespace).Get(secretName)
+	if err != nil {
+		return nil, fmt.Errorf("can't get manager agent auth secret %q: %w", naming.ManualRef(sdc.Namespace, secretName), err)
+	}
+
+	token, err := helpers.GetAgentAuthTokenFromSecret(secret)
+	if err != nil {
+		return nil, fmt.Errorf("can't get agent token from secret %q: %w", naming.ObjRef(secret), err)
+	}
+
+	return controllerhelpers.NewScyllaClientFromToken(hosts, token)
+}
+
+// syncRepair repairs the keyspace on one host at a time after its replication factor increased,
+// so that the new replicas receive the existing data.
+func (skc *Controller) syncRepair(
+	ctx context.Context,
+	sk *scyllav1alpha1.ScyllaDBKeyspace,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBKeyspaceStatus,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if status.Repair == nil {
+		return progressingConditions, nil
+	}
+
+	if sdc == nil {
+		progressingConditions = append(progressingConditions, makeProgressingCondition(
+			sk,
+			repairControllerProgressingCondition,
+			"WaitingForScyllaDBDatacenter",
+			fmt.Sprintf("Waiting for ScyllaDBDatacenter %q to exist.", naming.ManualRef(sk.Namespace, sk.Spec.ScyllaDBDatacenterRef.Name)),
+		))
+		return progressingConditions, nil
+	}
+
+	name := getKeyspaceName(sk)
+	repair := status.Repair
+
+	if len(repair.Host) == 0 {
+		if len(repair.PendingHosts) == 0 {
+			status.Repair = nil
+			skc.eventRecorder.Eventf(sk, corev1.EventTypeNormal, "RepairCompleted", "Repaired keyspace %q", name)
+			return progressingConditions, nil
+		}
+
+		repair.Host = repair.PendingHosts[0]
+		repair.PendingHosts = repair.PendingHosts[1:]
+		repair.ID = nil
+	}
+
+	scyllaClient, err := skc.getScyllaClient(sdc, []string{repair.Host})
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't create scylla client: %w", err)
+	}
+	defer scyllaClient.Close()
+
+	if repair.ID == nil {
+		id, err := scyllaClient.Repair(ctx, repair.Host, name)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't start repair on host %q: %w", repair.Host, err)
+		}
+
+		klog.V(2).InfoS("Started repair", "ScyllaDBKeyspace", klog.KObj(sk), "Keyspace", name, "Host", repair.Host, "ID", id)
+		repair.ID = pointer.Ptr(id)
+	} else {
+		repairStatus, err := scyllaClient.RepairStatus(ctx, repair.Host, name, *repair.ID)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't get repair status on host %q: %w", repair.Host, err)
+		}
+
+		switch repairStatus {
+		case scyllaclient.RepairStatusSuccessful:
+			klog.V(2).InfoS("Repair succeeded", "ScyllaDBKeyspace", klog.KObj(sk), "Keyspace", name, "Host", repair.Host, "ID", *repair.ID)
+			repair.Host = ""
+			repair.ID = nil
+
+			if len(repair.PendingHosts) == 0 {
+				status.Repair = nil
+				skc.eventRecorder.Eventf(sk, corev1.EventTypeNormal, "RepairCompleted", "Repaired keyspace %q", name)
+				return progressingConditions, nil
+			}
+
+		case scyllaclient.RepairStatusFailed:
+			skc.eventRecorder.Eventf(sk, corev1.EventTypeWarning, "RepairFailed", "Repair of keyspace %q failed on host %q, retrying", name, repair.Host)
+			repair.ID = nil
+
+		default:
+		}
+	}
+
+	remainingHosts := len(repair.PendingHosts)
+	if len(repair.Host) != 0 {
+		remainingHosts++
+	}
+
+	progressingConditions = append(progressingConditions, makeProgressingCondition(
+		sk,
+		repairControllerProgressingCondition,
+		internalapi.ProgressingReason,
+		fmt.Sprintf("Repairing keyspace %q, %d host(s) left.", name, remainingHosts),
+	))
+
+	return progressingConditions, nil
+}
@@ -0,0 +1,8 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbrepair
+
+const (
+	repairControllerProgressingCondition = "RepairControllerProgressing"
+	repairControllerDegradedCondition    = "RepairControllerDegraded"
+)
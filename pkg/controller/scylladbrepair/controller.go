@@ -0,0 +1,94 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbrepair
+
+import (
+	"context"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scyllaclient "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned"
+	scyllav1informers "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/scylla/v1"
+	scyllav1alpha1informers "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controller/manager"
+	"github.com/scylladb/scylla-operator/pkg/kubeinterfaces"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	ControllerName = "ScyllaDBRepairController"
+)
+
+var (
+	scyllaDBRepairControllerGVK = scyllav1alpha1.GroupVersion.WithKind("ScyllaDBRepair")
+)
+
+type Controller struct {
+	*manager.OneOffTaskController[*scyllav1alpha1.ScyllaDBRepair, scyllav1alpha1.ScyllaDBRepairStatus]
+
+	managerClient *managerclient.Client
+}
+
+func NewController(
+	scyllaClient scyllaclient.Interface,
+	scyllaClusterInformer scyllav1informers.ScyllaClusterInformer,
+	scyllaDBRepairInformer scyllav1alpha1informers.ScyllaDBRepairInformer,
+	managerClient *managerclient.Client,
+) (*Controller, error) {
+	src := &Controller{
+		managerClient: managerClient,
+	}
+
+	var err error
+	src.OneOffTaskController, err = manager.NewOneOffTaskController[*scyllav1alpha1.ScyllaDBRepair, scyllav1alpha1.ScyllaDBRepairStatus](
+		ControllerName,
+		scyllaDBRepairControllerGVK,
+		scyllaClusterInformer,
+		scyllaDBRepairInformer.Informer(),
+		managerClient,
+		manager.OneOffTaskControl[*scyllav1alpha1.ScyllaDBRepair, scyllav1alpha1.ScyllaDBRepairStatus]{
+			Kind:                 scyllaDBRepairControllerGVK.Kind,
+			Finalizer:            naming.ScyllaDBRepairFinalizer,
+			TaskType:             managerclient.RepairTask,
+			ProgressingCondition: repairControllerProgressingCondition,
+			DegradedCondition:    repairControllerDegradedCondition,
+			GetterLister: kubeinterfaces.NamespacedGetList[*scyllav1alpha1.ScyllaDBRepair]{
+				GetFunc: func(namespace, name string) (*scyllav1alpha1.ScyllaDBRepair, error) {
+					return scyllaDBRepairInformer.Lister().ScyllaDBRepairs(namespace).Get(name)
+				},
+				ListFunc: func(namespace string, selector labels.Selector) (ret []*scyllav1alpha1.ScyllaDBRepair, err error) {
+					return scyllaDBRepairInformer.Lister().ScyllaDBRepairs(namespace).List(selector)
+				},
+			},
+			UpdateFunc: func(ctx context.Context, sr *scyllav1alpha1.ScyllaDBRepair, opts metav1.UpdateOptions) (*scyllav1alpha1.ScyllaDBRepair, error) {
+				return scyllaClient.ScyllaV1alpha1().ScyllaDBRepairs(sr.Namespace).Update(ctx, sr, opts)
+			},
+			UpdateStatusFunc: func(ctx context.Context, sr *scyllav1alpha1.ScyllaDBRepair, opts metav1.UpdateOptions) (*scyllav1alpha1.ScyllaDBRepair, error) {
+				return scyllaClient.ScyllaV1alpha1().ScyllaDBRepairs(sr.Namespace).UpdateStatus(ctx, sr, opts)
+			},
+			DeleteFunc: func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+				return scyllaClient.ScyllaV1alpha1().ScyllaDBRepairs(namespace).Delete(ctx, name, opts)
+			},
+			GetScyllaClusterName: func(sr *scyllav1alpha1.ScyllaDBRepair) string {
+				return sr.Spec.ScyllaClusterName
+			},
+			GetTTLSecondsAfterFinished: func(sr *scyllav1alpha1.ScyllaDBRepair) *int32 {
+				return sr.Spec.TTLSecondsAfterFinished
+			},
+			GetStatus: func(sr *scyllav1alpha1.ScyllaDBRepair) *scyllav1alpha1.ScyllaDBRepairStatus {
+				return &sr.Status
+			},
+			GetTaskStatus: func(status *scyllav1alpha1.ScyllaDBRepairStatus) *scyllav1alpha1.ScyllaDBManagerTaskStatus {
+				return &status.ScyllaDBManagerTaskStatus
+			},
+			SyncTask: src.syncRepair,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return src, nil
+}
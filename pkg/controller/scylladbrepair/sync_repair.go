@@ -0,0 +1,88 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbrepair
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/v3/swagger/gen/scylla-manager/models"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controller/manager"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// syncRepair creates the repair task in Scylla Manager once and reflects its progress in the status.
+func (src *Controller) syncRepair(ctx context.Context, sr *scyllav1alpha1.ScyllaDBRepair, clusterID string, status *scyllav1alpha1.ScyllaDBRepairStatus) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	task, err := manager.FindOneOffTask(ctx, src.managerClient, clusterID, managerclient.RepairTask, sr.UID)
+	if err != nil {
+		return progressingConditions, err
+	}
+
+	if task == nil {
+		if status.TaskID != nil {
+			// The task was removed outside of the operator, e.g. together with the cluster registration.
+			status.Phase = scyllav1alpha1.ScyllaDBManagerTaskPhaseFailed
+			status.Message = pointer.Ptr(fmt.Sprintf("Task %q was removed from Scylla Manager.", *status.TaskID))
+			manager.SetOneOffTaskRunStatus(&status.ScyllaDBManagerTaskStatus, nil, time.Now())
+			return progressingConditions, nil
+		}
+
+		t, err := manager.MakeOneOffRepairTask(sr)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't make repair task: %w", err)
+		}
+
+		taskID, err := manager.CreateOneOffTask(ctx, src.managerClient, clusterID, t)
+		if err != nil {
+			return progressingConditions, err
+		}
+
+		status.TaskID = pointer.Ptr(taskID)
+		status.Phase = scyllav1alpha1.ScyllaDBManagerTaskPhasePending
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               repairControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "CreatedTask",
+			Message:            fmt.Sprintf("Created repair task %q.", taskID),
+			ObservedGeneration: sr.Generation,
+		})
+		return progressingConditions, nil
+	}
+
+	status.TaskID = pointer.Ptr(task.ID)
+	status.Phase = manager.GetOneOffTaskPhase(task)
+
+	var run *models.TaskRun
+	if status.Phase != scyllav1alpha1.ScyllaDBManagerTaskPhasePending {
+		progress, err := src.managerClient.RepairProgress(ctx, clusterID, task.ID, "latest")
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't get progress of repair task %q: %w", task.ID, err)
+		}
+
+		if progress.TaskRunRepairProgress != nil {
+			run = progress.Run
+			if progress.Progress != nil {
+				status.Progress = pointer.Ptr(progress.Progress.SuccessPercentage)
+			}
+		}
+	}
+	manager.SetOneOffTaskRunStatus(&status.ScyllaDBManagerTaskStatus, run, time.Now())
+
+	if !manager.IsFinishedPhase(status.Phase) {
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               repairControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForTask",
+			Message:            fmt.Sprintf("Waiting for repair task %q to finish.", task.ID),
+			ObservedGeneration: sr.Generation,
+		})
+	}
+
+	return progressingConditions, nil
+}
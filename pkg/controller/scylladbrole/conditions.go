@@ -0,0 +1,11 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbrole
+
+const (
+	credentialsSecretControllerProgressingCondition = "CredentialsSecretControllerProgressing"
+	credentialsSecretControllerDegradedCondition    = "CredentialsSecretControllerDegraded"
+	roleControllerProgressingCondition              = "RoleControllerProgressing"
+	roleControllerDegradedCondition                 = "RoleControllerDegraded"
+	roleAvailableCondition                          = "RoleAvailable"
+)
@@ -0,0 +1,287 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbrole
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scyllaclient "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned"
+	scyllav1alpha1informers "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/scylla/v1alpha1"
+	scyllav1alpha1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/kubeinterfaces"
+	"github.com/scylladb/scylla-operator/pkg/scheme"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+)
+
+const (
+	ControllerName = "ScyllaDBRoleController"
+
+	// roleResyncInterval is how often roles are compared with the cluster.
+	roleResyncInterval = 5 * time.Minute
+)
+
+var (
+	keyFunc                   = cache.DeletionHandlingMetaNamespaceKeyFunc
+	scyllaDBRoleControllerGVK = scyllav1alpha1.GroupVersion.WithKind("ScyllaDBRole")
+)
+
+type Controller struct {
+	kubeClient   kubernetes.Interface
+	scyllaClient scyllaclient.Interface
+
+	secretLister             corev1listers.SecretLister
+	serviceLister            corev1listers.ServiceLister
+	podLister                corev1listers.PodLister
+	scyllaDBDatacenterLister scyllav1alpha1listers.ScyllaDBDatacenterLister
+	scyllaDBRoleLister       scyllav1alpha1listers.ScyllaDBRoleLister
+
+	cachesToSync []cache.InformerSynced
+
+	eventRecorder record.EventRecorder
+
+	queue    workqueue.RateLimitingInterface
+	handlers *controllerhelpers.Handlers[*scyllav1alpha1.ScyllaDBRole]
+}
+
+func NewController(
+	kubeClient kubernetes.Interface,
+	scyllaClient scyllaclient.Interface,
+	secretInformer corev1informers.SecretInformer,
+	serviceInformer corev1informers.ServiceInformer,
+	podInformer corev1informers.PodInformer,
+	scyllaDBDatacenterInformer scyllav1alpha1informers.ScyllaDBDatacenterInformer,
+	scyllaDBRoleInformer scyllav1alpha1informers.ScyllaDBRoleInformer,
+) (*Controller, error) {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartStructuredLogging(0)
+	eventBroadcaster.StartRecordingToSink(&corev1client.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+
+	src := &Controller{
+		kubeClient:   kubeClient,
+		scyllaClient: scyllaClient,
+
+		secretLister:             secretInformer.Lister(),
+		serviceLister:            serviceInformer.Lister(),
+		podLister:                podInformer.Lister(),
+		scyllaDBDatacenterLister: scyllaDBDatacenterInformer.Lister(),
+		scyllaDBRoleLister:       scyllaDBRoleInformer.Lister(),
+
+		cachesToSync: []cache.InformerSynced{
+			secretInformer.Informer().HasSynced,
+			serviceInformer.Informer().HasSynced,
+			podInformer.Informer().HasSynced,
+			scyllaDBDatacenterInformer.Informer().HasSynced,
+			scyllaDBRoleInformer.Informer().HasSynced,
+		},
+
+		eventRecorder: eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "scylladbrole-controller"}),
+
+		queue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "scylladbrole"),
+	}
+
+	var err error
+	src.handlers, err = controllerhelpers.NewHandlers[*scyllav1alpha1.ScyllaDBRole](
+		src.queue,
+		keyFunc,
+		scheme.Scheme,
+		scyllaDBRoleControllerGVK,
+		kubeinterfaces.NamespacedGetList[*scyllav1alpha1.ScyllaDBRole]{
+			GetFunc: func(namespace, name string) (*scyllav1alpha1.ScyllaDBRole, error) {
+				return src.scyllaDBRoleLister.ScyllaDBRoles(namespace).Get(name)
+			},
+			ListFunc: func(namespace string, selector labels.Selector) (ret []*scyllav1alpha1.ScyllaDBRole, err error) {
+				return src.scyllaDBRoleLister.ScyllaDBRoles(namespace).List(selector)
+			},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("can't create handlers: %w", err)
+	}
+
+	secretInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    src.addSecret,
+		UpdateFunc: src.updateSecret,
+		DeleteFunc: src.deleteSecret,
+	})
+
+	scyllaDBRoleInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    src.addScyllaDBRole,
+		UpdateFunc: src.updateScyllaDBRole,
+		DeleteFunc: src.deleteScyllaDBRole,
+	})
+
+	scyllaDBDatacenterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    src.addScyllaDBDatacenter,
+		UpdateFunc: src.updateScyllaDBDatacenter,
+		DeleteFunc: src.deleteScyllaDBDatacenter,
+	})
+
+	return src, nil
+}
+
+func (src *Controller) processNextItem(ctx context.Context) bool {
+	key, quit := src.queue.Get()
+	if quit {
+		return false
+	}
+	defer src.queue.Done(key)
+
+	err := src.sync(ctx, key.(string))
+	// TODO: Do smarter filtering then just Reduce to handle cases like 2 conflict errors.
+	err = utilerrors.Reduce(err)
+	switch {
+	case err == nil:
+		src.queue.Forget(key)
+		return true
+
+	case apierrors.IsConflict(err):
+		klog.V(2).InfoS("Hit conflict, will retry in a bit", "Key", key, "Error", err)
+
+	case apierrors.IsAlreadyExists(err):
+		klog.V(2).InfoS("Hit already exists, will retry in a bit", "Key", key, "Error", err)
+
+	default:
+		utilruntime.HandleError(fmt.Errorf("syncing key '%v' failed: %v", key, err))
+	}
+
+	src.queue.AddRateLimited(key)
+
+	return true
+}
+
+func (src *Controller) runWorker(ctx context.Context) {
+	for src.processNextItem(ctx) {
+	}
+}
+
+func (src *Controller) Run(ctx context.Context, workers int) {
+	defer utilruntime.HandleCrash()
+
+	klog.InfoS("Starting controller", "controller", ControllerName)
+
+	var wg sync.WaitGroup
+	defer func() {
+		klog.InfoS("Shutting down controller", "controller", ControllerName)
+		src.queue.ShutDown()
+		wg.Wait()
+		klog.InfoS("Shut down controller", "controller", ControllerName)
+	}()
+
+	if !cache.WaitForNamedCacheSync(ControllerName, ctx.Done(), src.cachesToSync...) {
+		return
+	}
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wait.UntilWithContext(ctx, src.runWorker, time.Second)
+		}()
+	}
+
+	<-ctx.Done()
+}
+
+// enqueueScyllaDBRolesReferencingScyllaDBDatacenter enqueues ScyllaDBRoles managed through the ScyllaDBDatacenter.
+func (src *Controller) enqueueScyllaDBRolesReferencingScyllaDBDatacenter(depth int, obj kubeinterfaces.ObjectInterface, op controllerhelpers.HandlerOperationType) {
+	sdc := obj.(*scyllav1alpha1.ScyllaDBDatacenter)
+
+	srs, err := src.scyllaDBRoleLister.ScyllaDBRoles(sdc.Namespace).List(labels.Everything())
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("can't list ScyllaDBRoles: %w", err))
+		return
+	}
+
+	for _, sr := range srs {
+		if sr.Spec.ScyllaDBDatacenterRef.Name == sdc.Name {
+			src.handlers.Enqueue(depth+1, sr, op)
+		}
+	}
+}
+
+func (src *Controller) addSecret(obj interface{}) {
+	src.handlers.HandleAdd(
+		obj.(*corev1.Secret),
+		src.handlers.EnqueueOwner,
+	)
+}
+
+func (src *Controller) updateSecret(old, cur interface{}) {
+	src.handlers.HandleUpdate(
+		old.(*corev1.Secret),
+		cur.(*corev1.Secret),
+		src.handlers.EnqueueOwner,
+		src.deleteSecret,
+	)
+}
+
+func (src *Controller) deleteSecret(obj interface{}) {
+	src.handlers.HandleDelete(
+		obj,
+		src.handlers.EnqueueOwner,
+	)
+}
+
+func (src *Controller) addScyllaDBRole(obj interface{}) {
+	src.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.ScyllaDBRole),
+		src.handlers.Enqueue,
+	)
+}
+
+func (src *Controller) updateScyllaDBRole(old, cur interface{}) {
+	src.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.ScyllaDBRole),
+		cur.(*scyllav1alpha1.ScyllaDBRole),
+		src.handlers.Enqueue,
+		src.deleteScyllaDBRole,
+	)
+}
+
+func (src *Controller) deleteScyllaDBRole(obj interface{}) {
+	src.handlers.HandleDelete(
+		obj,
+		src.handlers.Enqueue,
+	)
+}
+
+func (src *Controller) addScyllaDBDatacenter(obj interface{}) {
+	src.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.ScyllaDBDatacenter),
+		src.enqueueScyllaDBRolesReferencingScyllaDBDatacenter,
+	)
+}
+
+func (src *Controller) updateScyllaDBDatacenter(old, cur interface{}) {
+	src.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.ScyllaDBDatacenter),
+		cur.(*scyllav1alpha1.ScyllaDBDatacenter),
+		src.enqueueScyllaDBRolesReferencingScyllaDBDatacenter,
+		src.deleteScyllaDBDatacenter,
+	)
+}
+
+func (src *Controller) deleteScyllaDBDatacenter(obj interface{}) {
+	src.handlers.HandleDelete(
+		obj,
+		src.enqueueScyllaDBRolesReferencingScyllaDBDatacenter,
+	)
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbrole
+
+import (
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+const (
+	passwordLength = 32
+)
+
+// makeCredentialsSecret returns the Secret holding the credentials of the role. The existing password is kept,
+// unless a password rotation is requested.
+func makeCredentialsSecret(sr *scyllav1alpha1.ScyllaDBRole, existingSecret *corev1.Secret, rotate bool) *corev1.Secret {
+	var password []byte
+	if existingSecret != nil && !rotate {
+		password = existingSecret.Data[naming.ScyllaDBRolePasswordSecretKey]
+	}
+
+	if len(password) == 0 {
+		password = []byte(rand.String(passwordLength))
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      naming.GetScyllaDBRoleCredentialsSecretName(sr.Name),
+			Namespace: sr.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(sr, scyllaDBRoleControllerGVK),
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			naming.ScyllaDBRoleUsernameSecretKey: []byte(getRoleName(sr)),
+			naming.ScyllaDBRolePasswordSecretKey: password,
+		},
+	}
+}
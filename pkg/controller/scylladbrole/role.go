@@ -0,0 +1,245 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbrole
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gocql/gocql"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/util/cql"
+)
+
+const (
+	listRolesQuery          = "LIST ROLES"
+	listPermissionsTemplate = "LIST ALL PERMISSIONS OF %s NORECURSIVE"
+)
+
+// role describes the attributes of a role the controller manages.
+type role struct {
+	login     bool
+	superuser bool
+}
+
+// grant is a permission of a role on a data resource.
+type grant struct {
+	permission string
+
+	// keyspace and table identify the resource. Empty keyspace stands for all keyspaces.
+	keyspace string
+	table    string
+}
+
+// resource returns the name of the resource, as reported by ScyllaDB.
+func (g grant) resource() string {
+	switch {
+	case len(g.keyspace) == 0:
+		return "<all keyspaces>"
+	case len(g.table) == 0:
+		return fmt.Sprintf("<keyspace %s>", g.keyspace)
+	default:
+		return fmt.Sprintf("<table %s.%s>", g.keyspace, g.table)
+	}
+}
+
+// resourceStatement returns the resource, as used in GRANT and REVOKE statements.
+func (g grant) resourceStatement() string {
+	switch {
+	case len(g.keyspace) == 0:
+		return "ALL KEYSPACES"
+	case len(g.table) == 0:
+		return fmt.Sprintf("KEYSPACE %s", cql.QuoteIdentifier(g.keyspace))
+	default:
+		return fmt.Sprintf("TABLE %s.%s", cql.QuoteIdentifier(g.keyspace), cql.QuoteIdentifier(g.table))
+	}
+}
+
+func (g grant) String() string {
+	return fmt.Sprintf("%s ON %s", g.permission, g.resource())
+}
+
+func getRoleName(sr *scyllav1alpha1.ScyllaDBRole) string {
+	if len(sr.Spec.RoleName) != 0 {
+		return sr.Spec.RoleName
+	}
+
+	return sr.Name
+}
+
+func makeRequiredRole(sr *scyllav1alpha1.ScyllaDBRole) *role {
+	r := &role{
+		login:     true,
+		superuser: sr.Spec.Superuser,
+	}
+
+	if sr.Spec.Login != nil {
+		r.login = *sr.Spec.Login
+	}
+
+	return r
+}
+
+func sortGrants(grants []grant) {
+	sort.Slice(grants, func(i, j int) bool {
+		return grants[i].String() < grants[j].String()
+	})
+}
+
+// makeRequiredGrants returns the grants specified by the ScyllaDBRole, sorted.
+func makeRequiredGrants(sr *scyllav1alpha1.ScyllaDBRole) []grant {
+	var grants []grant
+	for _, g := range sr.Spec.Grants {
+		for _, p := range g.Permissions {
+			grants = append(grants, grant{
+				permission: strings.ToUpper(string(p)),
+				keyspace:   g.Keyspace,
+				table:      g.Table,
+			})
+		}
+	}
+	sortGrants(grants)
+
+	return grants
+}
+
+// parseDataResource parses the name of a data resource reported by ScyllaDB.
+// It returns false for resources of other kinds, like roles or functions.
+func parseDataResource(resource string) (string, string, bool) {
+	if resource == "<all keyspaces>" {
+		return "", "", true
+	}
+
+	if ks, ok := strings.CutPrefix(resource, "<keyspace "); ok {
+		return strings.TrimSuffix(ks, ">"), "", true
+	}
+
+	if t, ok := strings.CutPrefix(resource, "<table "); ok {
+		ks, table, found := strings.Cut(strings.TrimSuffix(t, ">"), ".")
+		if !found {
+			return "", "", false
+		}
+		return ks, table, true
+	}
+
+	return "", "", false
+}
+
+// getGrantsDifference returns the grants that have to be granted and the ones that have to be revoked.
+func getGrantsDifference(required, existing []grant) ([]grant, []grant) {
+	requiredSet := make(map[grant]struct{}, len(required))
+	for _, g := range required {
+		requiredSet[g] = struct{}{}
+	}
+
+	existingSet := make(map[grant]struct{}, len(existing))
+	for _, g := range existing {
+		existingSet[g] = struct{}{}
+	}
+
+	var toGrant, toRevoke []grant
+	for _, g := range required {
+		if _, ok := existingSet[g]; !ok {
+			toGrant = append(toGrant, g)
+		}
+	}
+	for _, g := range existing {
+		if _, ok := requiredSet[g]; !ok {
+			toRevoke = append(toRevoke, g)
+		}
+	}
+
+	return toGrant, toRevoke
+}
+
+func makeCreateRoleStatement(name string, r *role, password string) string {
+	return fmt.Sprintf("CREATE ROLE IF NOT EXISTS %s WITH PASSWORD = %s AND LOGIN = %t AND SUPERUSER = %t", cql.QuoteIdentifier(name), cql.QuoteString(password), r.login, r.superuser)
+}
+
+func makeAlterRoleStatement(name string, r *role) string {
+	return fmt.Sprintf("ALTER ROLE %s WITH LOGIN = %t AND SUPERUSER = %t", cql.QuoteIdentifier(name), r.login, r.superuser)
+}
+
+func makeAlterRolePasswordStatement(name string, password string) string {
+	return fmt.Sprintf("ALTER ROLE %s WITH PASSWORD = %s", cql.QuoteIdentifier(name), cql.QuoteString(password))
+}
+
+func makeDropRoleStatement(name string) string {
+	return fmt.Sprintf("DROP ROLE IF EXISTS %s", cql.QuoteIdentifier(name))
+}
+
+func makeGrantStatement(name string, g grant) string {
+	return fmt.Sprintf("GRANT %s ON %s TO %s", g.permission, g.resourceStatement(), cql.QuoteIdentifier(name))
+}
+
+func makeRevokeStatement(name string, g grant) string {
+	return fmt.Sprintf("REVOKE %s ON %s FROM %s", g.permission, g.resourceStatement(), cql.QuoteIdentifier(name))
+}
+
+// getRole reads the role from the cluster. It returns nil if the role doesn't exist.
+func getRole(ctx context.Context, session *gocql.Session, name string) (*role, error) {
+	iter := session.Query(listRolesQuery).WithContext(ctx).Iter()
+
+	var found *role
+	for {
+		row := map[string]interface{}{}
+		if !iter.MapScan(row) {
+			break
+		}
+
+		if roleName, _ := row["role"].(string); roleName != name {
+			continue
+		}
+
+		login, _ := row["login"].(bool)
+		superuser, _ := row["super"].(bool)
+		found = &role{
+			login:     login,
+			superuser: superuser,
+		}
+	}
+
+	err := iter.Close()
+	if err != nil {
+		return nil, fmt.Errorf("can't list roles: %w", err)
+	}
+
+	return found, nil
+}
+
+// getGrants reads the permissions of the role on data resources from the cluster.
+func getGrants(ctx context.Context, session *gocql.Session, name string) ([]grant, error) {
+	iter := session.Query(fmt.Sprintf(listPermissionsTemplate, cql.QuoteIdentifier(name))).WithContext(ctx).Iter()
+
+	var grants []grant
+	for {
+		row := map[string]interface{}{}
+		if !iter.MapScan(row) {
+			break
+		}
+
+		resource, _ := row["resource"].(string)
+		permission, _ := row["permission"].(string)
+		keyspace, table, ok := parseDataResource(resource)
+		if !ok {
+			continue
+		}
+
+		grants = append(grants, grant{
+			permission: strings.ToUpper(permission),
+			keyspace:   keyspace,
+			table:      table,
+		})
+	}
+
+	err := iter.Close()
+	if err != nil {
+		return nil, fmt.Errorf("can't list permissions of role %q: %w", name, err)
+	}
+
+	sortGrants(grants)
+
+	return grants, nil
+}
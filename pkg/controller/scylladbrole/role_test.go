@@ -0,0 +1,214 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbrole
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMakeRequiredGrants(t *testing.T) {
+	t.Parallel()
+
+	sr := &scyllav1alpha1.ScyllaDBRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app",
+			Namespace: "scylla",
+		},
+		Spec: scyllav1alpha1.ScyllaDBRoleSpec{
+			Grants: []scyllav1alpha1.RoleGrant{
+				{
+					Keyspace:    "app_data",
+					Table:       "events",
+					Permissions: []scyllav1alpha1.RolePermission{scyllav1alpha1.RolePermissionModify},
+				},
+				{
+					Permissions: []scyllav1alpha1.RolePermission{scyllav1alpha1.RolePermissionSelect},
+				},
+				{
+					Keyspace:    "app_data",
+					Permissions: []scyllav1alpha1.RolePermission{scyllav1alpha1.RolePermissionSelect, scyllav1alpha1.RolePermissionAlter},
+				},
+			},
+		},
+	}
+
+	expected := []grant{
+		{permission: "ALTER", keyspace: "app_data"},
+		{permission: "MODIFY", keyspace: "app_data", table: "events"},
+		{permission: "SELECT", keyspace: ""},
+		{permission: "SELECT", keyspace: "app_data"},
+	}
+
+	got := makeRequiredGrants(sr)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected and got grants differ: %s", cmp.Diff(expected, got, cmp.AllowUnexported(grant{})))
+	}
+}
+
+func TestParseDataResource(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name             string
+		resource         string
+		expectedKeyspace string
+		expectedTable    string
+		expectedOK       bool
+	}{
+		{
+			name:             "all keyspaces",
+			resource:         "<all keyspaces>",
+			expectedKeyspace: "",
+			expectedTable:    "",
+			expectedOK:       true,
+		},
+		{
+			name:             "keyspace",
+			resource:         "<keyspace app_data>",
+			expectedKeyspace: "app_data",
+			expectedTable:    "",
+			expectedOK:       true,
+		},
+		{
+			name:             "table",
+			resource:         "<table app_data.events>",
+			expectedKeyspace: "app_data",
+			expectedTable:    "events",
+			expectedOK:       true,
+		},
+		{
+			name:             "role resources are ignored",
+			resource:         "<role app>",
+			expectedKeyspace: "",
+			expectedTable:    "",
+			expectedOK:       false,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			keyspace, table, ok := parseDataResource(tc.resource)
+			if keyspace != tc.expectedKeyspace || table != tc.expectedTable || ok != tc.expectedOK {
+				t.Errorf("expected (%q, %q, %t), got (%q, %q, %t)", tc.expectedKeyspace, tc.expectedTable, tc.expectedOK, keyspace, table, ok)
+			}
+
+			if ok {
+				g := grant{keyspace: keyspace, table: table}
+				if g.resource() != tc.resource {
+					t.Errorf("expected resource %q to round trip, got %q", tc.resource, g.resource())
+				}
+			}
+		})
+	}
+}
+
+func TestGetGrantsDifference(t *testing.T) {
+	t.Parallel()
+
+	required := []grant{
+		{permission: "SELECT", keyspace: "app_data"},
+		{permission: "MODIFY", keyspace: "app_data", table: "events"},
+	}
+	existing := []grant{
+		{permission: "SELECT", keyspace: "app_data"},
+		{permission: "DROP", keyspace: ""},
+	}
+
+	toGrant, toRevoke := getGrantsDifference(required, existing)
+
+	expectedToGrant := []grant{{permission: "MODIFY", keyspace: "app_data", table: "events"}}
+	if !reflect.DeepEqual(toGrant, expectedToGrant) {
+		t.Errorf("expected and got grants to grant differ: %s", cmp.Diff(expectedToGrant, toGrant, cmp.AllowUnexported(grant{})))
+	}
+
+	expectedToRevoke := []grant{{permission: "DROP", keyspace: ""}}
+	if !reflect.DeepEqual(toRevoke, expectedToRevoke) {
+		t.Errorf("expected and got grants to revoke differ: %s", cmp.Diff(expectedToRevoke, toRevoke, cmp.AllowUnexported(grant{})))
+	}
+}
+
+func TestStatements(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name              string
+		statement         string
+		expectedStatement string
+	}{
+		{
+			name:              "create role",
+			statement:         makeCreateRoleStatement("app", &role{login: true}, "it's-secret"),
+			expectedStatement: `CREATE ROLE IF NOT EXISTS "app" WITH PASSWORD = 'it''s-secret' AND LOGIN = true AND SUPERUSER = false`,
+		},
+		{
+			name:              "grant on table",
+			statement:         makeGrantStatement("app", grant{permission: "MODIFY", keyspace: "app_data", table: "events"}),
+			expectedStatement: `GRANT MODIFY ON TABLE "app_data"."events" TO "app"`,
+		},
+		{
+			name:              "revoke on all keyspaces",
+			statement:         makeRevokeStatement("app", grant{permission: "DROP"}),
+			expectedStatement: `REVOKE DROP ON ALL KEYSPACES FROM "app"`,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if tc.statement != tc.expectedStatement {
+				t.Errorf("expected and got statements differ: %s", cmp.Diff(tc.expectedStatement, tc.statement))
+			}
+		})
+	}
+}
+
+func TestIsRoleOwned(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		status   *scyllav1alpha1.ScyllaDBRoleStatus
+		expected bool
+	}{
+		{
+			name:     "role wasn't synced yet",
+			status:   &scyllav1alpha1.ScyllaDBRoleStatus{},
+			expected: false,
+		},
+		{
+			name: "role was created or adopted",
+			status: &scyllav1alpha1.ScyllaDBRoleStatus{
+				OwnsRole: true,
+			},
+			expected: true,
+		},
+		{
+			name: "role wasn't created or adopted despite an applied password",
+			status: &scyllav1alpha1.ScyllaDBRoleStatus{
+				PasswordSecretResourceVersion: "42",
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := isRoleOwned(tc.status)
+			if got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbrole
+
+import (
+	"context"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+func (src *Controller) calculateStatus(sr *scyllav1alpha1.ScyllaDBRole) *scyllav1alpha1.ScyllaDBRoleStatus {
+	status := sr.Status.DeepCopy()
+	status.ObservedGeneration = pointer.Ptr(sr.Generation)
+	status.RoleName = getRoleName(sr)
+	status.PasswordSecretName = naming.GetScyllaDBRoleCredentialsSecretName(sr.Name)
+
+	return status
+}
+
+func (src *Controller) updateStatus(ctx context.Context, currentSR *scyllav1alpha1.ScyllaDBRole, status *scyllav1alpha1.ScyllaDBRoleStatus) error {
+	if apiequality.Semantic.DeepEqual(&currentSR.Status, status) {
+		return nil
+	}
+
+	sr := currentSR.DeepCopy()
+	sr.Status = *status
+
+	klog.V(2).InfoS("Updating status", "ScyllaDBRole", klog.KObj(sr))
+
+	_, err := src.scyllaClient.ScyllaV1alpha1().ScyllaDBRoles(sr.Namespace).UpdateStatus(ctx, sr, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	klog.V(2).InfoS("Status updated", "ScyllaDBRole", klog.KObj(sr))
+
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbrole
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+func (src *Controller) sync(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		klog.ErrorS(err, "Failed to split meta namespace cache key", "cacheKey", key)
+		return err
+	}
+
+	startTime := time.Now()
+	klog.V(4).InfoS("Started syncing ScyllaDBRole", "ScyllaDBRole", klog.KRef(namespace, name), "startTime", startTime)
+	defer func() {
+		klog.V(4).InfoS("Finished syncing ScyllaDBRole", "ScyllaDBRole", klog.KRef(namespace, name), "duration", time.Since(startTime))
+	}()
+
+	sr, err := src.scyllaDBRoleLister.ScyllaDBRoles(namespace).Get(name)
+	if errors.IsNotFound(err) {
+		klog.V(2).InfoS("ScyllaDBRole has been deleted", "ScyllaDBRole", klog.KRef(namespace, name))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	sdc, err := src.scyllaDBDatacenterLister.ScyllaDBDatacenters(sr.Namespace).Get(sr.Spec.ScyllaDBDatacenterRef.Name)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("can't get ScyllaDBDatacenter %q: %w", naming.ManualRef(sr.Namespace, sr.Spec.ScyllaDBDatacenterRef.Name), err)
+	}
+	if errors.IsNotFound(err) {
+		sdc = nil
+	}
+
+	if sr.DeletionTimestamp != nil {
+		return src.syncFinalizer(ctx, sr, sdc)
+	}
+
+	updated, err := src.ensureFinalizer(ctx, sr)
+	if err != nil || updated {
+		// The update triggers another sync.
+		return err
+	}
+
+	src.queue.AddAfter(key, roleResyncInterval)
+
+	status := src.calculateStatus(sr)
+
+	var errs []error
+
+	var secret *corev1.Secret
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		credentialsSecretControllerProgressingCondition,
+		credentialsSecretControllerDegradedCondition,
+		sr.Generation,
+		func() ([]metav1.Condition, error) {
+			var progressingConditions []metav1.Condition
+			secret, progressingConditions, err = src.syncCredentialsSecret(ctx, sr, status)
+			return progressingConditions, err
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync credentials secret: %w", err))
+	}
+
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		roleControllerProgressingCondition,
+		roleControllerDegradedCondition,
+		sr.Generation,
+		func() ([]metav1.Condition, error) {
+			var progressingConditions []metav1.Condition
+			sr, progressingConditions, err = src.syncRole(ctx, sr, sdc, secret, status)
+			return progressingConditions, err
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync role: %w", err))
+	}
+
+	// Aggregate conditions.
+	err = controllerhelpers.SetAggregatedWorkloadConditions(&status.Conditions, sr.Generation)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't aggregate workload conditions: %w", err))
+	} else {
+		err = src.updateStatus(ctx, sr, status)
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// syncFinalizer drops the role of a deleted ScyllaDBRole and releases the object.
+func (src *Controller) syncFinalizer(ctx context.Context, sr *scyllav1alpha1.ScyllaDBRole, sdc *scyllav1alpha1.ScyllaDBDatacenter) error {
+	if !slices.ContainsItem(sr.Finalizers, naming.ScyllaDBRoleFinalizer) {
+		return nil
+	}
+
+	switch {
+	case !isRoleOwned(&sr.Status):
+		klog.V(2).InfoS("Role isn't owned by the ScyllaDBRole, not dropping it", "ScyllaDBRole", klog.KObj(sr))
+
+	case sdc == nil || sdc.DeletionTimestamp != nil:
+		// The role goes away together with the cluster.
+		klog.V(2).InfoS("ScyllaDBDatacenter is gone, not dropping the role", "ScyllaDBRole", klog.KObj(sr))
+
+	default:
+		err := src.dropRole(ctx, sr, sdc)
+		if err != nil {
+			return err
+		}
+	}
+
+	srCopy := sr.DeepCopy()
+	srCopy.Finalizers = slices.FilterOut(srCopy.Finalizers, func(f string) bool {
+		return f == naming.ScyllaDBRoleFinalizer
+	})
+	_, err := src.scyllaClient.ScyllaV1alpha1().ScyllaDBRoles(srCopy.Namespace).Update(ctx, srCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("can't remove finalizer from ScyllaDBRole %q: %w", naming.ObjRef(sr), err)
+	}
+
+	return nil
+}
+
+// ensureFinalizer adds a finalizer making sure the role is dropped when the ScyllaDBRole is deleted.
+// It returns true if the ScyllaDBRole was updated.
+func (src *Controller) ensureFinalizer(ctx context.Context, sr *scyllav1alpha1.ScyllaDBRole) (bool, error) {
+	if slices.ContainsItem(sr.Finalizers, naming.ScyllaDBRoleFinalizer) {
+		return false, nil
+	}
+
+	srCopy := sr.DeepCopy()
+	srCopy.Finalizers = append(srCopy.Finalizers, naming.ScyllaDBRoleFinalizer)
+	_, err := src.scyllaClient.ScyllaV1alpha1().ScyllaDBRoles(srCopy.Namespace).Update(ctx, srCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("can't add finalizer to ScyllaDBRole %q: %w", naming.ObjRef(sr), err)
+	}
+
+	return true, nil
+}
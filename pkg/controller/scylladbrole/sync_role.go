@@ -0,0 +1,273 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scylladbrole
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gocql/gocql"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+func makeProgressingCondition(sr *scyllav1alpha1.ScyllaDBRole, conditionType, reason, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: sr.Generation,
+	}
+}
+
+// isRoleOwned returns whether the role is managed by the ScyllaDBRole.
+func isRoleOwned(status *scyllav1alpha1.ScyllaDBRoleStatus) bool {
+	return status.OwnsRole
+}
+
+// persistRoleOwnership records the ownership of the role in the status before the role is created,
+// so a role created by the ScyllaDBRole is never mistaken for a foreign one when a later status update fails.
+func (src *Controller) persistRoleOwnership(ctx context.Context, sr *scyllav1alpha1.ScyllaDBRole) (*scyllav1alpha1.ScyllaDBRole, error) {
+	srCopy := sr.DeepCopy()
+	srCopy.Status.OwnsRole = true
+	updated, err := src.scyllaClient.ScyllaV1alpha1().ScyllaDBRoles(srCopy.Namespace).UpdateStatus(ctx, srCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return sr, fmt.Errorf("can't record ownership of the role in the status of ScyllaDBRole %q: %w", naming.ObjRef(sr), err)
+	}
+
+	return updated, nil
+}
+
+// syncCredentialsSecret makes sure the Secret holding the credentials of the role exists and rotates the password
+// when requested.
+func (src *Controller) syncCredentialsSecret(ctx context.Context, sr *scyllav1alpha1.ScyllaDBRole, status *scyllav1alpha1.ScyllaDBRoleStatus) (*corev1.Secret, []metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	secretName := naming.GetScyllaDBRoleCredentialsSecretName(sr.Name)
+	existingSecret, err := src.secretLister.Secrets(sr.Namespace).Get(secretName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, progressingConditions, fmt.Errorf("can't get Secret %q: %w", naming.ManualRef(sr.Namespace, secretName), err)
+	}
+	if apierrors.IsNotFound(err) {
+		existingSecret = nil
+	}
+
+	rotate := existingSecret != nil && sr.Spec.PasswordRotationReason != status.PasswordRotationReason
+
+	secret, _, err := resourceapply.ApplySecret(ctx, src.kubeClient.CoreV1(), src.secretLister, src.eventRecorder, makeCredentialsSecret(sr, existingSecret, rotate), resourceapply.ApplyOptions{})
+	if err != nil {
+		return nil, progressingConditions, fmt.Errorf("can't apply Secret %q: %w", naming.ManualRef(sr.Namespace, secretName), err)
+	}
+
+	if rotate {
+		klog.V(2).InfoS("Rotated password", "ScyllaDBRole", klog.KObj(sr), "Reason", sr.Spec.PasswordRotationReason)
+		src.eventRecorder.Eventf(sr, corev1.EventTypeNormal, "PasswordRotated", "Rotated password of role %q", getRoleName(sr))
+		status.LastPasswordRotationTime = pointer.Ptr(metav1.Now())
+	}
+	status.PasswordRotationReason = sr.Spec.PasswordRotationReason
+
+	return secret, progressingConditions, nil
+}
+
+func (src *Controller) newSession(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter) (*gocql.Session, error) {
+	hosts, err := controllerhelpers.GetScyllaDBDatacenterHosts(sdc, src.serviceLister, src.podLister)
+	if err != nil {
+		return nil, fmt.Errorf("can't get hosts of ScyllaDBDatacenter %q: %w", naming.ObjRef(sdc), err)
+	}
+
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("ScyllaDBDatacenter %q has no hosts", naming.ObjRef(sdc))
+	}
+
+	return controllerhelpers.NewScyllaDBDatacenterCQLSession(ctx, src.kubeClient, sdc, hosts)
+}
+
+func (src *Controller) dropRole(ctx context.Context, sr *scyllav1alpha1.ScyllaDBRole, sdc *scyllav1alpha1.ScyllaDBDatacenter) error {
+	session, err := src.newSession(ctx, sdc)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	name := getRoleName(sr)
+	err = session.Query(makeDropRoleStatement(name)).WithContext(ctx).Exec()
+	if err != nil {
+		return fmt.Errorf("can't drop role %q: %w", name, err)
+	}
+
+	klog.V(2).InfoS("Dropped role", "ScyllaDBRole", klog.KObj(sr), "Role", name)
+	src.eventRecorder.Eventf(sr, corev1.EventTypeNormal, "RoleDropped", "Dropped role %q", name)
+
+	return nil
+}
+
+// syncRole creates the role or alters it to match the spec, applies the password from the credentials Secret
+// and grants or revokes permissions.
+// It returns the ScyllaDBRole, which is updated when the ownership of the role has been recorded.
+func (src *Controller) syncRole(
+	ctx context.Context,
+	sr *scyllav1alpha1.ScyllaDBRole,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	secret *corev1.Secret,
+	status *scyllav1alpha1.ScyllaDBRoleStatus,
+) (*scyllav1alpha1.ScyllaDBRole, []metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if sdc == nil {
+		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               roleAvailableCondition,
+			Status:             metav1.ConditionFalse,
+			Reason:             "WaitingForScyllaDBDatacenter",
+			ObservedGeneration: sr.Generation,
+		})
+		progressingConditions = append(progressingConditions, makeProgressingCondition(
+			sr,
+			roleControllerProgressingCondition,
+			"WaitingForScyllaDBDatacenter",
+			fmt.Sprintf("Waiting for ScyllaDBDatacenter %q to exist.", naming.ManualRef(sr.Namespace, sr.Spec.ScyllaDBDatacenterRef.Name)),
+		))
+		return sr, progressingConditions, nil
+	}
+
+	if secret == nil {
+		progressingConditions = append(progressingConditions, makeProgressingCondition(
+			sr,
+			roleControllerProgressingCondition,
+			"WaitingForCredentialsSecret",
+			fmt.Sprintf("Waiting for Secret %q to be applied.", naming.ManualRef(sr.Namespace, naming.GetScyllaDBRoleCredentialsSecretName(sr.Name))),
+		))
+		return sr, progressingConditions, nil
+	}
+
+	password := string(secret.Data[naming.ScyllaDBRolePasswordSecretKey])
+	if len(password) == 0 {
+		return sr, progressingConditions, fmt.Errorf("secret %q is missing key %q", naming.ObjRef(secret), naming.ScyllaDBRolePasswordSecretKey)
+	}
+
+	session, err := src.newSession(ctx, sdc)
+	if err != nil {
+		return sr, progressingConditions, err
+	}
+	defer session.Close()
+
+	name := getRoleName(sr)
+	required := makeRequiredRole(sr)
+
+	existing, err := getRole(ctx, session, name)
+	if err != nil {
+		return sr, progressingConditions, err
+	}
+
+	switch {
+	case existing == nil:
+		if !isRoleOwned(status) {
+			sr, err = src.persistRoleOwnership(ctx, sr)
+			if err != nil {
+				return sr, progressingConditions, err
+			}
+			status.OwnsRole = true
+		}
+
+		err = session.Query(makeCreateRoleStatement(name, required, password)).WithContext(ctx).Exec()
+		if err != nil {
+			return sr, progressingConditions, fmt.Errorf("can't create role %q: %w", name, err)
+		}
+
+		klog.V(2).InfoS("Created role", "ScyllaDBRole", klog.KObj(sr), "Role", name)
+		src.eventRecorder.Eventf(sr, corev1.EventTypeNormal, "RoleCreated", "Created role %q", name)
+
+	case !isRoleOwned(status) && !sr.Spec.AdoptExistingRole:
+		message := fmt.Sprintf("Role %q already exists and wasn't created by this ScyllaDBRole. Set spec.adoptExistingRole to take it over.", name)
+		src.eventRecorder.Event(sr, corev1.EventTypeWarning, "RoleAlreadyExists", message)
+		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               roleAvailableCondition,
+			Status:             metav1.ConditionFalse,
+			Reason:             "RoleAlreadyExists",
+			Message:            message,
+			ObservedGeneration: sr.Generation,
+		})
+		return sr, progressingConditions, nil
+
+	default:
+		if !isRoleOwned(status) {
+			klog.V(2).InfoS("Adopting role", "ScyllaDBRole", klog.KObj(sr), "Role", name)
+			src.eventRecorder.Eventf(sr, corev1.EventTypeNormal, "RoleAdopted", "Adopted existing role %q", name)
+			status.OwnsRole = true
+			// The password of an adopted role is unknown.
+			status.PasswordSecretResourceVersion = ""
+		}
+
+		if *existing != *required {
+			err = session.Query(makeAlterRoleStatement(name, required)).WithContext(ctx).Exec()
+			if err != nil {
+				return sr, progressingConditions, fmt.Errorf("can't alter role %q: %w", name, err)
+			}
+
+			klog.V(2).InfoS("Altered role", "ScyllaDBRole", klog.KObj(sr), "Role", name)
+			src.eventRecorder.Eventf(sr, corev1.EventTypeNormal, "RoleAltered", "Altered role %q", name)
+		}
+
+		if status.PasswordSecretResourceVersion != secret.ResourceVersion {
+			err = session.Query(makeAlterRolePasswordStatement(name, password)).WithContext(ctx).Exec()
+			if err != nil {
+				return sr, progressingConditions, fmt.Errorf("can't set password of role %q: %w", name, err)
+			}
+
+			klog.V(2).InfoS("Set role password", "ScyllaDBRole", klog.KObj(sr), "Role", name, "Secret", klog.KObj(secret))
+		}
+	}
+	status.PasswordSecretResourceVersion = secret.ResourceVersion
+
+	requiredGrants := makeRequiredGrants(sr)
+	existingGrants, err := getGrants(ctx, session, name)
+	if err != nil {
+		return sr, progressingConditions, err
+	}
+
+	toGrant, toRevoke := getGrantsDifference(requiredGrants, existingGrants)
+	for _, g := range toGrant {
+		err = session.Query(makeGrantStatement(name, g)).WithContext(ctx).Exec()
+		if err != nil {
+			return sr, progressingConditions, fmt.Errorf("can't grant %q to role %q: %w", g, name, err)
+		}
+	}
+	for _, g := range toRevoke {
+		err = session.Query(makeRevokeStatement(name, g)).WithContext(ctx).Exec()
+		if err != nil {
+			return sr, progressingConditions, fmt.Errorf("can't revoke %q from role %q: %w", g, name, err)
+		}
+	}
+	if len(toGrant) != 0 || len(toRevoke) != 0 {
+		klog.V(2).InfoS("Updated role permissions", "ScyllaDBRole", klog.KObj(sr), "Role", name, "Granted", len(toGrant), "Revoked", len(toRevoke))
+		src.eventRecorder.Eventf(sr, corev1.EventTypeNormal, "PermissionsUpdated", "Granted %d and revoked %d permission(s) of role %q", len(toGrant), len(toRevoke), name)
+
+		existingGrants, err = getGrants(ctx, session, name)
+		if err != nil {
+			return sr, progressingConditions, err
+		}
+	}
+
+	status.GrantedPermissions = slices.ConvertSlice(existingGrants, func(g grant) string {
+		return g.String()
+	})
+
+	apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               roleAvailableCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             internalapi.AsExpectedReason,
+		ObservedGeneration: sr.Generation,
+	})
+
+	return sr, progressingConditions, nil
+}
@@ -0,0 +1,94 @@
+package controllerhelpers
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	okubecrypto "github.com/scylladb/scylla-operator/pkg/kubecrypto"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	cqlConnectTimeout = 10 * time.Second
+	cqlQueryTimeout   = 30 * time.Second
+)
+
+// GetScyllaDBDatacenterCQLTLSConfig returns a TLS config authenticating as the admin user using the client certificate
+// and the serving CA managed by the operator for the ScyllaDBDatacenter.
+func GetScyllaDBDatacenterCQLTLSConfig(ctx context.Context, kubeClient kubernetes.Interface, sdc *scyllav1alpha1.ScyllaDBDatacenter) (*tls.Config, error) {
+	clientCertSecretName := naming.GetScyllaClusterLocalUserAdminCertName(sdc.Name)
+	clientCertSecret, err := kubeClient.CoreV1().Secrets(sdc.Namespace).Get(ctx, clientCertSecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("can't get admin client certificate Secret %q: %w", naming.ManualRef(sdc.Namespace, clientCertSecretName), err)
+	}
+
+	certBytes, keyBytes, err := okubecrypto.GetCertKeyDataFromSecret(clientCertSecret)
+	if err != nil {
+		return nil, fmt.Errorf("can't get cert and key bytes from Secret %q: %w", naming.ObjRef(clientCertSecret), err)
+	}
+
+	clientCert, err := tls.X509KeyPair(certBytes, keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse client certificate from Secret %q: %w", naming.ObjRef(clientCertSecret), err)
+	}
+
+	servingCAConfigMapName := naming.GetScyllaClusterLocalServingCAName(sdc.Name)
+	servingCAConfigMap, err := kubeClient.CoreV1().ConfigMaps(sdc.Namespace).Get(ctx, servingCAConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("can't get serving CA ConfigMap %q: %w", naming.ManualRef(sdc.Namespace, servingCAConfigMapName), err)
+	}
+
+	servingCABytes, err := okubecrypto.GetCABundleDataFromConfigMap(servingCAConfigMap)
+	if err != nil {
+		return nil, fmt.Errorf("can't get ca bundle bytes from ConfigMap %q: %w", naming.ObjRef(servingCAConfigMap), err)
+	}
+
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(servingCABytes) {
+		return nil, fmt.Errorf("can't parse ca bundle from ConfigMap %q", naming.ObjRef(servingCAConfigMap))
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{clientCert},
+		RootCAs:      rootCAs,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// NewScyllaDBDatacenterCQLSession opens an admin CQL session to the given nodes of the ScyllaDBDatacenter.
+// Callers are responsible for closing the session.
+func NewScyllaDBDatacenterCQLSession(ctx context.Context, kubeClient kubernetes.Interface, sdc *scyllav1alpha1.ScyllaDBDatacenter, hosts []string) (*gocql.Session, error) {
+	tlsConfig, err := GetScyllaDBDatacenterCQLTLSConfig(ctx, kubeClient, sdc)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := gocql.NewCluster(hosts...)
+	cluster.Port = naming.ScyllaCQLSSLPort
+	cluster.ConnectTimeout = cqlConnectTimeout
+	cluster.Timeout = cqlQueryTimeout
+	cluster.Consistency = gocql.Quorum
+	cluster.DisableInitialHostLookup = true
+	cluster.SslOpts = &gocql.SslOptions{
+		Config:                 tlsConfig,
+		EnableHostVerification: true,
+	}
+	cluster.Authenticator = gocql.PasswordAuthenticator{
+		Username: "cassandra",
+		Password: "cassandra",
+	}
+
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return nil, fmt.Errorf("can't create CQL session to hosts %q: %w", hosts, err)
+	}
+
+	return session, nil
+}
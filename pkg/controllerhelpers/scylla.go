@@ -115,6 +115,21 @@ func GetRequiredScyllaHosts(sdc *scyllav1alpha1.ScyllaDBDatacenter, services map
 	return hosts, nil
 }
 
+// GetScyllaDBDatacenterHosts returns the addresses of all nodes of the ScyllaDBDatacenter using the cached Services and Pods.
+func GetScyllaDBDatacenterHosts(sdc *scyllav1alpha1.ScyllaDBDatacenter, serviceLister corev1listers.ServiceLister, podLister corev1listers.PodLister) ([]string, error) {
+	services, err := serviceLister.Services(sdc.Namespace).List(naming.ClusterSelector(sdc))
+	if err != nil {
+		return nil, fmt.Errorf("can't list Services of ScyllaDBDatacenter %q: %w", naming.ObjRef(sdc), err)
+	}
+
+	serviceMap := make(map[string]*corev1.Service, len(services))
+	for _, svc := range services {
+		serviceMap[svc.Name] = svc
+	}
+
+	return GetRequiredScyllaHosts(sdc, serviceMap, podLister)
+}
+
 func NewScyllaClient(cfg *scyllaclient.Config) (*scyllaclient.Client, error) {
 	scyllaClient, err := scyllaclient.NewClient(cfg)
 	if err != nil {
@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
+	"time"
 )
 
 type UpgradePhase string
@@ -16,6 +18,7 @@ const (
 	RolloutInitUpgradePhase UpgradePhase = "RolloutInit"
 	RolloutRunUpgradePhase  UpgradePhase = "RolloutRun"
 	PostHooksUpgradePhase   UpgradePhase = "PostHooks"
+	RollbackUpgradePhase    UpgradePhase = "Rollback"
 )
 
 type DatacenterUpgradeContext struct {
@@ -24,6 +27,85 @@ type DatacenterUpgradeContext struct {
 	ToVersion         string       `json:"toVersion"`
 	SystemSnapshotTag string       `json:"systemSnapshotTag"`
 	DataSnapshotTag   string       `json:"dataSnapshotTag"`
+	// FromImage is the ScyllaDB image the upgrade started from. It's missing for upgrades started by older versions.
+	FromImage string `json:"fromImage,omitempty"`
+	// ToImage is the ScyllaDB image being upgraded to. It's missing for upgrades started by older versions.
+	ToImage string `json:"toImage,omitempty"`
+	// Plan describes how the upgrade is carried out. It's missing for upgrades started by older versions.
+	Plan *UpgradePlan `json:"plan,omitempty"`
+	// Rollback describes why the upgrade is being rolled back. It's only set in the Rollback phase.
+	Rollback *UpgradeRollback `json:"rollback,omitempty"`
+}
+
+type UpgradeRollback struct {
+	Reason      string    `json:"reason"`
+	Message     string    `json:"message"`
+	FailedNodes []string  `json:"failedNodes,omitempty"`
+	StartTime   time.Time `json:"startTime"`
+	// RestoreSystemSnapshot specifies whether system keyspaces are restored from the pre-upgrade snapshot.
+	RestoreSystemSnapshot bool `json:"restoreSystemSnapshot"`
+}
+
+type UpgradeCheckName string
+
+const (
+	UpgradePathUpgradeCheck      UpgradeCheckName = "UpgradePath"
+	SchemaAgreementUpgradeCheck  UpgradeCheckName = "SchemaAgreement"
+	NodesUpUpgradeCheck          UpgradeCheckName = "NodesUpAndNormal"
+	NoRunningRepairsUpgradeCheck UpgradeCheckName = "NoRunningRepairs"
+)
+
+// UpgradeCheck is a result of a compatibility check run before the upgrade.
+type UpgradeCheck struct {
+	Name    UpgradeCheckName `json:"name"`
+	Passed  bool             `json:"passed"`
+	Message string           `json:"message,omitempty"`
+}
+
+// UpgradeRackStep describes the order in which nodes of a rack are upgraded.
+type UpgradeRackStep struct {
+	Rack  string   `json:"rack"`
+	Nodes []string `json:"nodes"`
+}
+
+type UpgradePlan struct {
+	// Checks hold results of the last run of pre-upgrade checks.
+	Checks []UpgradeCheck `json:"checks,omitempty"`
+	// Steps hold racks in the order they are upgraded.
+	Steps []UpgradeRackStep `json:"steps"`
+}
+
+// Render returns a human-readable description of the upgrade plan.
+func (uc *DatacenterUpgradeContext) Render() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Upgrade from %q to %q.\n", uc.FromVersion, uc.ToVersion)
+	if uc.Plan == nil {
+		return sb.String()
+	}
+
+	if len(uc.Plan.Checks) != 0 {
+		sb.WriteString("\nPre-upgrade checks:\n")
+		for _, c := range uc.Plan.Checks {
+			result := "passed"
+			if !c.Passed {
+				result = "failed"
+			}
+
+			fmt.Fprintf(&sb, "  - %s: %s", c.Name, result)
+			if len(c.Message) != 0 {
+				fmt.Fprintf(&sb, " (%s)", c.Message)
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString("\nRollout order:\n")
+	for i, step := range uc.Plan.Steps {
+		fmt.Fprintf(&sb, "  %d. rack %q: %s\n", i+1, step.Rack, strings.Join(step.Nodes, ", "))
+	}
+
+	return sb.String()
 }
 
 func (uc *DatacenterUpgradeContext) Decode(reader io.Reader) error {
@@ -0,0 +1,94 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package maintenancewindow
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+)
+
+// cronParser parses cron expressions with the same syntax as the cron field of scheduled ScyllaDB Manager tasks.
+var cronParser = cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ParseSchedule parses the schedule of a maintenance window in its timezone.
+func ParseSchedule(mw *scyllav1alpha1.MaintenanceWindow) (cron.Schedule, *time.Location, error) {
+	if strings.Contains(mw.Cron, "TZ") {
+		return nil, nil, fmt.Errorf("can't use TZ or CRON_TZ in cron, use timezone instead")
+	}
+
+	if strings.HasPrefix(strings.TrimSpace(mw.Cron), "@every") {
+		return nil, nil, fmt.Errorf("@every isn't supported, maintenance windows have to open at fixed times")
+	}
+
+	schedule, err := cronParser.Parse(mw.Cron)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't parse cron %q: %w", mw.Cron, err)
+	}
+
+	location := time.UTC
+	if mw.Timezone != nil {
+		location, err = time.LoadLocation(*mw.Timezone)
+		if err != nil {
+			return nil, nil, fmt.Errorf("can't load timezone %q: %w", *mw.Timezone, err)
+		}
+	}
+
+	return schedule, location, nil
+}
+
+// State describes whether disruptive operations can start at a given time.
+type State struct {
+	// Open is true when the time is within a maintenance window, or when there are no maintenance windows.
+	Open bool
+	// NextOpen is the time the next maintenance window opens. It's only set when Open is false.
+	NextOpen time.Time
+}
+
+// GetState returns the state of the maintenance windows at the given time.
+func GetState(windows []scyllav1alpha1.MaintenanceWindow, now time.Time) (State, error) {
+	if len(windows) == 0 {
+		return State{Open: true}, nil
+	}
+
+	var nextOpen time.Time
+	for i := range windows {
+		mw := &windows[i]
+
+		schedule, location, err := ParseSchedule(mw)
+		if err != nil {
+			return State{}, fmt.Errorf("invalid maintenance window %d: %w", i, err)
+		}
+
+		// The first activation after the window duration ago is within the window when it isn't in the future.
+		start := schedule.Next(now.Add(-mw.Duration.Duration).In(location))
+		if start.IsZero() {
+			continue
+		}
+
+		if !start.After(now) {
+			return State{Open: true}, nil
+		}
+
+		if nextOpen.IsZero() || start.Before(nextOpen) {
+			nextOpen = start
+		}
+	}
+
+	return State{
+		Open:     false,
+		NextOpen: nextOpen,
+	}, nil
+}
+
+// PendingMessage returns a message explaining that the action waits for the next maintenance window.
+func (s State) PendingMessage(action string) string {
+	if s.NextOpen.IsZero() {
+		return fmt.Sprintf("%s is pending until a maintenance window opens.", action)
+	}
+
+	return fmt.Sprintf("%s is pending until the next maintenance window opens at %s.", action, s.NextOpen.UTC().Format(time.RFC3339))
+}
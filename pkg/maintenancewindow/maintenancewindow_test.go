@@ -0,0 +1,119 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package maintenancewindow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetState(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 5, 14, 10, 30, 0, 0, time.UTC)
+
+	tt := []struct {
+		name          string
+		windows       []scyllav1alpha1.MaintenanceWindow
+		expected      State
+		expectedError bool
+	}{
+		{
+			name:     "no maintenance windows",
+			windows:  nil,
+			expected: State{Open: true},
+		},
+		{
+			name: "within a window",
+			windows: []scyllav1alpha1.MaintenanceWindow{
+				{
+					Cron:     "0 10 * * *",
+					Duration: metav1.Duration{Duration: time.Hour},
+				},
+			},
+			expected: State{Open: true},
+		},
+		{
+			name: "after a window closed",
+			windows: []scyllav1alpha1.MaintenanceWindow{
+				{
+					Cron:     "0 9 * * *",
+					Duration: metav1.Duration{Duration: time.Hour},
+				},
+			},
+			expected: State{
+				Open:     false,
+				NextOpen: time.Date(2024, 5, 15, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "earliest of multiple windows opens next",
+			windows: []scyllav1alpha1.MaintenanceWindow{
+				{
+					Cron:     "0 22 * * *",
+					Duration: metav1.Duration{Duration: time.Hour},
+				},
+				{
+					Cron:     "0 12 * * *",
+					Duration: metav1.Duration{Duration: time.Hour},
+				},
+			},
+			expected: State{
+				Open:     false,
+				NextOpen: time.Date(2024, 5, 14, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "window in a timezone",
+			windows: []scyllav1alpha1.MaintenanceWindow{
+				{
+					Cron:     "0 12 * * *",
+					Timezone: pointer.Ptr("Europe/Warsaw"),
+					Duration: metav1.Duration{Duration: time.Hour},
+				},
+			},
+			expected: State{Open: true},
+		},
+		{
+			name: "invalid cron",
+			windows: []scyllav1alpha1.MaintenanceWindow{
+				{
+					Cron:     "invalid",
+					Duration: metav1.Duration{Duration: time.Hour},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "@every cron",
+			windows: []scyllav1alpha1.MaintenanceWindow{
+				{
+					Cron:     "@every 1h",
+					Duration: metav1.Duration{Duration: time.Hour},
+				},
+			},
+			expectedError: true,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := GetState(tc.windows, now)
+			if (err != nil) != tc.expectedError {
+				t.Fatalf("expected error %t, got %v", tc.expectedError, err)
+			}
+
+			if !got.NextOpen.Equal(tc.expected.NextOpen) || got.Open != tc.expected.Open {
+				t.Errorf("expected and got state differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
@@ -35,6 +35,13 @@ const (
 	// HostIDAnnotation reflects the host_id of the scylla node.
 	HostIDAnnotation = "internal.scylla-operator.scylladb.com/host-id"
 
+	// RestoreSystemSnapshotAnnotation requests the node to restore system keyspaces from the snapshot with the tag
+	// before ScyllaDB starts.
+	RestoreSystemSnapshotAnnotation = "internal.scylla-operator.scylladb.com/restore-system-snapshot"
+
+	// RestoredSystemSnapshotAnnotation holds the tag of the snapshot system keyspaces of the node were restored from.
+	RestoredSystemSnapshotAnnotation = "internal.scylla-operator.scylladb.com/restored-system-snapshot"
+
 	// CurrentTokenRingHashAnnotation reflects the current hash of token ring of the scylla node.
 	CurrentTokenRingHashAnnotation = "internal.scylla-operator.scylladb.com/current-token-ring-hash"
 
@@ -92,6 +99,10 @@ const (
 	NodeJobLabel                 = "scylla-operator.scylladb.com/node-job"
 	NodeJobTypeLabel             = "scylla-operator.scylladb.com/node-job-type"
 
+	ParentClusterNameLabel           = "scylla-operator.scylladb.com/parent-scylladbcluster-name"
+	ParentClusterNamespaceLabel      = "scylla-operator.scylladb.com/parent-scylladbcluster-namespace"
+	ParentClusterDatacenterNameLabel = "scylla-operator.scylladb.com/parent-scylladbcluster-datacenter-name"
+
 	AppName           = "scylla"
 	OperatorAppName   = "scylla-operator"
 	ManagerAppName    = "scylla-manager"
@@ -101,13 +112,48 @@ const (
 	PrometheusPortAnnotation   = "prometheus.io/port"
 
 	ForceRedeploymentReasonAnnotation = "scylla-operator.scylladb.com/force-redeployment-reason"
+	RollbackUpgradeAnnotation         = "scylla-operator.scylladb.com/rollback-upgrade"
+	PauseRolloutAnnotation            = "scylla-operator.scylladb.com/pause-rollout"
+	AbortRolloutAnnotation            = "scylla-operator.scylladb.com/abort-rollout"
+	PauseStorageMigrationAnnotation   = "scylla-operator.scylladb.com/pause-storage-migration"
+	AbortStorageMigrationAnnotation   = "scylla-operator.scylladb.com/abort-storage-migration"
+	CQLReadinessCheckAnnotation       = "scylla-operator.scylladb.com/cql-readiness-check"
+	ApproveReplacementAnnotation      = "scylla-operator.scylladb.com/approve-replacement"
 	InputsHashAnnotation              = "scylla-operator.scylladb.com/inputs-hash"
+	// NodeDownSinceAnnotation records since when a node being removed is reported as down by the other nodes.
+	NodeDownSinceAnnotation = "scylla-operator.scylladb.com/node-down-since"
+	// CustomConfigBaselineHashAnnotation records the hash of the custom config that isn't a part of the inputs hash.
+	CustomConfigBaselineHashAnnotation = "scylla-operator.scylladb.com/custom-config-baseline-hash"
+	// ManagedAgentConfigHashAnnotation records the hash of the typed agent configuration rendered into the managed agent config Secret.
+	ManagedAgentConfigHashAnnotation = "scylla-operator.scylladb.com/managed-agent-config-hash"
 )
 
 const (
 	NodeConfigJobForNodeKey = "scylla-operator.scylladb.com/node-config-job-for-node"
 )
 
+const (
+	// ScyllaDBClusterFinalizer keeps a ScyllaDBCluster around until the ScyllaDBDatacenters in remote Kubernetes clusters are removed.
+	ScyllaDBClusterFinalizer = "scylla-operator.scylladb.com/scylladbcluster-protection"
+
+	// ScyllaDBKeyspaceFinalizer keeps a ScyllaDBKeyspace around until its keyspace is dropped.
+	ScyllaDBKeyspaceFinalizer = "scylla-operator.scylladb.com/scylladbkeyspace-protection"
+
+	// ScyllaDBRoleFinalizer keeps a ScyllaDBRole around until its role is dropped.
+	ScyllaDBRoleFinalizer = "scylla-operator.scylladb.com/scylladbrole-protection"
+
+	// ScyllaDBRepairFinalizer keeps a ScyllaDBRepair around until its Scylla Manager task is stopped and deleted.
+	ScyllaDBRepairFinalizer = "scylla-operator.scylladb.com/scylladbrepair-protection"
+
+	// ScyllaDBBackupFinalizer keeps a ScyllaDBBackup around until its Scylla Manager task is stopped and deleted.
+	ScyllaDBBackupFinalizer = "scylla-operator.scylladb.com/scylladbbackup-protection"
+
+	ScyllaDBRoleUsernameSecretKey = "username"
+	ScyllaDBRolePasswordSecretKey = "password"
+
+	KubeconfigSecretKey = "kubeconfig"
+)
+
 // Configuration Values
 const (
 	ScyllaContainerName          = "scylla"
@@ -125,11 +171,18 @@ const (
 	ScyllaAgentConfigFileName    = "scylla-manager-agent.yaml"
 	ScyllaAgentAuthTokenFileName = "auth-token.yaml"
 	ScyllaAgentConfigDefaultFile = "/etc/scylla-manager-agent/scylla-manager-agent.yaml"
+	ScyllaAgentManagedConfigDir  = "/var/run/secrets/scylla-operator.scylladb.com/scylla-manager-agent/managed-config"
+	ScyllaAgentManagedConfigName = "scylla-manager-agent-managed.yaml"
+	ScyllaAgentGCSServiceAccount = "gcs-service-account.json"
 	ScyllaClientConfigDirName    = "/mnt/scylla-client-config"
 	ScyllaDBManagedConfigDir     = "/var/run/configmaps/scylla-operator.scylladb.com/scylladb/managed-config"
 	ScyllaConfigName             = "scylla.yaml"
 	ScyllaDBManagedConfigName    = "scylladb-managed-config.yaml"
 	ScyllaManagedConfigPath      = ScyllaDBManagedConfigDir + "/" + ScyllaDBManagedConfigName
+	ScyllaDBInternodeCertsDir    = "/var/run/secrets/scylla-operator.scylladb.com/scylladb/internode-certs"
+	ScyllaDBInternodeCADir       = "/var/run/configmaps/scylla-operator.scylladb.com/scylladb/internode-ca"
+	ScyllaDBServingCADir         = "/var/run/configmaps/scylla-operator.scylladb.com/scylladb/serving-ca"
+	ScyllaDBUserAdminDir         = "/var/run/secrets/scylla-operator.scylladb.com/scylladb/user-admin"
 	ScyllaRackDCPropertiesName   = "cassandra-rackdc.properties"
 	ScyllaIOPropertiesName       = "io_properties.yaml"
 
@@ -142,6 +195,7 @@ const (
 	ScyllaDBAPIStatusProbePort = 8080
 	ScyllaDBIgnitionProbePort  = 42081
 	ScyllaAPIPort              = 10000
+	ScyllaCQLSSLPort           = 9142
 
 	OperatorEnvVarPrefix = "SCYLLA_OPERATOR_"
 )
@@ -197,4 +251,5 @@ const (
 
 const (
 	UpgradeContextConfigMapKey = "upgrade-context.json"
+	UpgradePlanConfigMapKey    = "upgrade-plan.txt"
 )
@@ -45,6 +45,10 @@ func AgentAuthTokenSecretName(sdc *scyllav1alpha1.ScyllaDBDatacenter) string {
 	return fmt.Sprintf("%s-auth-token", sdc.Name)
 }
 
+func ManagedAgentConfigSecretName(sdc *scyllav1alpha1.ScyllaDBDatacenter) string {
+	return fmt.Sprintf("%s-managed-agent-config", sdc.Name)
+}
+
 func AgentAuthTokenSecretNameForScyllaCluster(sc *scyllav1.ScyllaCluster) string {
 	return AgentAuthTokenSecretName(&scyllav1alpha1.ScyllaDBDatacenter{
 		ObjectMeta: metav1.ObjectMeta{
@@ -61,6 +65,10 @@ func MemberServiceNameForScyllaCluster(r scyllav1.RackSpec, sc *scyllav1.ScyllaC
 	return fmt.Sprintf("%s-%d", StatefulSetNameForRackForScyllaCluster(r, sc), idx)
 }
 
+func ScyllaDBDatacenterNameForScyllaDBCluster(sc *scyllav1alpha1.ScyllaDBCluster, dcName string) string {
+	return fmt.Sprintf("%s-%s", sc.Name, dcName)
+}
+
 func IdentityServiceName(sdc *scyllav1alpha1.ScyllaDBDatacenter) string {
 	return fmt.Sprintf("%s-client", sdc.Name)
 }
@@ -85,6 +93,10 @@ func ManagerClusterName(sc *scyllav1.ScyllaCluster) string {
 	return sc.Namespace + "/" + sc.Name
 }
 
+func BackupInventoryNameForScyllaCluster(sc *scyllav1.ScyllaCluster) string {
+	return sc.Name
+}
+
 func PVCNameForPod(podName string) string {
 	return fmt.Sprintf("%s-%s", PVCTemplateName, podName)
 }
@@ -216,6 +228,14 @@ func GetScyllaClusterAlternatorLocalServingCertName(scName string) string {
 	return fmt.Sprintf("%s-alternator-local-serving-certs", scName)
 }
 
+func GetScyllaClusterLocalInternodeCAName(scName string) string {
+	return fmt.Sprintf("%s-local-internode-ca", scName)
+}
+
+func GetScyllaDBNodeInternodeCertName(nodeName string) string {
+	return fmt.Sprintf("%s-internode-certs", nodeName)
+}
+
 func GetProtocolSubDomain(protocol, domain string) string {
 	return fmt.Sprintf("%s.%s", protocol, domain)
 }
@@ -246,3 +266,7 @@ func GetScyllaDBDatacenterGossipDatacenterName(sdc *scyllav1alpha1.ScyllaDBDatac
 func UpgradeContextConfigMapName(sdc *scyllav1alpha1.ScyllaDBDatacenter) string {
 	return fmt.Sprintf("%s-upgrade-context", sdc.Name)
 }
+
+func GetScyllaDBRoleCredentialsSecretName(roleName string) string {
+	return fmt.Sprintf("%s-credentials", roleName)
+}
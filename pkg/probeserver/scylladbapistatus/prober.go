@@ -2,12 +2,16 @@ package scylladbapistatus
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
 	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/util/cql"
 	corev1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
 )
@@ -16,23 +20,41 @@ const (
 	localhost = "localhost"
 )
 
+// CQLReadinessCheck configures a readiness check connecting to the node over CQL.
+// Files are read on every check, so rotated certificates are picked up.
+type CQLReadinessCheck struct {
+	Address string
+
+	// TLSCAFile enables TLS when set. The serving certificate is verified against it.
+	TLSCAFile     string
+	TLSServerName string
+	TLSCertFile   string
+	TLSKeyFile    string
+
+	Username     string
+	PasswordFile string
+}
+
 type Prober struct {
-	namespace     string
-	serviceName   string
-	serviceLister corev1.ServiceLister
-	timeout       time.Duration
+	namespace         string
+	serviceName       string
+	serviceLister     corev1.ServiceLister
+	cqlReadinessCheck *CQLReadinessCheck
+	timeout           time.Duration
 }
 
 func NewProber(
 	namespace string,
 	serviceName string,
 	serviceLister corev1.ServiceLister,
+	cqlReadinessCheck *CQLReadinessCheck,
 ) *Prober {
 	return &Prober{
-		namespace:     namespace,
-		serviceName:   serviceName,
-		serviceLister: serviceLister,
-		timeout:       60 * time.Second,
+		namespace:         namespace,
+		serviceName:       serviceName,
+		serviceLister:     serviceLister,
+		cqlReadinessCheck: cqlReadinessCheck,
+		timeout:           60 * time.Second,
 	}
 }
 
@@ -50,6 +72,94 @@ func (p *Prober) isNodeUnderMaintenance() (bool, error) {
 	return hasLabel, nil
 }
 
+func (c *CQLReadinessCheck) getTLSConfig() (*tls.Config, error) {
+	if len(c.TLSCAFile) == 0 {
+		return nil, nil
+	}
+
+	caBytes, err := os.ReadFile(c.TLSCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("can't read CA file %q: %w", c.TLSCAFile, err)
+	}
+
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caBytes) {
+		return nil, fmt.Errorf("can't parse CA file %q", c.TLSCAFile)
+	}
+
+	tlsConfig := &tls.Config{
+		RootCAs:    rootCAs,
+		ServerName: c.TLSServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if len(c.TLSCertFile) != 0 {
+		clientCert, err := tls.LoadX509KeyPair(c.TLSCertFile, c.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("can't load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	return tlsConfig, nil
+}
+
+func (c *CQLReadinessCheck) getCredentials() (*cql.Credentials, error) {
+	if len(c.Username) == 0 {
+		return nil, nil
+	}
+
+	password, err := os.ReadFile(c.PasswordFile)
+	if err != nil {
+		return nil, fmt.Errorf("can't read password file %q: %w", c.PasswordFile, err)
+	}
+
+	return &cql.Credentials{
+		Username: c.Username,
+		Password: string(password),
+	}, nil
+}
+
+// checkCQL proves the node serves client traffic by querying the local node information over CQL.
+// Without credentials, a request to authenticate is enough of a proof.
+func (p *Prober) checkCQL(ctx context.Context) error {
+	tlsConfig, err := p.cqlReadinessCheck.getTLSConfig()
+	if err != nil {
+		return err
+	}
+
+	credentials, err := p.cqlReadinessCheck.getCredentials()
+	if err != nil {
+		return err
+	}
+
+	client, err := cql.Dial(ctx, p.cqlReadinessCheck.Address, tlsConfig)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	err = client.Startup(ctx, credentials)
+	if credentials == nil && cql.IsAuthenticationRequired(err) {
+		klog.V(4).InfoS("readyz probe: CQL requires authentication, skipping query", "Service", p.serviceRef())
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("can't start CQL connection: %w", err)
+	}
+
+	result, err := client.Query(ctx, "SELECT key FROM system.local", cql.ConsistencyLocalOne)
+	if err != nil {
+		return err
+	}
+
+	if len(result.Rows) != 1 {
+		return fmt.Errorf("expected 1 row of local node information, got %d", len(result.Rows))
+	}
+
+	return nil
+}
+
 func (p *Prober) Readyz(w http.ResponseWriter, req *http.Request) {
 	ctx, ctxCancel := context.WithTimeout(req.Context(), p.timeout)
 	defer ctxCancel()
@@ -104,6 +214,15 @@ func (p *Prober) Readyz(w http.ResponseWriter, req *http.Request) {
 
 			klog.V(4).InfoS("readyz probe: node state", "Node", s.Addr, "NativeTransportEnabled", transportEnabled)
 			if transportEnabled {
+				if p.cqlReadinessCheck != nil {
+					err = p.checkCQL(ctx)
+					if err != nil {
+						w.WriteHeader(http.StatusServiceUnavailable)
+						klog.ErrorS(err, "readyz probe: node doesn't serve CQL", "Service", p.serviceRef(), "Node", s.Addr, "Address", p.cqlReadinessCheck.Address)
+						return
+					}
+				}
+
 				w.WriteHeader(http.StatusOK)
 				return
 			}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/scylladb/scylla-operator/pkg/helpers/slices"
 	"github.com/scylladb/scylla-operator/pkg/pointer"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -43,11 +45,45 @@ func ApplyStatefulSetWithControl(
 				return "spec.selector is immutable", pointer.Ptr(metav1.DeletePropagationOrphan), nil
 			}
 
+			if volumeClaimTemplatesDiffer(existing.Spec.VolumeClaimTemplates, required.Spec.VolumeClaimTemplates) {
+				// Orphaned Pods and PVCs are adopted by the recreated StatefulSet.
+				return "spec.volumeClaimTemplates are immutable", pointer.Ptr(metav1.DeletePropagationOrphan), nil
+			}
+
 			return "", nil, nil
 		},
 	)
 }
 
+// volumeClaimTemplatesDiffer returns whether storage requests or storage classes of volume claim templates differ.
+// Other fields aren't compared, as the existing templates are defaulted by the API server.
+func volumeClaimTemplatesDiffer(existing, required []corev1.PersistentVolumeClaim) bool {
+	if len(existing) != len(required) {
+		return true
+	}
+
+	for _, requiredPVC := range required {
+		existingPVC, _, ok := slices.Find(existing, func(pvc corev1.PersistentVolumeClaim) bool {
+			return pvc.Name == requiredPVC.Name
+		})
+		if !ok {
+			return true
+		}
+
+		existingRequest := existingPVC.Spec.Resources.Requests[corev1.ResourceStorage]
+		requiredRequest := requiredPVC.Spec.Resources.Requests[corev1.ResourceStorage]
+		if existingRequest.Cmp(requiredRequest) != 0 {
+			return true
+		}
+
+		if !equality.Semantic.DeepEqual(existingPVC.Spec.StorageClassName, requiredPVC.Spec.StorageClassName) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func ApplyStatefulSet(
 	ctx context.Context,
 	client appsv1client.StatefulSetsGetter,
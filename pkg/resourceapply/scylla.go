@@ -42,3 +42,35 @@ func ApplyScyllaDBDatacenter(
 		options,
 	)
 }
+
+func ApplyScyllaDBBackupInventoryWithControl(
+	ctx context.Context,
+	control ApplyControlInterface[*scyllav1alpha1.ScyllaDBBackupInventory],
+	recorder record.EventRecorder,
+	required *scyllav1alpha1.ScyllaDBBackupInventory,
+	options ApplyOptions,
+) (*scyllav1alpha1.ScyllaDBBackupInventory, bool, error) {
+	return ApplyGeneric[*scyllav1alpha1.ScyllaDBBackupInventory](ctx, control, recorder, required, options)
+}
+
+func ApplyScyllaDBBackupInventory(
+	ctx context.Context,
+	client scyllav1alpha1client.ScyllaDBBackupInventoriesGetter,
+	lister scyllav1alpha1listers.ScyllaDBBackupInventoryLister,
+	recorder record.EventRecorder,
+	required *scyllav1alpha1.ScyllaDBBackupInventory,
+	options ApplyOptions,
+) (*scyllav1alpha1.ScyllaDBBackupInventory, bool, error) {
+	return ApplyScyllaDBBackupInventoryWithControl(
+		ctx,
+		ApplyControlFuncs[*scyllav1alpha1.ScyllaDBBackupInventory]{
+			GetCachedFunc: lister.ScyllaDBBackupInventories(required.Namespace).Get,
+			CreateFunc:    client.ScyllaDBBackupInventories(required.Namespace).Create,
+			UpdateFunc:    client.ScyllaDBBackupInventories(required.Namespace).Update,
+			DeleteFunc:    client.ScyllaDBBackupInventories(required.Namespace).Delete,
+		},
+		recorder,
+		required,
+		options,
+	)
+}
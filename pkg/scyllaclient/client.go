@@ -355,7 +355,11 @@ func (c *Client) Decommission(ctx context.Context, host string) error {
 	return nil
 }
 
-func (c *Client) ScyllaVersion(ctx context.Context) (string, error) {
+func (c *Client) ScyllaVersion(ctx context.Context, host string) (string, error) {
+	if len(host) > 0 {
+		ctx = forceHost(ctx, host)
+	}
+
 	resp, err := c.scyllaClient.Operations.StorageServiceScyllaReleaseVersionGet(&scyllaoperations.StorageServiceScyllaReleaseVersionGetParams{Context: ctx})
 	if err != nil {
 		return "", err
@@ -392,6 +396,20 @@ func (c *Client) HasSchemaAgreement(ctx context.Context) (bool, error) {
 	return len(versions) == 1, nil
 }
 
+// ActiveRepairs returns IDs of repairs running on the host.
+func (c *Client) ActiveRepairs(ctx context.Context, host string) ([]int32, error) {
+	if len(host) > 0 {
+		ctx = forceHost(ctx, host)
+	}
+
+	resp, err := c.scyllaClient.Operations.StorageServiceActiveRepairGet(&scyllaoperations.StorageServiceActiveRepairGetParams{Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Payload, nil
+}
+
 func DefaultTransport() *http.Transport {
 	return &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
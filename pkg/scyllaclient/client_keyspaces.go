@@ -0,0 +1,83 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scyllaclient
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// ReplicationType describes how data of a keyspace is distributed across nodes.
+type ReplicationType string
+
+const (
+	VnodesReplicationType  ReplicationType = "vnodes"
+	TabletsReplicationType ReplicationType = "tablets"
+)
+
+// KeyspacesWithReplication returns keyspaces using the given replication type.
+// Older ScyllaDB versions ignore the filter and return all keyspaces, so callers have to make sure
+// the version supports tablets.
+func (c *Client) KeyspacesWithReplication(ctx context.Context, host string, replication ReplicationType) ([]string, error) {
+	// The generated client doesn't support filtering by replication yet.
+	var keyspaces []string
+	err := c.getJSON(ctx, host, "/storage_service/keyspaces", url.Values{"replication": []string{string(replication)}}, &keyspaces)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyspaces, nil
+}
+
+// EndpointDetails describes a replica of a token range.
+type EndpointDetails struct {
+	Host       string `json:"host"`
+	Datacenter string `json:"datacenter"`
+	Rack       string `json:"rack"`
+}
+
+// TokenRange describes a token range and its replicas.
+type TokenRange struct {
+	StartToken      string            `json:"start_token"`
+	EndToken        string            `json:"end_token"`
+	Endpoints       []string          `json:"endpoints"`
+	EndpointDetails []EndpointDetails `json:"endpoint_details"`
+}
+
+// DescribeRing returns token ranges of the keyspace with their replicas.
+// Keyspaces using tablets have to be described by one of their tables.
+func (c *Client) DescribeRing(ctx context.Context, host, keyspace, table string) ([]TokenRange, error) {
+	query := url.Values{}
+	if len(table) != 0 {
+		query.Set("table", table)
+	}
+
+	var ranges []TokenRange
+	err := c.getJSON(ctx, host, "/storage_service/describe_ring/"+url.PathEscape(keyspace), query, &ranges)
+	if err != nil {
+		return nil, err
+	}
+
+	return ranges, nil
+}
+
+// Tables returns names of the tables in the keyspace.
+func (c *Client) Tables(ctx context.Context, host, keyspace string) ([]string, error) {
+	// Names are returned as "<keyspace>:<table>".
+	var names []string
+	err := c.getJSON(ctx, host, "/column_family/name", nil, &names)
+	if err != nil {
+		return nil, err
+	}
+
+	var tables []string
+	for _, name := range names {
+		ks, table, ok := strings.Cut(name, ":")
+		if ok && ks == keyspace {
+			tables = append(tables, table)
+		}
+	}
+
+	return tables, nil
+}
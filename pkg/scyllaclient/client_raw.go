@@ -0,0 +1,45 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scyllaclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// getJSON calls an API endpoint that isn't covered by the generated client and decodes the response into out.
+func (c *Client) getJSON(ctx context.Context, host, path string, query url.Values, out any) error {
+	if len(host) > 0 {
+		ctx = forceHost(ctx, host)
+	}
+
+	u := c.newURL(host, path)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("can't create request: %w", err)
+	}
+
+	resp, err := c.transport.RoundTrip(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("unexpected status code %d calling %q: %s", resp.StatusCode, path, body)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(out)
+	if err != nil {
+		return fmt.Errorf("can't decode response of %q: %w", path, err)
+	}
+
+	return nil
+}
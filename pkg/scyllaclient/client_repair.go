@@ -0,0 +1,50 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scyllaclient
+
+import (
+	"context"
+	"fmt"
+
+	scyllaoperations "github.com/scylladb/scylladb-swagger-go-client/scylladb/gen/v1/client/operations"
+)
+
+type RepairStatus string
+
+const (
+	RepairStatusRunning    RepairStatus = "RUNNING"
+	RepairStatusSuccessful RepairStatus = "SUCCESSFUL"
+	RepairStatusFailed     RepairStatus = "FAILED"
+)
+
+// Repair starts a full repair of the keyspace ranges owned by the host and returns its ID.
+func (c *Client) Repair(ctx context.Context, host, keyspace string) (int32, error) {
+	ctx = forceHost(ctx, host)
+	ctx = noRetry(ctx)
+
+	resp, err := c.scyllaClient.Operations.StorageServiceRepairAsyncByKeyspacePost(&scyllaoperations.StorageServiceRepairAsyncByKeyspacePostParams{
+		Context:  ctx,
+		Keyspace: keyspace,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("can't start repair of keyspace %q: %w", keyspace, err)
+	}
+
+	return resp.Payload, nil
+}
+
+// RepairStatus returns the status of the repair with the given ID running on the host.
+func (c *Client) RepairStatus(ctx context.Context, host, keyspace string, id int32) (RepairStatus, error) {
+	ctx = forceHost(ctx, host)
+
+	resp, err := c.scyllaClient.Operations.StorageServiceRepairAsyncByKeyspaceGet(&scyllaoperations.StorageServiceRepairAsyncByKeyspaceGetParams{
+		Context:  ctx,
+		Keyspace: keyspace,
+		ID:       id,
+	})
+	if err != nil {
+		return "", fmt.Errorf("can't get status of repair %d of keyspace %q: %w", id, keyspace, err)
+	}
+
+	return RepairStatus(resp.Payload), nil
+}
@@ -0,0 +1,19 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scyllaclient
+
+import (
+	"context"
+)
+
+// StorageLoad returns the size of data stored on the host, in bytes.
+func (c *Client) StorageLoad(ctx context.Context, host string) (float64, error) {
+	// The generated client doesn't type the response.
+	var load float64
+	err := c.getJSON(ctx, host, "/storage_service/load", nil, &load)
+	if err != nil {
+		return 0, err
+	}
+
+	return load, nil
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scyllaclient
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	scyllaoperations "github.com/scylladb/scylladb-swagger-go-client/scylladb/gen/v1/client/operations"
+)
+
+// RaftTopologyUpgradeState describes whether the cluster manages topology through Raft.
+type RaftTopologyUpgradeState string
+
+const (
+	RaftTopologyUpgradeStateNotUpgraded RaftTopologyUpgradeState = "not_upgraded"
+	RaftTopologyUpgradeStateDone        RaftTopologyUpgradeState = "done"
+)
+
+type TaskState string
+
+const (
+	TaskStateCreated TaskState = "created"
+	TaskStateRunning TaskState = "running"
+	TaskStateDone    TaskState = "done"
+	TaskStateFailed  TaskState = "failed"
+)
+
+// Node operation task types.
+const (
+	ReplaceTaskType    = "replace"
+	RemoveNodeTaskType = "removenode"
+)
+
+const nodeOperationsTaskModule = "node_ops"
+
+// TaskStats is a short summary of a task manager task.
+type TaskStats struct {
+	TaskID string    `json:"task_id"`
+	Type   string    `json:"type"`
+	State  TaskState `json:"state"`
+	Entity string    `json:"entity"`
+}
+
+// TaskStatus describes a task manager task.
+type TaskStatus struct {
+	ID                string    `json:"id"`
+	Type              string    `json:"type"`
+	State             TaskState `json:"state"`
+	Entity            string    `json:"entity"`
+	Error             string    `json:"error"`
+	ProgressTotal     float64   `json:"progress_total"`
+	ProgressCompleted float64   `json:"progress_completed"`
+}
+
+// RaftTopologyUpgradeState returns the state of the upgrade to Raft managed topology.
+func (c *Client) RaftTopologyUpgradeState(ctx context.Context, host string) (RaftTopologyUpgradeState, error) {
+	var state RaftTopologyUpgradeState
+	err := c.getJSON(ctx, host, "/storage_service/raft_topology/upgrade", nil, &state)
+	if err != nil {
+		return "", err
+	}
+
+	return state, nil
+}
+
+// NodeOperationTasks lists topology operations known to the Raft topology coordinator.
+func (c *Client) NodeOperationTasks(ctx context.Context, host string) ([]TaskStats, error) {
+	var tasks []TaskStats
+	err := c.getJSON(ctx, host, "/task_manager/list_module_tasks/"+nodeOperationsTaskModule, nil, &tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// TaskStatus returns the status of a task manager task.
+func (c *Client) TaskStatus(ctx context.Context, host, id string) (*TaskStatus, error) {
+	status := &TaskStatus{}
+	err := c.getJSON(ctx, host, "/task_manager/task_status/"+url.PathEscape(id), nil, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// RemoveNode requests removal of a dead node from the cluster.
+// With Raft managed topology the request is queued by the topology coordinator and the call blocks
+// until it finishes, so callers should track the progress through NodeOperationTasks instead.
+func (c *Client) RemoveNode(ctx context.Context, host, hostID string) error {
+	ctx = forceHost(ctx, host)
+	ctx = noRetry(ctx)
+
+	_, err := c.scyllaClient.Operations.StorageServiceRemoveNodePost(&scyllaoperations.StorageServiceRemoveNodePostParams{
+		Context: ctx,
+		HostID:  hostID,
+	})
+	if err != nil {
+		return fmt.Errorf("can't remove node %q: %w", hostID, err)
+	}
+
+	return nil
+}
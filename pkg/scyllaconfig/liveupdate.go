@@ -0,0 +1,130 @@
+package scyllaconfig
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+)
+
+// liveUpdatableKeys holds scylla.yaml options that ScyllaDB re-reads on SIGHUP and applies without a restart.
+var liveUpdatableKeys = sets.New[string](
+	"cache_index_pages",
+	"cas_contention_timeout_in_ms",
+	"compaction_collection_elements_count_warning_threshold",
+	"compaction_enforce_min_threshold",
+	"compaction_flush_all_tables_before_major_seconds",
+	"compaction_large_cell_warning_threshold_mb",
+	"compaction_large_partition_warning_threshold_mb",
+	"compaction_large_row_warning_threshold_mb",
+	"compaction_rows_count_warning_threshold",
+	"compaction_static_shares",
+	"compaction_throughput_mb_per_sec",
+	"counter_write_request_timeout_in_ms",
+	"max_clustering_key_restrictions_per_query",
+	"max_memory_for_unlimited_query_hard_limit",
+	"max_memory_for_unlimited_query_soft_limit",
+	"max_partition_key_restrictions_per_query",
+	"memtable_flush_static_shares",
+	"permissions_cache_max_entries",
+	"permissions_update_interval_in_ms",
+	"permissions_validity_in_ms",
+	"query_tombstone_page_limit",
+	"range_request_timeout_in_ms",
+	"read_request_timeout_in_ms",
+	"reader_concurrency_semaphore_kill_limit_multiplier",
+	"reader_concurrency_semaphore_serialize_limit_multiplier",
+	"request_timeout_in_ms",
+	"restrict_twcs_without_default_ttl",
+	"stream_io_throughput_mb_per_sec",
+	"tombstone_warn_threshold",
+	"truncate_request_timeout_in_ms",
+	"twcs_max_window_count",
+	"write_request_timeout_in_ms",
+)
+
+// IsLiveUpdatable returns whether a change of the top level scylla.yaml key takes effect without a restart.
+func IsLiveUpdatable(key string) bool {
+	return liveUpdatableKeys.Has(key)
+}
+
+// Parse unmarshals scylla.yaml into a map of its top level keys.
+func Parse(data []byte) (map[string]any, error) {
+	var config map[string]any
+	err := yaml.Unmarshal(data, &config)
+	if err != nil {
+		return nil, fmt.Errorf("can't unmarshal scylla config: %w", err)
+	}
+
+	if config == nil {
+		config = map[string]any{}
+	}
+
+	return config, nil
+}
+
+// ChangedKeys returns a sorted list of top level keys that differ between the configs.
+func ChangedKeys(old, new map[string]any) []string {
+	changed := sets.New[string]()
+	for k, v := range new {
+		oldV, ok := old[k]
+		if !ok || !reflect.DeepEqual(oldV, v) {
+			changed.Insert(k)
+		}
+	}
+
+	for k := range old {
+		_, ok := new[k]
+		if !ok {
+			changed.Insert(k)
+		}
+	}
+
+	return sets.List(changed)
+}
+
+// PartitionKeys splits keys into the live updatable ones and the ones that need a restart.
+func PartitionKeys(keys []string) ([]string, []string) {
+	var live, nonLive []string
+	for _, k := range keys {
+		if IsLiveUpdatable(k) {
+			live = append(live, k)
+		} else {
+			nonLive = append(nonLive, k)
+		}
+	}
+
+	return live, nonLive
+}
+
+// WithoutLiveUpdatableKeys returns scylla.yaml stripped of live updatable keys.
+// When there is nothing to strip, data is returned as is so hashes computed from it stay stable.
+func WithoutLiveUpdatableKeys(data []byte) ([]byte, error) {
+	config, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(config))
+	for k := range config {
+		keys = append(keys, k)
+	}
+
+	if !slices.ContainsFunc(keys, IsLiveUpdatable) {
+		return data, nil
+	}
+
+	for _, k := range keys {
+		if IsLiveUpdatable(k) {
+			delete(config, k)
+		}
+	}
+
+	if len(config) == 0 {
+		return []byte{}, nil
+	}
+
+	return yaml.Marshal(config)
+}
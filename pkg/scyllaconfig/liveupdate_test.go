@@ -0,0 +1,90 @@
+package scyllaconfig
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChangedKeys(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		old      map[string]any
+		new      map[string]any
+		expected []string
+	}{
+		{
+			name:     "no change",
+			old:      map[string]any{"a": 1, "b": map[string]any{"c": "d"}},
+			new:      map[string]any{"a": 1, "b": map[string]any{"c": "d"}},
+			expected: nil,
+		},
+		{
+			name:     "changed, added and removed keys",
+			old:      map[string]any{"a": 1, "b": map[string]any{"c": "d"}, "e": true},
+			new:      map[string]any{"a": 2, "b": map[string]any{"c": "d"}, "f": true},
+			expected: []string{"a", "e", "f"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ChangedKeys(tc.old, tc.new)
+			if len(got) == 0 && len(tc.expected) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestWithoutLiveUpdatableKeys(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name        string
+		data        string
+		expected    string
+		expectedErr bool
+	}{
+		{
+			name:     "config without live updatable keys is kept intact",
+			data:     "cluster_name: \"foo\"\nrpc_address:   \"0.0.0.0\"\n",
+			expected: "cluster_name: \"foo\"\nrpc_address:   \"0.0.0.0\"\n",
+		},
+		{
+			name:     "live updatable keys are stripped",
+			data:     "cluster_name: foo\ncompaction_static_shares: 100\nread_request_timeout_in_ms: 5000\n",
+			expected: "cluster_name: foo\n",
+		},
+		{
+			name:     "config with only live updatable keys is empty",
+			data:     "compaction_static_shares: 100\n",
+			expected: "",
+		},
+		{
+			name:        "invalid config fails",
+			data:        "- foo",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := WithoutLiveUpdatableKeys([]byte(tc.data))
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+			if string(got) != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, string(got))
+			}
+		})
+	}
+}
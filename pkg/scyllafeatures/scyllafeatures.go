@@ -4,6 +4,8 @@ package scyllafeatures
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
 
 	"github.com/blang/semver"
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
@@ -12,12 +14,27 @@ import (
 
 var (
 	scyllaEnterpriseMinimalVersion = semver.MustParse("2000.0.0")
+
+	// versionRegexp matches the numeric part of both image tags and release versions reported by ScyllaDB,
+	// like "6.0.1", "2024.1", "6.2.0~rc1" or "6.0.1-0.20240612.bc89aac9d017".
+	versionRegexp = regexp.MustCompile(`^(\d+)\.(\d+)(?:\.(\d+))?`)
 )
 
 type ScyllaFeature string
 
 const (
+	// ReplacingNodeUsingHostID allows replacing nodes using their host ID instead of their IP address.
 	ReplacingNodeUsingHostID ScyllaFeature = "ReplacingNodeUsingHostID"
+	// ConsistentSchema makes schema changes go through Raft.
+	ConsistentSchema ScyllaFeature = "ConsistentSchema"
+	// AlternatorTTL allows expiring Alternator items.
+	AlternatorTTL ScyllaFeature = "AlternatorTTL"
+	// RaftTopology makes topology changes go through Raft, allowing concurrent topology operations.
+	RaftTopology ScyllaFeature = "RaftTopology"
+	// Tablets allows keyspaces to distribute data using tablets instead of vnodes.
+	Tablets ScyllaFeature = "Tablets"
+	// NodeOperationTasks exposes the state of topology operations through the task manager API.
+	NodeOperationTasks ScyllaFeature = "NodeOperationTasks"
 )
 
 type scyllaDBVersionMinimalConstraint struct {
@@ -30,43 +47,113 @@ var featureMinimalVersionConstraints = map[ScyllaFeature]scyllaDBVersionMinimalC
 		openSource: semver.MustParse("5.2.0"),
 		enterprise: semver.MustParse("2023.1.0"),
 	},
+	ConsistentSchema: {
+		openSource: semver.MustParse("5.2.0"),
+		enterprise: semver.MustParse("2023.1.0"),
+	},
+	AlternatorTTL: {
+		openSource: semver.MustParse("5.2.0"),
+		enterprise: semver.MustParse("2023.1.0"),
+	},
+	RaftTopology: {
+		openSource: semver.MustParse("6.0.0"),
+		enterprise: semver.MustParse("2024.2.0"),
+	},
+	Tablets: {
+		openSource: semver.MustParse("6.0.0"),
+		enterprise: semver.MustParse("2024.2.0"),
+	},
+	NodeOperationTasks: {
+		openSource: semver.MustParse("6.2.0"),
+		enterprise: semver.MustParse("2025.1.0"),
+	},
 }
 
-func VersionSupports(image string, feature ScyllaFeature) (bool, error) {
-	version, err := naming.ImageToVersion(image)
-	if err != nil {
-		return false, fmt.Errorf("can't get version from image %q: %w", image, err)
+// Features returns all known features, sorted by name.
+func Features() []ScyllaFeature {
+	features := make([]ScyllaFeature, 0, len(featureMinimalVersionConstraints))
+	for f := range featureMinimalVersionConstraints {
+		features = append(features, f)
+	}
+
+	sort.Slice(features, func(i, j int) bool {
+		return features[i] < features[j]
+	})
+
+	return features
+}
+
+// ParseVersion parses a ScyllaDB version as reported by its API or used in image tags.
+// Pre-release and build suffixes are dropped.
+func ParseVersion(version string) (semver.Version, error) {
+	m := versionRegexp.FindStringSubmatch(version)
+	if m == nil {
+		return semver.Version{}, fmt.Errorf("can't parse ScyllaDB version %q", version)
+	}
+
+	patch := m[3]
+	if len(patch) == 0 {
+		patch = "0"
 	}
 
+	return semver.Parse(fmt.Sprintf("%s.%s.%s", m[1], m[2], patch))
+}
+
+// VersionSupports returns whether the ScyllaDB version supports the feature.
+func VersionSupports(version string, feature ScyllaFeature) (bool, error) {
 	constraints, ok := featureMinimalVersionConstraints[feature]
 	if !ok {
 		return false, fmt.Errorf("unable to find minimal version constraints, unknown feature %q", feature)
 	}
 
-	parsedVersion, err := semver.Parse(version)
+	parsedVersion, err := ParseVersion(version)
 	if err != nil {
-		return false, fmt.Errorf("can't parse ScyllaCluster version %q: %w", version, err)
+		return false, err
 	}
 
-	if isOpenSource(parsedVersion) && parsedVersion.GTE(constraints.openSource) {
+	if IsOpenSource(parsedVersion) && parsedVersion.GTE(constraints.openSource) {
 		return true, nil
 	}
 
-	if isEnterprise(parsedVersion) && parsedVersion.GTE(constraints.enterprise) {
+	if IsEnterprise(parsedVersion) && parsedVersion.GTE(constraints.enterprise) {
 		return true, nil
 	}
 
 	return false, nil
 }
 
+// GetVersion returns the ScyllaDB version features of the datacenter are gated on.
+// It prefers the version detected from the running nodes and falls back to the image tag
+// until the version has been detected.
+func GetVersion(sdc *scyllav1alpha1.ScyllaDBDatacenter) (string, error) {
+	if sdc.Status.DetectedVersion != nil && len(sdc.Status.DetectedVersion.Version) != 0 {
+		return sdc.Status.DetectedVersion.Version, nil
+	}
+
+	version, err := naming.ImageToVersion(sdc.Spec.ScyllaDB.Image)
+	if err != nil {
+		return "", fmt.Errorf("can't get version from image %q: %w", sdc.Spec.ScyllaDB.Image, err)
+	}
+
+	return version, nil
+}
+
+// Supports returns whether all nodes of the datacenter support the feature.
 func Supports(sdc *scyllav1alpha1.ScyllaDBDatacenter, feature ScyllaFeature) (bool, error) {
-	return VersionSupports(sdc.Spec.ScyllaDB.Image, feature)
+	version, err := GetVersion(sdc)
+	if err != nil {
+		return false, err
+	}
+
+	return VersionSupports(version, feature)
 }
 
-func isEnterprise(v semver.Version) bool {
+// IsEnterprise returns whether the version is a ScyllaDB Enterprise version.
+func IsEnterprise(v semver.Version) bool {
 	return v.GTE(scyllaEnterpriseMinimalVersion)
 }
 
-func isOpenSource(v semver.Version) bool {
+// IsOpenSource returns whether the version is a ScyllaDB open source version.
+func IsOpenSource(v semver.Version) bool {
 	return v.LT(scyllaEnterpriseMinimalVersion)
 }
@@ -0,0 +1,83 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package scyllafeatures
+
+import (
+	"testing"
+)
+
+func TestVersionSupports(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name          string
+		version       string
+		feature       ScyllaFeature
+		expected      bool
+		expectedError bool
+	}{
+		{
+			name:     "image tag of supported open source version",
+			version:  "6.0.1",
+			feature:  Tablets,
+			expected: true,
+		},
+		{
+			name:     "release version reported by the API",
+			version:  "6.0.1-0.20240612.bc89aac9d017",
+			feature:  RaftTopology,
+			expected: true,
+		},
+		{
+			name:     "release candidate",
+			version:  "6.2.0~rc1-0.20240919.a71d4bc49cc8",
+			feature:  Tablets,
+			expected: true,
+		},
+		{
+			name:     "unsupported open source version",
+			version:  "5.4.9",
+			feature:  Tablets,
+			expected: false,
+		},
+		{
+			name:     "enterprise version without patch",
+			version:  "2024.1",
+			feature:  AlternatorTTL,
+			expected: true,
+		},
+		{
+			name:     "unsupported enterprise version",
+			version:  "2024.1.5",
+			feature:  Tablets,
+			expected: false,
+		},
+		{
+			name:          "unknown feature",
+			version:       "6.0.1",
+			feature:       "Foo",
+			expectedError: true,
+		},
+		{
+			name:          "invalid version",
+			version:       "latest",
+			feature:       Tablets,
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := VersionSupports(tc.version, tc.feature)
+			if (err != nil) != tc.expectedError {
+				t.Fatalf("expected error %v, got %v", tc.expectedError, err)
+			}
+
+			if got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
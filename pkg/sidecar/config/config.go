@@ -44,6 +44,9 @@ type ScyllaConfig struct {
 	scyllaRackDCPropertiesConfigMapPath string
 	cpuCount                            int
 	externalSeeds                       []string
+
+	// defaultScyllaYAML holds scylla.yaml shipped with the image, before any overrides were applied.
+	defaultScyllaYAML []byte
 }
 
 func NewScyllaConfig(m *identity.Member, kubeClient kubernetes.Interface, cpuCount int, externalSeeds []string) *ScyllaConfig {
@@ -94,6 +97,7 @@ func (s *ScyllaConfig) setupScyllaYAML(configFilePath, managedConfigMapPath, con
 	if err != nil {
 		return fmt.Errorf("can't read file %q: %w", configFilePath, err)
 	}
+	s.defaultScyllaYAML = configFileBytes
 
 	operatorConfigOverrides, err := os.ReadFile(managedConfigMapPath)
 	if err != nil {
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path"
+
+	okubecrypto "github.com/scylladb/scylla-operator/pkg/kubecrypto"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// ProjectInternodeCertificates writes the internode certificate and key of the node into dir.
+// StatefulSets can't mount a different Secret into every Pod, so the sidecar projects it instead.
+// It returns true if any of the files changed.
+func ProjectInternodeCertificates(ctx context.Context, secretsClient corev1client.SecretsGetter, namespace, nodeName, dir string) (bool, error) {
+	secretName := naming.GetScyllaDBNodeInternodeCertName(nodeName)
+	secret, err := secretsClient.Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return false, fmt.Errorf("can't get secret %q: %w", naming.ManualRef(namespace, secretName), err)
+	}
+
+	certBytes, keyBytes, err := okubecrypto.GetCertKeyDataFromSecret(secret)
+	if err != nil {
+		return false, fmt.Errorf("can't get cert and key bytes from secret %q: %w", naming.ObjRef(secret), err)
+	}
+
+	// Write the key first so a new certificate is never paired with a stale key for longer than necessary.
+	keyChanged, err := writeFileIfChanged(path.Join(dir, corev1.TLSPrivateKeyKey), keyBytes)
+	if err != nil {
+		return false, err
+	}
+
+	certChanged, err := writeFileIfChanged(path.Join(dir, corev1.TLSCertKey), certBytes)
+	if err != nil {
+		return false, err
+	}
+
+	return keyChanged || certChanged, nil
+}
+
+// writeFileIfChanged atomically replaces the file content, unless it's already up to date.
+func writeFileIfChanged(filePath string, data []byte) (bool, error) {
+	existing, err := os.ReadFile(filePath)
+	if err == nil && bytes.Equal(existing, data) {
+		return false, nil
+	}
+	if err != nil && !os.IsNotExist(err) {
+		return false, fmt.Errorf("can't read file %q: %w", filePath, err)
+	}
+
+	tmpFile, err := os.CreateTemp(path.Dir(filePath), "."+path.Base(filePath)+".*")
+	if err != nil {
+		return false, fmt.Errorf("can't create temporary file for %q: %w", filePath, err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.Write(data)
+	if err != nil {
+		tmpFile.Close()
+		return false, fmt.Errorf("can't write temporary file %q: %w", tmpFile.Name(), err)
+	}
+
+	err = tmpFile.Close()
+	if err != nil {
+		return false, fmt.Errorf("can't close temporary file %q: %w", tmpFile.Name(), err)
+	}
+
+	err = os.Chmod(tmpFile.Name(), 0600)
+	if err != nil {
+		return false, fmt.Errorf("can't change mode of temporary file %q: %w", tmpFile.Name(), err)
+	}
+
+	err = os.Rename(tmpFile.Name(), filePath)
+	if err != nil {
+		return false, fmt.Errorf("can't rename %q to %q: %w", tmpFile.Name(), filePath, err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,207 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/scyllaconfig"
+	"k8s.io/klog/v2"
+)
+
+const (
+	procDir           = "/proc"
+	scyllaProcessName = "scylla"
+)
+
+// ConfigReloader applies changes of the mounted ScyllaDB configuration to the running node.
+// Changes of live updatable options are picked up by ScyllaDB on SIGHUP, other changes
+// are written to scylla.yaml and take effect once the operator rolls out the node.
+type ConfigReloader struct {
+	configFilePath    string
+	managedConfigPath string
+	customConfigPath  string
+	defaultConfig     []byte
+	appliedConfig     map[string]any
+	reloadFunc        func() error
+}
+
+func newConfigReloader(configFilePath, managedConfigPath, customConfigPath string, defaultConfig []byte, reloadFunc func() error) (*ConfigReloader, error) {
+	appliedConfigBytes, err := os.ReadFile(configFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("can't read file %q: %w", configFilePath, err)
+	}
+
+	appliedConfig, err := scyllaconfig.Parse(appliedConfigBytes)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse file %q: %w", configFilePath, err)
+	}
+
+	return &ConfigReloader{
+		configFilePath:    configFilePath,
+		managedConfigPath: managedConfigPath,
+		customConfigPath:  customConfigPath,
+		defaultConfig:     defaultConfig,
+		appliedConfig:     appliedConfig,
+		reloadFunc:        reloadFunc,
+	}, nil
+}
+
+// NewConfigReloader creates a reloader for the scylla.yaml set up by Setup, which has to be called first.
+// scyllaPID is the PID of the process started by the sidecar to run ScyllaDB.
+func (s *ScyllaConfig) NewConfigReloader(scyllaPID int) (*ConfigReloader, error) {
+	if s.defaultScyllaYAML == nil {
+		return nil, fmt.Errorf("scylla.yaml hasn't been set up yet")
+	}
+
+	return newConfigReloader(scyllaYAMLPath, naming.ScyllaManagedConfigPath, scyllaYAMLConfigMapPath, s.defaultScyllaYAML, func() error {
+		return signalProcesses(procDir, scyllaPID, scyllaProcessName, syscall.SIGHUP)
+	})
+}
+
+// Reload merges the mounted configs and applies the changes since the last reload.
+func (r *ConfigReloader) Reload() error {
+	managedConfigBytes, err := os.ReadFile(r.managedConfigPath)
+	if err != nil {
+		return fmt.Errorf("can't read file %q: %w", r.managedConfigPath, err)
+	}
+
+	customConfigBytes, err := os.ReadFile(r.customConfigPath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("can't read file %q: %w", r.customConfigPath, err)
+	}
+
+	desiredConfigBytes, err := mergeYAMLs(r.defaultConfig, managedConfigBytes, customConfigBytes)
+	if err != nil {
+		return fmt.Errorf("can't merge scylladb configs: %w", err)
+	}
+
+	desiredConfig, err := scyllaconfig.Parse(desiredConfigBytes)
+	if err != nil {
+		return fmt.Errorf("can't parse merged scylladb config: %w", err)
+	}
+
+	changedKeys := scyllaconfig.ChangedKeys(r.appliedConfig, desiredConfig)
+	if len(changedKeys) == 0 {
+		return nil
+	}
+
+	err = os.WriteFile(r.configFilePath, desiredConfigBytes, os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("can't write file %q: %w", r.configFilePath, err)
+	}
+
+	liveKeys, nonLiveKeys := scyllaconfig.PartitionKeys(changedKeys)
+	if len(nonLiveKeys) != 0 {
+		klog.InfoS("ScyllaDB config options changed that take effect after a restart", "Options", nonLiveKeys)
+	}
+
+	if len(liveKeys) != 0 {
+		klog.InfoS("Reloading ScyllaDB config", "Options", liveKeys)
+		err = r.reloadFunc()
+		if err != nil {
+			return fmt.Errorf("can't reload scylladb config: %w", err)
+		}
+	}
+
+	r.appliedConfig = desiredConfig
+
+	return nil
+}
+
+// getParentPID returns the parent PID from the content of /proc/<pid>/stat.
+// The command name can contain spaces and parentheses, so the fields are parsed after its last closing parenthesis.
+func getParentPID(stat string) (int, error) {
+	i := strings.LastIndex(stat, ")")
+	if i < 0 {
+		return 0, fmt.Errorf("can't find the end of the command name")
+	}
+
+	// The fields after the command name are the state and the parent PID.
+	fields := strings.Fields(stat[i+1:])
+	if len(fields) < 2 {
+		return 0, fmt.Errorf("can't find the parent PID")
+	}
+
+	ppid, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, fmt.Errorf("can't parse parent PID %q: %w", fields[1], err)
+	}
+
+	return ppid, nil
+}
+
+// findProcesses returns PIDs of the processes with the given name in the process tree rooted at rootPID.
+func findProcesses(procDir string, rootPID int, name string) ([]int, error) {
+	entries, err := os.ReadDir(procDir)
+	if err != nil {
+		return nil, fmt.Errorf("can't read directory %q: %w", procDir, err)
+	}
+
+	children := map[int][]int{}
+	for _, e := range entries {
+		pid, err := strconv.Atoi(e.Name())
+		if err != nil {
+			continue
+		}
+
+		stat, err := os.ReadFile(path.Join(procDir, e.Name(), "stat"))
+		if err != nil {
+			// The process might have exited in the meantime.
+			continue
+		}
+
+		ppid, err := getParentPID(string(stat))
+		if err != nil {
+			return nil, fmt.Errorf("can't parse stat of process %d: %w", pid, err)
+		}
+
+		children[ppid] = append(children[ppid], pid)
+	}
+
+	var pids []int
+	queue := []int{rootPID}
+	for len(queue) != 0 {
+		pid := queue[0]
+		queue = queue[1:]
+		queue = append(queue, children[pid]...)
+
+		comm, err := os.ReadFile(path.Join(procDir, strconv.Itoa(pid), "comm"))
+		if err != nil {
+			// The process might have exited in the meantime.
+			continue
+		}
+
+		if strings.TrimSpace(string(comm)) == name {
+			pids = append(pids, pid)
+		}
+	}
+
+	return pids, nil
+}
+
+// signalProcesses sends the signal to the processes with the given name in the process tree rooted at rootPID,
+// so processes that weren't started by the sidecar aren't signalled.
+func signalProcesses(procDir string, rootPID int, name string, sig syscall.Signal) error {
+	pids, err := findProcesses(procDir, rootPID, name)
+	if err != nil {
+		return err
+	}
+
+	if len(pids) == 0 {
+		return fmt.Errorf("can't find process %q started by process %d", name, rootPID)
+	}
+
+	for _, pid := range pids {
+		err = syscall.Kill(pid, sig)
+		if err != nil {
+			return fmt.Errorf("can't send signal %q to process %d: %w", sig, pid, err)
+		}
+	}
+
+	return nil
+}
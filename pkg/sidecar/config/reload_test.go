@@ -0,0 +1,174 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConfigReloader_Reload(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name           string
+		customConfig   string
+		expectedReload bool
+		expectedConfig string
+	}{
+		{
+			name:           "nothing changed",
+			customConfig:   "",
+			expectedReload: false,
+			expectedConfig: "cluster_name: foo\nread_request_timeout_in_ms: 5000\n",
+		},
+		{
+			name:           "live updatable option changed",
+			customConfig:   "read_request_timeout_in_ms: 1000\n",
+			expectedReload: true,
+			expectedConfig: "cluster_name: foo\nread_request_timeout_in_ms: 1000\n",
+		},
+		{
+			name:           "option requiring a restart changed",
+			customConfig:   "listen_interface: eth1\n",
+			expectedReload: false,
+			expectedConfig: "cluster_name: foo\nlisten_interface: eth1\nread_request_timeout_in_ms: 5000\n",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			configFilePath := path.Join(dir, "scylla.yaml")
+			managedConfigPath := path.Join(dir, "managed.yaml")
+			customConfigPath := path.Join(dir, "custom.yaml")
+
+			defaultConfig := []byte("cluster_name: default\nread_request_timeout_in_ms: 5000\n")
+			managedConfig := []byte("cluster_name: foo\n")
+
+			err := os.WriteFile(configFilePath, []byte("cluster_name: foo\nread_request_timeout_in_ms: 5000\n"), 0666)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = os.WriteFile(managedConfigPath, managedConfig, 0666)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			reloaded := false
+			r, err := newConfigReloader(configFilePath, managedConfigPath, customConfigPath, defaultConfig, func() error {
+				reloaded = true
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(tc.customConfig) != 0 {
+				err = os.WriteFile(customConfigPath, []byte(tc.customConfig), 0666)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err = r.Reload()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if reloaded != tc.expectedReload {
+				t.Errorf("expected reload %t, got %t", tc.expectedReload, reloaded)
+			}
+
+			gotConfig, err := os.ReadFile(configFilePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(gotConfig) != tc.expectedConfig {
+				t.Errorf("expected config %q, got %q", tc.expectedConfig, string(gotConfig))
+			}
+		})
+	}
+}
+
+func TestFindProcesses(t *testing.T) {
+	t.Parallel()
+
+	type process struct {
+		pid  int
+		ppid int
+		comm string
+	}
+
+	processes := []process{
+		{pid: 1, ppid: 0, comm: "pause"},
+		{pid: 10, ppid: 1, comm: "scylla-operator"},
+		{pid: 11, ppid: 10, comm: "supervisord"},
+		{pid: 12, ppid: 11, comm: "scylla"},
+		{pid: 13, ppid: 11, comm: "scylla-manager-"},
+		{pid: 20, ppid: 1, comm: "scylla"},
+	}
+
+	tt := []struct {
+		name         string
+		rootPID      int
+		expectedPIDs []int
+	}{
+		{
+			name:         "finds scylla process started by the root process",
+			rootPID:      10,
+			expectedPIDs: []int{12},
+		},
+		{
+			name:         "finds scylla process when it is the root process",
+			rootPID:      20,
+			expectedPIDs: []int{20},
+		},
+		{
+			name:         "finds no scylla process outside of the process tree",
+			rootPID:      13,
+			expectedPIDs: nil,
+		},
+	}
+
+	procDir := t.TempDir()
+	for _, p := range processes {
+		dir := path.Join(procDir, strconv.Itoa(p.pid))
+		err := os.Mkdir(dir, 0777)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		stat := fmt.Sprintf("%d (%s) S %d %d\n", p.pid, p.comm, p.ppid, p.pid)
+		err = os.WriteFile(path.Join(dir, "stat"), []byte(stat), 0666)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = os.WriteFile(path.Join(dir, "comm"), []byte(p.comm+"\n"), 0666)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			pids, err := findProcesses(procDir, tc.rootPID, scyllaProcessName)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(pids, tc.expectedPIDs) {
+				t.Errorf("expected and got PIDs differ: %s", cmp.Diff(tc.expectedPIDs, pids))
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	snapshotsDirName = "snapshots"
+)
+
+// snapshotMetadataFiles are written into snapshot directories by ScyllaDB and aren't part of the table data.
+var snapshotMetadataFiles = map[string]struct{}{
+	"manifest.json": {},
+	"schema.cql":    {},
+}
+
+// RestoreTables replaces the SSTables of every table that has a snapshot with the tag by the snapshot content.
+// dataDir is the ScyllaDB data directory holding a directory for every keyspace.
+// ScyllaDB must not be running while the tables are restored.
+// It returns the number of restored tables.
+func RestoreTables(dataDir, tag string) (int, error) {
+	snapshotDirs, err := filepath.Glob(filepath.Join(dataDir, "*", "*", snapshotsDirName, tag))
+	if err != nil {
+		return 0, fmt.Errorf("can't find snapshots with tag %q: %w", tag, err)
+	}
+
+	for _, snapshotDir := range snapshotDirs {
+		tableDir := filepath.Dir(filepath.Dir(snapshotDir))
+		klog.V(2).InfoS("Restoring table from snapshot", "Table", tableDir, "Tag", tag)
+
+		err = restoreTable(tableDir, snapshotDir)
+		if err != nil {
+			return 0, fmt.Errorf("can't restore table %q from snapshot %q: %w", tableDir, tag, err)
+		}
+	}
+
+	return len(snapshotDirs), nil
+}
+
+func restoreTable(tableDir, snapshotDir string) error {
+	tableEntries, err := os.ReadDir(tableDir)
+	if err != nil {
+		return fmt.Errorf("can't read directory %q: %w", tableDir, err)
+	}
+
+	// Only regular files hold the table data. Directories, like snapshots or upload, are kept.
+	for _, e := range tableEntries {
+		if !e.Type().IsRegular() {
+			continue
+		}
+
+		err = os.Remove(filepath.Join(tableDir, e.Name()))
+		if err != nil {
+			return fmt.Errorf("can't remove file %q: %w", e.Name(), err)
+		}
+	}
+
+	snapshotEntries, err := os.ReadDir(snapshotDir)
+	if err != nil {
+		return fmt.Errorf("can't read directory %q: %w", snapshotDir, err)
+	}
+
+	for _, e := range snapshotEntries {
+		if !e.Type().IsRegular() {
+			continue
+		}
+
+		if _, ok := snapshotMetadataFiles[e.Name()]; ok {
+			continue
+		}
+
+		// Snapshots are hard links to the SSTables, so we link them back instead of copying.
+		err = os.Link(filepath.Join(snapshotDir, e.Name()), filepath.Join(tableDir, e.Name()))
+		if err != nil && !errors.Is(err, fs.ErrExist) {
+			return fmt.Errorf("can't link file %q: %w", e.Name(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package snapshot
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRestoreTables(t *testing.T) {
+	t.Parallel()
+
+	dataDir := t.TempDir()
+
+	writeFiles := func(dir string, files ...string) {
+		t.Helper()
+
+		err := os.MkdirAll(dir, 0770)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, f := range files {
+			err = os.WriteFile(filepath.Join(dir, f), []byte(dir+f), 0660)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	listFiles := func(dir string) []string {
+		t.Helper()
+
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var files []string
+		for _, e := range entries {
+			files = append(files, e.Name())
+		}
+		sort.Strings(files)
+
+		return files
+	}
+
+	localTableDir := filepath.Join(dataDir, "system", "local-7ad54392bcdd35a684174e047860b377")
+	writeFiles(localTableDir, "me-2-big-Data.db", "me-2-big-Index.db")
+	writeFiles(filepath.Join(localTableDir, "snapshots", "so_system"), "me-1-big-Data.db", "me-1-big-Index.db", "manifest.json", "schema.cql")
+	writeFiles(filepath.Join(localTableDir, "upload"))
+
+	peersTableDir := filepath.Join(dataDir, "system", "peers-37f71aca7dc2383ba70672528af04d4f")
+	writeFiles(peersTableDir, "me-3-big-Data.db")
+
+	userTableDir := filepath.Join(dataDir, "ks", "tbl-37f71aca7dc2383ba70672528af04d4f")
+	writeFiles(userTableDir, "me-4-big-Data.db")
+	writeFiles(filepath.Join(userTableDir, "snapshots", "so_data"), "me-3-big-Data.db")
+
+	restored, err := RestoreTables(dataDir, "so_system")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if restored != 1 {
+		t.Errorf("expected 1 restored table, got %d", restored)
+	}
+
+	for _, tc := range []struct {
+		dir      string
+		expected []string
+	}{
+		{
+			dir:      localTableDir,
+			expected: []string{"me-1-big-Data.db", "me-1-big-Index.db", "snapshots", "upload"},
+		},
+		{
+			dir:      peersTableDir,
+			expected: []string{"me-3-big-Data.db"},
+		},
+		{
+			dir:      userTableDir,
+			expected: []string{"me-4-big-Data.db", "snapshots"},
+		},
+	} {
+		got := listFiles(tc.dir)
+		if !cmp.Equal(got, tc.expected) {
+			t.Errorf("expected and got files of %q differ: %s", tc.dir, cmp.Diff(tc.expected, got))
+		}
+	}
+}
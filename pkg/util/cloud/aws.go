@@ -0,0 +1,36 @@
+// Copyright (C) 2021 ScyllaDB
+
+package cloud
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+)
+
+func (d *Discoverer) discoverAWS(ctx context.Context) (*InstanceMetadata, error) {
+	client := imds.New(imds.Options{
+		Endpoint:   d.AWSEndpoint,
+		HTTPClient: d.Client,
+		// Discovery is bounded by the probe timeout, retries would only make it slower outside of AWS.
+		Retryer: aws.NopRetryer{},
+	})
+
+	// The identity document is the only source of the region that works in Local Zones and Wavelength Zones.
+	out, err := client.GetInstanceIdentityDocument(ctx, &imds.GetInstanceIdentityDocumentInput{})
+	if err != nil {
+		return nil, nil
+	}
+
+	if len(out.InstanceType) == 0 {
+		return nil, nil
+	}
+
+	return &InstanceMetadata{
+		CloudProvider: AWSCloud,
+		InstanceType:  out.InstanceType,
+		Region:        out.Region,
+		Zone:          out.AvailabilityZone,
+	}, nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	azureMetadataHeader = "Metadata"
+
+	azureComputePath = "/metadata/instance/compute?api-version=2021-02-01&format=json"
+)
+
+type azureComputeMetadata struct {
+	VMSize   string `json:"vmSize"`
+	Location string `json:"location"`
+	Zone     string `json:"zone"`
+}
+
+func (d *Discoverer) discoverAzure(ctx context.Context) (*InstanceMetadata, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.AzureEndpoint+azureComputePath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("can't create request: %w", err)
+	}
+	req.Header.Set(azureMetadataHeader, "true")
+
+	resp, err := d.Client.Do(req)
+	if err != nil {
+		return nil, nil
+	}
+	defer resp.Body.Close()
+
+	// Other providers serve their metadata on the same address, so any failure means we aren't on Azure.
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil
+	}
+
+	cm := &azureComputeMetadata{}
+	err = json.NewDecoder(resp.Body).Decode(cm)
+	if err != nil || len(cm.VMSize) == 0 {
+		return nil, nil
+	}
+
+	im := &InstanceMetadata{
+		CloudProvider: AzureCloud,
+		InstanceType:  cm.VMSize,
+		Region:        cm.Location,
+	}
+
+	// Zones are numbered within a region. Kubernetes labels them as "<region>-<zone>".
+	if len(cm.Zone) != 0 {
+		im.Zone = fmt.Sprintf("%s-%s", cm.Location, cm.Zone)
+	}
+
+	return im, nil
+}
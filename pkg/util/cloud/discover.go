@@ -6,33 +6,73 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 )
 
-func Discover(ctx context.Context) (*InstanceMetadata, error) {
-	client := http.DefaultClient
+const (
+	DefaultAWSMetadataEndpoint   = "http://169.254.169.254"
+	DefaultGCEMetadataEndpoint   = "http://metadata.google.internal"
+	DefaultAzureMetadataEndpoint = "http://169.254.169.254"
 
-	im := &InstanceMetadata{
-		CloudProvider: UnknownCloud,
+	defaultProbeTimeout = 2 * time.Second
+)
+
+// Discoverer queries instance metadata services of supported cloud providers.
+type Discoverer struct {
+	Client *http.Client
+
+	AWSEndpoint   string
+	GCEEndpoint   string
+	AzureEndpoint string
+
+	// ProbeTimeout limits how long discovery waits for each metadata service.
+	ProbeTimeout time.Duration
+}
+
+func NewDiscoverer() *Discoverer {
+	return &Discoverer{
+		Client:        &http.Client{},
+		AWSEndpoint:   DefaultAWSMetadataEndpoint,
+		GCEEndpoint:   DefaultGCEMetadataEndpoint,
+		AzureEndpoint: DefaultAzureMetadataEndpoint,
+		ProbeTimeout:  defaultProbeTimeout,
+	}
+}
+
+// Discover returns metadata of the instance it's running on.
+// Outside of supported clouds, e.g. on bare metal, it returns metadata of an UnknownCloud provider.
+func (d *Discoverer) Discover(ctx context.Context) (*InstanceMetadata, error) {
+	providers := []struct {
+		name     CloudProvider
+		discover func(context.Context) (*InstanceMetadata, error)
+	}{
+		// GCE is checked first as its metadata service is the only one identifying itself in responses.
+		{name: GCPCloud, discover: d.discoverGCE},
+		{name: AzureCloud, discover: d.discoverAzure},
+		{name: AWSCloud, discover: d.discoverAWS},
 	}
 
-	if OnEKS() {
-		eksIm, err := discoverEKS(ctx, client)
+	for _, p := range providers {
+		im, err := func() (*InstanceMetadata, error) {
+			probeCtx, probeCtxCancel := context.WithTimeout(ctx, d.ProbeTimeout)
+			defer probeCtxCancel()
+
+			return p.discover(probeCtx)
+		}()
 		if err != nil {
-			return nil, fmt.Errorf("get EKS instance metadata: %w", err)
+			return nil, fmt.Errorf("can't get %s instance metadata: %w", p.name, err)
 		}
-		im.CloudProvider = EKSCloud
-		im.InstanceType = eksIm.InstanceType
-	}
 
-	if OnGKE() {
-		gkeIm, err := discoverGKE(client)
-		if err != nil {
-			return nil, fmt.Errorf("get GKE instance metadata: %w", err)
+		if im != nil {
+			return im, nil
 		}
-		im.CloudProvider = GKECloud
-		//TODO: instange type
-		im.InstanceType = gkeIm.InstanceName
 	}
 
-	return im, nil
+	return &InstanceMetadata{
+		CloudProvider: UnknownCloud,
+	}, nil
+}
+
+func Discover(ctx context.Context) (*InstanceMetadata, error) {
+	return NewDiscoverer().Discover(ctx)
 }
@@ -0,0 +1,257 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func newGCEServer(t *testing.T, status int) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/computeMetadata/v1/instance/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Metadata-Flavor", "Google")
+
+		if r.Header.Get("Metadata-Flavor") != "Google" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/computeMetadata/v1/instance/machine-type":
+			fmt.Fprint(w, "projects/123456789/machineTypes/n2-highmem-8")
+		case "/computeMetadata/v1/instance/zone":
+			fmt.Fprint(w, "projects/123456789/zones/us-central1-a")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func newAzureServer(t *testing.T, zone string) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Metadata") != "true" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if r.URL.Path != "/metadata/instance/compute" || r.URL.Query().Get("api-version") == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"location": "eastus", "vmSize": "Standard_L8s_v3", "zone": %q}`, zone)
+	}))
+}
+
+func newAWSServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	const token = "token"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/latest/api/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("X-Aws-Ec2-Metadata-Token-Ttl-Seconds", "21600")
+		fmt.Fprint(w, token)
+	})
+	mux.HandleFunc("/latest/dynamic/instance-identity/document", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Aws-Ec2-Metadata-Token") != token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		fmt.Fprint(w, `{"availabilityZone": "us-west-2-lax-1a", "region": "us-west-2", "instanceType": "i4i.2xlarge"}`)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func newNotFoundServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.NotFoundHandler())
+}
+
+func TestDiscoverer_Discover(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name        string
+		newGCE      func(*testing.T) *httptest.Server
+		newAzure    func(*testing.T) *httptest.Server
+		newAWS      func(*testing.T) *httptest.Server
+		expected    *InstanceMetadata
+		expectedErr error
+	}{
+		{
+			name:     "GCE machine type and zone are discovered",
+			newGCE:   func(t *testing.T) *httptest.Server { return newGCEServer(t, http.StatusOK) },
+			newAzure: newNotFoundServer,
+			newAWS:   newNotFoundServer,
+			expected: &InstanceMetadata{
+				CloudProvider: GCPCloud,
+				InstanceType:  "n2-highmem-8",
+				Region:        "us-central1",
+				Zone:          "us-central1-a",
+			},
+			expectedErr: nil,
+		},
+		{
+			name:     "GCE values failing to be read are unknown",
+			newGCE:   func(t *testing.T) *httptest.Server { return newGCEServer(t, http.StatusInternalServerError) },
+			newAzure: newNotFoundServer,
+			newAWS:   newNotFoundServer,
+			expected: &InstanceMetadata{
+				CloudProvider: GCPCloud,
+			},
+			expectedErr: nil,
+		},
+		{
+			name:     "zonal Azure VM is discovered",
+			newGCE:   newNotFoundServer,
+			newAzure: func(t *testing.T) *httptest.Server { return newAzureServer(t, "2") },
+			newAWS:   newNotFoundServer,
+			expected: &InstanceMetadata{
+				CloudProvider: AzureCloud,
+				InstanceType:  "Standard_L8s_v3",
+				Region:        "eastus",
+				Zone:          "eastus-2",
+			},
+			expectedErr: nil,
+		},
+		{
+			name:     "regional Azure VM has no zone",
+			newGCE:   newNotFoundServer,
+			newAzure: func(t *testing.T) *httptest.Server { return newAzureServer(t, "") },
+			newAWS:   newNotFoundServer,
+			expected: &InstanceMetadata{
+				CloudProvider: AzureCloud,
+				InstanceType:  "Standard_L8s_v3",
+				Region:        "eastus",
+			},
+			expectedErr: nil,
+		},
+		{
+			name:     "AWS instance is discovered using IMDSv2",
+			newGCE:   newNotFoundServer,
+			newAzure: newNotFoundServer,
+			newAWS:   newAWSServer,
+			expected: &InstanceMetadata{
+				CloudProvider: AWSCloud,
+				InstanceType:  "i4i.2xlarge",
+				Region:        "us-west-2",
+				Zone:          "us-west-2-lax-1a",
+			},
+			expectedErr: nil,
+		},
+		{
+			name:     "bare metal is an unknown cloud",
+			newGCE:   newNotFoundServer,
+			newAzure: newNotFoundServer,
+			newAWS:   newNotFoundServer,
+			expected: &InstanceMetadata{
+				CloudProvider: UnknownCloud,
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gceServer := tc.newGCE(t)
+			defer gceServer.Close()
+			azureServer := tc.newAzure(t)
+			defer azureServer.Close()
+			awsServer := tc.newAWS(t)
+			defer awsServer.Close()
+
+			d := &Discoverer{
+				Client:        &http.Client{},
+				AWSEndpoint:   awsServer.URL,
+				GCEEndpoint:   gceServer.URL,
+				AzureEndpoint: azureServer.URL,
+				ProbeTimeout:  5 * time.Second,
+			}
+
+			got, err := d.Discover(context.Background())
+			if !reflect.DeepEqual(err, tc.expectedErr) {
+				t.Errorf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got instance metadata differ: %s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func TestInstanceMetadata_TopologyLabels(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		im       *InstanceMetadata
+		expected map[string]string
+	}{
+		{
+			name: "all labels are set",
+			im: &InstanceMetadata{
+				CloudProvider: AWSCloud,
+				InstanceType:  "i4i.2xlarge",
+				Region:        "us-east-1",
+				Zone:          "us-east-1a",
+			},
+			expected: map[string]string{
+				"node.kubernetes.io/instance-type": "i4i.2xlarge",
+				"topology.kubernetes.io/region":    "us-east-1",
+				"topology.kubernetes.io/zone":      "us-east-1a",
+			},
+		},
+		{
+			name: "unknown values are omitted",
+			im: &InstanceMetadata{
+				CloudProvider: UnknownCloud,
+			},
+			expected: map[string]string{},
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.im.TopologyLabels()
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got labels differ: %s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2021 ScyllaDB
+
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	gceMetadataFlavorHeader = "Metadata-Flavor"
+	gceMetadataFlavor       = "Google"
+
+	gceMachineTypePath = "/computeMetadata/v1/instance/machine-type"
+	gceZonePath        = "/computeMetadata/v1/instance/zone"
+)
+
+// gceGet returns the value at the given path. It returns false when the endpoint isn't a GCE metadata server,
+// and an empty value when the metadata server doesn't provide it.
+func (d *Discoverer) gceGet(ctx context.Context, path string) (string, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.GCEEndpoint+path, nil)
+	if err != nil {
+		return "", false, fmt.Errorf("can't create request: %w", err)
+	}
+	req.Header.Set(gceMetadataFlavorHeader, gceMetadataFlavor)
+
+	resp, err := d.Client.Do(req)
+	if err != nil {
+		return "", false, nil
+	}
+	defer resp.Body.Close()
+
+	if resp.Header.Get(gceMetadataFlavorHeader) != gceMetadataFlavor {
+		return "", false, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		// The instance runs on GCE, but the value isn't available, e.g. when the metadata server restricts access.
+		// The value is reported as unknown, so it doesn't prevent using the rest of the metadata.
+		return "", true, nil
+	}
+
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", true, fmt.Errorf("can't read metadata at path %q: %w", path, err)
+	}
+
+	return strings.TrimSpace(string(buf)), true, nil
+}
+
+func lastPathSegment(s string) string {
+	return s[strings.LastIndex(s, "/")+1:]
+}
+
+// gceZoneToRegion trims the zone suffix, e.g. "us-central1-a" belongs to "us-central1".
+func gceZoneToRegion(zone string) string {
+	i := strings.LastIndex(zone, "-")
+	if i < 0 {
+		return ""
+	}
+
+	return zone[:i]
+}
+
+func (d *Discoverer) discoverGCE(ctx context.Context) (*InstanceMetadata, error) {
+	// Machine type has the format of "projects/<project-number>/machineTypes/<machine-type>".
+	machineType, ok, err := d.gceGet(ctx, gceMachineTypePath)
+	if err != nil {
+		return nil, fmt.Errorf("can't get machine type: %w", err)
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	// Zone has the format of "projects/<project-number>/zones/<zone>".
+	zone, _, err := d.gceGet(ctx, gceZonePath)
+	if err != nil {
+		return nil, fmt.Errorf("can't get zone: %w", err)
+	}
+	zone = lastPathSegment(zone)
+
+	return &InstanceMetadata{
+		CloudProvider: GCPCloud,
+		InstanceType:  lastPathSegment(machineType),
+		Region:        gceZoneToRegion(zone),
+		Zone:          zone,
+	}, nil
+}
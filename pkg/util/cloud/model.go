@@ -2,15 +2,42 @@
 
 package cloud
 
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
 type CloudProvider string
 
 const (
-	EKSCloud     CloudProvider = "EKS"
-	GKECloud     CloudProvider = "GKE"
+	AWSCloud     CloudProvider = "AWS"
+	GCPCloud     CloudProvider = "GCP"
+	AzureCloud   CloudProvider = "Azure"
 	UnknownCloud CloudProvider = "Unknown"
 )
 
 type InstanceMetadata struct {
-	InstanceType  string
 	CloudProvider CloudProvider
+	InstanceType  string
+	Region        string
+	Zone          string
+}
+
+// TopologyLabels returns the well-known Node labels describing the instance.
+// Labels with unknown values are omitted.
+func (im *InstanceMetadata) TopologyLabels() map[string]string {
+	labels := map[string]string{}
+
+	if len(im.InstanceType) != 0 {
+		labels[corev1.LabelInstanceTypeStable] = im.InstanceType
+	}
+
+	if len(im.Region) != 0 {
+		labels[corev1.LabelTopologyRegion] = im.Region
+	}
+
+	if len(im.Zone) != 0 {
+		labels[corev1.LabelTopologyZone] = im.Zone
+	}
+
+	return labels
 }
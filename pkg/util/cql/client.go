@@ -0,0 +1,319 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package cql
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+const (
+	cqlVersion = "3.0.0"
+
+	defaultTimeout = 10 * time.Second
+)
+
+type Consistency uint16
+
+const (
+	ConsistencyAny         Consistency = 0x0000
+	ConsistencyOne         Consistency = 0x0001
+	ConsistencyQuorum      Consistency = 0x0004
+	ConsistencyAll         Consistency = 0x0005
+	ConsistencyLocalQuorum Consistency = 0x0006
+	ConsistencyLocalOne    Consistency = 0x000A
+)
+
+type ResultKind int32
+
+const (
+	ResultKindVoid         ResultKind = 0x0001
+	ResultKindRows         ResultKind = 0x0002
+	ResultKindSetKeyspace  ResultKind = 0x0003
+	ResultKindPrepared     ResultKind = 0x0004
+	ResultKindSchemaChange ResultKind = 0x0005
+)
+
+const (
+	rowsFlagGlobalTablesSpec int32 = 0x0001
+	rowsFlagHasMorePages     int32 = 0x0002
+	rowsFlagNoMetadata       int32 = 0x0004
+)
+
+// Error is an ERROR response returned by the server.
+type Error struct {
+	Code    int32
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("server error 0x%04x: %s", e.Code, e.Message)
+}
+
+// AuthenticationRequiredError is returned by Startup when the server requires authentication
+// and no credentials were provided.
+type AuthenticationRequiredError struct {
+	Authenticator string
+}
+
+func (e *AuthenticationRequiredError) Error() string {
+	return fmt.Sprintf("server requires authentication using %q", e.Authenticator)
+}
+
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// Result is the result of a query. Values of rows are kept in their serialized form.
+type Result struct {
+	Kind    ResultKind
+	Columns []string
+	Rows    [][][]byte
+}
+
+// Client is a minimal native protocol v4 client using a single connection.
+// Requests are sent one at a time, so it's not safe for concurrent use.
+type Client struct {
+	conn    net.Conn
+	timeout time.Duration
+}
+
+// Dial connects to the given address. When tlsConfig is not nil, the connection is encrypted.
+func Dial(ctx context.Context, address string, tlsConfig *tls.Config) (*Client, error) {
+	var conn net.Conn
+	var err error
+	if tlsConfig != nil {
+		d := &tls.Dialer{
+			Config: tlsConfig,
+		}
+		conn, err = d.DialContext(ctx, "tcp", address)
+	} else {
+		d := &net.Dialer{}
+		conn, err = d.DialContext(ctx, "tcp", address)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("can't dial %q: %w", address, err)
+	}
+
+	return NewClient(conn), nil
+}
+
+// NewClient returns a client using an established connection.
+func NewClient(conn net.Conn) *Client {
+	return &Client{
+		conn:    conn,
+		timeout: defaultTimeout,
+	}
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Startup initializes the connection. When the server requires authentication, the credentials are sent using
+// the SASL PLAIN mechanism. If credentials are nil, AuthenticationRequiredError is returned instead.
+func (c *Client) Startup(ctx context.Context, credentials *Credentials) error {
+	fb := NewFrameBuilder(0, OpcodeStartup)
+	fb.WriteStringMap([]string{"CQL_VERSION"}, map[string]string{
+		"CQL_VERSION": cqlVersion,
+	})
+
+	opcode, fp, err := c.roundTrip(ctx, fb.Bytes())
+	if err != nil {
+		return fmt.Errorf("can't send startup: %w", err)
+	}
+
+	switch opcode {
+	case OpcodeReady:
+		return nil
+
+	case OpcodeAuthenticate:
+		var authenticator string
+		err = fp.Parse(func() {
+			authenticator = fp.ReadString()
+		})
+		if err != nil {
+			return err
+		}
+
+		if credentials == nil {
+			return &AuthenticationRequiredError{
+				Authenticator: authenticator,
+			}
+		}
+
+		return c.authenticate(ctx, credentials)
+
+	default:
+		return fmt.Errorf("unexpected response to startup: %s", opcode)
+	}
+}
+
+func (c *Client) authenticate(ctx context.Context, credentials *Credentials) error {
+	token := make([]byte, 0, 2+len(credentials.Username)+len(credentials.Password))
+	token = append(token, 0)
+	token = append(token, credentials.Username...)
+	token = append(token, 0)
+	token = append(token, credentials.Password...)
+
+	fb := NewFrameBuilder(0, OpcodeAuthResponse)
+	fb.WriteBytes(token)
+
+	opcode, _, err := c.roundTrip(ctx, fb.Bytes())
+	if err != nil {
+		return fmt.Errorf("can't authenticate: %w", err)
+	}
+
+	switch opcode {
+	case OpcodeAuthSuccess:
+		return nil
+
+	case OpcodeAuthChallenge:
+		return fmt.Errorf("can't authenticate: multi-step authentication isn't supported")
+
+	default:
+		return fmt.Errorf("unexpected response to authentication: %s", opcode)
+	}
+}
+
+// Query executes a query without bound values and returns the first page of its result.
+func (c *Client) Query(ctx context.Context, query string, consistency Consistency) (*Result, error) {
+	fb := NewFrameBuilder(0, OpcodeQuery)
+	fb.WriteLongString(query)
+	fb.WriteShort(uint16(consistency))
+	fb.WriteFlags(0x00)
+
+	opcode, fp, err := c.roundTrip(ctx, fb.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("can't execute query: %w", err)
+	}
+
+	if opcode != OpcodeResult {
+		return nil, fmt.Errorf("unexpected response to query: %s", opcode)
+	}
+
+	result := &Result{}
+	err = fp.Parse(func() {
+		result.Kind = ResultKind(fp.ReadInt())
+		if result.Kind != ResultKindRows {
+			return
+		}
+
+		flags := fp.ReadInt()
+		columnsCount := int(fp.ReadInt())
+		if flags&rowsFlagHasMorePages != 0 {
+			_ = fp.ReadBytes()
+		}
+
+		if flags&rowsFlagNoMetadata == 0 {
+			if flags&rowsFlagGlobalTablesSpec != 0 {
+				_ = fp.ReadString()
+				_ = fp.ReadString()
+			}
+
+			result.Columns = make([]string, 0, columnsCount)
+			for i := 0; i < columnsCount; i++ {
+				if flags&rowsFlagGlobalTablesSpec == 0 {
+					_ = fp.ReadString()
+					_ = fp.ReadString()
+				}
+				result.Columns = append(result.Columns, fp.ReadString())
+				fp.SkipOption()
+			}
+		}
+
+		rowsCount := int(fp.ReadInt())
+		result.Rows = make([][][]byte, 0, rowsCount)
+		for i := 0; i < rowsCount; i++ {
+			row := make([][]byte, 0, columnsCount)
+			for j := 0; j < columnsCount; j++ {
+				row = append(row, fp.ReadBytes())
+			}
+			result.Rows = append(result.Rows, row)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// roundTrip sends a request frame and reads the response. ERROR responses are returned as Error.
+func (c *Client) roundTrip(ctx context.Context, frame []byte) (Opcode, *FrameParser, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(c.timeout)
+	}
+
+	err := c.conn.SetDeadline(deadline)
+	if err != nil {
+		return 0, nil, fmt.Errorf("can't set deadline: %w", err)
+	}
+
+	_, err = c.conn.Write(frame)
+	if err != nil {
+		return 0, nil, fmt.Errorf("can't write frame: %w", err)
+	}
+
+	headerBytes := make([]byte, headerLen)
+	_, err = io.ReadFull(c.conn, headerBytes)
+	if err != nil {
+		return 0, nil, fmt.Errorf("can't read frame header: %w", err)
+	}
+
+	header, err := ParseFrameHeader(headerBytes)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	body := make([]byte, header.Length)
+	_, err = io.ReadFull(c.conn, body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("can't read frame body: %w", err)
+	}
+
+	fp := NewFrameParser(bytes.NewBuffer(body))
+	err = fp.Parse(func() {
+		if header.Flags&flagTracing != 0 {
+			_ = fp.readBytes(16)
+		}
+		if header.Flags&flagWarning != 0 {
+			_ = fp.ReadStringList()
+		}
+		if header.Flags&flagCustomPayload != 0 {
+			_ = fp.ReadBytesMap()
+		}
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if header.Opcode == OpcodeError {
+		serverErr := &Error{}
+		err = fp.Parse(func() {
+			serverErr.Code = fp.ReadInt()
+			serverErr.Message = fp.ReadString()
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+
+		return 0, nil, serverErr
+	}
+
+	return header.Opcode, fp, nil
+}
+
+// IsAuthenticationRequired returns true if the error is caused by missing credentials.
+func IsAuthenticationRequired(err error) bool {
+	var authErr *AuthenticationRequiredError
+	return errors.As(err, &authErr)
+}
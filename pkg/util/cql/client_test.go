@@ -0,0 +1,284 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package cql
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type request struct {
+	opcode Opcode
+	body   []byte
+}
+
+type response struct {
+	flags  byte
+	opcode Opcode
+	body   []byte
+}
+
+func makeResponseFrame(r response) []byte {
+	p := []byte{responseVersion, r.flags, 0x00, 0x00, byte(r.opcode)}
+	p = binary.BigEndian.AppendUint32(p, uint32(len(r.body)))
+	return append(p, r.body...)
+}
+
+// serve reads a request for every response and replies with it.
+func serve(t *testing.T, conn net.Conn, responses []response) <-chan []request {
+	t.Helper()
+
+	requestsCh := make(chan []request, 1)
+	go func() {
+		defer close(requestsCh)
+		defer conn.Close()
+
+		var requests []request
+		for _, r := range responses {
+			header := make([]byte, headerLen)
+			_, err := io.ReadFull(conn, header)
+			if err != nil {
+				t.Errorf("can't read request header: %v", err)
+				return
+			}
+
+			body := make([]byte, binary.BigEndian.Uint32(header[5:9]))
+			_, err = io.ReadFull(conn, body)
+			if err != nil {
+				t.Errorf("can't read request body: %v", err)
+				return
+			}
+
+			requests = append(requests, request{opcode: Opcode(header[4]), body: body})
+
+			_, err = conn.Write(makeResponseFrame(r))
+			if err != nil {
+				t.Errorf("can't write response: %v", err)
+				return
+			}
+		}
+
+		requestsCh <- requests
+	}()
+
+	return requestsCh
+}
+
+func TestClientStartup(t *testing.T) {
+	t.Parallel()
+
+	startupBody := []byte{
+		0x00, 0x01,
+		0x00, 0x0B, 'C', 'Q', 'L', '_', 'V', 'E', 'R', 'S', 'I', 'O', 'N',
+		0x00, 0x05, '3', '.', '0', '.', '0',
+	}
+	authenticateBody := append([]byte{0x00, 0x1A}, "PasswordAuthenticator-test"...)
+
+	tt := []struct {
+		name             string
+		credentials      *Credentials
+		responses        []response
+		expectedRequests []request
+		expectedErr      error
+	}{
+		{
+			name:        "server without authentication is ready",
+			credentials: nil,
+			responses: []response{
+				{opcode: OpcodeReady},
+			},
+			expectedRequests: []request{
+				{opcode: OpcodeStartup, body: startupBody},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "credentials are sent using SASL PLAIN",
+			credentials: &Credentials{Username: "user", Password: "pass"},
+			responses: []response{
+				{opcode: OpcodeAuthenticate, body: authenticateBody},
+				{opcode: OpcodeAuthSuccess, body: []byte{0xFF, 0xFF, 0xFF, 0xFF}},
+			},
+			expectedRequests: []request{
+				{opcode: OpcodeStartup, body: startupBody},
+				{opcode: OpcodeAuthResponse, body: []byte{0x00, 0x00, 0x00, 0x0A, 0x00, 'u', 's', 'e', 'r', 0x00, 'p', 'a', 's', 's'}},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "authentication is required without credentials",
+			credentials: nil,
+			responses: []response{
+				{opcode: OpcodeAuthenticate, body: authenticateBody},
+			},
+			expectedRequests: []request{
+				{opcode: OpcodeStartup, body: startupBody},
+			},
+			expectedErr: &AuthenticationRequiredError{Authenticator: "PasswordAuthenticator-test"},
+		},
+		{
+			name:        "bad credentials return server error",
+			credentials: &Credentials{Username: "user", Password: "pass"},
+			responses: []response{
+				{opcode: OpcodeAuthenticate, body: authenticateBody},
+				{opcode: OpcodeError, body: []byte{0x00, 0x00, 0x01, 0x00, 0x00, 0x03, 'b', 'a', 'd'}},
+			},
+			expectedRequests: []request{
+				{opcode: OpcodeStartup, body: startupBody},
+				{opcode: OpcodeAuthResponse, body: []byte{0x00, 0x00, 0x00, 0x0A, 0x00, 'u', 's', 'e', 'r', 0x00, 'p', 'a', 's', 's'}},
+			},
+			expectedErr: fmt.Errorf("can't authenticate: %w", &Error{Code: 0x0100, Message: "bad"}),
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			clientConn, serverConn := net.Pipe()
+			requestsCh := serve(t, serverConn, tc.responses)
+
+			c := NewClient(clientConn)
+			defer c.Close()
+
+			err := c.Startup(context.Background(), tc.credentials)
+			if !reflect.DeepEqual(err, tc.expectedErr) {
+				t.Errorf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			requests := <-requestsCh
+			if !reflect.DeepEqual(requests, tc.expectedRequests) {
+				t.Errorf("expected and got requests differ: %s", cmp.Diff(tc.expectedRequests, requests, cmp.AllowUnexported(request{})))
+			}
+		})
+	}
+}
+
+func TestClientQuery(t *testing.T) {
+	t.Parallel()
+
+	rowsBody := []byte{
+		// Kind.
+		0x00, 0x00, 0x00, 0x02,
+		// Flags: global tables spec.
+		0x00, 0x00, 0x00, 0x01,
+		// Columns count.
+		0x00, 0x00, 0x00, 0x02,
+		// Global tables spec.
+		0x00, 0x06, 's', 'y', 's', 't', 'e', 'm',
+		0x00, 0x05, 'l', 'o', 'c', 'a', 'l',
+		// Column of varchar type.
+		0x00, 0x03, 'k', 'e', 'y',
+		0x00, 0x0D,
+		// Column of set<inet> type.
+		0x00, 0x06, 't', 'o', 'k', 'e', 'n', 's',
+		0x00, 0x22, 0x00, 0x10,
+		// Rows count.
+		0x00, 0x00, 0x00, 0x01,
+		// Row.
+		0x00, 0x00, 0x00, 0x05, 'l', 'o', 'c', 'a', 'l',
+		0xFF, 0xFF, 0xFF, 0xFF,
+	}
+
+	tt := []struct {
+		name            string
+		response        response
+		expectedRequest request
+		expectedResult  *Result
+		expectedErr     error
+	}{
+		{
+			name: "rows are parsed",
+			response: response{
+				opcode: OpcodeResult,
+				body:   rowsBody,
+			},
+			expectedRequest: request{
+				opcode: OpcodeQuery,
+				body: append(
+					append([]byte{0x00, 0x00, 0x00, 0x1C}, "SELECT key FROM system.local"...),
+					0x00, 0x0A, 0x00,
+				),
+			},
+			expectedResult: &Result{
+				Kind:    ResultKindRows,
+				Columns: []string{"key", "tokens"},
+				Rows: [][][]byte{
+					{[]byte("local"), nil},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "warnings are skipped",
+			response: response{
+				flags:  flagWarning,
+				opcode: OpcodeResult,
+				body:   append([]byte{0x00, 0x01, 0x00, 0x04, 'w', 'a', 'r', 'n'}, 0x00, 0x00, 0x00, 0x01),
+			},
+			expectedRequest: request{
+				opcode: OpcodeQuery,
+				body: append(
+					append([]byte{0x00, 0x00, 0x00, 0x1C}, "SELECT key FROM system.local"...),
+					0x00, 0x0A, 0x00,
+				),
+			},
+			expectedResult: &Result{
+				Kind: ResultKindVoid,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "truncated result returns an error",
+			response: response{
+				opcode: OpcodeResult,
+				body:   rowsBody[:len(rowsBody)-2],
+			},
+			expectedRequest: request{
+				opcode: OpcodeQuery,
+				body: append(
+					append([]byte{0x00, 0x00, 0x00, 0x1C}, "SELECT key FROM system.local"...),
+					0x00, 0x0A, 0x00,
+				),
+			},
+			expectedResult: nil,
+			expectedErr:    fmt.Errorf("malformed frame: %w", errors.New("can't read 4 bytes from buffer of 2 bytes")),
+		},
+	}
+
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			clientConn, serverConn := net.Pipe()
+			requestsCh := serve(t, serverConn, []response{tc.response})
+
+			c := NewClient(clientConn)
+			defer c.Close()
+
+			result, err := c.Query(context.Background(), "SELECT key FROM system.local", ConsistencyLocalOne)
+			if !reflect.DeepEqual(err, tc.expectedErr) {
+				t.Errorf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			if !reflect.DeepEqual(result, tc.expectedResult) {
+				t.Errorf("expected and got results differ: %s", cmp.Diff(tc.expectedResult, result))
+			}
+
+			requests := <-requestsCh
+			if !reflect.DeepEqual(requests, []request{tc.expectedRequest}) {
+				t.Errorf("expected and got requests differ: %s", cmp.Diff([]request{tc.expectedRequest}, requests, cmp.AllowUnexported(request{})))
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ package cql
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 )
 
@@ -13,8 +14,168 @@ const (
 	// OptionsFrame is a minimal OPTIONS CQL frame.
 	// Ref: https://github.com/apache/cassandra/blob/f278f6774fc76465c182041e081982105c3e7dbb/doc/native_protocol_v4.spec
 	OptionsFrame = `\x04\x00\x00\x00\x05\x00\x00\x00\x00`
+
+	requestVersion  byte = 0x04
+	responseVersion byte = 0x84
+
+	// maxBodyLen is the maximum length of a frame body allowed by the protocol.
+	maxBodyLen = 256 * 1024 * 1024
+)
+
+type Opcode byte
+
+const (
+	OpcodeError         Opcode = 0x00
+	OpcodeStartup       Opcode = 0x01
+	OpcodeReady         Opcode = 0x02
+	OpcodeAuthenticate  Opcode = 0x03
+	OpcodeOptions       Opcode = 0x05
+	OpcodeSupported     Opcode = 0x06
+	OpcodeQuery         Opcode = 0x07
+	OpcodeResult        Opcode = 0x08
+	OpcodeAuthChallenge Opcode = 0x0E
+	OpcodeAuthResponse  Opcode = 0x0F
+	OpcodeAuthSuccess   Opcode = 0x10
+)
+
+func (o Opcode) String() string {
+	switch o {
+	case OpcodeError:
+		return "ERROR"
+	case OpcodeStartup:
+		return "STARTUP"
+	case OpcodeReady:
+		return "READY"
+	case OpcodeAuthenticate:
+		return "AUTHENTICATE"
+	case OpcodeOptions:
+		return "OPTIONS"
+	case OpcodeSupported:
+		return "SUPPORTED"
+	case OpcodeQuery:
+		return "QUERY"
+	case OpcodeResult:
+		return "RESULT"
+	case OpcodeAuthChallenge:
+		return "AUTH_CHALLENGE"
+	case OpcodeAuthResponse:
+		return "AUTH_RESPONSE"
+	case OpcodeAuthSuccess:
+		return "AUTH_SUCCESS"
+	default:
+		return fmt.Sprintf("0x%02x", byte(o))
+	}
+}
+
+const (
+	flagTracing       byte = 0x02
+	flagCustomPayload byte = 0x04
+	flagWarning       byte = 0x08
 )
 
+type FrameHeader struct {
+	Version byte
+	Flags   byte
+	Stream  int16
+	Opcode  Opcode
+	Length  uint32
+}
+
+// ParseFrameHeader parses the header of a response frame.
+func ParseFrameHeader(p []byte) (*FrameHeader, error) {
+	if len(p) != headerLen {
+		return nil, fmt.Errorf("expected header of %d bytes, got %d", headerLen, len(p))
+	}
+
+	h := &FrameHeader{
+		Version: p[0],
+		Flags:   p[1],
+		Stream:  int16(binary.BigEndian.Uint16(p[2:4])),
+		Opcode:  Opcode(p[4]),
+		Length:  binary.BigEndian.Uint32(p[5:9]),
+	}
+
+	if h.Version != responseVersion {
+		return nil, fmt.Errorf("unsupported response protocol version 0x%02x", h.Version)
+	}
+
+	if h.Length > maxBodyLen {
+		return nil, fmt.Errorf("frame body length %d exceeds the maximum of %d", h.Length, maxBodyLen)
+	}
+
+	return h, nil
+}
+
+// FrameBuilder builds request frames.
+type FrameBuilder struct {
+	opcode Opcode
+	stream int16
+	body   bytes.Buffer
+}
+
+func NewFrameBuilder(stream int16, opcode Opcode) *FrameBuilder {
+	return &FrameBuilder{
+		opcode: opcode,
+		stream: stream,
+	}
+}
+
+// WriteFlags writes a single byte of flags.
+func (fb *FrameBuilder) WriteFlags(flags byte) {
+	fb.body.WriteByte(flags)
+}
+
+func (fb *FrameBuilder) WriteShort(n uint16) {
+	fb.body.Write(binary.BigEndian.AppendUint16(nil, n))
+}
+
+func (fb *FrameBuilder) WriteInt(n int32) {
+	fb.body.Write(binary.BigEndian.AppendUint32(nil, uint32(n)))
+}
+
+func (fb *FrameBuilder) WriteString(s string) {
+	fb.WriteShort(uint16(len(s)))
+	fb.body.WriteString(s)
+}
+
+func (fb *FrameBuilder) WriteLongString(s string) {
+	fb.WriteInt(int32(len(s)))
+	fb.body.WriteString(s)
+}
+
+// WriteBytes writes [bytes]. A nil slice is encoded as null.
+func (fb *FrameBuilder) WriteBytes(p []byte) {
+	if p == nil {
+		fb.WriteInt(-1)
+		return
+	}
+
+	fb.WriteInt(int32(len(p)))
+	fb.body.Write(p)
+}
+
+// WriteStringMap writes [string map] with keys in the given order.
+func (fb *FrameBuilder) WriteStringMap(keys []string, m map[string]string) {
+	fb.WriteShort(uint16(len(keys)))
+	for _, k := range keys {
+		fb.WriteString(k)
+		fb.WriteString(m[k])
+	}
+}
+
+// Bytes returns the encoded frame including its header.
+func (fb *FrameBuilder) Bytes() []byte {
+	p := make([]byte, 0, headerLen+fb.body.Len())
+	p = append(p, requestVersion, 0x00)
+	p = binary.BigEndian.AppendUint16(p, uint16(fb.stream))
+	p = append(p, byte(fb.opcode))
+	p = binary.BigEndian.AppendUint32(p, uint32(fb.body.Len()))
+	p = append(p, fb.body.Bytes()...)
+	return p
+}
+
+// FrameParser reads protocol notations from a frame.
+// Reading past the end of the buffer panics, use Parse to turn it into an error.
 type FrameParser struct {
 	buf *bytes.Buffer
 }
@@ -25,6 +186,27 @@ func NewFrameParser(buf *bytes.Buffer) *FrameParser {
 	}
 }
 
+// Parse runs f and returns an error if it reads a malformed frame.
+func (fp *FrameParser) Parse(f func()) (err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		rErr, ok := r.(error)
+		if !ok {
+			panic(r)
+		}
+
+		err = fmt.Errorf("malformed frame: %w", rErr)
+	}()
+
+	f()
+
+	return nil
+}
+
 func (fp *FrameParser) SkipHeader() {
 	_ = fp.readBytes(headerLen)
 }
@@ -41,6 +223,10 @@ func (fp *FrameParser) ReadShort() uint16 {
 	return uint16(fp.readByte())<<8 | uint16(fp.readByte())
 }
 
+func (fp *FrameParser) ReadInt() int32 {
+	return int32(binary.BigEndian.Uint32(fp.readBytes(4)))
+}
+
 func (fp *FrameParser) ReadStringMultiMap() map[string][]string {
 	n := fp.ReadShort()
 	m := make(map[string][]string, n)
@@ -53,6 +239,10 @@ func (fp *FrameParser) ReadStringMultiMap() map[string][]string {
 }
 
 func (fp *FrameParser) readBytes(n int) []byte {
+	if n > fp.buf.Len() {
+		panic(fmt.Errorf("can't read %d bytes from buffer of %d bytes", n, fp.buf.Len()))
+	}
+
 	p := make([]byte, 0, n)
 	for i := 0; i < n; i++ {
 		p = append(p, fp.readByte())
@@ -61,6 +251,20 @@ func (fp *FrameParser) readBytes(n int) []byte {
 	return p
 }
 
+// ReadBytes reads [bytes]. Null is returned as a nil slice.
+func (fp *FrameParser) ReadBytes() []byte {
+	n := fp.ReadInt()
+	if n < 0 {
+		return nil
+	}
+	return fp.readBytes(int(n))
+}
+
+// ReadShortBytes reads [short bytes].
+func (fp *FrameParser) ReadShortBytes() []byte {
+	return fp.readBytes(int(fp.ReadShort()))
+}
+
 func (fp *FrameParser) ReadString() string {
 	return string(fp.readBytes(int(fp.ReadShort())))
 }
@@ -73,3 +277,50 @@ func (fp *FrameParser) ReadStringList() []string {
 	}
 	return l
 }
+
+// ReadBytesMap reads [bytes map].
+func (fp *FrameParser) ReadBytesMap() map[string][]byte {
+	n := fp.ReadShort()
+	m := make(map[string][]byte, n)
+	for i := uint16(0); i < n; i++ {
+		k := fp.ReadString()
+		m[k] = fp.ReadBytes()
+	}
+	return m
+}
+
+// SkipOption reads an [option] describing a column type, including the types it's parametrized with.
+func (fp *FrameParser) SkipOption() {
+	id := fp.ReadShort()
+	switch id {
+	case 0x0000:
+		// Custom type is described by its class name.
+		_ = fp.ReadString()
+
+	case 0x0020, 0x0022:
+		// List and set.
+		fp.SkipOption()
+
+	case 0x0021:
+		// Map.
+		fp.SkipOption()
+		fp.SkipOption()
+
+	case 0x0030:
+		// User defined type.
+		_ = fp.ReadString()
+		_ = fp.ReadString()
+		n := fp.ReadShort()
+		for i := uint16(0); i < n; i++ {
+			_ = fp.ReadString()
+			fp.SkipOption()
+		}
+
+	case 0x0031:
+		// Tuple.
+		n := fp.ReadShort()
+		for i := uint16(0); i < n; i++ {
+			fp.SkipOption()
+		}
+	}
+}
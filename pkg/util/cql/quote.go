@@ -0,0 +1,17 @@
+// Copyright (c) 2024 ScyllaDB.
+
+package cql
+
+import (
+	"strings"
+)
+
+// QuoteIdentifier returns a case-sensitive CQL identifier that can safely be used in statements.
+func QuoteIdentifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+// QuoteString returns a CQL string literal that can safely be used in statements.
+func QuoteString(s string) string {
+	return `'` + strings.ReplaceAll(s, `'`, `''`) + `'`
+}